@@ -9,6 +9,9 @@ const (
 	GrpcUserIDMetadataKey = "user-id"
 	GrpcTokenMetadataKey  = "authorization"
 	HttpUserIDHeaderKey   = "Grpc-Metadata-User-Id"
+
+	GrpcCartTokenMetadataKey = "cart-token"
+	HttpCartTokenHeaderKey   = "Grpc-Metadata-Cart-Token"
 )
 
 type userIDCtxKeyType struct{}
@@ -24,6 +27,16 @@ func UserIDFromContext(ctx context.Context) string {
 	return ""
 }
 
+// CartTokenFromContext 获取网关为游客签发的购物车令牌
+func CartTokenFromContext(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, GrpcCartTokenMetadataKey)
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return ""
+}
+
 func GatewayUserIDFromContext(ctx context.Context) string {
 	contextUserID, ok := ctx.Value(userIDCtxKey).(string)
 	if ok {
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// CartTokenHeader 客户端携带购物车令牌的请求头，网关签发新令牌时也通过该响应头返回
+	CartTokenHeader = "X-Cart-Token"
+	// CartTokenCookie 保存购物车令牌的 Cookie
+	CartTokenCookie = "cart_token"
+	// cartTokenMaxAge Cookie 有效期，游客购物车本身由订单服务按最后访问时间过期
+	cartTokenMaxAge = 30 * 24 * time.Hour
+	// CartMergeQueueKey 登录时提交的游客购物车合并请求队列，用户服务写入，订单服务消费
+	CartMergeQueueKey = "cart:merge:queue"
+)
+
+// CartMergeRequest 游客购物车合并请求，以 JSON 写入 CartMergeQueueKey 队列
+type CartMergeRequest struct {
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	// Attempts 已失败的合并次数，由订单服务重新排队时累加
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// NewCartToken 生成购物车令牌，32 位十六进制随机串，不包含任何用户信息
+func NewCartToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// IsValidCartToken 检查购物车令牌格式
+func IsValidCartToken(token string) bool {
+	if len(token) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
+
+// CartTokenHandler 为游客签发购物车令牌，并将令牌写入 gRPC metadata
+// 令牌从 X-Cart-Token 请求头或 cart_token Cookie 中读取；未登录且没有有效令牌时签发新令牌。
+// 必须在 BuildMetadataMiddleware 之后执行，否则写入的 metadata 请求头会被清除。
+func CartTokenHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(CartTokenHeader)
+		if token == "" {
+			if cookie, err := r.Cookie(CartTokenCookie); err == nil {
+				token = cookie.Value
+			}
+		}
+		r.Header.Del(HttpCartTokenHeaderKey)
+
+		if !IsValidCartToken(token) {
+			token = ""
+			if r.Header.Get(HttpUserIDHeaderKey) == "" {
+				token = NewCartToken()
+				w.Header().Set(CartTokenHeader, token)
+				http.SetCookie(w, &http.Cookie{
+					Name:     CartTokenCookie,
+					Value:    token,
+					Path:     "/",
+					MaxAge:   int(cartTokenMaxAge.Seconds()),
+					HttpOnly: true,
+					SameSite: http.SameSiteLaxMode,
+				})
+			}
+		}
+
+		// Gateway 会将该请求头写入 gRPC metadata 的 cart-token
+		if token != "" {
+			r.Header.Set(HttpCartTokenHeaderKey, token)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// BuildCartTokenMiddleware 游客购物车令牌中间件，见 CartTokenHandler
+func BuildCartTokenMiddleware() echo.MiddlewareFunc {
+	return echo.WrapMiddleware(CartTokenHandler)
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Operators 运营人员白名单
+// 用户服务没有角色，后台接口按配置的运营人员用户ID授权，名单为空时拒绝所有后台请求。
+type Operators struct {
+	userIDs map[string]struct{}
+}
+
+// NewOperators 创建运营人员白名单
+func NewOperators(userIDs []string) *Operators {
+	o := &Operators{userIDs: make(map[string]struct{}, len(userIDs))}
+	for _, userID := range userIDs {
+		if userID != "" {
+			o.userIDs[userID] = struct{}{}
+		}
+	}
+	return o
+}
+
+// Contains 用户是否为运营人员
+func (o *Operators) Contains(userID string) bool {
+	_, ok := o.userIDs[userID]
+	return ok
+}
+
+// Authorize 校验调用方为运营人员并返回其用户ID
+// 未认证返回 Unauthenticated，不在白名单中返回 PermissionDenied。
+func (o *Operators) Authorize(ctx context.Context) (string, error) {
+	userID := UserIDFromContext(ctx)
+	if userID == "" {
+		return "", status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	if !o.Contains(userID) {
+		return "", status.Error(codes.PermissionDenied, "无运营权限")
+	}
+	return userID, nil
+}
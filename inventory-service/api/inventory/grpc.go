@@ -2,6 +2,7 @@ package inventory
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -147,24 +148,39 @@ func (s *Server) ReserveInventory(ctx context.Context, req *pb.ReserveInventoryR
 		}
 	}
 
-	// 设置30分钟后过期
+	// 过期时间由订单服务按支付截止时间指定，未指定时默认30分钟
 	expiresAt := time.Now().Add(30 * time.Minute)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.AsTime().After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = req.ExpiresAt.AsTime()
+	}
 
 	reservations, err := s.businessService.ReserveInventoryWithValidation(ctx, orderID, items, &expiresAt)
 	if err != nil {
-		if err == inventoryDomain.ErrInsufficientInventory {
-			return nil, status.Errorf(codes.FailedPrecondition, "insufficient inventory")
+		var insufficientErr *inventoryDomain.InsufficientInventoryError
+		if errors.As(err, &insufficientErr) {
+			shortfalls := make([]*pb.InventoryShortfall, len(insufficientErr.Shortfalls))
+			for i, shortfall := range insufficientErr.Shortfalls {
+				shortfalls[i] = &pb.InventoryShortfall{
+					SkuId:     shortfall.SkuID.String(),
+					Requested: shortfall.Requested,
+					Available: shortfall.Available,
+				}
+			}
+			return &pb.ReserveInventoryResp{
+				Success:    false,
+				Message:    "insufficient inventory",
+				Shortfalls: shortfalls,
+			}, nil
 		}
 		return nil, status.Errorf(codes.Internal, "failed to reserve inventory: %v", err)
 	}
 
-	// 保存预占记录
+	// 预占记录已在预占事务中写入
 	pbReservations := make([]*pb.InventoryReservation, len(reservations))
 	for i, res := range reservations {
-		// 创建预占记录
-		if _, err := s.reservationApp.CreateReservation(ctx, res.SkuID, res.OrderID, res.Quantity, res.ExpiresAt); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create reservation: %v", err)
-		}
 		pbReservations[i] = s.reservationToPB(res)
 	}
 
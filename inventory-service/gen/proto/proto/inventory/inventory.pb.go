@@ -667,8 +667,9 @@ func (x *UpdateInventoryResp) GetInventory() *Inventory {
 // 预占库存请求
 type ReserveInventoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
-	Items         []*ReserveItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                    // 预占商品列表
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`       // 订单ID
+	Items         []*ReserveItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                          // 预占商品列表
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 预占过期时间，为空时默认30分钟
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -717,6 +718,13 @@ func (x *ReserveInventoryReq) GetItems() []*ReserveItem {
 	return nil
 }
 
+func (x *ReserveInventoryReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 // 预占商品项
 type ReserveItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -776,6 +784,7 @@ type ReserveInventoryResp struct {
 	Success       bool                    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`          // 预占结果
 	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`           // 错误信息
 	Reservations  []*InventoryReservation `protobuf:"bytes,3,rep,name=reservations,proto3" json:"reservations,omitempty"` // 预占记录
+	Shortfalls    []*InventoryShortfall   `protobuf:"bytes,4,rep,name=shortfalls,proto3" json:"shortfalls,omitempty"`     // 库存不足时每个SKU的缺口
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -831,6 +840,74 @@ func (x *ReserveInventoryResp) GetReservations() []*InventoryReservation {
 	return nil
 }
 
+func (x *ReserveInventoryResp) GetShortfalls() []*InventoryShortfall {
+	if x != nil {
+		return x.Shortfalls
+	}
+	return nil
+}
+
+// SKU库存缺口
+type InventoryShortfall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"` // SKU ID
+	Requested     int32                  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`     // 请求数量
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`     // 当前可用数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryShortfall) Reset() {
+	*x = InventoryShortfall{}
+	mi := &file_proto_inventory_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryShortfall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryShortfall) ProtoMessage() {}
+
+func (x *InventoryShortfall) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryShortfall.ProtoReflect.Descriptor instead.
+func (*InventoryShortfall) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InventoryShortfall) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *InventoryShortfall) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *InventoryShortfall) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
 // 释放预占库存请求
 type ReleaseReservedInventoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -841,7 +918,7 @@ type ReleaseReservedInventoryReq struct {
 
 func (x *ReleaseReservedInventoryReq) Reset() {
 	*x = ReleaseReservedInventoryReq{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[12]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +930,7 @@ func (x *ReleaseReservedInventoryReq) String() string {
 func (*ReleaseReservedInventoryReq) ProtoMessage() {}
 
 func (x *ReleaseReservedInventoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[12]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +943,7 @@ func (x *ReleaseReservedInventoryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseReservedInventoryReq.ProtoReflect.Descriptor instead.
 func (*ReleaseReservedInventoryReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{12}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReleaseReservedInventoryReq) GetOrderId() string {
@@ -887,7 +964,7 @@ type ReleaseReservedInventoryResp struct {
 
 func (x *ReleaseReservedInventoryResp) Reset() {
 	*x = ReleaseReservedInventoryResp{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[13]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +976,7 @@ func (x *ReleaseReservedInventoryResp) String() string {
 func (*ReleaseReservedInventoryResp) ProtoMessage() {}
 
 func (x *ReleaseReservedInventoryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[13]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +989,7 @@ func (x *ReleaseReservedInventoryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseReservedInventoryResp.ProtoReflect.Descriptor instead.
 func (*ReleaseReservedInventoryResp) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{13}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReleaseReservedInventoryResp) GetSuccess() bool {
@@ -939,7 +1016,7 @@ type ConfirmInventoryDeductionReq struct {
 
 func (x *ConfirmInventoryDeductionReq) Reset() {
 	*x = ConfirmInventoryDeductionReq{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[14]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1028,7 @@ func (x *ConfirmInventoryDeductionReq) String() string {
 func (*ConfirmInventoryDeductionReq) ProtoMessage() {}
 
 func (x *ConfirmInventoryDeductionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[14]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1041,7 @@ func (x *ConfirmInventoryDeductionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmInventoryDeductionReq.ProtoReflect.Descriptor instead.
 func (*ConfirmInventoryDeductionReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{14}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ConfirmInventoryDeductionReq) GetOrderId() string {
@@ -985,7 +1062,7 @@ type ConfirmInventoryDeductionResp struct {
 
 func (x *ConfirmInventoryDeductionResp) Reset() {
 	*x = ConfirmInventoryDeductionResp{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[15]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1074,7 @@ func (x *ConfirmInventoryDeductionResp) String() string {
 func (*ConfirmInventoryDeductionResp) ProtoMessage() {}
 
 func (x *ConfirmInventoryDeductionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[15]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1087,7 @@ func (x *ConfirmInventoryDeductionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmInventoryDeductionResp.ProtoReflect.Descriptor instead.
 func (*ConfirmInventoryDeductionResp) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{15}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ConfirmInventoryDeductionResp) GetSuccess() bool {
@@ -1039,7 +1116,7 @@ type GetInventoryLogsReq struct {
 
 func (x *GetInventoryLogsReq) Reset() {
 	*x = GetInventoryLogsReq{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[16]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1051,7 +1128,7 @@ func (x *GetInventoryLogsReq) String() string {
 func (*GetInventoryLogsReq) ProtoMessage() {}
 
 func (x *GetInventoryLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[16]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1141,7 @@ func (x *GetInventoryLogsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInventoryLogsReq.ProtoReflect.Descriptor instead.
 func (*GetInventoryLogsReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{16}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetInventoryLogsReq) GetSkuId() string {
@@ -1101,7 +1178,7 @@ type GetInventoryLogsResp struct {
 
 func (x *GetInventoryLogsResp) Reset() {
 	*x = GetInventoryLogsResp{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[17]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1113,7 +1190,7 @@ func (x *GetInventoryLogsResp) String() string {
 func (*GetInventoryLogsResp) ProtoMessage() {}
 
 func (x *GetInventoryLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[17]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1203,7 @@ func (x *GetInventoryLogsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInventoryLogsResp.ProtoReflect.Descriptor instead.
 func (*GetInventoryLogsResp) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{17}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetInventoryLogsResp) GetLogs() []*InventoryLog {
@@ -1167,7 +1244,7 @@ type CheckInventoryAvailabilityReq struct {
 
 func (x *CheckInventoryAvailabilityReq) Reset() {
 	*x = CheckInventoryAvailabilityReq{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[18]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1179,7 +1256,7 @@ func (x *CheckInventoryAvailabilityReq) String() string {
 func (*CheckInventoryAvailabilityReq) ProtoMessage() {}
 
 func (x *CheckInventoryAvailabilityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[18]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1269,7 @@ func (x *CheckInventoryAvailabilityReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckInventoryAvailabilityReq.ProtoReflect.Descriptor instead.
 func (*CheckInventoryAvailabilityReq) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{18}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CheckInventoryAvailabilityReq) GetItems() []*ReserveItem {
@@ -1213,7 +1290,7 @@ type CheckInventoryAvailabilityResp struct {
 
 func (x *CheckInventoryAvailabilityResp) Reset() {
 	*x = CheckInventoryAvailabilityResp{}
-	mi := &file_proto_inventory_inventory_proto_msgTypes[19]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1225,7 +1302,7 @@ func (x *CheckInventoryAvailabilityResp) String() string {
 func (*CheckInventoryAvailabilityResp) ProtoMessage() {}
 
 func (x *CheckInventoryAvailabilityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_inventory_proto_msgTypes[19]
+	mi := &file_proto_inventory_inventory_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,7 +1315,7 @@ func (x *CheckInventoryAvailabilityResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckInventoryAvailabilityResp.ProtoReflect.Descriptor instead.
 func (*CheckInventoryAvailabilityResp) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{19}
+	return file_proto_inventory_inventory_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CheckInventoryAvailabilityResp) GetAvailable() bool {
@@ -1303,17 +1380,26 @@ const file_proto_inventory_inventory_proto_rawDesc = "" +
 	"\x04type\x18\x03 \x01(\x0e2(.inventory.inventory.InventoryChangeTypeR\x04type\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\"S\n" +
 	"\x13UpdateInventoryResp\x12<\n" +
-	"\tinventory\x18\x01 \x01(\v2\x1e.inventory.inventory.InventoryR\tinventory\"h\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1e.inventory.inventory.InventoryR\tinventory\"\xa3\x01\n" +
 	"\x13ReserveInventoryReq\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x126\n" +
-	"\x05items\x18\x02 \x03(\v2 .inventory.inventory.ReserveItemR\x05items\"@\n" +
+	"\x05items\x18\x02 \x03(\v2 .inventory.inventory.ReserveItemR\x05items\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"@\n" +
 	"\vReserveItem\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x99\x01\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xe2\x01\n" +
 	"\x14ReserveInventoryResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12M\n" +
-	"\freservations\x18\x03 \x03(\v2).inventory.inventory.InventoryReservationR\freservations\"8\n" +
+	"\freservations\x18\x03 \x03(\v2).inventory.inventory.InventoryReservationR\freservations\x12G\n" +
+	"\n" +
+	"shortfalls\x18\x04 \x03(\v2'.inventory.inventory.InventoryShortfallR\n" +
+	"shortfalls\"g\n" +
+	"\x12InventoryShortfall\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\x05R\trequested\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\"8\n" +
 	"\x1bReleaseReservedInventoryReq\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\"R\n" +
 	"\x1cReleaseReservedInventoryResp\x12\x18\n" +
@@ -1369,7 +1455,7 @@ func file_proto_inventory_inventory_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_inventory_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_proto_inventory_inventory_proto_goTypes = []any{
 	(InventoryChangeType)(0),               // 0: inventory.inventory.InventoryChangeType
 	(*Inventory)(nil),                      // 1: inventory.inventory.Inventory
@@ -1384,51 +1470,54 @@ var file_proto_inventory_inventory_proto_goTypes = []any{
 	(*ReserveInventoryReq)(nil),            // 10: inventory.inventory.ReserveInventoryReq
 	(*ReserveItem)(nil),                    // 11: inventory.inventory.ReserveItem
 	(*ReserveInventoryResp)(nil),           // 12: inventory.inventory.ReserveInventoryResp
-	(*ReleaseReservedInventoryReq)(nil),    // 13: inventory.inventory.ReleaseReservedInventoryReq
-	(*ReleaseReservedInventoryResp)(nil),   // 14: inventory.inventory.ReleaseReservedInventoryResp
-	(*ConfirmInventoryDeductionReq)(nil),   // 15: inventory.inventory.ConfirmInventoryDeductionReq
-	(*ConfirmInventoryDeductionResp)(nil),  // 16: inventory.inventory.ConfirmInventoryDeductionResp
-	(*GetInventoryLogsReq)(nil),            // 17: inventory.inventory.GetInventoryLogsReq
-	(*GetInventoryLogsResp)(nil),           // 18: inventory.inventory.GetInventoryLogsResp
-	(*CheckInventoryAvailabilityReq)(nil),  // 19: inventory.inventory.CheckInventoryAvailabilityReq
-	(*CheckInventoryAvailabilityResp)(nil), // 20: inventory.inventory.CheckInventoryAvailabilityResp
-	(*timestamppb.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(*InventoryShortfall)(nil),             // 13: inventory.inventory.InventoryShortfall
+	(*ReleaseReservedInventoryReq)(nil),    // 14: inventory.inventory.ReleaseReservedInventoryReq
+	(*ReleaseReservedInventoryResp)(nil),   // 15: inventory.inventory.ReleaseReservedInventoryResp
+	(*ConfirmInventoryDeductionReq)(nil),   // 16: inventory.inventory.ConfirmInventoryDeductionReq
+	(*ConfirmInventoryDeductionResp)(nil),  // 17: inventory.inventory.ConfirmInventoryDeductionResp
+	(*GetInventoryLogsReq)(nil),            // 18: inventory.inventory.GetInventoryLogsReq
+	(*GetInventoryLogsResp)(nil),           // 19: inventory.inventory.GetInventoryLogsResp
+	(*CheckInventoryAvailabilityReq)(nil),  // 20: inventory.inventory.CheckInventoryAvailabilityReq
+	(*CheckInventoryAvailabilityResp)(nil), // 21: inventory.inventory.CheckInventoryAvailabilityResp
+	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
 }
 var file_proto_inventory_inventory_proto_depIdxs = []int32{
-	21, // 0: inventory.inventory.Inventory.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 0: inventory.inventory.Inventory.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 1: inventory.inventory.InventoryLog.type:type_name -> inventory.inventory.InventoryChangeType
-	21, // 2: inventory.inventory.InventoryLog.created_at:type_name -> google.protobuf.Timestamp
-	21, // 3: inventory.inventory.InventoryReservation.created_at:type_name -> google.protobuf.Timestamp
-	21, // 4: inventory.inventory.InventoryReservation.expires_at:type_name -> google.protobuf.Timestamp
+	22, // 2: inventory.inventory.InventoryLog.created_at:type_name -> google.protobuf.Timestamp
+	22, // 3: inventory.inventory.InventoryReservation.created_at:type_name -> google.protobuf.Timestamp
+	22, // 4: inventory.inventory.InventoryReservation.expires_at:type_name -> google.protobuf.Timestamp
 	1,  // 5: inventory.inventory.GetInventoryResp.inventory:type_name -> inventory.inventory.Inventory
 	1,  // 6: inventory.inventory.BatchGetInventoryResp.inventories:type_name -> inventory.inventory.Inventory
 	0,  // 7: inventory.inventory.UpdateInventoryReq.type:type_name -> inventory.inventory.InventoryChangeType
 	1,  // 8: inventory.inventory.UpdateInventoryResp.inventory:type_name -> inventory.inventory.Inventory
 	11, // 9: inventory.inventory.ReserveInventoryReq.items:type_name -> inventory.inventory.ReserveItem
-	3,  // 10: inventory.inventory.ReserveInventoryResp.reservations:type_name -> inventory.inventory.InventoryReservation
-	2,  // 11: inventory.inventory.GetInventoryLogsResp.logs:type_name -> inventory.inventory.InventoryLog
-	11, // 12: inventory.inventory.CheckInventoryAvailabilityReq.items:type_name -> inventory.inventory.ReserveItem
-	4,  // 13: inventory.inventory.InventoryService.GetInventory:input_type -> inventory.inventory.GetInventoryReq
-	6,  // 14: inventory.inventory.InventoryService.BatchGetInventory:input_type -> inventory.inventory.BatchGetInventoryReq
-	8,  // 15: inventory.inventory.InventoryService.UpdateInventory:input_type -> inventory.inventory.UpdateInventoryReq
-	10, // 16: inventory.inventory.InventoryService.ReserveInventory:input_type -> inventory.inventory.ReserveInventoryReq
-	13, // 17: inventory.inventory.InventoryService.ReleaseReservedInventory:input_type -> inventory.inventory.ReleaseReservedInventoryReq
-	15, // 18: inventory.inventory.InventoryService.ConfirmInventoryDeduction:input_type -> inventory.inventory.ConfirmInventoryDeductionReq
-	17, // 19: inventory.inventory.InventoryService.GetInventoryLogs:input_type -> inventory.inventory.GetInventoryLogsReq
-	19, // 20: inventory.inventory.InventoryService.CheckInventoryAvailability:input_type -> inventory.inventory.CheckInventoryAvailabilityReq
-	5,  // 21: inventory.inventory.InventoryService.GetInventory:output_type -> inventory.inventory.GetInventoryResp
-	7,  // 22: inventory.inventory.InventoryService.BatchGetInventory:output_type -> inventory.inventory.BatchGetInventoryResp
-	9,  // 23: inventory.inventory.InventoryService.UpdateInventory:output_type -> inventory.inventory.UpdateInventoryResp
-	12, // 24: inventory.inventory.InventoryService.ReserveInventory:output_type -> inventory.inventory.ReserveInventoryResp
-	14, // 25: inventory.inventory.InventoryService.ReleaseReservedInventory:output_type -> inventory.inventory.ReleaseReservedInventoryResp
-	16, // 26: inventory.inventory.InventoryService.ConfirmInventoryDeduction:output_type -> inventory.inventory.ConfirmInventoryDeductionResp
-	18, // 27: inventory.inventory.InventoryService.GetInventoryLogs:output_type -> inventory.inventory.GetInventoryLogsResp
-	20, // 28: inventory.inventory.InventoryService.CheckInventoryAvailability:output_type -> inventory.inventory.CheckInventoryAvailabilityResp
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	22, // 10: inventory.inventory.ReserveInventoryReq.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 11: inventory.inventory.ReserveInventoryResp.reservations:type_name -> inventory.inventory.InventoryReservation
+	13, // 12: inventory.inventory.ReserveInventoryResp.shortfalls:type_name -> inventory.inventory.InventoryShortfall
+	2,  // 13: inventory.inventory.GetInventoryLogsResp.logs:type_name -> inventory.inventory.InventoryLog
+	11, // 14: inventory.inventory.CheckInventoryAvailabilityReq.items:type_name -> inventory.inventory.ReserveItem
+	4,  // 15: inventory.inventory.InventoryService.GetInventory:input_type -> inventory.inventory.GetInventoryReq
+	6,  // 16: inventory.inventory.InventoryService.BatchGetInventory:input_type -> inventory.inventory.BatchGetInventoryReq
+	8,  // 17: inventory.inventory.InventoryService.UpdateInventory:input_type -> inventory.inventory.UpdateInventoryReq
+	10, // 18: inventory.inventory.InventoryService.ReserveInventory:input_type -> inventory.inventory.ReserveInventoryReq
+	14, // 19: inventory.inventory.InventoryService.ReleaseReservedInventory:input_type -> inventory.inventory.ReleaseReservedInventoryReq
+	16, // 20: inventory.inventory.InventoryService.ConfirmInventoryDeduction:input_type -> inventory.inventory.ConfirmInventoryDeductionReq
+	18, // 21: inventory.inventory.InventoryService.GetInventoryLogs:input_type -> inventory.inventory.GetInventoryLogsReq
+	20, // 22: inventory.inventory.InventoryService.CheckInventoryAvailability:input_type -> inventory.inventory.CheckInventoryAvailabilityReq
+	5,  // 23: inventory.inventory.InventoryService.GetInventory:output_type -> inventory.inventory.GetInventoryResp
+	7,  // 24: inventory.inventory.InventoryService.BatchGetInventory:output_type -> inventory.inventory.BatchGetInventoryResp
+	9,  // 25: inventory.inventory.InventoryService.UpdateInventory:output_type -> inventory.inventory.UpdateInventoryResp
+	12, // 26: inventory.inventory.InventoryService.ReserveInventory:output_type -> inventory.inventory.ReserveInventoryResp
+	15, // 27: inventory.inventory.InventoryService.ReleaseReservedInventory:output_type -> inventory.inventory.ReleaseReservedInventoryResp
+	17, // 28: inventory.inventory.InventoryService.ConfirmInventoryDeduction:output_type -> inventory.inventory.ConfirmInventoryDeductionResp
+	19, // 29: inventory.inventory.InventoryService.GetInventoryLogs:output_type -> inventory.inventory.GetInventoryLogsResp
+	21, // 30: inventory.inventory.InventoryService.CheckInventoryAvailability:output_type -> inventory.inventory.CheckInventoryAvailabilityResp
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_inventory_proto_init() }
@@ -1442,7 +1531,7 @@ func file_proto_inventory_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_inventory_proto_rawDesc), len(file_proto_inventory_inventory_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   20,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
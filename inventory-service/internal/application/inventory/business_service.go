@@ -54,22 +54,7 @@ func (s *BusinessService) ReserveInventoryWithValidation(ctx context.Context, or
 		return nil, fmt.Errorf("invalid products found: %v", invalidSkus)
 	}
 
-	// 2. 检查库存可用性
-	available, insufficientSkus, err := s.inventoryService.CheckInventoryAvailability(ctx, items)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check inventory availability: %w", err)
-	}
-
-	if !available {
-		// 通知订单服务库存不足
-		if err := s.clientManager.OrderClient.NotifyInventoryReserved(ctx, orderID.String(), false,
-			fmt.Sprintf("insufficient inventory for skus: %v", insufficientSkus)); err != nil {
-			// 记录错误但不阻塞主流程
-		}
-		return nil, fmt.Errorf("insufficient inventory for skus: %v", insufficientSkus)
-	}
-
-	// 3. 执行库存预占
+	// 2. 执行库存预占（库存检查与扣减在同一事务内完成）
 	reservations, err := s.inventoryService.ReserveInventory(ctx, orderID, items, expiresAt)
 	if err != nil {
 		// 通知订单服务预占失败
@@ -79,7 +64,7 @@ func (s *BusinessService) ReserveInventoryWithValidation(ctx context.Context, or
 		return nil, fmt.Errorf("failed to reserve inventory: %w", err)
 	}
 
-	// 4. 通知订单服务预占成功
+	// 3. 通知订单服务预占成功
 	if err := s.clientManager.OrderClient.NotifyInventoryReserved(ctx, orderID.String(), true, "inventory reserved successfully"); err != nil {
 		// 记录错误但不回滚，因为库存已经预占成功
 	}
@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -171,47 +172,102 @@ type ReserveItem struct {
 }
 
 // BatchReserveInventory 批量预占库存
+// 所有SKU在一个事务内预占，任一SKU不足时整体失败并返回 InsufficientInventoryError；
+// 订单已有预占记录时直接返回已有记录，保证重试幂等。
 func (s *DomainService) BatchReserveInventory(ctx context.Context, orderID uuid.UUID, items []ReserveItem, expiresAt *time.Time) ([]*InventoryReservation, error) {
 	if len(items) == 0 {
 		return nil, ErrInvalidQuantity
 	}
 
-	// 首先检查所有库存是否充足
-	available, _, err := s.CheckInventoryAvailability(ctx, items)
+	// 合并重复SKU并按SKU ID排序，保证并发事务以相同顺序加锁，避免死锁
+	merged, err := mergeReserveItems(items)
 	if err != nil {
 		return nil, err
 	}
-	if !available {
-		return nil, ErrInsufficientInventory
+	skuIDs := make([]uuid.UUID, len(merged))
+	for i, item := range merged {
+		skuIDs[i] = item.SkuID
 	}
 
 	var reservations []*InventoryReservation
+	err = s.inventoryRepo.ReserveBatch(ctx, orderID, skuIDs, func(locked map[uuid.UUID]*Inventory, existing []*InventoryReservation) (*ReserveChanges, error) {
+		if len(existing) > 0 {
+			reservations = existing
+			return nil, nil
+		}
 
-	// 为每个商品创建预占记录并更新库存
-	for _, item := range items {
-		// 更新库存（预占）
-		_, err := s.UpdateInventoryQuantity(
-			ctx,
-			item.SkuID,
-			InventoryChangeTypeReserve,
-			item.Quantity,
-			"订单预占",
-			&orderID,
-			nil,
-		)
-		if err != nil {
-			// TODO: 需要回滚已经预占的库存
-			return nil, err
+		// 先检查全部SKU，一次性返回所有缺口
+		var shortfalls []Shortfall
+		for _, item := range merged {
+			var available int32
+			if inv, ok := locked[item.SkuID]; ok {
+				available = inv.AvailableQuantity
+			}
+			if available < item.Quantity {
+				shortfalls = append(shortfalls, Shortfall{
+					SkuID:     item.SkuID,
+					Requested: item.Quantity,
+					Available: available,
+				})
+			}
+		}
+		if len(shortfalls) > 0 {
+			return nil, &InsufficientInventoryError{Shortfalls: shortfalls}
 		}
 
-		// 创建预占记录
-		reservation := NewInventoryReservation(item.SkuID, orderID, item.Quantity, expiresAt)
-		reservations = append(reservations, reservation)
+		changes := &ReserveChanges{}
+		for _, item := range merged {
+			inv := locked[item.SkuID]
+			beforeQuantity := inv.AvailableQuantity
+			if err := inv.UpdateQuantity(InventoryChangeTypeReserve, item.Quantity); err != nil {
+				return nil, err
+			}
+
+			changes.Inventories = append(changes.Inventories, inv)
+			changes.Reservations = append(changes.Reservations, NewInventoryReservation(item.SkuID, orderID, item.Quantity, expiresAt))
+			changes.Logs = append(changes.Logs, NewInventoryLog(
+				item.SkuID,
+				InventoryChangeTypeReserve,
+				-item.Quantity,
+				beforeQuantity,
+				inv.AvailableQuantity,
+				"订单预占",
+				&orderID,
+				nil,
+			))
+		}
+
+		reservations = changes.Reservations
+		return changes, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return reservations, nil
 }
 
+// mergeReserveItems 合并相同SKU的预占数量并按SKU ID排序
+func mergeReserveItems(items []ReserveItem) ([]ReserveItem, error) {
+	quantities := make(map[uuid.UUID]int32, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		quantities[item.SkuID] += item.Quantity
+	}
+
+	merged := make([]ReserveItem, 0, len(quantities))
+	for skuID, quantity := range quantities {
+		merged = append(merged, ReserveItem{SkuID: skuID, Quantity: quantity})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].SkuID.String() < merged[j].SkuID.String()
+	})
+
+	return merged, nil
+}
+
 // ReleaseReservation 释放预占
 func (s *DomainService) ReleaseReservation(ctx context.Context, reservation *InventoryReservation) error {
 	if !reservation.CanRelease() {
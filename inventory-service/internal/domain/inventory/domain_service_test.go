@@ -0,0 +1,125 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReserveRepository 仅实现批量预占所需的方法
+type fakeReserveRepository struct {
+	Repository
+	inventories map[uuid.UUID]*Inventory
+	existing    []*InventoryReservation
+	lockOrder   []uuid.UUID
+	committed   *ReserveChanges
+}
+
+func (r *fakeReserveRepository) ReserveBatch(ctx context.Context, orderID uuid.UUID, skuIDs []uuid.UUID, reserve ReserveFunc) error {
+	r.lockOrder = skuIDs
+	locked := make(map[uuid.UUID]*Inventory)
+	for _, skuID := range skuIDs {
+		if inv, ok := r.inventories[skuID]; ok {
+			copied := *inv
+			locked[skuID] = &copied
+		}
+	}
+
+	changes, err := reserve(locked, r.existing)
+	if err != nil {
+		return err
+	}
+	r.committed = changes
+	return nil
+}
+
+func TestBatchReserveInventory(t *testing.T) {
+	skuA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	skuB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	skuC := uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	missing := uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+	orderID := uuid.New()
+	expiresAt := time.Now().Add(30 * time.Minute)
+
+	newRepo := func() *fakeReserveRepository {
+		return &fakeReserveRepository{
+			inventories: map[uuid.UUID]*Inventory{
+				skuA: NewInventory(skuA, 10, 1),
+				skuB: NewInventory(skuB, 2, 1),
+				skuC: NewInventory(skuC, 5, 1),
+			},
+		}
+	}
+
+	t.Run("reserves all skus in sorted order", func(t *testing.T) {
+		repo := newRepo()
+		ds := NewDomainService(repo, nil)
+
+		reservations, err := ds.BatchReserveInventory(context.Background(), orderID, []ReserveItem{
+			{SkuID: skuC, Quantity: 2},
+			{SkuID: skuA, Quantity: 3},
+			{SkuID: skuC, Quantity: 1},
+		}, &expiresAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{skuA, skuC}, repo.lockOrder)
+		require.Len(t, reservations, 2)
+		assert.Equal(t, int32(3), reservations[0].Quantity)
+		assert.Equal(t, int32(3), reservations[1].Quantity)
+		require.NotNil(t, repo.committed)
+		assert.Equal(t, int32(7), repo.committed.Inventories[0].AvailableQuantity)
+		assert.Equal(t, int32(2), repo.committed.Inventories[1].AvailableQuantity)
+		assert.Len(t, repo.committed.Logs, 2)
+	})
+
+	t.Run("reports every shortfall and writes nothing", func(t *testing.T) {
+		repo := newRepo()
+		ds := NewDomainService(repo, nil)
+
+		_, err := ds.BatchReserveInventory(context.Background(), orderID, []ReserveItem{
+			{SkuID: skuA, Quantity: 1},
+			{SkuID: skuB, Quantity: 3},
+			{SkuID: missing, Quantity: 1},
+		}, &expiresAt)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrInsufficientInventory))
+		var insufficientErr *InsufficientInventoryError
+		require.True(t, errors.As(err, &insufficientErr))
+		assert.Equal(t, []Shortfall{
+			{SkuID: skuB, Requested: 3, Available: 2},
+			{SkuID: missing, Requested: 1, Available: 0},
+		}, insufficientErr.Shortfalls)
+		assert.Nil(t, repo.committed)
+	})
+
+	t.Run("returns existing reservations on retry", func(t *testing.T) {
+		repo := newRepo()
+		existing := NewInventoryReservation(skuA, orderID, 1, &expiresAt)
+		repo.existing = []*InventoryReservation{existing}
+		ds := NewDomainService(repo, nil)
+
+		reservations, err := ds.BatchReserveInventory(context.Background(), orderID, []ReserveItem{
+			{SkuID: skuA, Quantity: 1},
+		}, &expiresAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*InventoryReservation{existing}, reservations)
+		assert.Nil(t, repo.committed)
+	})
+
+	t.Run("rejects non-positive quantity", func(t *testing.T) {
+		ds := NewDomainService(newRepo(), nil)
+
+		_, err := ds.BatchReserveInventory(context.Background(), orderID, []ReserveItem{
+			{SkuID: skuA, Quantity: 0},
+		}, &expiresAt)
+
+		assert.ErrorIs(t, err, ErrInvalidQuantity)
+	})
+}
@@ -1,6 +1,12 @@
 package inventory
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
 
 var (
 	// ErrInventoryNotFound 库存记录不存在
@@ -34,3 +40,26 @@ var (
 	ErrInvalidOrderID = errors.New("invalid order id")
 )
 
+// Shortfall 单个SKU的库存缺口
+type Shortfall struct {
+	SkuID     uuid.UUID
+	Requested int32
+	Available int32
+}
+
+// InsufficientInventoryError 库存不足错误，携带每个SKU的缺口明细
+type InsufficientInventoryError struct {
+	Shortfalls []Shortfall
+}
+
+func (e *InsufficientInventoryError) Error() string {
+	parts := make([]string, len(e.Shortfalls))
+	for i, s := range e.Shortfalls {
+		parts[i] = fmt.Sprintf("%s(requested=%d, available=%d)", s.SkuID, s.Requested, s.Available)
+	}
+	return ErrInsufficientInventory.Error() + ": " + strings.Join(parts, ", ")
+}
+
+func (e *InsufficientInventoryError) Unwrap() error {
+	return ErrInsufficientInventory
+}
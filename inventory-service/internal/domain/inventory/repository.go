@@ -34,6 +34,21 @@ type Repository interface {
 
 	// ListOutOfStock 查询售罄的商品
 	ListOutOfStock(ctx context.Context, offset, limit int) ([]*Inventory, int64, error)
+
+	// ReserveBatch 在同一个事务中按 skuIDs 的顺序逐行加锁，由 reserve 计算变更，
+	// 再写回库存并写入预占记录和变动日志；reserve 返回错误或 nil 变更时不做任何写入
+	ReserveBatch(ctx context.Context, orderID uuid.UUID, skuIDs []uuid.UUID, reserve ReserveFunc) error
+}
+
+// ReserveFunc 批量预占回调，locked 为已加锁的库存（不存在的SKU不在其中），
+// existing 为该订单已有的预占中记录
+type ReserveFunc func(locked map[uuid.UUID]*Inventory, existing []*InventoryReservation) (*ReserveChanges, error)
+
+// ReserveChanges 一次批量预占产生的变更
+type ReserveChanges struct {
+	Inventories  []*Inventory
+	Reservations []*InventoryReservation
+	Logs         []*InventoryLog
 }
 
 // LogRepository 库存日志仓储接口
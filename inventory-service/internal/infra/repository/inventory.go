@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/people257/poor-guy-shop/inventory-service/gen/gen/model"
 	"github.com/people257/poor-guy-shop/inventory-service/gen/gen/query"
@@ -85,7 +87,10 @@ func (r *InventoryRepository) Update(ctx context.Context, inv *inventory.Invento
 	inventoryModel := r.domainToModel(inv)
 
 	q := r.query.Inventory
-	_, err := q.WithContext(ctx).Where(q.ID.Eq(inventoryModel.ID)).Updates(inventoryModel)
+	_, err := q.WithContext(ctx).
+		Select(r.quantityColumns()...).
+		Where(q.ID.Eq(inventoryModel.ID)).
+		Updates(inventoryModel)
 	return err
 }
 
@@ -95,6 +100,7 @@ func (r *InventoryRepository) UpdateWithVersion(ctx context.Context, inv *invent
 
 	q := r.query.Inventory
 	result, err := q.WithContext(ctx).
+		Select(r.quantityColumns()...).
 		Where(q.ID.Eq(inventoryModel.ID), q.Version.Eq(version)).
 		Updates(inventoryModel)
 
@@ -109,6 +115,13 @@ func (r *InventoryRepository) UpdateWithVersion(ctx context.Context, inv *invent
 	return nil
 }
 
+// quantityColumns 更新库存时写入的列
+// 结构体更新会跳过零值字段，必须显式指定列，否则库存扣减到0时不会写入。
+func (r *InventoryRepository) quantityColumns() []field.Expr {
+	q := r.query.Inventory
+	return []field.Expr{q.AvailableQuantity, q.ReservedQuantity, q.TotalQuantity, q.AlertQuantity, q.UpdatedAt, q.Version}
+}
+
 // Delete 删除库存记录
 func (r *InventoryRepository) Delete(ctx context.Context, skuID uuid.UUID) error {
 	q := r.query.Inventory
@@ -203,6 +216,67 @@ func (r *InventoryRepository) ListOutOfStock(ctx context.Context, offset, limit
 	return inventories, total, nil
 }
 
+// ReserveBatch 在同一个事务中批量预占库存
+func (r *InventoryRepository) ReserveBatch(ctx context.Context, orderID uuid.UUID, skuIDs []uuid.UUID, reserve inventory.ReserveFunc) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txQuery := query.Use(tx)
+		inventoryRepo := NewInventoryRepository(tx, txQuery)
+		reservationRepo := NewReservationRepository(tx, txQuery)
+		logRepo := NewInventoryLogRepository(tx, txQuery)
+
+		// 按调用方给定的顺序逐行加锁
+		q := txQuery.Inventory
+		locked := make(map[uuid.UUID]*inventory.Inventory, len(skuIDs))
+		for _, skuID := range skuIDs {
+			inventoryModel, err := q.WithContext(ctx).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where(q.SkuID.Eq(skuID.String())).
+				First()
+			if err != nil {
+				if err == gorm.ErrRecordNotFound {
+					continue
+				}
+				return err
+			}
+			locked[skuID] = r.modelToDomain(inventoryModel)
+		}
+
+		existing, err := reservationRepo.GetByOrderID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		reserved := make([]*inventory.InventoryReservation, 0, len(existing))
+		for _, res := range existing {
+			if res.Status == inventory.ReservationStatusReserved {
+				reserved = append(reserved, res)
+			}
+		}
+
+		changes, err := reserve(locked, reserved)
+		if err != nil || changes == nil {
+			return err
+		}
+
+		for _, inv := range changes.Inventories {
+			if err := inventoryRepo.UpdateWithVersion(ctx, inv, inv.Version-1); err != nil {
+				return err
+			}
+		}
+		for _, res := range changes.Reservations {
+			if err := reservationRepo.Create(ctx, res); err != nil {
+				return err
+			}
+		}
+		for _, log := range changes.Logs {
+			if err := logRepo.Create(ctx, log); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // modelToDomain 将数据库模型转换为领域对象
 func (r *InventoryRepository) modelToDomain(model *model.Inventory) *inventory.Inventory {
 	id, _ := uuid.Parse(model.ID)
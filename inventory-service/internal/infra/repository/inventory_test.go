@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/inventory-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/inventory-service/internal/domain/inventory"
+)
+
+func TestInventoryRepository_ReserveBatchLastUnits(t *testing.T) {
+	dsn := os.Getenv("INVENTORY_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("INVENTORY_TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	repo := NewInventoryRepository(db, query.Use(db))
+	ds := inventory.NewDomainService(repo, nil)
+
+	skuID := uuid.New()
+	require.NoError(t, repo.Create(ctx, inventory.NewInventory(skuID, 3, 1)))
+	t.Cleanup(func() {
+		db.Exec("DELETE FROM inventory_logs WHERE sku_id = ?", skuID.String())
+		db.Exec("DELETE FROM inventory_reservations WHERE sku_id = ?", skuID.String())
+		db.Exec("DELETE FROM inventories WHERE sku_id = ?", skuID.String())
+	})
+	expiresAt := time.Now().Add(30 * time.Minute)
+
+	// 预占全部剩余库存后，可用库存必须写入0
+	_, err = ds.BatchReserveInventory(ctx, uuid.New(), []inventory.ReserveItem{{SkuID: skuID, Quantity: 3}}, &expiresAt)
+	require.NoError(t, err)
+
+	inv, err := repo.GetBySkuID(ctx, skuID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), inv.AvailableQuantity)
+	assert.Equal(t, int32(3), inv.ReservedQuantity)
+
+	_, err = ds.BatchReserveInventory(ctx, uuid.New(), []inventory.ReserveItem{{SkuID: skuID, Quantity: 1}}, &expiresAt)
+	assert.ErrorIs(t, err, inventory.ErrInsufficientInventory)
+}
@@ -0,0 +1,303 @@
+package aftersale
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/aftersale"
+	aftersaleapp "github.com/people257/poor-guy-shop/order-service/internal/application/aftersale"
+	aftersaledomain "github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// GrpcHandler 售后gRPC处理器
+type GrpcHandler struct {
+	pb.UnimplementedAfterSaleServiceServer
+	afterSaleService *aftersaleapp.Service
+	operators        *auth.Operators
+}
+
+// NewGrpcHandler 创建售后gRPC处理器
+func NewGrpcHandler(afterSaleService *aftersaleapp.Service, operators *auth.Operators) *GrpcHandler {
+	return &GrpcHandler{
+		afterSaleService: afterSaleService,
+		operators:        operators,
+	}
+}
+
+// ApplyAfterSale 申请售后
+func (h *GrpcHandler) ApplyAfterSale(ctx context.Context, req *pb.ApplyAfterSaleReq) (*pb.ApplyAfterSaleResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	amount, err := h.parseDecimal(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "退款金额格式错误: %v", err)
+	}
+
+	appReq := aftersaleapp.ApplyAfterSaleRequest{
+		UserID:          userID,
+		OrderID:         req.OrderId,
+		OrderItemID:     req.OrderItemId,
+		Type:            int32(req.Type),
+		Reason:          int32(req.Reason),
+		Description:     req.Description,
+		Quantity:        req.Quantity,
+		Amount:          amount,
+		EvidenceFileIDs: req.EvidenceFileIds,
+	}
+
+	afterSale, err := h.afterSaleService.ApplyAfterSale(ctx, appReq)
+	if err != nil {
+		return nil, h.afterSaleError(err, "申请售后失败")
+	}
+
+	return &pb.ApplyAfterSaleResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// GetAfterSale 获取售后详情
+func (h *GrpcHandler) GetAfterSale(ctx context.Context, req *pb.GetAfterSaleReq) (*pb.GetAfterSaleResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	afterSale, err := h.afterSaleService.GetAfterSale(ctx, req.AfterSaleId, userID)
+	if err != nil {
+		return nil, h.afterSaleError(err, "获取售后详情失败")
+	}
+
+	return &pb.GetAfterSaleResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// ListAfterSales 获取售后列表
+func (h *GrpcHandler) ListAfterSales(ctx context.Context, req *pb.ListAfterSalesReq) (*pb.ListAfterSalesResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	appReq := aftersaleapp.ListAfterSalesRequest{
+		UserID:   userID,
+		OrderID:  req.OrderId,
+		Status:   int32(req.Status),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	result, err := h.afterSaleService.ListAfterSales(ctx, appReq)
+	if err != nil {
+		return nil, h.afterSaleError(err, "获取售后列表失败")
+	}
+
+	afterSales := make([]*pb.AfterSale, 0, len(result.AfterSales))
+	for _, afterSale := range result.AfterSales {
+		afterSales = append(afterSales, h.entityToProto(afterSale))
+	}
+
+	return &pb.ListAfterSalesResp{
+		AfterSales: afterSales,
+		Total:      result.Total,
+	}, nil
+}
+
+// CancelAfterSale 撤销售后
+func (h *GrpcHandler) CancelAfterSale(ctx context.Context, req *pb.CancelAfterSaleReq) (*pb.CancelAfterSaleResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	afterSale, err := h.afterSaleService.CancelAfterSale(ctx, req.AfterSaleId, userID)
+	if err != nil {
+		return nil, h.afterSaleError(err, "撤销售后失败")
+	}
+
+	return &pb.CancelAfterSaleResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// SubmitReturn 填写退货物流
+func (h *GrpcHandler) SubmitReturn(ctx context.Context, req *pb.SubmitReturnReq) (*pb.SubmitReturnResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	if req.CarrierCode == "" || req.TrackingNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "承运商和物流单号不能为空")
+	}
+
+	appReq := aftersaleapp.SubmitReturnRequest{
+		AfterSaleID:    req.AfterSaleId,
+		UserID:         userID,
+		CarrierCode:    req.CarrierCode,
+		TrackingNumber: req.TrackingNumber,
+	}
+
+	afterSale, err := h.afterSaleService.SubmitReturn(ctx, appReq)
+	if err != nil {
+		return nil, h.afterSaleError(err, "填写退货物流失败")
+	}
+
+	return &pb.SubmitReturnResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// ReviewAfterSale 审核售后，仅运营人员可调用
+func (h *GrpcHandler) ReviewAfterSale(ctx context.Context, req *pb.ReviewAfterSaleReq) (*pb.ReviewAfterSaleResp, error) {
+	operatorID, err := h.operators.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Approved && req.RejectReason == "" {
+		return nil, status.Error(codes.InvalidArgument, "拒绝原因不能为空")
+	}
+
+	amount, err := h.parseDecimal(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "退款金额格式错误: %v", err)
+	}
+
+	appReq := aftersaleapp.ReviewAfterSaleRequest{
+		AfterSaleID:  req.AfterSaleId,
+		OperatorID:   operatorID,
+		Approved:     req.Approved,
+		Amount:       amount,
+		RejectReason: req.RejectReason,
+	}
+
+	afterSale, err := h.afterSaleService.ReviewAfterSale(ctx, appReq)
+	if err != nil {
+		return nil, h.afterSaleError(err, "审核售后失败")
+	}
+
+	return &pb.ReviewAfterSaleResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// ReceiveReturn 确认收到退货，仅运营人员可调用
+func (h *GrpcHandler) ReceiveReturn(ctx context.Context, req *pb.ReceiveReturnReq) (*pb.ReceiveReturnResp, error) {
+	operatorID, err := h.operators.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	afterSale, err := h.afterSaleService.ReceiveReturn(ctx, req.AfterSaleId, operatorID)
+	if err != nil {
+		return nil, h.afterSaleError(err, "确认收货失败")
+	}
+
+	return &pb.ReceiveReturnResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// RetryRefund 重试退款，仅运营人员可调用
+func (h *GrpcHandler) RetryRefund(ctx context.Context, req *pb.RetryRefundReq) (*pb.RetryRefundResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	afterSale, err := h.afterSaleService.RetryRefund(ctx, req.AfterSaleId)
+	if err != nil {
+		return nil, h.afterSaleError(err, "重试退款失败")
+	}
+
+	return &pb.RetryRefundResp{
+		AfterSale: h.entityToProto(afterSale),
+	}, nil
+}
+
+// afterSaleError 将售后错误转换为gRPC状态码
+func (h *GrpcHandler) afterSaleError(err error, msg string) error {
+	switch {
+	case errors.Is(err, aftersaledomain.ErrAfterSaleNotFound):
+		return status.Errorf(codes.NotFound, "售后申请不存在")
+	case errors.Is(err, orderdomain.ErrOrderNotFound):
+		return status.Errorf(codes.NotFound, "订单不存在")
+	case errors.Is(err, orderdomain.ErrOrderItemNotFound):
+		return status.Errorf(codes.NotFound, "订单商品不存在")
+	case errors.Is(err, aftersaledomain.ErrInvalidAfterSaleType), errors.Is(err, aftersaledomain.ErrInvalidAfterSaleReason),
+		errors.Is(err, aftersaledomain.ErrInvalidQuantity), errors.Is(err, aftersaledomain.ErrInvalidRefundAmount),
+		errors.Is(err, aftersaledomain.ErrTooManyEvidenceFiles), errors.Is(err, aftersaledomain.ErrInvalidEvidenceFile):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, aftersaledomain.ErrInvalidAfterSaleStatus), errors.Is(err, aftersaledomain.ErrOrderNotEligible),
+		errors.Is(err, aftersaledomain.ErrQuantityExceedsOrder), errors.Is(err, aftersaledomain.ErrRefundAmountExceeded):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, aftersaledomain.ErrRefundInFlight):
+		return status.Errorf(codes.FailedPrecondition, "退款已发起，请与支付服务对账后处理")
+	case errors.Is(err, aftersaledomain.ErrAfterSaleConflict):
+		return status.Errorf(codes.Aborted, "售后状态已变更，请刷新后重试")
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// entityToProto 将售后实体转换为protobuf消息
+func (h *GrpcHandler) entityToProto(afterSale *aftersaledomain.AfterSale) *pb.AfterSale {
+	return &pb.AfterSale{
+		Id:                   afterSale.ID,
+		OrderId:              afterSale.OrderID,
+		OrderItemId:          afterSale.OrderItemID,
+		UserId:               afterSale.UserID,
+		Type:                 pb.AfterSaleType(afterSale.Type),
+		Status:               pb.AfterSaleStatus(afterSale.Status),
+		Reason:               pb.AfterSaleReason(afterSale.Reason),
+		Description:          afterSale.Description,
+		Quantity:             afterSale.Quantity,
+		RequestedAmount:      afterSale.RequestedAmount.StringFixed(2),
+		ApprovedAmount:       afterSale.ApprovedAmount.StringFixed(2),
+		EvidenceFileIds:      afterSale.EvidenceFileIDs,
+		RejectReason:         afterSale.RejectReason,
+		ReturnCarrierCode:    afterSale.ReturnCarrierCode,
+		ReturnTrackingNumber: afterSale.ReturnTrackingNumber,
+		RefundId:             afterSale.RefundID,
+		ReviewedAt:           h.parseTime(afterSale.ReviewedAt),
+		ReceivedAt:           h.parseTime(afterSale.ReceivedAt),
+		RefundedAt:           h.parseTime(afterSale.RefundedAt),
+		CreatedAt:            h.parseTime(afterSale.CreatedAt),
+		UpdatedAt:            h.parseTime(afterSale.UpdatedAt),
+	}
+}
+
+// parseTime 解析时间字符串
+func (h *GrpcHandler) parseTime(timeStr string) *timestamppb.Timestamp {
+	if timeStr == "" {
+		return nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", timeStr, time.Local)
+	if err != nil {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// parseDecimal 解析decimal字符串
+func (h *GrpcHandler) parseDecimal(decimalStr string) (decimal.Decimal, error) {
+	if decimalStr == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(decimalStr)
+}
@@ -0,0 +1,43 @@
+package aftersale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/aftersale"
+)
+
+// TestOperatorOnlyRPCs 商家侧售后接口在调用应用服务之前拒绝非运营人员，买家不能审核自己的售后申请
+func TestOperatorOnlyRPCs(t *testing.T) {
+	h := NewGrpcHandler(nil, auth.NewOperators([]string{"operator-1"}))
+
+	rpcs := map[string]func(ctx context.Context) error{
+		"ReviewAfterSale": func(ctx context.Context) error {
+			_, err := h.ReviewAfterSale(ctx, &pb.ReviewAfterSaleReq{AfterSaleId: "after-sale-1", Approved: true})
+			return err
+		},
+		"ReceiveReturn": func(ctx context.Context) error {
+			_, err := h.ReceiveReturn(ctx, &pb.ReceiveReturnReq{AfterSaleId: "after-sale-1"})
+			return err
+		},
+		"RetryRefund": func(ctx context.Context) error {
+			_, err := h.RetryRefund(ctx, &pb.RetryRefundReq{AfterSaleId: "after-sale-1"})
+			return err
+		},
+	}
+
+	shopper := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.GrpcUserIDMetadataKey, "user-1"))
+	for name, call := range rpcs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background())))
+			assert.Equal(t, codes.PermissionDenied, status.Code(call(shopper)))
+		})
+	}
+}
@@ -2,10 +2,13 @@ package cart
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/people257/poor-guy-shop/common/auth"
 	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -13,6 +16,7 @@ import (
 	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/cart"
 	cartapp "github.com/people257/poor-guy-shop/order-service/internal/application/cart"
 	cartdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
 )
 
 // GrpcHandler 购物车gRPC处理器
@@ -30,26 +34,34 @@ func NewGrpcHandler(cartService *cartapp.Service) *GrpcHandler {
 
 // AddCartItem 添加商品到购物车
 func (h *GrpcHandler) AddCartItem(ctx context.Context, req *pb.AddCartItemReq) (*pb.AddCartItemResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// TODO: 从product-service获取商品信息和价格
-	// 这里暂时使用固定价格，实际应该调用product-service获取实时价格
-	price := decimal.NewFromFloat(99.99)
-
 	appReq := cartapp.AddToCartRequest{
 		UserID:    userID,
+		CartToken: cartToken,
 		ProductID: req.ProductId,
 		SkuID:     req.SkuId,
 		Quantity:  req.Quantity,
-		Price:     price,
 	}
 
 	cartItem, err := h.cartService.AddToCart(ctx, appReq)
 	if err != nil {
+		if errors.Is(err, cartdomain.ErrQuantityExceeded) {
+			return nil, h.quantityExceededError()
+		}
+		if st := h.limitExceededError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, cartdomain.ErrProductUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, cartdomain.ReasonOffShelf)
+		}
+		if errors.Is(err, cartdomain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, cartdomain.ReasonInsufficientStock)
+		}
 		return nil, status.Errorf(codes.Internal, "添加商品到购物车失败: %v", err)
 	}
 
@@ -60,23 +72,30 @@ func (h *GrpcHandler) AddCartItem(ctx context.Context, req *pb.AddCartItemReq) (
 
 // UpdateCartItem 更新购物车商品
 func (h *GrpcHandler) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItemReq) (*pb.UpdateCartItemResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	appReq := cartapp.UpdateQuantityRequest{
-		CartID:   req.ItemId,
-		UserID:   userID,
-		Quantity: req.Quantity,
+		CartID:    req.ItemId,
+		UserID:    userID,
+		CartToken: cartToken,
+		Quantity:  req.Quantity,
 	}
 
 	cartItem, err := h.cartService.UpdateQuantity(ctx, appReq)
 	if err != nil {
-		if err == cartdomain.ErrCartItemNotFound {
+		if errors.Is(err, cartdomain.ErrCartItemNotFound) {
 			return nil, status.Errorf(codes.NotFound, "购物车商品不存在")
 		}
+		if errors.Is(err, cartdomain.ErrQuantityExceeded) {
+			return nil, h.quantityExceededError()
+		}
+		if st := h.limitExceededError(err); st != nil {
+			return nil, st
+		}
 		return nil, status.Errorf(codes.Internal, "更新购物车商品失败: %v", err)
 	}
 
@@ -87,20 +106,21 @@ func (h *GrpcHandler) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItem
 
 // RemoveCartItem 删除购物车商品
 func (h *GrpcHandler) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemReq) (*pb.RemoveCartItemResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	appReq := cartapp.RemoveFromCartRequest{
-		CartID: req.ItemId,
-		UserID: userID,
+		CartID:    req.ItemId,
+		UserID:    userID,
+		CartToken: cartToken,
 	}
 
-	err := h.cartService.RemoveFromCart(ctx, appReq)
+	err = h.cartService.RemoveFromCart(ctx, appReq)
 	if err != nil {
-		if err == cartdomain.ErrCartItemNotFound {
+		if errors.Is(err, cartdomain.ErrCartItemNotFound) {
 			return nil, status.Errorf(codes.NotFound, "购物车商品不存在")
 		}
 		return nil, status.Errorf(codes.Internal, "删除购物车商品失败: %v", err)
@@ -113,14 +133,15 @@ func (h *GrpcHandler) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItem
 
 // GetCart 获取购物车
 func (h *GrpcHandler) GetCart(ctx context.Context, req *pb.GetCartReq) (*pb.GetCartResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	appReq := cartapp.GetCartRequest{
-		UserID: userID,
+		UserID:    userID,
+		CartToken: cartToken,
 	}
 
 	result, err := h.cartService.GetCart(ctx, appReq)
@@ -131,36 +152,30 @@ func (h *GrpcHandler) GetCart(ctx context.Context, req *pb.GetCartReq) (*pb.GetC
 	// 转换为proto对象
 	var items []*pb.CartItem
 	for _, item := range result.Items {
-		items = append(items, h.entityToProto(item))
-	}
-
-	// 计算汇总信息
-	summary := &pb.CartSummary{
-		TotalItems:     int32(len(result.Items)),
-		SelectedItems:  result.TotalCount,
-		TotalAmount:    h.calculateTotalAmount(result.Items).String(),
-		SelectedAmount: result.TotalAmount.String(),
+		items = append(items, h.cartItemToProto(item))
 	}
 
 	return &pb.GetCartResp{
-		Items:   items,
-		Summary: summary,
+		Items:     items,
+		Summary:   h.summaryToProto(result.Summary),
+		Refreshed: result.Refreshed,
 	}, nil
 }
 
 // ClearCart 清空购物车
 func (h *GrpcHandler) ClearCart(ctx context.Context, req *pb.ClearCartReq) (*pb.ClearCartResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	appReq := cartapp.ClearCartRequest{
-		UserID: userID,
+		UserID:    userID,
+		CartToken: cartToken,
 	}
 
-	err := h.cartService.ClearCart(ctx, appReq)
+	err = h.cartService.ClearCart(ctx, appReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "清空购物车失败: %v", err)
 	}
@@ -172,43 +187,108 @@ func (h *GrpcHandler) ClearCart(ctx context.Context, req *pb.ClearCartReq) (*pb.
 
 // SelectCartItems 选择购物车商品
 func (h *GrpcHandler) SelectCartItems(ctx context.Context, req *pb.SelectCartItemsReq) (*pb.SelectCartItemsResp, error) {
-	// 从认证上下文获取用户ID
-	userID := auth.UserIDFromContext(ctx)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	// 已登录用户使用用户购物车，游客使用网关签发的购物车令牌
+	userID, cartToken, err := cartOwner(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	appReq := cartapp.BatchUpdateSelectionRequest{
-		UserID:   userID,
-		CartIDs:  req.ItemIds,
-		Selected: req.Selected,
+		UserID:    userID,
+		CartToken: cartToken,
+		CartIDs:   req.ItemIds,
+		Selected:  req.Selected,
 	}
 
-	err := h.cartService.BatchUpdateSelection(ctx, appReq)
+	err = h.cartService.BatchUpdateSelection(ctx, appReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "选择购物车商品失败: %v", err)
 	}
 
 	// 重新获取购物车计算汇总
-	cartReq := cartapp.GetCartRequest{UserID: userID}
+	cartReq := cartapp.GetCartRequest{UserID: userID, CartToken: cartToken}
 	result, err := h.cartService.GetCart(ctx, cartReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "获取购物车汇总失败: %v", err)
 	}
 
-	summary := &pb.CartSummary{
-		TotalItems:     int32(len(result.Items)),
-		SelectedItems:  result.TotalCount,
-		TotalAmount:    h.calculateTotalAmount(result.Items).String(),
-		SelectedAmount: result.TotalAmount.String(),
-	}
-
 	return &pb.SelectCartItemsResp{
 		Success: true,
-		Summary: summary,
+		Summary: h.summaryToProto(result.Summary),
+	}, nil
+}
+
+// MergeCart 合并游客购物车
+func (h *GrpcHandler) MergeCart(ctx context.Context, req *pb.MergeCartReq) (*pb.MergeCartResp, error) {
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	cartToken := req.CartToken
+	if cartToken == "" {
+		cartToken = auth.CartTokenFromContext(ctx)
+	}
+	if !auth.IsValidCartToken(cartToken) {
+		return nil, status.Error(codes.InvalidArgument, "购物车令牌无效")
+	}
+
+	result, err := h.cartService.MergeCart(ctx, cartapp.MergeCartRequest{
+		UserID:    userID,
+		CartToken: cartToken,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "合并购物车失败: %v", err)
+	}
+
+	return &pb.MergeCartResp{
+		MergedItems: int32(result.Merged),
+		CappedItems: int32(result.Capped),
 	}, nil
 }
 
+// cartOwner 获取购物车所有者：已登录时返回用户ID，否则返回网关签发的购物车令牌
+func cartOwner(ctx context.Context) (userID, cartToken string, err error) {
+	if userID = auth.UserIDFromContext(ctx); userID != "" {
+		return userID, "", nil
+	}
+
+	cartToken = auth.CartTokenFromContext(ctx)
+	if !auth.IsValidCartToken(cartToken) {
+		return "", "", status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	return "", cartToken, nil
+}
+
+// quantityExceededError 超过单个商品购买数量上限
+func (h *GrpcHandler) quantityExceededError() error {
+	return status.Errorf(codes.ResourceExhausted, "单个商品最多购买 %d 件", cartdomain.MaxItemQuantity)
+}
+
+// limitExceededError 将超出限购转换为 ResourceExhausted，附带触发的规则和剩余可购买数量，其他错误返回nil
+func (h *GrpcHandler) limitExceededError(err error) error {
+	var exceeded *purchaselimit.ExceededError
+	if !errors.As(err, &exceeded) {
+		return nil
+	}
+
+	st := status.New(codes.ResourceExhausted, exceeded.Message())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "PURCHASE_LIMIT_EXCEEDED",
+		Domain: "order-service",
+		Metadata: map[string]string{
+			"sku_id":    exceeded.SkuID,
+			"scope":     string(exceeded.Scope),
+			"limit":     strconv.Itoa(int(exceeded.Limit)),
+			"remaining": strconv.Itoa(int(exceeded.Remaining)),
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 // entityToProto 将领域实体转换为proto对象
 func (h *GrpcHandler) entityToProto(cartItem *cartdomain.ShoppingCart) *pb.CartItem {
 	pbItem := &pb.CartItem{
@@ -246,14 +326,38 @@ func (h *GrpcHandler) entityToProto(cartItem *cartdomain.ShoppingCart) *pb.CartI
 	return pbItem
 }
 
-// calculateTotalAmount 计算总金额
-func (h *GrpcHandler) calculateTotalAmount(items []*cartdomain.ShoppingCart) decimal.Decimal {
-	var total decimal.Decimal
-	for _, item := range items {
-		subtotal := item.Price.Mul(decimal.NewFromInt32(item.Quantity))
-		total = total.Add(subtotal)
+// cartItemToProto 将带实时价格的购物车商品项转换为proto对象
+func (h *GrpcHandler) cartItemToProto(item *cartdomain.CartItem) *pb.CartItem {
+	return &pb.CartItem{
+		Id:                item.ID,
+		UserId:            item.UserID,
+		ProductId:         item.ProductID,
+		SkuId:             item.SkuID,
+		ProductName:       item.ProductName,
+		ProductImage:      item.ProductImage,
+		SkuName:           item.SkuName,
+		Price:             item.Price.String(),
+		Quantity:          int32(item.Quantity),
+		TotalAmount:       item.TotalAmount.String(),
+		Selected:          item.Selected,
+		Available:         item.Available,
+		CreatedAt:         h.parseTime(item.CreatedAt),
+		UpdatedAt:         h.parseTime(item.UpdatedAt),
+		AddedPrice:        item.AddedPrice.String(),
+		PriceDelta:        item.PriceDelta().String(),
+		PriceChanged:      item.PriceChanged(),
+		UnavailableReason: item.UnavailableReason,
+	}
+}
+
+// summaryToProto 将购物车汇总转换为proto对象
+func (h *GrpcHandler) summaryToProto(summary cartdomain.CartSummary) *pb.CartSummary {
+	return &pb.CartSummary{
+		TotalItems:     int32(summary.TotalItems),
+		SelectedItems:  int32(summary.SelectedItems),
+		TotalAmount:    summary.TotalAmount.String(),
+		SelectedAmount: summary.SelectedAmount.String(),
 	}
-	return total
 }
 
 // parseTime 解析时间字符串
@@ -0,0 +1,192 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+)
+
+// CreateCouponTemplate 创建优惠券模板，仅运营人员可调用
+func (h *GrpcHandler) CreateCouponTemplate(ctx context.Context, req *pb.CreateCouponTemplateReq) (*pb.CreateCouponTemplateResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	amount, err := h.parseDecimal(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "减免金额格式错误: %v", err)
+	}
+	threshold, err := h.parseDecimal(req.Threshold)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "使用门槛格式错误: %v", err)
+	}
+	maxDiscount, err := h.parseDecimal(req.MaxDiscount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "最高优惠金额格式错误: %v", err)
+	}
+
+	template, err := h.orderService.CreateCouponTemplate(ctx, orderapp.CreateCouponTemplateRequest{
+		Name:          req.Name,
+		Type:          promotion.CouponType(req.Type),
+		Amount:        amount,
+		PercentOff:    req.PercentOff,
+		Threshold:     threshold,
+		MaxDiscount:   maxDiscount,
+		Repeatable:    req.Repeatable,
+		ScopeType:     promotion.ScopeType(req.ScopeType),
+		ScopeIDs:      req.ScopeIds,
+		Stackable:     req.Stackable,
+		TotalQuantity: req.TotalQuantity,
+		PerUserLimit:  req.PerUserLimit,
+		ValidFrom:     formatLocalTime(req.ValidFrom),
+		ValidTo:       formatLocalTime(req.ValidTo),
+		ValidDays:     req.ValidDays,
+	})
+	if err != nil {
+		if errors.Is(err, promotion.ErrInvalidTemplate) {
+			return nil, status.Errorf(codes.InvalidArgument, "优惠券模板参数错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "创建优惠券模板失败: %v", err)
+	}
+
+	return &pb.CreateCouponTemplateResp{
+		Template: h.couponTemplateToProto(template),
+	}, nil
+}
+
+// ClaimCoupon 领取优惠券
+func (h *GrpcHandler) ClaimCoupon(ctx context.Context, req *pb.ClaimCouponReq) (*pb.ClaimCouponResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	coupon, err := h.orderService.ClaimCoupon(ctx, userID, req.TemplateId)
+	if err != nil {
+		switch {
+		case errors.Is(err, promotion.ErrTemplateNotFound):
+			return nil, status.Errorf(codes.NotFound, "优惠券不存在")
+		case errors.Is(err, promotion.ErrTemplateNotClaimable):
+			return nil, status.Errorf(codes.FailedPrecondition, "优惠券不在领取时间内")
+		case errors.Is(err, promotion.ErrCouponSoldOut):
+			return nil, status.Errorf(codes.ResourceExhausted, "优惠券已领完")
+		case errors.Is(err, promotion.ErrClaimLimitReached):
+			return nil, status.Errorf(codes.ResourceExhausted, "已达到每人限领张数")
+		}
+		return nil, status.Errorf(codes.Internal, "领取优惠券失败: %v", err)
+	}
+
+	return &pb.ClaimCouponResp{
+		Coupon: h.couponToProto(coupon, time.Now()),
+	}, nil
+}
+
+// ListMyCoupons 我的优惠券
+func (h *GrpcHandler) ListMyCoupons(ctx context.Context, req *pb.ListMyCouponsReq) (*pb.ListMyCouponsResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	coupons, err := h.orderService.ListCoupons(ctx, userID, promotion.CouponStatus(req.Status))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "获取优惠券失败: %v", err)
+	}
+
+	now := time.Now()
+	resp := &pb.ListMyCouponsResp{Coupons: make([]*pb.Coupon, 0, len(coupons))}
+	for _, coupon := range coupons {
+		resp.Coupons = append(resp.Coupons, h.couponToProto(coupon, now))
+	}
+	return resp, nil
+}
+
+// couponError 将下单、报价时的优惠券错误转换为gRPC状态，其他错误返回nil
+func (h *GrpcHandler) couponError(err error) error {
+	switch {
+	case errors.Is(err, promotion.ErrCouponNotFound):
+		return status.Errorf(codes.NotFound, "优惠券不存在")
+	case errors.Is(err, promotion.ErrCouponNotApplicable), errors.Is(err, promotion.ErrCouponNotStackable),
+		errors.Is(err, promotion.ErrCouponUnavailable):
+		return status.Errorf(codes.FailedPrecondition, "优惠券不可用: %v", err)
+	}
+	return nil
+}
+
+// appliedCouponsToProto 将订单使用的优惠券转换为protobuf消息
+func (h *GrpcHandler) appliedCouponsToProto(coupons []*orderdomain.AppliedCoupon) []*pb.AppliedCoupon {
+	pbCoupons := make([]*pb.AppliedCoupon, 0, len(coupons))
+	for _, coupon := range coupons {
+		pbCoupons = append(pbCoupons, &pb.AppliedCoupon{
+			CouponId:       coupon.CouponID,
+			Name:           coupon.Name,
+			DiscountAmount: coupon.DiscountAmount.StringFixed(2),
+		})
+	}
+	return pbCoupons
+}
+
+// couponTemplateToProto 将优惠券模板转换为protobuf消息
+func (h *GrpcHandler) couponTemplateToProto(template *promotion.Template) *pb.CouponTemplate {
+	return &pb.CouponTemplate{
+		Id:             template.ID,
+		Name:           template.Name,
+		Type:           pb.CouponType(template.Type),
+		Amount:         template.Amount.StringFixed(2),
+		PercentOff:     template.PercentOff,
+		Threshold:      template.Threshold.StringFixed(2),
+		MaxDiscount:    template.MaxDiscount.StringFixed(2),
+		Repeatable:     template.Repeatable,
+		ScopeType:      pb.CouponScopeType(template.ScopeType),
+		ScopeIds:       template.ScopeIDs,
+		Stackable:      template.Stackable,
+		TotalQuantity:  template.TotalQuantity,
+		IssuedQuantity: template.IssuedQuantity,
+		PerUserLimit:   template.PerUserLimit,
+		ValidFrom:      h.parseTime(template.ValidFrom),
+		ValidTo:        h.parseTime(template.ValidTo),
+		ValidDays:      template.ValidDays,
+		CreatedAt:      h.parseTime(template.CreatedAt),
+	}
+}
+
+// couponToProto 将用户优惠券转换为protobuf消息
+func (h *GrpcHandler) couponToProto(coupon *promotion.Coupon, now time.Time) *pb.Coupon {
+	pbCoupon := &pb.Coupon{
+		Id:        coupon.ID,
+		Status:    pb.CouponStatus(coupon.Status),
+		Expired:   coupon.Status == promotion.CouponStatusAvailable && !coupon.Usable(now),
+		OrderId:   coupon.OrderID,
+		ValidFrom: h.parseTime(coupon.ValidFrom),
+		ValidTo:   h.parseTime(coupon.ValidTo),
+		CreatedAt: h.parseTime(coupon.CreatedAt),
+	}
+	if coupon.Template != nil {
+		pbCoupon.Template = h.couponTemplateToProto(coupon.Template)
+	}
+	if coupon.Status != promotion.CouponStatusAvailable {
+		pbCoupon.DiscountAmount = coupon.DiscountAmount.StringFixed(2)
+	}
+	return pbCoupon
+}
+
+// formatLocalTime 转换为本地时间字符串，未设置时返回空字符串
+func formatLocalTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return localTime(ts).Format("2006-01-02 15:04:05")
+}
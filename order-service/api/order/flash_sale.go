@@ -0,0 +1,168 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// CreateFlashSale 创建秒杀活动，仅运营人员可调用
+func (h *GrpcHandler) CreateFlashSale(ctx context.Context, req *pb.CreateFlashSaleReq) (*pb.CreateFlashSaleResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	price, err := h.parseDecimal(req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "秒杀价格式错误: %v", err)
+	}
+
+	sale, err := h.orderService.CreateFlashSale(ctx, orderapp.CreateFlashSaleRequest{
+		Name:         req.Name,
+		ProductID:    req.ProductId,
+		SkuID:        req.SkuId,
+		Price:        price,
+		Quota:        req.Quota,
+		PerUserLimit: req.PerUserLimit,
+		StartAt:      formatLocalTime(req.StartAt),
+		EndAt:        formatLocalTime(req.EndAt),
+	})
+	if err != nil {
+		if errors.Is(err, flashsale.ErrInvalidSale) {
+			return nil, status.Errorf(codes.InvalidArgument, "秒杀活动参数错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "创建秒杀活动失败: %v", err)
+	}
+
+	return &pb.CreateFlashSaleResp{
+		Sale: h.flashSaleToProto(sale, sale.Quota),
+	}, nil
+}
+
+// ListFlashSales 秒杀活动列表
+func (h *GrpcHandler) ListFlashSales(ctx context.Context, req *pb.ListFlashSalesReq) (*pb.ListFlashSalesResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	infos, err := h.orderService.ListFlashSales(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "获取秒杀活动失败: %v", err)
+	}
+
+	resp := &pb.ListFlashSalesResp{Sales: make([]*pb.FlashSale, 0, len(infos))}
+	for _, info := range infos {
+		resp.Sales = append(resp.Sales, h.flashSaleToProto(info.Sale, info.Remaining))
+	}
+	return resp, nil
+}
+
+// PurchaseFlashSale 抢购
+func (h *GrpcHandler) PurchaseFlashSale(ctx context.Context, req *pb.PurchaseFlashSaleReq) (*pb.PurchaseFlashSaleResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	if req.AddressId == "" {
+		return nil, status.Error(codes.InvalidArgument, "收货地址不能为空")
+	}
+
+	ticket, err := h.orderService.PurchaseFlashSale(ctx, orderapp.PurchaseFlashSaleRequest{
+		UserID:        userID,
+		SaleID:        req.SaleId,
+		Quantity:      req.Quantity,
+		AddressID:     req.AddressId,
+		PaymentMethod: req.PaymentMethod,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, flashsale.ErrTooManyRequests):
+			return nil, status.Errorf(codes.ResourceExhausted, "请求过于频繁，请稍后重试")
+		case errors.Is(err, flashsale.ErrSaleNotFound):
+			return nil, status.Errorf(codes.NotFound, "秒杀活动不存在")
+		case errors.Is(err, orderdomain.ErrAddressNotFound):
+			return nil, status.Errorf(codes.NotFound, "收货地址不存在")
+		case errors.Is(err, flashsale.ErrInvalidQuantity):
+			return nil, status.Errorf(codes.InvalidArgument, "抢购数量错误")
+		case errors.Is(err, flashsale.ErrSaleNotActive):
+			return nil, status.Errorf(codes.FailedPrecondition, "秒杀活动未开始或已结束")
+		case errors.Is(err, flashsale.ErrSoldOut):
+			return nil, status.Errorf(codes.FailedPrecondition, "已抢光")
+		case errors.Is(err, flashsale.ErrUserLimitExceeded):
+			return nil, status.Errorf(codes.FailedPrecondition, "已达到每人限购数量")
+		}
+		return nil, status.Errorf(codes.Internal, "抢购失败: %v", err)
+	}
+
+	return &pb.PurchaseFlashSaleResp{
+		Ticket: h.flashSaleTicketToProto(ticket),
+	}, nil
+}
+
+// GetFlashSaleTicket 抢购结果
+func (h *GrpcHandler) GetFlashSaleTicket(ctx context.Context, req *pb.GetFlashSaleTicketReq) (*pb.GetFlashSaleTicketResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	ticket, err := h.orderService.GetFlashSaleTicket(ctx, userID, req.TicketId)
+	if err != nil {
+		if errors.Is(err, flashsale.ErrTicketNotFound) {
+			return nil, status.Errorf(codes.NotFound, "抢购记录不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "获取抢购记录失败: %v", err)
+	}
+
+	return &pb.GetFlashSaleTicketResp{
+		Ticket: h.flashSaleTicketToProto(ticket),
+	}, nil
+}
+
+// flashSaleToProto 将秒杀活动转换为protobuf消息
+func (h *GrpcHandler) flashSaleToProto(sale *flashsale.Sale, remaining int32) *pb.FlashSale {
+	return &pb.FlashSale{
+		Id:           sale.ID,
+		Name:         sale.Name,
+		ProductId:    sale.ProductID,
+		SkuId:        sale.SkuID,
+		Price:        sale.Price.StringFixed(2),
+		Quota:        sale.Quota,
+		Stock:        sale.Stock,
+		Remaining:    remaining,
+		PerUserLimit: sale.PerUserLimit,
+		StartAt:      h.parseTime(sale.StartAt),
+		EndAt:        h.parseTime(sale.EndAt),
+		Preloaded:    sale.Preloaded,
+		Settled:      sale.Settled,
+		Sold:         sale.Sold,
+		CreatedAt:    h.parseTime(sale.CreatedAt),
+	}
+}
+
+// flashSaleTicketToProto 将抢购记录转换为protobuf消息
+func (h *GrpcHandler) flashSaleTicketToProto(ticket *flashsale.Ticket) *pb.FlashSaleTicket {
+	return &pb.FlashSaleTicket{
+		Id:        ticket.ID,
+		SaleId:    ticket.SaleID,
+		Quantity:  ticket.Quantity,
+		Status:    pb.FlashSaleTicketStatus(ticket.Status),
+		OrderId:   ticket.OrderID,
+		Reason:    ticket.Reason,
+		CreatedAt: h.parseTime(ticket.CreatedAt),
+		UpdatedAt: h.parseTime(ticket.UpdatedAt),
+	}
+}
@@ -0,0 +1,203 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
+)
+
+// CreateFreightTemplate 创建运费模板，仅运营人员可调用
+func (h *GrpcHandler) CreateFreightTemplate(ctx context.Context, req *pb.CreateFreightTemplateReq) (*pb.CreateFreightTemplateResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	appReq, err := h.freightTemplateRequest("", req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := h.orderService.SaveFreightTemplate(ctx, appReq)
+	if err != nil {
+		if errors.Is(err, freight.ErrInvalidTemplate) {
+			return nil, status.Errorf(codes.InvalidArgument, "运费模板参数错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "创建运费模板失败: %v", err)
+	}
+
+	return &pb.CreateFreightTemplateResp{
+		Template: h.freightTemplateToProto(template),
+	}, nil
+}
+
+// UpdateFreightTemplate 更新运费模板，仅运营人员可调用
+func (h *GrpcHandler) UpdateFreightTemplate(ctx context.Context, req *pb.UpdateFreightTemplateReq) (*pb.UpdateFreightTemplateResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "运费模板ID不能为空")
+	}
+
+	appReq, err := h.freightTemplateRequest(req.Id, req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := h.orderService.SaveFreightTemplate(ctx, appReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, freight.ErrInvalidTemplate):
+			return nil, status.Errorf(codes.InvalidArgument, "运费模板参数错误: %v", err)
+		case errors.Is(err, freight.ErrTemplateNotFound):
+			return nil, status.Errorf(codes.NotFound, "运费模板不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "更新运费模板失败: %v", err)
+	}
+
+	return &pb.UpdateFreightTemplateResp{
+		Template: h.freightTemplateToProto(template),
+	}, nil
+}
+
+// ListFreightTemplates 运费模板列表，仅运营人员可调用
+func (h *GrpcHandler) ListFreightTemplates(ctx context.Context, req *pb.ListFreightTemplatesReq) (*pb.ListFreightTemplatesResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	templates, err := h.orderService.ListFreightTemplates(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "获取运费模板失败: %v", err)
+	}
+
+	resp := &pb.ListFreightTemplatesResp{Templates: make([]*pb.FreightTemplate, 0, len(templates))}
+	for _, template := range templates {
+		resp.Templates = append(resp.Templates, h.freightTemplateToProto(template))
+	}
+	return resp, nil
+}
+
+// SetProductFreightTemplate 设置商品运费模板，仅运营人员可调用
+func (h *GrpcHandler) SetProductFreightTemplate(ctx context.Context, req *pb.SetProductFreightTemplateReq) (*pb.SetProductFreightTemplateResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.orderService.SetProductFreightTemplate(ctx, req.ProductId, req.TemplateId); err != nil {
+		switch {
+		case errors.Is(err, freight.ErrInvalidTemplate):
+			return nil, status.Errorf(codes.InvalidArgument, "商品ID不能为空")
+		case errors.Is(err, freight.ErrTemplateNotFound):
+			return nil, status.Errorf(codes.NotFound, "运费模板不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "设置商品运费模板失败: %v", err)
+	}
+
+	return &pb.SetProductFreightTemplateResp{
+		Success: true,
+	}, nil
+}
+
+// freightTemplateRequest 将protobuf运费模板转换为应用层请求
+func (h *GrpcHandler) freightTemplateRequest(id string, template *pb.FreightTemplate) (orderapp.SaveFreightTemplateRequest, error) {
+	if template == nil {
+		return orderapp.SaveFreightTemplateRequest{}, status.Error(codes.InvalidArgument, "运费模板不能为空")
+	}
+
+	defaultRule, err := h.freightRuleRequest(template.DefaultRule)
+	if err != nil {
+		return orderapp.SaveFreightTemplateRequest{}, err
+	}
+
+	req := orderapp.SaveFreightTemplateRequest{
+		ID:               id,
+		Name:             template.Name,
+		FirstWeight:      template.FirstWeight,
+		AdditionalWeight: template.AdditionalWeight,
+		DefaultRule:      defaultRule,
+		IsDefault:        template.IsDefault,
+	}
+	for _, region := range template.Regions {
+		rule, err := h.freightRuleRequest(region.Rule)
+		if err != nil {
+			return orderapp.SaveFreightTemplateRequest{}, err
+		}
+		req.Regions = append(req.Regions, orderapp.FreightRegionRequest{
+			Provinces: region.Provinces,
+			Cities:    region.Cities,
+			Rule:      rule,
+		})
+	}
+	return req, nil
+}
+
+// freightRuleRequest 解析运费计费规则中的金额
+func (h *GrpcHandler) freightRuleRequest(rule *pb.FreightRule) (orderapp.FreightRuleRequest, error) {
+	if rule == nil {
+		return orderapp.FreightRuleRequest{}, nil
+	}
+
+	firstFee, err := h.parseDecimal(rule.FirstFee)
+	if err != nil {
+		return orderapp.FreightRuleRequest{}, status.Errorf(codes.InvalidArgument, "首重运费格式错误: %v", err)
+	}
+	additionalFee, err := h.parseDecimal(rule.AdditionalFee)
+	if err != nil {
+		return orderapp.FreightRuleRequest{}, status.Errorf(codes.InvalidArgument, "续重运费格式错误: %v", err)
+	}
+	freeThreshold, err := h.parseDecimal(rule.FreeThreshold)
+	if err != nil {
+		return orderapp.FreightRuleRequest{}, status.Errorf(codes.InvalidArgument, "包邮门槛格式错误: %v", err)
+	}
+	surcharge, err := h.parseDecimal(rule.Surcharge)
+	if err != nil {
+		return orderapp.FreightRuleRequest{}, status.Errorf(codes.InvalidArgument, "附加费格式错误: %v", err)
+	}
+
+	return orderapp.FreightRuleRequest{
+		FirstFee:      firstFee,
+		AdditionalFee: additionalFee,
+		FreeThreshold: freeThreshold,
+		Surcharge:     surcharge,
+	}, nil
+}
+
+// freightTemplateToProto 将运费模板转换为protobuf消息
+func (h *GrpcHandler) freightTemplateToProto(template *freight.Template) *pb.FreightTemplate {
+	pbTemplate := &pb.FreightTemplate{
+		Id:               template.ID,
+		Name:             template.Name,
+		FirstWeight:      template.FirstWeight,
+		AdditionalWeight: template.AdditionalWeight,
+		DefaultRule:      h.freightRuleToProto(template.DefaultRule),
+		Regions:          make([]*pb.FreightRegion, 0, len(template.Regions)),
+		IsDefault:        template.IsDefault,
+		CreatedAt:        h.parseTime(template.CreatedAt),
+		UpdatedAt:        h.parseTime(template.UpdatedAt),
+	}
+	for _, region := range template.Regions {
+		pbTemplate.Regions = append(pbTemplate.Regions, &pb.FreightRegion{
+			Provinces: region.Provinces,
+			Cities:    region.Cities,
+			Rule:      h.freightRuleToProto(region.Rule),
+		})
+	}
+	return pbTemplate
+}
+
+// freightRuleToProto 将运费计费规则转换为protobuf消息
+func (h *GrpcHandler) freightRuleToProto(rule freight.Rule) *pb.FreightRule {
+	return &pb.FreightRule{
+		FirstFee:      rule.FirstFee.StringFixed(2),
+		AdditionalFee: rule.AdditionalFee.StringFixed(2),
+		FreeThreshold: rule.FreeThreshold.StringFixed(2),
+		Surcharge:     rule.Surcharge.StringFixed(2),
+	}
+}
@@ -2,18 +2,23 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/people257/poor-guy-shop/common/auth"
 
 	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
 	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	cartdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
 	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
 )
 
@@ -21,12 +26,14 @@ import (
 type GrpcHandler struct {
 	pb.UnimplementedOrderServiceServer
 	orderService *orderapp.Service
+	operators    *auth.Operators
 }
 
 // NewGrpcHandler 创建订单gRPC处理器
-func NewGrpcHandler(orderService *orderapp.Service) *GrpcHandler {
+func NewGrpcHandler(orderService *orderapp.Service, operators *auth.Operators) *GrpcHandler {
 	return &GrpcHandler{
 		orderService: orderService,
+		operators:    operators,
 	}
 }
 
@@ -37,64 +44,48 @@ func (h *GrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderReq) (
 	if userID == "" {
 		return nil, status.Error(codes.Unauthenticated, "用户未认证")
 	}
-	// 构建应用层请求
+	// 构建应用层请求，价格与名称以商品服务为准，忽略客户端传入的金额字段
 	var items []orderapp.CreateOrderItemRequest
 	for _, item := range req.Items {
-		price, err := decimal.NewFromString(item.Price)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "商品价格格式错误: %v", err)
-		}
-
 		items = append(items, orderapp.CreateOrderItemRequest{
-			ProductID:   item.ProductId,
-			SkuID:       item.SkuId,
-			Quantity:    item.Quantity,
-			Price:       price,
-			ProductName: item.ProductName,
-			SkuName:     item.SkuName,
+			ProductID: item.ProductId,
+			SkuID:     item.SkuId,
+			Quantity:  item.Quantity,
 		})
 	}
 
-	discountAmount := decimal.Zero
-	if req.DiscountAmount != "" {
-		var err error
-		discountAmount, err = decimal.NewFromString(req.DiscountAmount)
+	var expectedAmount *decimal.Decimal
+	if req.ExpectedAmount != "" {
+		amount, err := decimal.NewFromString(req.ExpectedAmount)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "优惠金额格式错误: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "预期金额格式错误: %v", err)
 		}
+		expectedAmount = &amount
 	}
 
-	shippingFee := decimal.Zero
-	if req.ShippingFee != "" {
-		var err error
-		shippingFee, err = decimal.NewFromString(req.ShippingFee)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "运费格式错误: %v", err)
-		}
+	// 优先使用用户服务中保存的地址，手工填写的地址仅为兼容旧客户端
+	if req.AddressId == "" && req.Address == nil {
+		return nil, status.Error(codes.InvalidArgument, "收货地址不能为空")
 	}
 
 	appReq := orderapp.CreateOrderRequest{
-		UserID: userID, // 使用从上下文获取的用户ID
-		Items:  items,
-		Address: orderapp.CreateOrderAddressRequest{
-			ReceiverName:  req.Address.ReceiverName,
-			ReceiverPhone: req.Address.ReceiverPhone,
-			Province:      req.Address.Province,
-			City:          req.Address.City,
-			District:      req.Address.District,
-			DetailAddress: req.Address.DetailAddress,
-			PostalCode:    req.Address.PostalCode,
-		},
+		UserID:         userID, // 使用从上下文获取的用户ID
+		Items:          items,
+		AddressID:      req.AddressId,
+		Address:        h.addressRequest(req.Address),
 		PaymentMethod:  req.PaymentMethod,
 		Remark:         req.Remark,
-		DiscountAmount: discountAmount,
-		ShippingFee:    shippingFee,
+		ExpectedAmount: expectedAmount,
+		QuoteToken:     req.QuoteToken,
+		IdempotencyKey: req.IdempotencyKey,
+		CouponIDs:      req.CouponIds,
+		SkipCoupons:    req.SkipCoupons,
 	}
 
 	// 调用应用服务
 	orderEntity, err := h.orderService.CreateOrder(ctx, appReq)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "创建订单失败: %v", err)
+		return nil, h.createOrderError(err)
 	}
 
 	// 转换为响应
@@ -103,6 +94,191 @@ func (h *GrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderReq) (
 	}, nil
 }
 
+// QuoteOrder 下单报价
+func (h *GrpcHandler) QuoteOrder(ctx context.Context, req *pb.QuoteOrderReq) (*pb.QuoteOrderResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	items := make([]orderapp.CreateOrderItemRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, orderapp.CreateOrderItemRequest{
+			ProductID: item.ProductId,
+			SkuID:     item.SkuId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	appReq := orderapp.QuoteOrderRequest{
+		UserID:      userID,
+		Items:       items,
+		AddressID:   req.AddressId,
+		Address:     h.addressRequest(req.Address),
+		CouponIDs:   req.CouponIds,
+		SkipCoupons: req.SkipCoupons,
+	}
+
+	quote, err := h.orderService.QuoteOrder(ctx, appReq)
+	if err != nil {
+		if errors.Is(err, orderdomain.ErrEmptyOrderItems) || errors.Is(err, orderdomain.ErrInvalidQuantity) {
+			return nil, status.Errorf(codes.InvalidArgument, "订单商品参数错误: %v", err)
+		}
+		if errors.Is(err, orderdomain.ErrAddressNotFound) {
+			return nil, status.Errorf(codes.NotFound, "收货地址不存在")
+		}
+		if st := h.couponError(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "订单报价失败: %v", err)
+	}
+
+	resp := &pb.QuoteOrderResp{
+		TotalAmount:    quote.TotalAmount.StringFixed(2),
+		DiscountAmount: quote.DiscountAmount.StringFixed(2),
+		ShippingFee:    quote.ShippingFee.StringFixed(2),
+		PayableAmount:  quote.PayableAmount.StringFixed(2),
+		QuoteToken:     quote.QuoteToken,
+		Coupons:        h.appliedCouponsToProto(quote.Coupons),
+	}
+	if quote.QuoteToken != "" {
+		resp.ExpiresAt = timestamppb.New(quote.ExpiresAt)
+	}
+	for _, item := range quote.Items {
+		resp.Items = append(resp.Items, &pb.QuoteItem{
+			ProductId:    item.ProductID,
+			SkuId:        item.SkuID,
+			ProductName:  item.ProductName,
+			SkuName:      item.SkuName,
+			ProductImage: item.ProductImage,
+			Price:        item.Price.StringFixed(2),
+			Quantity:     item.Quantity,
+			Subtotal:     item.TotalAmount.StringFixed(2),
+		})
+	}
+	for _, item := range quote.UnavailableItems {
+		resp.UnavailableItems = append(resp.UnavailableItems, &pb.UnavailableItem{
+			ProductId: item.ProductID,
+			SkuId:     item.SkuID,
+			Reason:    item.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+// CheckoutCart 购物车结算
+func (h *GrpcHandler) CheckoutCart(ctx context.Context, req *pb.CheckoutCartReq) (*pb.CheckoutCartResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	if req.AddressId == "" {
+		return nil, status.Error(codes.InvalidArgument, "收货地址不能为空")
+	}
+
+	var expectedAmount *decimal.Decimal
+	if req.ExpectedAmount != "" {
+		amount, err := decimal.NewFromString(req.ExpectedAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "预期金额格式错误: %v", err)
+		}
+		expectedAmount = &amount
+	}
+
+	appReq := orderapp.CheckoutCartRequest{
+		UserID:         userID,
+		CartItemIDs:    req.CartItemIds,
+		AddressID:      req.AddressId,
+		PaymentMethod:  req.PaymentMethod,
+		Remark:         req.Remark,
+		ExpectedAmount: expectedAmount,
+		CouponIDs:      req.CouponIds,
+		SkipCoupons:    req.SkipCoupons,
+	}
+
+	orderEntity, err := h.orderService.CheckoutCart(ctx, appReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, cartdomain.ErrCartItemNotFound):
+			return nil, status.Errorf(codes.NotFound, "购物车项不存在")
+		case errors.Is(err, cartdomain.ErrNoSelectedItems):
+			return nil, status.Errorf(codes.FailedPrecondition, "购物车没有选中的商品")
+		case errors.Is(err, orderdomain.ErrCartChanged):
+			return nil, status.Errorf(codes.Aborted, "购物车已变更，请刷新后重试")
+		}
+		return nil, h.createOrderError(err)
+	}
+
+	return &pb.CheckoutCartResp{
+		Order: h.entityToProto(orderEntity),
+	}, nil
+}
+
+// createOrderError 将下单错误转换为gRPC状态
+func (h *GrpcHandler) createOrderError(err error) error {
+	if st := h.idempotencyError(err); st != nil {
+		return st
+	}
+	if st := h.limitExceededError(err); st != nil {
+		return st
+	}
+	if st := h.couponError(err); st != nil {
+		return st
+	}
+
+	switch {
+	case errors.Is(err, orderdomain.ErrEmptyOrderItems), errors.Is(err, orderdomain.ErrInvalidQuantity):
+		return status.Errorf(codes.InvalidArgument, "订单商品参数错误: %v", err)
+	case errors.Is(err, orderdomain.ErrAddressNotFound):
+		return status.Errorf(codes.NotFound, "收货地址不存在")
+	case errors.Is(err, orderdomain.ErrQuoteInvalid):
+		return status.Errorf(codes.InvalidArgument, "报价令牌无效")
+	case errors.Is(err, orderdomain.ErrProductUnavailable), errors.Is(err, orderdomain.ErrPriceChanged),
+		errors.Is(err, orderdomain.ErrInsufficientStock), errors.Is(err, orderdomain.ErrQuoteExpired),
+		errors.Is(err, orderdomain.ErrQuoteMismatch):
+		return status.Errorf(codes.FailedPrecondition, "创建订单失败: %v", err)
+	}
+	return status.Errorf(codes.Internal, "创建订单失败: %v", err)
+}
+
+// idempotencyError 将幂等键错误转换为gRPC状态码，其他错误返回nil
+func (h *GrpcHandler) idempotencyError(err error) error {
+	switch {
+	case errors.Is(err, idempotency.ErrInvalidKey):
+		return status.Errorf(codes.InvalidArgument, "幂等键无效: %v", err)
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return status.Errorf(codes.FailedPrecondition, "幂等键已被其他请求使用")
+	case errors.Is(err, idempotency.ErrRequestInProgress):
+		return status.Errorf(codes.Aborted, "相同幂等键的请求正在处理中，请稍后重试")
+	}
+	return nil
+}
+
+// transitionError 将非法状态转换转换为 FailedPrecondition，附带订单当前状态，其他错误返回nil
+func (h *GrpcHandler) transitionError(err error) error {
+	var transitionErr *orderdomain.TransitionError
+	if !errors.As(err, &transitionErr) {
+		return nil
+	}
+
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("订单当前状态为 %s，不能执行 %s", transitionErr.From, transitionErr.Event))
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "ORDER_STATUS_TRANSITION_INVALID",
+		Domain: "order-service",
+		Metadata: map[string]string{
+			"current_status": transitionErr.From.String(),
+			"event":          string(transitionErr.Event),
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 // GetOrder 获取订单详情
 func (h *GrpcHandler) GetOrder(ctx context.Context, req *pb.GetOrderReq) (*pb.GetOrderResp, error) {
 	// 从认证上下文获取用户ID
@@ -128,6 +304,32 @@ func (h *GrpcHandler) GetOrder(ctx context.Context, req *pb.GetOrderReq) (*pb.Ge
 	}, nil
 }
 
+// GetOrderTimeline 获取订单时间线
+func (h *GrpcHandler) GetOrderTimeline(ctx context.Context, req *pb.GetOrderTimelineReq) (*pb.GetOrderTimelineResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	events, err := h.orderService.GetOrderTimeline(ctx, orderapp.GetOrderRequest{
+		OrderID: req.OrderId,
+		UserID:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, orderdomain.ErrOrderNotFound) {
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "获取订单时间线失败: %v", err)
+	}
+
+	resp := &pb.GetOrderTimelineResp{}
+	for _, event := range events {
+		resp.Events = append(resp.Events, h.timelineEventToProto(event))
+	}
+	return resp, nil
+}
+
 // ListOrders 获取订单列表
 func (h *GrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersReq) (*pb.ListOrdersResp, error) {
 	// 从认证上下文获取用户ID
@@ -163,7 +365,7 @@ func (h *GrpcHandler) ListOrders(ctx context.Context, req *pb.ListOrdersReq) (*p
 	}, nil
 }
 
-// UpdateOrderStatus 更新订单状态
+// UpdateOrderStatus 更新订单状态，买家只能取消订单或确认收货
 func (h *GrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusReq) (*pb.UpdateOrderStatusResp, error) {
 	// 从认证上下文获取用户ID
 	userID := auth.UserIDFromContext(ctx)
@@ -183,6 +385,18 @@ func (h *GrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrder
 		if err == orderdomain.ErrOrderNotFound {
 			return nil, status.Errorf(codes.NotFound, "订单不存在")
 		}
+		if st := h.transitionError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, orderdomain.ErrInvalidOrderStatus) {
+			return nil, status.Errorf(codes.InvalidArgument, "订单状态错误: %v", err)
+		}
+		if errors.Is(err, orderdomain.ErrStatusNotAllowed) {
+			return nil, status.Errorf(codes.PermissionDenied, "只能取消订单或确认收货")
+		}
+		if errors.Is(err, orderdomain.ErrOrderConflict) {
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
 		return nil, status.Errorf(codes.Internal, "更新订单状态失败: %v", err)
 	}
 
@@ -191,6 +405,87 @@ func (h *GrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrder
 	}, nil
 }
 
+// ShipOrder 订单发货，仅运营人员可调用
+func (h *GrpcHandler) ShipOrder(ctx context.Context, req *pb.ShipOrderReq) (*pb.ShipOrderResp, error) {
+	operatorID, err := h.operators.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.CarrierCode == "" || req.TrackingNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "承运商和物流单号不能为空")
+	}
+
+	appReq := orderapp.ShipOrderRequest{
+		OrderID:        req.OrderId,
+		OperatorID:     operatorID,
+		CarrierCode:    req.CarrierCode,
+		TrackingNumber: req.TrackingNumber,
+	}
+	for _, item := range req.Items {
+		appReq.Items = append(appReq.Items, orderapp.ShipOrderItemRequest{
+			OrderItemID: item.OrderItemId,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	result, err := h.orderService.ShipOrder(ctx, appReq)
+	if err != nil {
+		if st := h.transitionError(err); st != nil {
+			return nil, st
+		}
+		switch {
+		case errors.Is(err, orderdomain.ErrOrderNotFound):
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		case errors.Is(err, orderdomain.ErrOrderItemNotFound), errors.Is(err, orderdomain.ErrInvalidQuantity):
+			return nil, status.Errorf(codes.InvalidArgument, "发货商品参数错误: %v", err)
+		case errors.Is(err, orderdomain.ErrNothingToShip), errors.Is(err, orderdomain.ErrShipmentExceedsOrder):
+			return nil, status.Errorf(codes.FailedPrecondition, "订单发货失败: %v", err)
+		case errors.Is(err, orderdomain.ErrOrderConflict):
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
+		return nil, status.Errorf(codes.Internal, "订单发货失败: %v", err)
+	}
+
+	return &pb.ShipOrderResp{
+		Shipment:    h.shipmentToProto(result.Shipment),
+		OrderStatus: pb.OrderStatus(result.Order.Status),
+	}, nil
+}
+
+// ChangeOrderAddress 运营修改收货地址，仅运营人员可调用，状态日志记录通过授权的运营人员
+func (h *GrpcHandler) ChangeOrderAddress(ctx context.Context, req *pb.ChangeOrderAddressReq) (*pb.ChangeOrderAddressResp, error) {
+	operatorID, err := h.operators.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	address := req.Address
+	if address == nil || address.ReceiverName == "" || address.ReceiverPhone == "" ||
+		address.Province == "" || address.City == "" || address.DetailAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "收货人、电话、省市和详细地址不能为空")
+	}
+
+	result, err := h.orderService.ChangeOrderAddress(ctx, orderapp.ChangeOrderAddressRequest{
+		OrderID:    req.OrderId,
+		OperatorID: operatorID,
+		Address:    h.addressRequest(address),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, orderdomain.ErrOrderNotFound), errors.Is(err, orderdomain.ErrOrderAddressNotFound):
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		case errors.Is(err, orderdomain.ErrAddressNotChangeable):
+			return nil, status.Errorf(codes.FailedPrecondition, "订单已发货，不能修改收货地址")
+		case errors.Is(err, orderdomain.ErrOrderConflict):
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
+		return nil, status.Errorf(codes.Internal, "修改收货地址失败: %v", err)
+	}
+
+	return &pb.ChangeOrderAddressResp{
+		Address: h.addressToProto(result),
+	}, nil
+}
+
 // CancelOrder 取消订单
 func (h *GrpcHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderReq) (*pb.CancelOrderResp, error) {
 	// 从认证上下文获取用户ID
@@ -210,6 +505,12 @@ func (h *GrpcHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderReq) (
 		if err == orderdomain.ErrOrderNotFound {
 			return nil, status.Errorf(codes.NotFound, "订单不存在")
 		}
+		if st := h.transitionError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, orderdomain.ErrOrderConflict) {
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
 		return nil, status.Errorf(codes.Internal, "取消订单失败: %v", err)
 	}
 
@@ -227,16 +528,35 @@ func (h *GrpcHandler) PayOrder(ctx context.Context, req *pb.PayOrderReq) (*pb.Pa
 	}
 
 	appReq := orderapp.PayOrderRequest{
-		OrderID:       req.OrderId,
-		UserID:        userID,
-		PaymentMethod: req.PaymentMethod,
+		OrderID:        req.OrderId,
+		UserID:         userID,
+		PaymentMethod:  req.PaymentMethod,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 
 	err := h.orderService.PayOrder(ctx, appReq)
 	if err != nil {
+		if st := h.idempotencyError(err); st != nil {
+			return nil, st
+		}
 		if err == orderdomain.ErrOrderNotFound {
 			return nil, status.Errorf(codes.NotFound, "订单不存在")
 		}
+		if errors.Is(err, orderdomain.ErrOrderExpired) {
+			return nil, status.Errorf(codes.FailedPrecondition, "订单已超过支付截止时间")
+		}
+		if errors.Is(err, orderdomain.ErrBalanceNotPayable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "尾款支付尚未开始")
+		}
+		if errors.Is(err, orderdomain.ErrDepositUnpaid) {
+			return nil, status.Errorf(codes.FailedPrecondition, "请先支付定金")
+		}
+		if st := h.transitionError(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, orderdomain.ErrOrderConflict) {
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
 		return nil, status.Errorf(codes.Internal, "支付订单失败: %v", err)
 	}
 
@@ -245,6 +565,41 @@ func (h *GrpcHandler) PayOrder(ctx context.Context, req *pb.PayOrderReq) (*pb.Pa
 	}, nil
 }
 
+// ExtendReceive 延长收货
+func (h *GrpcHandler) ExtendReceive(ctx context.Context, req *pb.ExtendReceiveReq) (*pb.ExtendReceiveResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	orderEntity, err := h.orderService.ExtendReceive(ctx, orderapp.ExtendReceiveRequest{
+		OrderID: req.OrderId,
+		UserID:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, orderdomain.ErrOrderNotFound) {
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		if errors.Is(err, orderdomain.ErrReceiveNotExtendable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "只有已发货的订单可以延长收货")
+		}
+		if errors.Is(err, orderdomain.ErrReceiveExtended) {
+			return nil, status.Errorf(codes.FailedPrecondition, "订单已延长过收货")
+		}
+		if errors.Is(err, orderdomain.ErrOrderConflict) {
+			return nil, status.Errorf(codes.Aborted, "订单状态已变更，请刷新后重试")
+		}
+		return nil, status.Errorf(codes.Internal, "延长收货失败: %v", err)
+	}
+
+	resp := &pb.ExtendReceiveResp{}
+	if deadline, ok := h.orderService.AutoConfirmDeadline(orderEntity); ok {
+		resp.AutoConfirmDeadline = timestamppb.New(deadline)
+	}
+	return resp, nil
+}
+
 // entityToProto 将领域实体转换为proto对象
 func (h *GrpcHandler) entityToProto(orderEntity *orderdomain.Order) *pb.Order {
 	pbOrder := &pb.Order{
@@ -300,10 +655,115 @@ func (h *GrpcHandler) entityToProto(orderEntity *orderdomain.Order) *pb.Order {
 			pbOrder.UpdatedAt = timestamppb.New(t)
 		}
 	}
+	if orderEntity.PaymentDeadline != "" {
+		if t, err := time.ParseInLocation("2006-01-02 15:04:05", orderEntity.PaymentDeadline, time.Local); err == nil {
+			pbOrder.PaymentDeadline = timestamppb.New(t)
+		}
+	}
+	if deadline, ok := h.orderService.AutoConfirmDeadline(orderEntity); ok {
+		pbOrder.AutoConfirmDeadline = timestamppb.New(deadline)
+	}
+	pbOrder.ReceiveExtended = orderEntity.ReceiveDeadline != ""
+	pbOrder.PresaleId = orderEntity.PresaleID
+	for _, payment := range orderEntity.PaymentStages {
+		pbOrder.PaymentStages = append(pbOrder.PaymentStages, h.paymentStageToProto(payment))
+	}
 
 	return pbOrder
 }
 
+// addressRequest 将protobuf地址转换为应用层请求，未填写时返回空地址
+func (h *GrpcHandler) addressRequest(address *pb.OrderAddressReq) orderapp.CreateOrderAddressRequest {
+	if address == nil {
+		return orderapp.CreateOrderAddressRequest{}
+	}
+	return orderapp.CreateOrderAddressRequest{
+		ReceiverName:  address.ReceiverName,
+		ReceiverPhone: address.ReceiverPhone,
+		Province:      address.Province,
+		City:          address.City,
+		District:      address.District,
+		DetailAddress: address.DetailAddress,
+		PostalCode:    address.PostalCode,
+	}
+}
+
+// addressToProto 将订单地址快照转换为proto对象
+func (h *GrpcHandler) addressToProto(address *orderdomain.OrderAddress) *pb.OrderAddress {
+	return &pb.OrderAddress{
+		Id:            address.ID,
+		ReceiverName:  address.ReceiverName,
+		ReceiverPhone: address.ReceiverPhone,
+		Province:      address.Province,
+		City:          address.City,
+		District:      address.District,
+		Address:       address.DetailAddress,
+		PostalCode:    address.PostalCode,
+	}
+}
+
+// shipmentToProto 将发货包裹转换为proto对象
+func (h *GrpcHandler) shipmentToProto(shipment *orderdomain.Shipment) *pb.Shipment {
+	pbShipment := &pb.Shipment{
+		Id:             shipment.ID,
+		OrderId:        shipment.OrderID,
+		CarrierCode:    shipment.CarrierCode,
+		TrackingNumber: shipment.TrackingNumber,
+	}
+	for _, item := range shipment.Items {
+		pbShipment.Items = append(pbShipment.Items, &pb.ShipmentItem{
+			OrderItemId: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", shipment.ShippedAt, time.Local); err == nil {
+		pbShipment.ShippedAt = timestamppb.New(t)
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", shipment.CreatedAt, time.Local); err == nil {
+		pbShipment.CreatedAt = timestamppb.New(t)
+	}
+
+	return pbShipment
+}
+
+// timelineEventToProto 时间线事件转换为 protobuf 消息
+func (h *GrpcHandler) timelineEventToProto(event *orderdomain.TimelineEvent) *pb.TimelineEvent {
+	pbEvent := &pb.TimelineEvent{
+		FromStatus:   pb.OrderStatus(event.FromStatus),
+		ToStatus:     pb.OrderStatus(event.ToStatus),
+		OperatorId:   event.OperatorID,
+		OperatorType: event.OperatorType,
+		Remark:       event.Remark,
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", event.OccurredAt, time.Local); err == nil {
+		pbEvent.OccurredAt = timestamppb.New(t)
+	}
+
+	switch event.Type {
+	case orderdomain.TimelineEventStatusChanged:
+		pbEvent.Type = pb.TimelineEventType_TIMELINE_EVENT_TYPE_STATUS_CHANGED
+	case orderdomain.TimelineEventPaid:
+		pbEvent.Type = pb.TimelineEventType_TIMELINE_EVENT_TYPE_PAID
+		pbEvent.PaymentMethod = pb.PaymentMethod(pb.PaymentMethod_value[event.PaymentMethod])
+		pbEvent.Amount = event.Amount.String()
+	case orderdomain.TimelineEventAddressChanged:
+		pbEvent.Type = pb.TimelineEventType_TIMELINE_EVENT_TYPE_ADDRESS_CHANGED
+	case orderdomain.TimelineEventShipped:
+		pbEvent.Type = pb.TimelineEventType_TIMELINE_EVENT_TYPE_SHIPPED
+		pbEvent.Shipment = h.shipmentToProto(&orderdomain.Shipment{
+			ID:             event.ShipmentID,
+			CarrierCode:    event.CarrierCode,
+			TrackingNumber: event.TrackingNumber,
+			OperatorID:     event.OperatorID,
+			Items:          event.Items,
+			ShippedAt:      event.OccurredAt,
+		})
+	}
+
+	return pbEvent
+}
+
 // parseTime 解析时间字符串
 func (h *GrpcHandler) parseTime(timeStr string) *timestamppb.Timestamp {
 	if timeStr == "" {
@@ -0,0 +1,121 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+)
+
+// exportStream 只提供上下文的导出流
+type exportStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(*pb.ExportOrdersResp) error { return nil }
+
+// TestOperatorOnlyRPCs 后台接口在调用应用服务之前拒绝非运营人员
+func TestOperatorOnlyRPCs(t *testing.T) {
+	h := NewGrpcHandler(nil, auth.NewOperators([]string{"operator-1"}))
+
+	rpcs := map[string]func(ctx context.Context) error{
+		"ShipOrder": func(ctx context.Context) error {
+			_, err := h.ShipOrder(ctx, &pb.ShipOrderReq{OrderId: "order-1", CarrierCode: "SF", TrackingNumber: "SF1"})
+			return err
+		},
+		"SearchOrders": func(ctx context.Context) error {
+			_, err := h.SearchOrders(ctx, &pb.SearchOrdersReq{})
+			return err
+		},
+		"ExportOrders": func(ctx context.Context) error {
+			return h.ExportOrders(&pb.ExportOrdersReq{}, &exportStream{ctx: ctx})
+		},
+		"SetPurchaseLimit": func(ctx context.Context) error {
+			_, err := h.SetPurchaseLimit(ctx, &pb.SetPurchaseLimitReq{SkuId: "sku-1", MaxPerUser: 100})
+			return err
+		},
+		"GetPurchaseLimit": func(ctx context.Context) error {
+			_, err := h.GetPurchaseLimit(ctx, &pb.GetPurchaseLimitReq{SkuId: "sku-1"})
+			return err
+		},
+		"DeletePurchaseLimit": func(ctx context.Context) error {
+			_, err := h.DeletePurchaseLimit(ctx, &pb.DeletePurchaseLimitReq{SkuId: "sku-1"})
+			return err
+		},
+		"CreateCouponTemplate": func(ctx context.Context) error {
+			_, err := h.CreateCouponTemplate(ctx, &pb.CreateCouponTemplateReq{Name: "满100减100"})
+			return err
+		},
+		"CreateFreightTemplate": func(ctx context.Context) error {
+			_, err := h.CreateFreightTemplate(ctx, &pb.CreateFreightTemplateReq{})
+			return err
+		},
+		"UpdateFreightTemplate": func(ctx context.Context) error {
+			_, err := h.UpdateFreightTemplate(ctx, &pb.UpdateFreightTemplateReq{Id: "template-1"})
+			return err
+		},
+		"ListFreightTemplates": func(ctx context.Context) error {
+			_, err := h.ListFreightTemplates(ctx, &pb.ListFreightTemplatesReq{})
+			return err
+		},
+		"SetProductFreightTemplate": func(ctx context.Context) error {
+			_, err := h.SetProductFreightTemplate(ctx, &pb.SetProductFreightTemplateReq{ProductId: "product-1"})
+			return err
+		},
+		"ChangeOrderAddress": func(ctx context.Context) error {
+			_, err := h.ChangeOrderAddress(ctx, &pb.ChangeOrderAddressReq{OrderId: "order-1", Address: &pb.OrderAddressReq{ReceiverName: "张三", ReceiverPhone: "13800000000", Province: "浙江", City: "杭州", DetailAddress: "西湖区"}})
+			return err
+		},
+		"CreateFlashSale": func(ctx context.Context) error {
+			_, err := h.CreateFlashSale(ctx, &pb.CreateFlashSaleReq{Name: "秒杀", Price: "0.01"})
+			return err
+		},
+		"CreatePresale": func(ctx context.Context) error {
+			_, err := h.CreatePresale(ctx, &pb.CreatePresaleReq{Name: "预售", Price: "0.01", Deposit: "0.01"})
+			return err
+		},
+	}
+
+	shopper := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.GrpcUserIDMetadataKey, "user-1"))
+	for name, call := range rpcs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background())))
+			assert.Equal(t, codes.PermissionDenied, status.Code(call(shopper)))
+		})
+	}
+}
+
+func TestStatsScopeUserID(t *testing.T) {
+	h := NewGrpcHandler(nil, auth.NewOperators([]string{"operator-1"}))
+
+	// 普通用户不能请求全局统计
+	_, err := h.statsScopeUserID("user-1", pb.StatsScope_STATS_SCOPE_GLOBAL)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	userID, err := h.statsScopeUserID("user-1", pb.StatsScope_STATS_SCOPE_USER)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+
+	userID, err = h.statsScopeUserID("operator-1", pb.StatsScope_STATS_SCOPE_GLOBAL)
+	require.NoError(t, err)
+	assert.Empty(t, userID)
+
+	userID, err = h.statsScopeUserID("operator-1", pb.StatsScope_STATS_SCOPE_USER)
+	require.NoError(t, err)
+	assert.Equal(t, "operator-1", userID)
+
+	_, err = h.statsScopeUserID("user-1", pb.StatsScope(99))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
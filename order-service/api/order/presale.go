@@ -0,0 +1,145 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/presale"
+)
+
+// CreatePresale 创建预售活动，仅运营人员可调用
+func (h *GrpcHandler) CreatePresale(ctx context.Context, req *pb.CreatePresaleReq) (*pb.CreatePresaleResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	price, err := h.parseDecimal(req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "预售价格式错误: %v", err)
+	}
+	deposit, err := h.parseDecimal(req.Deposit)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "定金格式错误: %v", err)
+	}
+
+	p, err := h.orderService.CreatePresale(ctx, orderapp.CreatePresaleRequest{
+		Name:           req.Name,
+		ProductID:      req.ProductId,
+		SkuID:          req.SkuId,
+		Price:          price,
+		Deposit:        deposit,
+		DepositPolicy:  presale.DepositPolicy(req.DepositPolicy),
+		DepositStartAt: formatLocalTime(req.DepositStartAt),
+		DepositEndAt:   formatLocalTime(req.DepositEndAt),
+		BalanceStartAt: formatLocalTime(req.BalanceStartAt),
+		BalanceEndAt:   formatLocalTime(req.BalanceEndAt),
+	})
+	if err != nil {
+		if errors.Is(err, presale.ErrInvalidPresale) {
+			return nil, status.Errorf(codes.InvalidArgument, "预售活动参数错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "创建预售活动失败: %v", err)
+	}
+
+	return &pb.CreatePresaleResp{
+		Presale: h.presaleToProto(p),
+	}, nil
+}
+
+// ListPresales 预售活动列表
+func (h *GrpcHandler) ListPresales(ctx context.Context, req *pb.ListPresalesReq) (*pb.ListPresalesResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	presales, err := h.orderService.ListPresales(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "获取预售活动失败: %v", err)
+	}
+
+	resp := &pb.ListPresalesResp{Presales: make([]*pb.Presale, 0, len(presales))}
+	for _, p := range presales {
+		resp.Presales = append(resp.Presales, h.presaleToProto(p))
+	}
+	return resp, nil
+}
+
+// CreatePresaleOrder 预售下单
+func (h *GrpcHandler) CreatePresaleOrder(ctx context.Context, req *pb.CreatePresaleOrderReq) (*pb.CreatePresaleOrderResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+	if req.AddressId == "" {
+		return nil, status.Error(codes.InvalidArgument, "收货地址不能为空")
+	}
+
+	orderEntity, err := h.orderService.CreatePresaleOrder(ctx, orderapp.CreatePresaleOrderRequest{
+		UserID:         userID,
+		PresaleID:      req.PresaleId,
+		Quantity:       req.Quantity,
+		AddressID:      req.AddressId,
+		PaymentMethod:  req.PaymentMethod,
+		Remark:         req.Remark,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, presale.ErrPresaleNotFound):
+			return nil, status.Errorf(codes.NotFound, "预售活动不存在")
+		case errors.Is(err, presale.ErrPresaleNotActive):
+			return nil, status.Errorf(codes.FailedPrecondition, "预售活动不在定金期内")
+		case errors.Is(err, orderdomain.ErrInvalidPresaleTerms):
+			return nil, status.Errorf(codes.FailedPrecondition, "预售订单金额错误: %v", err)
+		}
+		return nil, h.createOrderError(err)
+	}
+
+	return &pb.CreatePresaleOrderResp{
+		Order: h.entityToProto(orderEntity),
+	}, nil
+}
+
+// presaleToProto 将预售活动转换为protobuf消息
+func (h *GrpcHandler) presaleToProto(p *presale.Presale) *pb.Presale {
+	return &pb.Presale{
+		Id:             p.ID,
+		Name:           p.Name,
+		ProductId:      p.ProductID,
+		SkuId:          p.SkuID,
+		Price:          p.Price.StringFixed(2),
+		Deposit:        p.Deposit.StringFixed(2),
+		DepositPolicy:  pb.DepositPolicy(p.DepositPolicy),
+		DepositStartAt: h.parseTime(p.DepositStartAt),
+		DepositEndAt:   h.parseTime(p.DepositEndAt),
+		BalanceStartAt: h.parseTime(p.BalanceStartAt),
+		BalanceEndAt:   h.parseTime(p.BalanceEndAt),
+		CreatedAt:      h.parseTime(p.CreatedAt),
+	}
+}
+
+// paymentStageToProto 将订单支付阶段转换为protobuf消息
+func (h *GrpcHandler) paymentStageToProto(payment *orderdomain.OrderPayment) *pb.OrderPaymentStage {
+	return &pb.OrderPaymentStage{
+		Id:             payment.ID,
+		Stage:          pb.PaymentStage(payment.Stage),
+		Amount:         payment.Amount.StringFixed(2),
+		PaymentStatus:  payment.Status,
+		RefundedAmount: payment.RefundedAmount.StringFixed(2),
+		PayableFrom:    h.parseTime(payment.PayableFrom),
+		Deadline:       h.parseTime(payment.Deadline),
+		PaidAt:         h.parseTime(payment.PaidAt),
+		Forfeitable:    payment.Forfeitable,
+	}
+}
@@ -0,0 +1,114 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
+)
+
+// SetPurchaseLimit 设置限购规则，仅运营人员可调用
+func (h *GrpcHandler) SetPurchaseLimit(ctx context.Context, req *pb.SetPurchaseLimitReq) (*pb.SetPurchaseLimitResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, err := h.orderService.SetPurchaseLimit(ctx, orderapp.SetPurchaseLimitRequest{
+		SkuID:       req.SkuId,
+		ProductID:   req.ProductId,
+		MaxPerOrder: req.MaxPerOrder,
+		MaxPerUser:  req.MaxPerUser,
+		WindowDays:  req.WindowDays,
+	})
+	if err != nil {
+		if errors.Is(err, purchaselimit.ErrInvalidLimit) {
+			return nil, status.Errorf(codes.InvalidArgument, "限购规则参数错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "设置限购规则失败: %v", err)
+	}
+
+	return &pb.SetPurchaseLimitResp{
+		Limit: h.purchaseLimitToProto(limit),
+	}, nil
+}
+
+// GetPurchaseLimit 获取限购规则，仅运营人员可调用
+func (h *GrpcHandler) GetPurchaseLimit(ctx context.Context, req *pb.GetPurchaseLimitReq) (*pb.GetPurchaseLimitResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	limit, err := h.orderService.GetPurchaseLimit(ctx, req.SkuId)
+	if err != nil {
+		if errors.Is(err, purchaselimit.ErrLimitNotFound) {
+			return nil, status.Errorf(codes.NotFound, "限购规则不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "获取限购规则失败: %v", err)
+	}
+
+	return &pb.GetPurchaseLimitResp{
+		Limit: h.purchaseLimitToProto(limit),
+	}, nil
+}
+
+// DeletePurchaseLimit 删除限购规则，仅运营人员可调用
+func (h *GrpcHandler) DeletePurchaseLimit(ctx context.Context, req *pb.DeletePurchaseLimitReq) (*pb.DeletePurchaseLimitResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.orderService.DeletePurchaseLimit(ctx, req.SkuId); err != nil {
+		if errors.Is(err, purchaselimit.ErrLimitNotFound) {
+			return nil, status.Errorf(codes.NotFound, "限购规则不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "删除限购规则失败: %v", err)
+	}
+
+	return &pb.DeletePurchaseLimitResp{
+		Success: true,
+	}, nil
+}
+
+// limitExceededError 将超出限购转换为 ResourceExhausted，附带触发的规则和剩余可购买数量，其他错误返回nil
+func (h *GrpcHandler) limitExceededError(err error) error {
+	var exceeded *purchaselimit.ExceededError
+	if !errors.As(err, &exceeded) {
+		return nil
+	}
+
+	st := status.New(codes.ResourceExhausted, exceeded.Message())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "PURCHASE_LIMIT_EXCEEDED",
+		Domain: "order-service",
+		Metadata: map[string]string{
+			"sku_id":    exceeded.SkuID,
+			"scope":     string(exceeded.Scope),
+			"limit":     strconv.Itoa(int(exceeded.Limit)),
+			"remaining": strconv.Itoa(int(exceeded.Remaining)),
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// purchaseLimitToProto 将限购规则转换为protobuf消息
+func (h *GrpcHandler) purchaseLimitToProto(limit *purchaselimit.Limit) *pb.PurchaseLimit {
+	return &pb.PurchaseLimit{
+		SkuId:       limit.SkuID,
+		ProductId:   limit.ProductID,
+		MaxPerOrder: limit.MaxPerOrder,
+		MaxPerUser:  limit.MaxPerUser,
+		WindowDays:  limit.WindowDays,
+		CreatedAt:   h.parseTime(limit.CreatedAt),
+		UpdatedAt:   h.parseTime(limit.UpdatedAt),
+	}
+}
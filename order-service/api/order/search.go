@@ -0,0 +1,205 @@
+package order
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// exportHeader 导出 CSV 的表头
+var exportHeader = []string{"订单号", "用户ID", "订单状态", "支付方式", "支付状态", "商品总额", "优惠金额", "运费", "实付金额", "下单时间", "支付时间"}
+
+var orderStatusLabels = map[int32]string{
+	int32(orderdomain.OrderStatusPendingPayment): "待付款",
+	int32(orderdomain.OrderStatusDepositPaid):    "待付尾款",
+	int32(orderdomain.OrderStatusPaid):           "已付款",
+	int32(orderdomain.OrderStatusShipped):        "已发货",
+	int32(orderdomain.OrderStatusDelivered):      "已收货",
+	int32(orderdomain.OrderStatusCancelled):      "已取消",
+	int32(orderdomain.OrderStatusRefunded):       "已退款",
+}
+
+var paymentStatusLabels = map[int32]string{
+	int32(orderdomain.PaymentStatusUnpaid):    "未支付",
+	int32(orderdomain.PaymentStatusPaid):      "已支付",
+	int32(orderdomain.PaymentStatusRefunding): "退款中",
+	int32(orderdomain.PaymentStatusRefunded):  "已退款",
+	int32(orderdomain.PaymentStatusForfeited): "定金不退",
+}
+
+// SearchOrders 运营搜索订单，仅运营人员可调用
+func (h *GrpcHandler) SearchOrders(ctx context.Context, req *pb.SearchOrdersReq) (*pb.SearchOrdersResp, error) {
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := h.searchFilterFromProto(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "搜索条件错误: %v", err)
+	}
+
+	result, err := h.orderService.SearchOrders(ctx, orderapp.SearchOrdersRequest{
+		Filter:   filter,
+		Cursor:   req.Cursor,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, searchError(err, "搜索订单失败")
+	}
+
+	resp := &pb.SearchOrdersResp{NextCursor: result.NextCursor}
+	for _, orderEntity := range result.Orders {
+		resp.Orders = append(resp.Orders, h.entityToProto(orderEntity))
+	}
+	return resp, nil
+}
+
+// ExportOrders 按搜索条件流式导出 CSV，仅运营人员可调用
+func (h *GrpcHandler) ExportOrders(req *pb.ExportOrdersReq, stream grpc.ServerStreamingServer[pb.ExportOrdersResp]) error {
+	ctx := stream.Context()
+	if _, err := h.operators.Authorize(ctx); err != nil {
+		return err
+	}
+
+	filter, err := h.searchFilterFromProto(req.Filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "搜索条件错误: %v", err)
+	}
+
+	var buf bytes.Buffer
+	// UTF-8 BOM，避免 Excel 打开中文表头乱码
+	buf.WriteString("\ufeff")
+	w := csv.NewWriter(&buf)
+	if err := w.Write(exportHeader); err != nil {
+		return status.Errorf(codes.Internal, "导出订单失败: %v", err)
+	}
+
+	err = h.orderService.ExportOrders(ctx, filter, func(orders []*orderdomain.Order) error {
+		for _, o := range orders {
+			if err := w.Write(exportRow(o)); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+
+		chunk := make([]byte, buf.Len())
+		copy(chunk, buf.Bytes())
+		buf.Reset()
+		return stream.Send(&pb.ExportOrdersResp{Chunk: chunk})
+	})
+	if err != nil {
+		return searchError(err, "导出订单失败")
+	}
+
+	// 没有匹配的订单时只发送表头
+	w.Flush()
+	if buf.Len() > 0 {
+		return stream.Send(&pb.ExportOrdersResp{Chunk: buf.Bytes()})
+	}
+	return nil
+}
+
+// searchFilterFromProto 转换搜索条件，时间统一转换为本地时间与数据库保持一致
+func (h *GrpcHandler) searchFilterFromProto(pbFilter *pb.OrderSearchFilter) (orderdomain.SearchFilter, error) {
+	var filter orderdomain.SearchFilter
+	if pbFilter == nil {
+		return filter, nil
+	}
+
+	filter.OrderNo = pbFilter.OrderNo
+	filter.UserID = pbFilter.UserId
+	filter.PaymentMethod = pbFilter.PaymentMethod
+	for _, s := range pbFilter.Statuses {
+		filter.Statuses = append(filter.Statuses, int32(s))
+	}
+
+	if pbFilter.MinAmount != "" {
+		amount, err := decimal.NewFromString(pbFilter.MinAmount)
+		if err != nil {
+			return filter, errors.New("金额下限格式错误")
+		}
+		filter.MinAmount = &amount
+	}
+	if pbFilter.MaxAmount != "" {
+		amount, err := decimal.NewFromString(pbFilter.MaxAmount)
+		if err != nil {
+			return filter, errors.New("金额上限格式错误")
+		}
+		filter.MaxAmount = &amount
+	}
+
+	filter.CreatedFrom = localTime(pbFilter.CreatedFrom)
+	filter.CreatedTo = localTime(pbFilter.CreatedTo)
+	filter.PaidFrom = localTime(pbFilter.PaidFrom)
+	filter.PaidTo = localTime(pbFilter.PaidTo)
+	return filter, nil
+}
+
+// localTime 未设置时返回零值
+func localTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime().In(time.Local)
+}
+
+// searchError 转换搜索、导出的错误
+func searchError(err error, msg string) error {
+	switch {
+	case errors.Is(err, orderdomain.ErrInvalidSearchFilter), errors.Is(err, orderdomain.ErrInvalidSearchCursor):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, msg)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// exportRow 订单转换为 CSV 行
+func exportRow(o *orderdomain.Order) []string {
+	return []string{
+		csvSafe(o.OrderNo),
+		csvSafe(o.UserID),
+		labelOrCode(orderStatusLabels, o.Status),
+		csvSafe(o.PaymentMethod),
+		labelOrCode(paymentStatusLabels, o.PaymentStatus),
+		o.TotalAmount.StringFixed(2),
+		o.DiscountAmount.StringFixed(2),
+		o.ShippingFee.StringFixed(2),
+		o.ActualAmount.StringFixed(2),
+		o.CreatedAt,
+		o.PaymentTime,
+	}
+}
+
+func labelOrCode(labels map[int32]string, code int32) string {
+	if label, ok := labels[code]; ok {
+		return label
+	}
+	return strconv.Itoa(int(code))
+}
+
+// csvSafe 转义以公式字符开头的值，避免在表格软件中被当作公式执行
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
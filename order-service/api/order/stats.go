@@ -0,0 +1,98 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	orderdomain "github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+var statsGranularities = map[pb.StatsGranularity]orderdomain.StatsGranularity{
+	pb.StatsGranularity_STATS_GRANULARITY_UNKNOWN: orderdomain.StatsGranularityDay,
+	pb.StatsGranularity_STATS_GRANULARITY_DAY:     orderdomain.StatsGranularityDay,
+	pb.StatsGranularity_STATS_GRANULARITY_WEEK:    orderdomain.StatsGranularityWeek,
+	pb.StatsGranularity_STATS_GRANULARITY_MONTH:   orderdomain.StatsGranularityMonth,
+}
+
+// GetOrderStats 订单统计
+func (h *GrpcHandler) GetOrderStats(ctx context.Context, req *pb.GetOrderStatsReq) (*pb.GetOrderStatsResp, error) {
+	// 从认证上下文获取用户ID
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "用户未认证")
+	}
+
+	granularity, ok := statsGranularities[req.Granularity]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的汇总周期: %v", req.Granularity)
+	}
+
+	scopeUserID, err := h.statsScopeUserID(userID, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	appReq := orderapp.GetOrderStatsRequest{
+		UserID:      scopeUserID,
+		Granularity: granularity,
+		From:        localTime(req.StartTime),
+		To:          localTime(req.EndTime),
+	}
+
+	result, err := h.orderService.GetOrderStats(ctx, appReq)
+	if err != nil {
+		if errors.Is(err, orderdomain.ErrInvalidStatsRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "统计范围错误: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "订单统计失败: %v", err)
+	}
+
+	resp := &pb.GetOrderStatsResp{
+		Counts: &pb.OrderStatusCounts{
+			TotalOrders:     result.Counts.TotalOrders,
+			PendingOrders:   result.Counts.PendingOrders,
+			PaidOrders:      result.Counts.PaidOrders,
+			ShippedOrders:   result.Counts.ShippedOrders,
+			DeliveredOrders: result.Counts.DeliveredOrders,
+			CancelledOrders: result.Counts.CancelledOrders,
+			RefundedOrders:  result.Counts.RefundedOrders,
+		},
+		TotalGmv:          result.TotalGMV.StringFixed(2),
+		TotalPaidAmount:   result.TotalPaid.StringFixed(2),
+		TotalRefundAmount: result.TotalRefund.StringFixed(2),
+		StartTime:         timestamppb.New(result.From),
+		EndTime:           timestamppb.New(result.To),
+	}
+	for _, bucket := range result.Buckets {
+		resp.Buckets = append(resp.Buckets, &pb.AmountBucket{
+			Period:       bucket.Period,
+			Gmv:          bucket.GMV.StringFixed(2),
+			PaidAmount:   bucket.PaidAmount.StringFixed(2),
+			RefundAmount: bucket.RefundAmount.StringFixed(2),
+		})
+	}
+	return resp, nil
+}
+
+// statsScopeUserID 返回统计范围对应的用户ID，空字符串表示全部用户
+// 全局统计只对运营人员开放，其他用户请求全局统计时返回 PermissionDenied。
+func (h *GrpcHandler) statsScopeUserID(userID string, scope pb.StatsScope) (string, error) {
+	switch scope {
+	case pb.StatsScope_STATS_SCOPE_UNKNOWN, pb.StatsScope_STATS_SCOPE_USER:
+		return userID, nil
+	case pb.StatsScope_STATS_SCOPE_GLOBAL:
+		if !h.operators.Contains(userID) {
+			return "", status.Error(codes.PermissionDenied, "无运营权限")
+		}
+		return "", nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "不支持的统计范围: %v", scope)
+}
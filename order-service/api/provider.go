@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/google/wire"
 
+	"github.com/people257/poor-guy-shop/order-service/api/aftersale"
 	"github.com/people257/poor-guy-shop/order-service/api/cart"
 	"github.com/people257/poor-guy-shop/order-service/api/order"
 )
@@ -11,4 +12,5 @@ import (
 var ProviderSet = wire.NewSet(
 	order.NewGrpcHandler,
 	cart.NewGrpcHandler,
+	aftersale.NewGrpcHandler,
 )
@@ -2,14 +2,20 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log"
 	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/people257/poor-guy-shop/common/auth"
+	authpb "github.com/people257/poor-guy-shop/user-service/gen/proto/user/auth"
+
+	pbaftersale "github.com/people257/poor-guy-shop/order-service/gen/proto/order/aftersale"
 	pbcart "github.com/people257/poor-guy-shop/order-service/gen/proto/order/cart"
 	pborder "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
 )
@@ -18,6 +24,7 @@ func main() {
 	var (
 		grpcAddr    = flag.String("grpc-addr", ":9002", "gRPC server address")
 		gatewayAddr = flag.String("gateway-addr", ":8002", "Gateway server address")
+		authAddr    = flag.String("auth-addr", "localhost:9000", "User service gRPC address for authentication")
 	)
 	flag.Parse()
 
@@ -32,6 +39,13 @@ func main() {
 	}
 	defer conn.Close()
 
+	// 创建用户服务认证连接
+	authConn, err := grpc.DialContext(ctx, *authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial auth server: %v", err)
+	}
+	defer authConn.Close()
+
 	// 创建gRPC-Gateway mux
 	mux := runtime.NewServeMux()
 
@@ -45,8 +59,20 @@ func main() {
 		log.Fatalf("Failed to register cart service handler: %v", err)
 	}
 
+	// 注册售后服务
+	if err := pbaftersale.RegisterAfterSaleServiceHandler(ctx, mux, conn); err != nil {
+		log.Fatalf("Failed to register after-sale service handler: %v", err)
+	}
+
+	e := echo.New()
+	e.HideBanner = true
+	// 清除客户端传入的 Grpc-Metadata- 请求头，认证通过后写入 user-id metadata
+	e.Use(auth.BuildMetadataMiddleware(authpb.NewAuthServiceClient(authConn)))
+	// 为游客签发购物车令牌
+	e.Any("/*", echo.WrapHandler(auth.CartTokenHandler(mux)))
+
 	log.Printf("Starting gateway server on %s", *gatewayAddr)
-	if err := http.ListenAndServe(*gatewayAddr, mux); err != nil {
+	if err := e.Start(*gatewayAddr); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("Failed to start gateway server: %v", err)
 	}
 }
@@ -5,16 +5,20 @@ import (
 
 	"github.com/people257/poor-guy-shop/common/server"
 
+	"github.com/people257/poor-guy-shop/order-service/api/aftersale"
 	"github.com/people257/poor-guy-shop/order-service/api/cart"
 	"github.com/people257/poor-guy-shop/order-service/api/order"
+	pb_aftersale "github.com/people257/poor-guy-shop/order-service/gen/proto/order/aftersale"
 	pb_cart "github.com/people257/poor-guy-shop/order-service/gen/proto/order/cart"
 	pb_order "github.com/people257/poor-guy-shop/order-service/gen/proto/order/order"
+	orderapp "github.com/people257/poor-guy-shop/order-service/internal/application/order"
 	"google.golang.org/grpc"
 )
 
 // Application 应用程序结构
 type Application struct {
-	Server *server.Server
+	Server    *server.Server
+	Scheduler *orderapp.Scheduler
 }
 
 // NewApplication 创建应用程序实例
@@ -22,19 +26,27 @@ func NewApplication(
 	srv *server.Server,
 	orderHandler *order.GrpcHandler,
 	cartHandler *cart.GrpcHandler,
+	afterSaleHandler *aftersale.GrpcHandler,
+	scheduler *orderapp.Scheduler,
 ) *Application {
 	// 注册gRPC服务
 	srv.RegisterServer(func(grpcServer *grpc.Server) {
 		pb_order.RegisterOrderServiceServer(grpcServer, orderHandler)
 		pb_cart.RegisterCartServiceServer(grpcServer, cartHandler)
+		pb_aftersale.RegisterAfterSaleServiceServer(grpcServer, afterSaleHandler)
 	})
 
 	return &Application{
-		Server: srv,
+		Server:    srv,
+		Scheduler: scheduler,
 	}
 }
 
 // Run 运行应用程序
 func (app *Application) Run(ctx context.Context) error {
+	// 启动定时任务
+	app.Scheduler.Start(ctx)
+	defer app.Scheduler.Stop()
+
 	return app.Server.Run(ctx)
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
@@ -20,6 +22,91 @@ type ServicesConfig struct {
 	ProductService   ServiceConfig `mapstructure:"product_service"`
 	PaymentService   ServiceConfig `mapstructure:"payment_service"`
 	InventoryService ServiceConfig `mapstructure:"inventory_service"`
+	OSSService       ServiceConfig `mapstructure:"oss_service"`
+}
+
+// OrderConfig 订单业务配置
+type OrderConfig struct {
+	// PaymentTimeout 下单后的支付时限，超时未支付的订单自动取消
+	PaymentTimeout time.Duration `mapstructure:"payment_timeout"`
+	// AutoConfirmDays 发货后自动确认收货的天数，买家未确认收货的订单到期由系统确认
+	AutoConfirmDays int `mapstructure:"auto_confirm_days"`
+	// ReceiveExtensionDays 买家延长收货时顺延的天数，每个订单只能延长一次
+	ReceiveExtensionDays int `mapstructure:"receive_extension_days"`
+	// QuoteTTL 下单报价的有效期
+	QuoteTTL time.Duration `mapstructure:"quote_ttl"`
+	// QuoteSecret 报价令牌的签名密钥，多实例部署时必须一致
+	QuoteSecret string `mapstructure:"quote_secret"`
+	// IdempotencyTTL 下单、支付幂等键的保留时长，有效期内的重放返回首次请求的结果
+	IdempotencyTTL time.Duration `mapstructure:"idempotency_ttl"`
+	// GuestCartTTL 游客购物车的有效期，超过该时长未访问的游客购物车自动过期
+	GuestCartTTL time.Duration `mapstructure:"guest_cart_ttl"`
+	// Cart 购物车存储配置
+	Cart CartConfig `mapstructure:"cart"`
+	// OrderNo 订单号生成配置
+	OrderNo OrderNoConfig `mapstructure:"order_no"`
+	// Events 订单事件发布配置
+	Events EventsConfig `mapstructure:"events"`
+	// FlashSale 秒杀配置
+	FlashSale FlashSaleConfig `mapstructure:"flash_sale"`
+	// Payment 支付单配置
+	Payment PaymentConfig `mapstructure:"payment"`
+}
+
+// CartConfig 购物车存储配置
+type CartConfig struct {
+	// Storage 存储方式：postgres（默认，直接读写数据库）或 redis（Redis Hash 缓存，异步写回数据库）
+	Storage string `mapstructure:"storage"`
+	// CacheTTL redis 方式下购物车缓存的有效期，超过该时长未访问的购物车从缓存淘汰，下次访问时从数据库重新加载
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// OrderNoConfig 订单号生成配置
+type OrderNoConfig struct {
+	// Generator 生成方式：snowflake（默认，机器号 + 毫秒内序号）或 redis（按天递增的全局序号）
+	Generator string `mapstructure:"generator"`
+	// WorkerID snowflake 方式的机器号，取值 1-1023，多实例部署时必须各不相同
+	WorkerID int64 `mapstructure:"worker_id"`
+	// CheckDigit 是否在订单号末尾追加 Luhn 校验位
+	CheckDigit bool `mapstructure:"check_digit"`
+}
+
+// EventsConfig 订单事件发布配置
+type EventsConfig struct {
+	// Broker 消息中间件：redis（默认，Redis Streams）或 memory（进程内，只用于测试和本地调试）
+	Broker string `mapstructure:"broker"`
+	// Stream redis 方式的 Stream 名称
+	Stream string `mapstructure:"stream"`
+	// MaxLen Stream 保留的近似消息数，0 表示不裁剪
+	MaxLen int64 `mapstructure:"max_len"`
+	// Retention 已投递事件在发件箱中的保留时长
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// FlashSaleConfig 秒杀配置
+type FlashSaleConfig struct {
+	// RateLimit 每个用户每秒允许的抢购请求数，超出的请求直接拒绝
+	RateLimit int `mapstructure:"rate_limit"`
+	// PreloadLead 活动开始前提前预热库存到 Redis 的时长
+	PreloadLead time.Duration `mapstructure:"preload_lead"`
+	// Retention 活动结束后 Redis 中的库存和抢购记录的保留时长，用于对账和查询抢购结果
+	Retention time.Duration `mapstructure:"retention"`
+	// StaleAfter 排队超过该时长仍未处理的抢购记录重新排队
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+}
+
+// PaymentConfig 支付单配置
+type PaymentConfig struct {
+	// NotifyURL 支付服务回调订单服务的地址
+	NotifyURL string `mapstructure:"notify_url"`
+	// ReturnURL 支付完成后用户跳转的页面地址
+	ReturnURL string `mapstructure:"return_url"`
+}
+
+// AuthConfig 后台接口授权配置
+type AuthConfig struct {
+	// OperatorIDs 运营人员的用户ID，只有名单中的用户可以调用发货、审核售后、活动配置等后台接口
+	OperatorIDs []string `mapstructure:"operator_ids"`
 }
 
 // Config 应用配置
@@ -28,6 +115,8 @@ type Config struct {
 	Database         db.DatabaseConfig       `mapstructure:"database"`
 	Redis            db.RedisConfig          `mapstructure:"redis"`
 	Services         ServicesConfig          `mapstructure:"services"`
+	Order            OrderConfig             `mapstructure:"order"`
+	Auth             AuthConfig              `mapstructure:"auth"`
 }
 
 // MustLoad 加载配置
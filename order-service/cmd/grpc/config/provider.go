@@ -1,6 +1,14 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"hash/fnv"
+	"log"
+	"os"
+	"time"
+
+	"github.com/people257/poor-guy-shop/common/auth"
 	"github.com/people257/poor-guy-shop/common/db"
 	"github.com/people257/poor-guy-shop/common/server/config"
 )
@@ -16,11 +24,95 @@ func GetDBConfig(cfg *Config) *db.DatabaseConfig {
 }
 
 // GetRedisConfig 获取Redis配置
-func GetRedisConfig(cfg *Config) db.RedisConfig {
-	return cfg.Redis
+func GetRedisConfig(cfg *Config) *db.RedisConfig {
+	return &cfg.Redis
 }
 
 // GetServicesConfig 获取服务配置
 func GetServicesConfig(cfg *Config) *ServicesConfig {
 	return &cfg.Services
 }
+
+// GetOperators 获取运营人员白名单
+func GetOperators(cfg *Config) *auth.Operators {
+	if len(cfg.Auth.OperatorIDs) == 0 {
+		log.Printf("auth.operator_ids is not configured, all admin requests will be rejected")
+	}
+	return auth.NewOperators(cfg.Auth.OperatorIDs)
+}
+
+// GetOrderConfig 获取订单业务配置
+func GetOrderConfig(cfg *Config) *OrderConfig {
+	if cfg.Order.PaymentTimeout <= 0 {
+		cfg.Order.PaymentTimeout = 30 * time.Minute
+	}
+	if cfg.Order.AutoConfirmDays <= 0 {
+		cfg.Order.AutoConfirmDays = 10
+	}
+	if cfg.Order.ReceiveExtensionDays <= 0 {
+		cfg.Order.ReceiveExtensionDays = 3
+	}
+	if cfg.Order.QuoteTTL <= 0 {
+		cfg.Order.QuoteTTL = 15 * time.Minute
+	}
+	if cfg.Order.IdempotencyTTL <= 0 {
+		cfg.Order.IdempotencyTTL = 24 * time.Hour
+	}
+	if cfg.Order.GuestCartTTL <= 0 {
+		cfg.Order.GuestCartTTL = 7 * 24 * time.Hour
+	}
+	if cfg.Order.Cart.Storage == "" {
+		cfg.Order.Cart.Storage = "postgres"
+	}
+	if cfg.Order.Cart.CacheTTL <= 0 {
+		cfg.Order.Cart.CacheTTL = 7 * 24 * time.Hour
+	}
+	if cfg.Order.QuoteSecret == "" {
+		// 未配置时使用随机密钥，报价令牌只能在签发它的实例上使用
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err)
+		}
+		cfg.Order.QuoteSecret = hex.EncodeToString(secret)
+		log.Printf("order.quote_secret is not configured, quote tokens are only valid on this instance")
+	}
+	if cfg.Order.OrderNo.Generator == "" {
+		cfg.Order.OrderNo.Generator = "snowflake"
+	}
+	if cfg.Order.OrderNo.Generator == "snowflake" && cfg.Order.OrderNo.WorkerID == 0 {
+		// 未配置时根据主机名生成机器号，多实例部署时仍可能重复
+		hostname, _ := os.Hostname()
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(hostname))
+		cfg.Order.OrderNo.WorkerID = int64(h.Sum32()%1023) + 1
+		log.Printf("order.order_no.worker_id is not configured, using %d derived from hostname %q", cfg.Order.OrderNo.WorkerID, hostname)
+	}
+	if cfg.Order.Events.Broker == "" {
+		cfg.Order.Events.Broker = "redis"
+	}
+	if cfg.Order.Events.Stream == "" {
+		cfg.Order.Events.Stream = "order-events"
+	}
+	if cfg.Order.Events.Retention <= 0 {
+		cfg.Order.Events.Retention = 7 * 24 * time.Hour
+	}
+	if cfg.Order.FlashSale.RateLimit <= 0 {
+		cfg.Order.FlashSale.RateLimit = 5
+	}
+	if cfg.Order.FlashSale.PreloadLead <= 0 {
+		cfg.Order.FlashSale.PreloadLead = 10 * time.Minute
+	}
+	if cfg.Order.FlashSale.Retention <= 0 {
+		cfg.Order.FlashSale.Retention = 24 * time.Hour
+	}
+	if cfg.Order.FlashSale.StaleAfter <= 0 {
+		cfg.Order.FlashSale.StaleAfter = time.Minute
+	}
+	if cfg.Order.Payment.NotifyURL == "" {
+		log.Printf("order.payment.notify_url is not configured, payment results will not be notified")
+	}
+	if cfg.Order.Payment.ReturnURL == "" {
+		log.Printf("order.payment.return_url is not configured, users will not be redirected after payment")
+	}
+	return &cfg.Order
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/wire"
 
+	"github.com/people257/poor-guy-shop/common/db"
 	"github.com/people257/poor-guy-shop/common/server"
 
 	"github.com/people257/poor-guy-shop/order-service/api"
@@ -25,12 +26,16 @@ func InitializeApplication(ctx context.Context, configPath string) (*Application
 		appconfig.MustLoad,
 		appconfig.GetGrpcServerConfig,
 		appconfig.GetDBConfig,
+		appconfig.GetRedisConfig,
 		appconfig.GetServicesConfig,
+		appconfig.GetOrderConfig,
+		appconfig.GetOperators,
 
 		// 基础设施
 		internal.NewDatabase,
 		internal.NewGormDB,
 		internal.NewQuery,
+		db.NewRedis,
 		server.InitializeServer,
 
 		// 各层Provider
@@ -8,16 +8,28 @@ package main
 
 import (
 	"context"
+	"github.com/people257/poor-guy-shop/common/db"
+	"github.com/people257/poor-guy-shop/common/rate"
 	"github.com/people257/poor-guy-shop/common/server"
+	aftersale3 "github.com/people257/poor-guy-shop/order-service/api/aftersale"
 	cart3 "github.com/people257/poor-guy-shop/order-service/api/cart"
 	order3 "github.com/people257/poor-guy-shop/order-service/api/order"
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/internal"
+	aftersale2 "github.com/people257/poor-guy-shop/order-service/internal/application/aftersale"
 	cart2 "github.com/people257/poor-guy-shop/order-service/internal/application/cart"
 	order2 "github.com/people257/poor-guy-shop/order-service/internal/application/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/presale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
 	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/eventbus"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/orderno"
 	"github.com/people257/poor-guy-shop/order-service/internal/infra/repository"
 )
 
@@ -29,11 +41,39 @@ func InitializeApplication(ctx context.Context, configPath string) (*Application
 	grpcServerConfig := config.GetGrpcServerConfig(configConfig)
 	serverServer, cleanup := server.InitializeServer(ctx, grpcServerConfig)
 	databaseConfig := config.GetDBConfig(configConfig)
-	db := internal.NewDatabase(databaseConfig)
-	gormDB := internal.NewGormDB(db)
-	query := internal.NewQuery(db)
+	dbDB := internal.NewDatabase(databaseConfig)
+	gormDB := internal.NewGormDB(dbDB)
+	query := internal.NewQuery(dbDB)
 	orderRepository := repository.NewOrderRepository(gormDB, query)
-	domainService := order.NewDomainService(orderRepository)
+	orderConfig := config.GetOrderConfig(configConfig)
+	redisConfig := config.GetRedisConfig(configConfig)
+	orderNoGenerator, err := orderno.NewGenerator(orderConfig, redisConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	domainService := order.NewDomainService(orderRepository, orderNoGenerator)
+	universalClient := db.NewRedis(redisConfig)
+	cartRepository, err := repository.NewCartRepository(orderConfig, gormDB, query, universalClient)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	flusher := repository.NewCartFlusher(cartRepository)
+	idempotencyRepository := repository.NewIdempotencyRepository(gormDB, query)
+	purchaseLimitRepository := repository.NewPurchaseLimitRepository(gormDB, query)
+	purchaselimitDomainService := purchaselimit.NewDomainService(purchaseLimitRepository)
+	promotionRepository := repository.NewPromotionRepository(gormDB, query)
+	promotionDomainService := promotion.NewDomainService(promotionRepository)
+	freightRepository := repository.NewFreightRepository(gormDB, query)
+	freightDomainService := freight.NewDomainService(freightRepository)
+	flashsaleRepository := repository.NewFlashSaleRepository(gormDB, query)
+	stock := repository.NewFlashSaleStock(universalClient)
+	ticketQueue := repository.NewFlashSaleTicketQueue(universalClient)
+	flashsaleDomainService := flashsale.NewDomainService(flashsaleRepository, stock, ticketQueue)
+	presaleRepository := repository.NewPresaleRepository(gormDB, query)
+	presaleDomainService := presale.NewDomainService(presaleRepository)
+	slidingWindowLimiter := rate.NewSlidingWindowLimiter(universalClient)
 	servicesConfig := config.GetServicesConfig(configConfig)
 	userServiceClient, err := client.NewUserServiceClientFromConfig(servicesConfig)
 	if err != nil {
@@ -55,13 +95,34 @@ func InitializeApplication(ctx context.Context, configPath string) (*Application
 		cleanup()
 		return nil, nil, err
 	}
-	service := order2.NewService(orderRepository, domainService, userServiceClient, productServiceClient, paymentServiceClient, inventoryServiceClient)
-	grpcHandler := order3.NewGrpcHandler(service)
-	cartRepository := repository.NewCartRepository(gormDB, query)
+	fileServiceClient, err := client.NewFileServiceClientFromConfig(servicesConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	sagaRepository := repository.NewSagaRepository(gormDB, query)
+	createOrderSaga := order2.NewCreateOrderSaga(sagaRepository, orderRepository, domainService, paymentServiceClient, inventoryServiceClient, orderConfig)
+	service := order2.NewService(orderRepository, domainService, cartRepository, flusher, idempotencyRepository, purchaseLimitRepository, purchaselimitDomainService, promotionRepository, promotionDomainService, freightRepository, freightDomainService, flashsaleRepository, flashsaleDomainService, stock, ticketQueue, slidingWindowLimiter, presaleRepository, presaleDomainService, userServiceClient, productServiceClient, paymentServiceClient, inventoryServiceClient, createOrderSaga, orderConfig)
+	operators := config.GetOperators(configConfig)
+	grpcHandler := order3.NewGrpcHandler(service, operators)
 	cartDomainService := cart.NewDomainService(cartRepository)
-	cartService := cart2.NewService(cartRepository, cartDomainService)
+	guestRepository := repository.NewGuestCartRepository(universalClient, orderConfig)
+	mergeQueue := repository.NewCartMergeQueue(universalClient)
+	cartService := cart2.NewService(cartRepository, cartDomainService, guestRepository, mergeQueue, purchaselimitDomainService, productServiceClient, inventoryServiceClient)
 	cartGrpcHandler := cart3.NewGrpcHandler(cartService)
-	application := NewApplication(serverServer, grpcHandler, cartGrpcHandler)
+	aftersaleRepository := repository.NewAfterSaleRepository(gormDB, query)
+	aftersaleDomainService := aftersale.NewDomainService(aftersaleRepository)
+	aftersaleService := aftersale2.NewService(aftersaleRepository, aftersaleDomainService, orderRepository, domainService, inventoryServiceClient, paymentServiceClient, fileServiceClient)
+	aftersaleGrpcHandler := aftersale3.NewGrpcHandler(aftersaleService, operators)
+	outboxRepository := repository.NewOutboxRepository(gormDB, query)
+	eventPublisher, err := eventbus.NewPublisher(orderConfig, redisConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	eventRelay := order2.NewEventRelay(outboxRepository, eventPublisher, orderConfig)
+	scheduler := order2.NewScheduler(createOrderSaga, service, eventRelay, flusher, cartService)
+	application := NewApplication(serverServer, grpcHandler, cartGrpcHandler, aftersaleGrpcHandler, scheduler)
 	return application, func() {
 		cleanup()
 	}, nil
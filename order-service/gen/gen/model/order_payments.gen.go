@@ -15,20 +15,26 @@ const TableNameOrderPayment = "order_payments"
 
 // OrderPayment mapped from table <order_payments>
 type OrderPayment struct {
-	ID            string          `gorm:"column:id;type:character varying(36);primaryKey;default:(gen_random_uuid())" json:"id"`
-	OrderID       string          `gorm:"column:order_id;type:character varying(36);not null" json:"order_id"`
-	PaymentNo     string          `gorm:"column:payment_no;type:character varying(64);not null" json:"payment_no"`
-	PaymentMethod string          `gorm:"column:payment_method;type:character varying(20);not null" json:"payment_method"`
-	PaymentAmount decimal.Decimal `gorm:"column:payment_amount;type:numeric(10,2);not null" json:"payment_amount"`
-	PaymentStatus int32           `gorm:"column:payment_status;type:integer;not null" json:"payment_status"`
-	ThirdPartyNo  *string         `gorm:"column:third_party_no;type:character varying(100)" json:"third_party_no"`
-	PaymentTime   *time.Time      `gorm:"column:payment_time;type:timestamp without time zone" json:"payment_time"`
-	RefundTime    *time.Time      `gorm:"column:refund_time;type:timestamp without time zone" json:"refund_time"`
-	Remark        *string         `gorm:"column:remark;type:text" json:"remark"`
-	CreatedAt     time.Time       `gorm:"column:created_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoCreateTime" json:"created_at"`
-	UpdatedAt     time.Time       `gorm:"column:updated_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime" json:"updated_at"`
-	DeletedAt     gorm.DeletedAt  `gorm:"column:deleted_at;type:timestamp without time zone" json:"deleted_at"`
-	Version       int32           `gorm:"column:version;type:integer;not null;default:1" json:"version"`
+	ID             string          `gorm:"column:id;type:character varying(36);primaryKey;default:(gen_random_uuid())" json:"id"`
+	OrderID        string          `gorm:"column:order_id;type:character varying(36);not null" json:"order_id"`
+	PaymentNo      string          `gorm:"column:payment_no;type:character varying(64);not null" json:"payment_no"`
+	PaymentMethod  string          `gorm:"column:payment_method;type:character varying(20);not null" json:"payment_method"`
+	PaymentAmount  decimal.Decimal `gorm:"column:payment_amount;type:numeric(10,2);not null" json:"payment_amount"`
+	PaymentStatus  int32           `gorm:"column:payment_status;type:integer;not null;comment:支付状态：0未支付 1已支付 2退款中 3已退款 4定金不退" json:"payment_status"` // 支付状态：0未支付 1已支付 2退款中 3已退款 4定金不退
+	ThirdPartyNo   *string         `gorm:"column:third_party_no;type:character varying(100)" json:"third_party_no"`
+	PaymentTime    *time.Time      `gorm:"column:payment_time;type:timestamp without time zone" json:"payment_time"`
+	RefundTime     *time.Time      `gorm:"column:refund_time;type:timestamp without time zone" json:"refund_time"`
+	Remark         *string         `gorm:"column:remark;type:text" json:"remark"`
+	CreatedAt      time.Time       `gorm:"column:created_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time       `gorm:"column:updated_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime" json:"updated_at"`
+	DeletedAt      gorm.DeletedAt  `gorm:"column:deleted_at;type:timestamp without time zone" json:"deleted_at"`
+	Version        int32           `gorm:"column:version;type:integer;not null;default:1" json:"version"`
+	Stage          int32           `gorm:"column:stage;type:integer;not null;default:0;comment:支付阶段：0一次付清 1定金 2尾款" json:"stage"`               // 支付阶段：0一次付清 1定金 2尾款
+	PayableFrom    *time.Time      `gorm:"column:payable_from;type:timestamp without time zone;comment:开始支付时间" json:"payable_from"`            // 开始支付时间
+	Deadline       *time.Time      `gorm:"column:deadline;type:timestamp without time zone;comment:支付截止时间" json:"deadline"`                    // 支付截止时间
+	RefundedAmount decimal.Decimal `gorm:"column:refunded_amount;type:numeric(10,2);not null;default:0;comment:已退款金额" json:"refunded_amount"`  // 已退款金额
+	Forfeitable    bool            `gorm:"column:forfeitable;type:boolean;not null;default:false;comment:买家取消或超时未付尾款时定金不退" json:"forfeitable"` // 买家取消或超时未付尾款时定金不退
+	RequestedAt    *time.Time      `gorm:"column:requested_at;type:timestamp without time zone;comment:支付服务中的支付单创建时间" json:"requested_at"`     // 支付服务中的支付单创建时间
 }
 
 // TableName OrderPayment's table name
@@ -15,26 +15,29 @@ const TableNameOrder = "orders"
 
 // Order mapped from table <orders>
 type Order struct {
-	ID             string           `gorm:"column:id;type:character varying(36);primaryKey;default:(gen_random_uuid())" json:"id"`
-	OrderNo        string           `gorm:"column:order_no;type:character varying(32);not null;comment:订单号，格式：ORD+年月日+序号" json:"order_no"` // 订单号，格式：ORD+年月日+序号
-	UserID         string           `gorm:"column:user_id;type:character varying(36);not null" json:"user_id"`
-	Status         int32            `gorm:"column:status;type:integer;not null;default:1;comment:订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款" json:"status"` // 订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款
-	TotalAmount    decimal.Decimal  `gorm:"column:total_amount;type:numeric(10,2);not null" json:"total_amount"`
-	DiscountAmount *decimal.Decimal `gorm:"column:discount_amount;type:numeric(10,2)" json:"discount_amount"`
-	ShippingFee    *decimal.Decimal `gorm:"column:shipping_fee;type:numeric(10,2)" json:"shipping_fee"`
-	ActualAmount   decimal.Decimal  `gorm:"column:actual_amount;type:numeric(10,2);not null" json:"actual_amount"`
-	PaymentMethod  *string          `gorm:"column:payment_method;type:character varying(20)" json:"payment_method"`
-	PaymentStatus  *int32           `gorm:"column:payment_status;type:integer;comment:支付状态：0未支付 1已支付 2退款中 3已退款" json:"payment_status"` // 支付状态：0未支付 1已支付 2退款中 3已退款
-	PaymentTime    *time.Time       `gorm:"column:payment_time;type:timestamp without time zone" json:"payment_time"`
-	DeliveryTime   *time.Time       `gorm:"column:delivery_time;type:timestamp without time zone" json:"delivery_time"`
-	ReceiveTime    *time.Time       `gorm:"column:receive_time;type:timestamp without time zone" json:"receive_time"`
-	CancelTime     *time.Time       `gorm:"column:cancel_time;type:timestamp without time zone" json:"cancel_time"`
-	CancelReason   *string          `gorm:"column:cancel_reason;type:text" json:"cancel_reason"`
-	Remark         *string          `gorm:"column:remark;type:text" json:"remark"`
-	CreatedAt      time.Time        `gorm:"column:created_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoCreateTime" json:"created_at"`
-	UpdatedAt      time.Time        `gorm:"column:updated_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime" json:"updated_at"`
-	DeletedAt      gorm.DeletedAt   `gorm:"column:deleted_at;type:timestamp without time zone" json:"deleted_at"`
-	Version        int32            `gorm:"column:version;type:integer;not null;default:1" json:"version"`
+	ID              string           `gorm:"column:id;type:character varying(36);primaryKey;default:(gen_random_uuid())" json:"id"`
+	OrderNo         string           `gorm:"column:order_no;type:character varying(32);not null;comment:订单号，格式：ORD+年月日+序号" json:"order_no"` // 订单号，格式：ORD+年月日+序号
+	UserID          string           `gorm:"column:user_id;type:character varying(36);not null" json:"user_id"`
+	Status          int32            `gorm:"column:status;type:integer;not null;default:1;comment:订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款 7定金已付待付尾款" json:"status"` // 订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款 7定金已付待付尾款
+	TotalAmount     decimal.Decimal  `gorm:"column:total_amount;type:numeric(10,2);not null" json:"total_amount"`
+	DiscountAmount  *decimal.Decimal `gorm:"column:discount_amount;type:numeric(10,2)" json:"discount_amount"`
+	ShippingFee     *decimal.Decimal `gorm:"column:shipping_fee;type:numeric(10,2)" json:"shipping_fee"`
+	ActualAmount    decimal.Decimal  `gorm:"column:actual_amount;type:numeric(10,2);not null" json:"actual_amount"`
+	PaymentMethod   *string          `gorm:"column:payment_method;type:character varying(20)" json:"payment_method"`
+	PaymentStatus   *int32           `gorm:"column:payment_status;type:integer;comment:支付状态：0未支付 1已支付 2退款中 3已退款" json:"payment_status"` // 支付状态：0未支付 1已支付 2退款中 3已退款
+	PaymentTime     *time.Time       `gorm:"column:payment_time;type:timestamp without time zone" json:"payment_time"`
+	DeliveryTime    *time.Time       `gorm:"column:delivery_time;type:timestamp without time zone" json:"delivery_time"`
+	ReceiveTime     *time.Time       `gorm:"column:receive_time;type:timestamp without time zone" json:"receive_time"`
+	CancelTime      *time.Time       `gorm:"column:cancel_time;type:timestamp without time zone" json:"cancel_time"`
+	CancelReason    *string          `gorm:"column:cancel_reason;type:text" json:"cancel_reason"`
+	Remark          *string          `gorm:"column:remark;type:text" json:"remark"`
+	CreatedAt       time.Time        `gorm:"column:created_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time        `gorm:"column:updated_at;type:timestamp without time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime" json:"updated_at"`
+	DeletedAt       gorm.DeletedAt   `gorm:"column:deleted_at;type:timestamp without time zone" json:"deleted_at"`
+	Version         int32            `gorm:"column:version;type:integer;not null;default:1" json:"version"`
+	PaymentDeadline *time.Time       `gorm:"column:payment_deadline;type:timestamp without time zone;comment:支付截止时间，超时未支付自动取消" json:"payment_deadline"` // 支付截止时间，超时未支付自动取消
+	ReceiveDeadline *time.Time       `gorm:"column:receive_deadline;type:timestamp without time zone;comment:买家延长收货后的自动确认收货时间" json:"receive_deadline"` // 买家延长收货后的自动确认收货时间
+	PresaleID       *string          `gorm:"column:presale_id;type:character varying(36);comment:预售活动ID，普通订单为空" json:"presale_id"`                      // 预售活动ID，普通订单为空
 }
 
 // TableName Order's table name
@@ -16,59 +16,134 @@ import (
 )
 
 var (
-	Q              = new(Query)
-	Order          *order
-	OrderAddress   *orderAddress
-	OrderItem      *orderItem
-	OrderPayment   *orderPayment
-	OrderStatusLog *orderStatusLog
-	ShoppingCart   *shoppingCart
+	Q                      = new(Query)
+	AfterSale              *afterSale
+	AfterSaleEvidence      *afterSaleEvidence
+	CouponTemplate         *couponTemplate
+	FlashSale              *flashSale
+	FreightTemplate        *freightTemplate
+	IdempotencyKey         *idempotencyKey
+	Order                  *order
+	OrderAddress           *orderAddress
+	OrderItem              *orderItem
+	OrderOutboxEvent       *orderOutboxEvent
+	OrderPayment           *orderPayment
+	OrderPurchaseLimit     *orderPurchaseLimit
+	OrderSaga              *orderSaga
+	OrderSagaLog           *orderSagaLog
+	OrderShipment          *orderShipment
+	OrderShipmentItem      *orderShipmentItem
+	OrderStatusLog         *orderStatusLog
+	Presale                *presale
+	ProductFreightTemplate *productFreightTemplate
+	ShoppingCart           *shoppingCart
+	UserCoupon             *userCoupon
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	AfterSale = &Q.AfterSale
+	AfterSaleEvidence = &Q.AfterSaleEvidence
+	CouponTemplate = &Q.CouponTemplate
+	FlashSale = &Q.FlashSale
+	FreightTemplate = &Q.FreightTemplate
+	IdempotencyKey = &Q.IdempotencyKey
 	Order = &Q.Order
 	OrderAddress = &Q.OrderAddress
 	OrderItem = &Q.OrderItem
+	OrderOutboxEvent = &Q.OrderOutboxEvent
 	OrderPayment = &Q.OrderPayment
+	OrderPurchaseLimit = &Q.OrderPurchaseLimit
+	OrderSaga = &Q.OrderSaga
+	OrderSagaLog = &Q.OrderSagaLog
+	OrderShipment = &Q.OrderShipment
+	OrderShipmentItem = &Q.OrderShipmentItem
 	OrderStatusLog = &Q.OrderStatusLog
+	Presale = &Q.Presale
+	ProductFreightTemplate = &Q.ProductFreightTemplate
 	ShoppingCart = &Q.ShoppingCart
+	UserCoupon = &Q.UserCoupon
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:             db,
-		Order:          newOrder(db, opts...),
-		OrderAddress:   newOrderAddress(db, opts...),
-		OrderItem:      newOrderItem(db, opts...),
-		OrderPayment:   newOrderPayment(db, opts...),
-		OrderStatusLog: newOrderStatusLog(db, opts...),
-		ShoppingCart:   newShoppingCart(db, opts...),
+		db:                     db,
+		AfterSale:              newAfterSale(db, opts...),
+		AfterSaleEvidence:      newAfterSaleEvidence(db, opts...),
+		CouponTemplate:         newCouponTemplate(db, opts...),
+		FlashSale:              newFlashSale(db, opts...),
+		FreightTemplate:        newFreightTemplate(db, opts...),
+		IdempotencyKey:         newIdempotencyKey(db, opts...),
+		Order:                  newOrder(db, opts...),
+		OrderAddress:           newOrderAddress(db, opts...),
+		OrderItem:              newOrderItem(db, opts...),
+		OrderOutboxEvent:       newOrderOutboxEvent(db, opts...),
+		OrderPayment:           newOrderPayment(db, opts...),
+		OrderPurchaseLimit:     newOrderPurchaseLimit(db, opts...),
+		OrderSaga:              newOrderSaga(db, opts...),
+		OrderSagaLog:           newOrderSagaLog(db, opts...),
+		OrderShipment:          newOrderShipment(db, opts...),
+		OrderShipmentItem:      newOrderShipmentItem(db, opts...),
+		OrderStatusLog:         newOrderStatusLog(db, opts...),
+		Presale:                newPresale(db, opts...),
+		ProductFreightTemplate: newProductFreightTemplate(db, opts...),
+		ShoppingCart:           newShoppingCart(db, opts...),
+		UserCoupon:             newUserCoupon(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Order          order
-	OrderAddress   orderAddress
-	OrderItem      orderItem
-	OrderPayment   orderPayment
-	OrderStatusLog orderStatusLog
-	ShoppingCart   shoppingCart
+	AfterSale              afterSale
+	AfterSaleEvidence      afterSaleEvidence
+	CouponTemplate         couponTemplate
+	FlashSale              flashSale
+	FreightTemplate        freightTemplate
+	IdempotencyKey         idempotencyKey
+	Order                  order
+	OrderAddress           orderAddress
+	OrderItem              orderItem
+	OrderOutboxEvent       orderOutboxEvent
+	OrderPayment           orderPayment
+	OrderPurchaseLimit     orderPurchaseLimit
+	OrderSaga              orderSaga
+	OrderSagaLog           orderSagaLog
+	OrderShipment          orderShipment
+	OrderShipmentItem      orderShipmentItem
+	OrderStatusLog         orderStatusLog
+	Presale                presale
+	ProductFreightTemplate productFreightTemplate
+	ShoppingCart           shoppingCart
+	UserCoupon             userCoupon
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:             db,
-		Order:          q.Order.clone(db),
-		OrderAddress:   q.OrderAddress.clone(db),
-		OrderItem:      q.OrderItem.clone(db),
-		OrderPayment:   q.OrderPayment.clone(db),
-		OrderStatusLog: q.OrderStatusLog.clone(db),
-		ShoppingCart:   q.ShoppingCart.clone(db),
+		db:                     db,
+		AfterSale:              q.AfterSale.clone(db),
+		AfterSaleEvidence:      q.AfterSaleEvidence.clone(db),
+		CouponTemplate:         q.CouponTemplate.clone(db),
+		FlashSale:              q.FlashSale.clone(db),
+		FreightTemplate:        q.FreightTemplate.clone(db),
+		IdempotencyKey:         q.IdempotencyKey.clone(db),
+		Order:                  q.Order.clone(db),
+		OrderAddress:           q.OrderAddress.clone(db),
+		OrderItem:              q.OrderItem.clone(db),
+		OrderOutboxEvent:       q.OrderOutboxEvent.clone(db),
+		OrderPayment:           q.OrderPayment.clone(db),
+		OrderPurchaseLimit:     q.OrderPurchaseLimit.clone(db),
+		OrderSaga:              q.OrderSaga.clone(db),
+		OrderSagaLog:           q.OrderSagaLog.clone(db),
+		OrderShipment:          q.OrderShipment.clone(db),
+		OrderShipmentItem:      q.OrderShipmentItem.clone(db),
+		OrderStatusLog:         q.OrderStatusLog.clone(db),
+		Presale:                q.Presale.clone(db),
+		ProductFreightTemplate: q.ProductFreightTemplate.clone(db),
+		ShoppingCart:           q.ShoppingCart.clone(db),
+		UserCoupon:             q.UserCoupon.clone(db),
 	}
 }
 
@@ -82,33 +157,78 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:             db,
-		Order:          q.Order.replaceDB(db),
-		OrderAddress:   q.OrderAddress.replaceDB(db),
-		OrderItem:      q.OrderItem.replaceDB(db),
-		OrderPayment:   q.OrderPayment.replaceDB(db),
-		OrderStatusLog: q.OrderStatusLog.replaceDB(db),
-		ShoppingCart:   q.ShoppingCart.replaceDB(db),
+		db:                     db,
+		AfterSale:              q.AfterSale.replaceDB(db),
+		AfterSaleEvidence:      q.AfterSaleEvidence.replaceDB(db),
+		CouponTemplate:         q.CouponTemplate.replaceDB(db),
+		FlashSale:              q.FlashSale.replaceDB(db),
+		FreightTemplate:        q.FreightTemplate.replaceDB(db),
+		IdempotencyKey:         q.IdempotencyKey.replaceDB(db),
+		Order:                  q.Order.replaceDB(db),
+		OrderAddress:           q.OrderAddress.replaceDB(db),
+		OrderItem:              q.OrderItem.replaceDB(db),
+		OrderOutboxEvent:       q.OrderOutboxEvent.replaceDB(db),
+		OrderPayment:           q.OrderPayment.replaceDB(db),
+		OrderPurchaseLimit:     q.OrderPurchaseLimit.replaceDB(db),
+		OrderSaga:              q.OrderSaga.replaceDB(db),
+		OrderSagaLog:           q.OrderSagaLog.replaceDB(db),
+		OrderShipment:          q.OrderShipment.replaceDB(db),
+		OrderShipmentItem:      q.OrderShipmentItem.replaceDB(db),
+		OrderStatusLog:         q.OrderStatusLog.replaceDB(db),
+		Presale:                q.Presale.replaceDB(db),
+		ProductFreightTemplate: q.ProductFreightTemplate.replaceDB(db),
+		ShoppingCart:           q.ShoppingCart.replaceDB(db),
+		UserCoupon:             q.UserCoupon.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Order          IOrderDo
-	OrderAddress   IOrderAddressDo
-	OrderItem      IOrderItemDo
-	OrderPayment   IOrderPaymentDo
-	OrderStatusLog IOrderStatusLogDo
-	ShoppingCart   IShoppingCartDo
+	AfterSale              IAfterSaleDo
+	AfterSaleEvidence      IAfterSaleEvidenceDo
+	CouponTemplate         ICouponTemplateDo
+	FlashSale              IFlashSaleDo
+	FreightTemplate        IFreightTemplateDo
+	IdempotencyKey         IIdempotencyKeyDo
+	Order                  IOrderDo
+	OrderAddress           IOrderAddressDo
+	OrderItem              IOrderItemDo
+	OrderOutboxEvent       IOrderOutboxEventDo
+	OrderPayment           IOrderPaymentDo
+	OrderPurchaseLimit     IOrderPurchaseLimitDo
+	OrderSaga              IOrderSagaDo
+	OrderSagaLog           IOrderSagaLogDo
+	OrderShipment          IOrderShipmentDo
+	OrderShipmentItem      IOrderShipmentItemDo
+	OrderStatusLog         IOrderStatusLogDo
+	Presale                IPresaleDo
+	ProductFreightTemplate IProductFreightTemplateDo
+	ShoppingCart           IShoppingCartDo
+	UserCoupon             IUserCouponDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Order:          q.Order.WithContext(ctx),
-		OrderAddress:   q.OrderAddress.WithContext(ctx),
-		OrderItem:      q.OrderItem.WithContext(ctx),
-		OrderPayment:   q.OrderPayment.WithContext(ctx),
-		OrderStatusLog: q.OrderStatusLog.WithContext(ctx),
-		ShoppingCart:   q.ShoppingCart.WithContext(ctx),
+		AfterSale:              q.AfterSale.WithContext(ctx),
+		AfterSaleEvidence:      q.AfterSaleEvidence.WithContext(ctx),
+		CouponTemplate:         q.CouponTemplate.WithContext(ctx),
+		FlashSale:              q.FlashSale.WithContext(ctx),
+		FreightTemplate:        q.FreightTemplate.WithContext(ctx),
+		IdempotencyKey:         q.IdempotencyKey.WithContext(ctx),
+		Order:                  q.Order.WithContext(ctx),
+		OrderAddress:           q.OrderAddress.WithContext(ctx),
+		OrderItem:              q.OrderItem.WithContext(ctx),
+		OrderOutboxEvent:       q.OrderOutboxEvent.WithContext(ctx),
+		OrderPayment:           q.OrderPayment.WithContext(ctx),
+		OrderPurchaseLimit:     q.OrderPurchaseLimit.WithContext(ctx),
+		OrderSaga:              q.OrderSaga.WithContext(ctx),
+		OrderSagaLog:           q.OrderSagaLog.WithContext(ctx),
+		OrderShipment:          q.OrderShipment.WithContext(ctx),
+		OrderShipmentItem:      q.OrderShipmentItem.WithContext(ctx),
+		OrderStatusLog:         q.OrderStatusLog.WithContext(ctx),
+		Presale:                q.Presale.WithContext(ctx),
+		ProductFreightTemplate: q.ProductFreightTemplate.WithContext(ctx),
+		ShoppingCart:           q.ShoppingCart.WithContext(ctx),
+		UserCoupon:             q.UserCoupon.WithContext(ctx),
 	}
 }
 
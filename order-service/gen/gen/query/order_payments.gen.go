@@ -42,6 +42,12 @@ func newOrderPayment(db *gorm.DB, opts ...gen.DOOption) orderPayment {
 	_orderPayment.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_orderPayment.DeletedAt = field.NewField(tableName, "deleted_at")
 	_orderPayment.Version = field.NewInt32(tableName, "version")
+	_orderPayment.Stage = field.NewInt32(tableName, "stage")
+	_orderPayment.PayableFrom = field.NewTime(tableName, "payable_from")
+	_orderPayment.Deadline = field.NewTime(tableName, "deadline")
+	_orderPayment.RefundedAmount = field.NewField(tableName, "refunded_amount")
+	_orderPayment.Forfeitable = field.NewBool(tableName, "forfeitable")
+	_orderPayment.RequestedAt = field.NewTime(tableName, "requested_at")
 
 	_orderPayment.fillFieldMap()
 
@@ -51,21 +57,27 @@ func newOrderPayment(db *gorm.DB, opts ...gen.DOOption) orderPayment {
 type orderPayment struct {
 	orderPaymentDo orderPaymentDo
 
-	ALL           field.Asterisk
-	ID            field.String
-	OrderID       field.String
-	PaymentNo     field.String
-	PaymentMethod field.String
-	PaymentAmount field.Field
-	PaymentStatus field.Int32
-	ThirdPartyNo  field.String
-	PaymentTime   field.Time
-	RefundTime    field.Time
-	Remark        field.String
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
-	DeletedAt     field.Field
-	Version       field.Int32
+	ALL            field.Asterisk
+	ID             field.String
+	OrderID        field.String
+	PaymentNo      field.String
+	PaymentMethod  field.String
+	PaymentAmount  field.Field
+	PaymentStatus  field.Int32 // 支付状态：0未支付 1已支付 2退款中 3已退款 4定金不退
+	ThirdPartyNo   field.String
+	PaymentTime    field.Time
+	RefundTime     field.Time
+	Remark         field.String
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
+	DeletedAt      field.Field
+	Version        field.Int32
+	Stage          field.Int32 // 支付阶段：0一次付清 1定金 2尾款
+	PayableFrom    field.Time  // 开始支付时间
+	Deadline       field.Time  // 支付截止时间
+	RefundedAmount field.Field // 已退款金额
+	Forfeitable    field.Bool  // 买家取消或超时未付尾款时定金不退
+	RequestedAt    field.Time  // 支付服务中的支付单创建时间
 
 	fieldMap map[string]field.Expr
 }
@@ -96,6 +108,12 @@ func (o *orderPayment) updateTableName(table string) *orderPayment {
 	o.UpdatedAt = field.NewTime(table, "updated_at")
 	o.DeletedAt = field.NewField(table, "deleted_at")
 	o.Version = field.NewInt32(table, "version")
+	o.Stage = field.NewInt32(table, "stage")
+	o.PayableFrom = field.NewTime(table, "payable_from")
+	o.Deadline = field.NewTime(table, "deadline")
+	o.RefundedAmount = field.NewField(table, "refunded_amount")
+	o.Forfeitable = field.NewBool(table, "forfeitable")
+	o.RequestedAt = field.NewTime(table, "requested_at")
 
 	o.fillFieldMap()
 
@@ -124,7 +142,7 @@ func (o *orderPayment) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (o *orderPayment) fillFieldMap() {
-	o.fieldMap = make(map[string]field.Expr, 14)
+	o.fieldMap = make(map[string]field.Expr, 20)
 	o.fieldMap["id"] = o.ID
 	o.fieldMap["order_id"] = o.OrderID
 	o.fieldMap["payment_no"] = o.PaymentNo
@@ -139,6 +157,12 @@ func (o *orderPayment) fillFieldMap() {
 	o.fieldMap["updated_at"] = o.UpdatedAt
 	o.fieldMap["deleted_at"] = o.DeletedAt
 	o.fieldMap["version"] = o.Version
+	o.fieldMap["stage"] = o.Stage
+	o.fieldMap["payable_from"] = o.PayableFrom
+	o.fieldMap["deadline"] = o.Deadline
+	o.fieldMap["refunded_amount"] = o.RefundedAmount
+	o.fieldMap["forfeitable"] = o.Forfeitable
+	o.fieldMap["requested_at"] = o.RequestedAt
 }
 
 func (o orderPayment) clone(db *gorm.DB) orderPayment {
@@ -48,6 +48,9 @@ func newOrder(db *gorm.DB, opts ...gen.DOOption) order {
 	_order.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_order.DeletedAt = field.NewField(tableName, "deleted_at")
 	_order.Version = field.NewInt32(tableName, "version")
+	_order.PaymentDeadline = field.NewTime(tableName, "payment_deadline")
+	_order.ReceiveDeadline = field.NewTime(tableName, "receive_deadline")
+	_order.PresaleID = field.NewString(tableName, "presale_id")
 
 	_order.fillFieldMap()
 
@@ -57,27 +60,30 @@ func newOrder(db *gorm.DB, opts ...gen.DOOption) order {
 type order struct {
 	orderDo orderDo
 
-	ALL            field.Asterisk
-	ID             field.String
-	OrderNo        field.String // 订单号，格式：ORD+年月日+序号
-	UserID         field.String
-	Status         field.Int32 // 订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款
-	TotalAmount    field.Field
-	DiscountAmount field.Field
-	ShippingFee    field.Field
-	ActualAmount   field.Field
-	PaymentMethod  field.String
-	PaymentStatus  field.Int32 // 支付状态：0未支付 1已支付 2退款中 3已退款
-	PaymentTime    field.Time
-	DeliveryTime   field.Time
-	ReceiveTime    field.Time
-	CancelTime     field.Time
-	CancelReason   field.String
-	Remark         field.String
-	CreatedAt      field.Time
-	UpdatedAt      field.Time
-	DeletedAt      field.Field
-	Version        field.Int32
+	ALL             field.Asterisk
+	ID              field.String
+	OrderNo         field.String // 订单号，格式：ORD+年月日+序号
+	UserID          field.String
+	Status          field.Int32 // 订单状态：1待付款 2已付款 3已发货 4已收货 5已取消 6已退款 7定金已付待付尾款
+	TotalAmount     field.Field
+	DiscountAmount  field.Field
+	ShippingFee     field.Field
+	ActualAmount    field.Field
+	PaymentMethod   field.String
+	PaymentStatus   field.Int32 // 支付状态：0未支付 1已支付 2退款中 3已退款
+	PaymentTime     field.Time
+	DeliveryTime    field.Time
+	ReceiveTime     field.Time
+	CancelTime      field.Time
+	CancelReason    field.String
+	Remark          field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	DeletedAt       field.Field
+	Version         field.Int32
+	PaymentDeadline field.Time   // 支付截止时间，超时未支付自动取消
+	ReceiveDeadline field.Time   // 买家延长收货后的自动确认收货时间
+	PresaleID       field.String // 预售活动ID，普通订单为空
 
 	fieldMap map[string]field.Expr
 }
@@ -114,6 +120,9 @@ func (o *order) updateTableName(table string) *order {
 	o.UpdatedAt = field.NewTime(table, "updated_at")
 	o.DeletedAt = field.NewField(table, "deleted_at")
 	o.Version = field.NewInt32(table, "version")
+	o.PaymentDeadline = field.NewTime(table, "payment_deadline")
+	o.ReceiveDeadline = field.NewTime(table, "receive_deadline")
+	o.PresaleID = field.NewString(table, "presale_id")
 
 	o.fillFieldMap()
 
@@ -138,7 +147,7 @@ func (o *order) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (o *order) fillFieldMap() {
-	o.fieldMap = make(map[string]field.Expr, 20)
+	o.fieldMap = make(map[string]field.Expr, 23)
 	o.fieldMap["id"] = o.ID
 	o.fieldMap["order_no"] = o.OrderNo
 	o.fieldMap["user_id"] = o.UserID
@@ -159,6 +168,9 @@ func (o *order) fillFieldMap() {
 	o.fieldMap["updated_at"] = o.UpdatedAt
 	o.fieldMap["deleted_at"] = o.DeletedAt
 	o.fieldMap["version"] = o.Version
+	o.fieldMap["payment_deadline"] = o.PaymentDeadline
+	o.fieldMap["receive_deadline"] = o.ReceiveDeadline
+	o.fieldMap["presale_id"] = o.PresaleID
 }
 
 func (o order) clone(db *gorm.DB) order {
@@ -0,0 +1,1484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: order/aftersale/aftersale.proto
+
+package aftersale
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 售后类型枚举
+type AfterSaleType int32
+
+const (
+	AfterSaleType_AFTER_SALE_TYPE_UNKNOWN           AfterSaleType = 0
+	AfterSaleType_AFTER_SALE_TYPE_REFUND_ONLY       AfterSaleType = 1 // 仅退款
+	AfterSaleType_AFTER_SALE_TYPE_RETURN_AND_REFUND AfterSaleType = 2 // 退货退款
+)
+
+// Enum value maps for AfterSaleType.
+var (
+	AfterSaleType_name = map[int32]string{
+		0: "AFTER_SALE_TYPE_UNKNOWN",
+		1: "AFTER_SALE_TYPE_REFUND_ONLY",
+		2: "AFTER_SALE_TYPE_RETURN_AND_REFUND",
+	}
+	AfterSaleType_value = map[string]int32{
+		"AFTER_SALE_TYPE_UNKNOWN":           0,
+		"AFTER_SALE_TYPE_REFUND_ONLY":       1,
+		"AFTER_SALE_TYPE_RETURN_AND_REFUND": 2,
+	}
+)
+
+func (x AfterSaleType) Enum() *AfterSaleType {
+	p := new(AfterSaleType)
+	*p = x
+	return p
+}
+
+func (x AfterSaleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AfterSaleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_aftersale_aftersale_proto_enumTypes[0].Descriptor()
+}
+
+func (AfterSaleType) Type() protoreflect.EnumType {
+	return &file_order_aftersale_aftersale_proto_enumTypes[0]
+}
+
+func (x AfterSaleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AfterSaleType.Descriptor instead.
+func (AfterSaleType) EnumDescriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{0}
+}
+
+// 售后状态枚举
+type AfterSaleStatus int32
+
+const (
+	AfterSaleStatus_AFTER_SALE_STATUS_UNKNOWN   AfterSaleStatus = 0
+	AfterSaleStatus_AFTER_SALE_STATUS_PENDING   AfterSaleStatus = 1 // 待审核
+	AfterSaleStatus_AFTER_SALE_STATUS_APPROVED  AfterSaleStatus = 2 // 已同意，待买家退货
+	AfterSaleStatus_AFTER_SALE_STATUS_REJECTED  AfterSaleStatus = 3 // 已拒绝
+	AfterSaleStatus_AFTER_SALE_STATUS_RETURNED  AfterSaleStatus = 4 // 买家已寄回
+	AfterSaleStatus_AFTER_SALE_STATUS_REFUNDING AfterSaleStatus = 5 // 退款中
+	AfterSaleStatus_AFTER_SALE_STATUS_REFUNDED  AfterSaleStatus = 6 // 已退款
+	AfterSaleStatus_AFTER_SALE_STATUS_CANCELLED AfterSaleStatus = 7 // 买家已撤销
+)
+
+// Enum value maps for AfterSaleStatus.
+var (
+	AfterSaleStatus_name = map[int32]string{
+		0: "AFTER_SALE_STATUS_UNKNOWN",
+		1: "AFTER_SALE_STATUS_PENDING",
+		2: "AFTER_SALE_STATUS_APPROVED",
+		3: "AFTER_SALE_STATUS_REJECTED",
+		4: "AFTER_SALE_STATUS_RETURNED",
+		5: "AFTER_SALE_STATUS_REFUNDING",
+		6: "AFTER_SALE_STATUS_REFUNDED",
+		7: "AFTER_SALE_STATUS_CANCELLED",
+	}
+	AfterSaleStatus_value = map[string]int32{
+		"AFTER_SALE_STATUS_UNKNOWN":   0,
+		"AFTER_SALE_STATUS_PENDING":   1,
+		"AFTER_SALE_STATUS_APPROVED":  2,
+		"AFTER_SALE_STATUS_REJECTED":  3,
+		"AFTER_SALE_STATUS_RETURNED":  4,
+		"AFTER_SALE_STATUS_REFUNDING": 5,
+		"AFTER_SALE_STATUS_REFUNDED":  6,
+		"AFTER_SALE_STATUS_CANCELLED": 7,
+	}
+)
+
+func (x AfterSaleStatus) Enum() *AfterSaleStatus {
+	p := new(AfterSaleStatus)
+	*p = x
+	return p
+}
+
+func (x AfterSaleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AfterSaleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_aftersale_aftersale_proto_enumTypes[1].Descriptor()
+}
+
+func (AfterSaleStatus) Type() protoreflect.EnumType {
+	return &file_order_aftersale_aftersale_proto_enumTypes[1]
+}
+
+func (x AfterSaleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AfterSaleStatus.Descriptor instead.
+func (AfterSaleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{1}
+}
+
+// 售后原因枚举
+type AfterSaleReason int32
+
+const (
+	AfterSaleReason_AFTER_SALE_REASON_UNKNOWN          AfterSaleReason = 0
+	AfterSaleReason_AFTER_SALE_REASON_QUALITY_ISSUE    AfterSaleReason = 1 // 质量问题
+	AfterSaleReason_AFTER_SALE_REASON_DAMAGED          AfterSaleReason = 2 // 商品破损
+	AfterSaleReason_AFTER_SALE_REASON_WRONG_ITEM       AfterSaleReason = 3 // 发错货
+	AfterSaleReason_AFTER_SALE_REASON_NOT_AS_DESCRIBED AfterSaleReason = 4 // 与描述不符
+	AfterSaleReason_AFTER_SALE_REASON_NO_LONGER_NEEDED AfterSaleReason = 5 // 不想要了
+	AfterSaleReason_AFTER_SALE_REASON_NOT_RECEIVED     AfterSaleReason = 6 // 未收到货
+	AfterSaleReason_AFTER_SALE_REASON_OTHER            AfterSaleReason = 7 // 其他
+)
+
+// Enum value maps for AfterSaleReason.
+var (
+	AfterSaleReason_name = map[int32]string{
+		0: "AFTER_SALE_REASON_UNKNOWN",
+		1: "AFTER_SALE_REASON_QUALITY_ISSUE",
+		2: "AFTER_SALE_REASON_DAMAGED",
+		3: "AFTER_SALE_REASON_WRONG_ITEM",
+		4: "AFTER_SALE_REASON_NOT_AS_DESCRIBED",
+		5: "AFTER_SALE_REASON_NO_LONGER_NEEDED",
+		6: "AFTER_SALE_REASON_NOT_RECEIVED",
+		7: "AFTER_SALE_REASON_OTHER",
+	}
+	AfterSaleReason_value = map[string]int32{
+		"AFTER_SALE_REASON_UNKNOWN":          0,
+		"AFTER_SALE_REASON_QUALITY_ISSUE":    1,
+		"AFTER_SALE_REASON_DAMAGED":          2,
+		"AFTER_SALE_REASON_WRONG_ITEM":       3,
+		"AFTER_SALE_REASON_NOT_AS_DESCRIBED": 4,
+		"AFTER_SALE_REASON_NO_LONGER_NEEDED": 5,
+		"AFTER_SALE_REASON_NOT_RECEIVED":     6,
+		"AFTER_SALE_REASON_OTHER":            7,
+	}
+)
+
+func (x AfterSaleReason) Enum() *AfterSaleReason {
+	p := new(AfterSaleReason)
+	*p = x
+	return p
+}
+
+func (x AfterSaleReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AfterSaleReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_aftersale_aftersale_proto_enumTypes[2].Descriptor()
+}
+
+func (AfterSaleReason) Type() protoreflect.EnumType {
+	return &file_order_aftersale_aftersale_proto_enumTypes[2]
+}
+
+func (x AfterSaleReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AfterSaleReason.Descriptor instead.
+func (AfterSaleReason) EnumDescriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{2}
+}
+
+// 售后申请信息
+type AfterSale struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId              string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderItemId          string                 `protobuf:"bytes,3,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	UserId               string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type                 AfterSaleType          `protobuf:"varint,5,opt,name=type,proto3,enum=order.aftersale.AfterSaleType" json:"type,omitempty"`
+	Status               AfterSaleStatus        `protobuf:"varint,6,opt,name=status,proto3,enum=order.aftersale.AfterSaleStatus" json:"status,omitempty"`
+	Reason               AfterSaleReason        `protobuf:"varint,7,opt,name=reason,proto3,enum=order.aftersale.AfterSaleReason" json:"reason,omitempty"`
+	Description          string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity             int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RequestedAmount      string                 `protobuf:"bytes,10,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`   // 申请退款金额
+	ApprovedAmount       string                 `protobuf:"bytes,11,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`      // 审核通过的退款金额
+	EvidenceFileIds      []string               `protobuf:"bytes,12,rep,name=evidence_file_ids,json=evidenceFileIds,proto3" json:"evidence_file_ids,omitempty"` // 凭证图片，oss-infra 文件ID
+	RejectReason         string                 `protobuf:"bytes,13,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	ReturnCarrierCode    string                 `protobuf:"bytes,14,opt,name=return_carrier_code,json=returnCarrierCode,proto3" json:"return_carrier_code,omitempty"`          // 退货承运商编码
+	ReturnTrackingNumber string                 `protobuf:"bytes,15,opt,name=return_tracking_number,json=returnTrackingNumber,proto3" json:"return_tracking_number,omitempty"` // 退货物流单号
+	RefundId             string                 `protobuf:"bytes,16,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`                                       // 支付服务退款单ID
+	ReviewedAt           *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReceivedAt           *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	RefundedAt           *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AfterSale) Reset() {
+	*x = AfterSale{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AfterSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfterSale) ProtoMessage() {}
+
+func (x *AfterSale) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfterSale.ProtoReflect.Descriptor instead.
+func (*AfterSale) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AfterSale) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AfterSale) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AfterSale) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *AfterSale) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AfterSale) GetType() AfterSaleType {
+	if x != nil {
+		return x.Type
+	}
+	return AfterSaleType_AFTER_SALE_TYPE_UNKNOWN
+}
+
+func (x *AfterSale) GetStatus() AfterSaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AfterSaleStatus_AFTER_SALE_STATUS_UNKNOWN
+}
+
+func (x *AfterSale) GetReason() AfterSaleReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AfterSaleReason_AFTER_SALE_REASON_UNKNOWN
+}
+
+func (x *AfterSale) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AfterSale) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AfterSale) GetRequestedAmount() string {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return ""
+}
+
+func (x *AfterSale) GetApprovedAmount() string {
+	if x != nil {
+		return x.ApprovedAmount
+	}
+	return ""
+}
+
+func (x *AfterSale) GetEvidenceFileIds() []string {
+	if x != nil {
+		return x.EvidenceFileIds
+	}
+	return nil
+}
+
+func (x *AfterSale) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *AfterSale) GetReturnCarrierCode() string {
+	if x != nil {
+		return x.ReturnCarrierCode
+	}
+	return ""
+}
+
+func (x *AfterSale) GetReturnTrackingNumber() string {
+	if x != nil {
+		return x.ReturnTrackingNumber
+	}
+	return ""
+}
+
+func (x *AfterSale) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *AfterSale) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *AfterSale) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *AfterSale) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
+func (x *AfterSale) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AfterSale) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 申请售后请求
+type ApplyAfterSaleReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderId         string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderItemId     string                 `protobuf:"bytes,2,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Type            AfterSaleType          `protobuf:"varint,3,opt,name=type,proto3,enum=order.aftersale.AfterSaleType" json:"type,omitempty"`
+	Reason          AfterSaleReason        `protobuf:"varint,4,opt,name=reason,proto3,enum=order.aftersale.AfterSaleReason" json:"reason,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity        int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount          string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`                                            // 申请退款金额，为空时按可退金额退款
+	EvidenceFileIds []string               `protobuf:"bytes,8,rep,name=evidence_file_ids,json=evidenceFileIds,proto3" json:"evidence_file_ids,omitempty"` // 凭证图片，oss-infra 文件ID，最多9张
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyAfterSaleReq) Reset() {
+	*x = ApplyAfterSaleReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyAfterSaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAfterSaleReq) ProtoMessage() {}
+
+func (x *ApplyAfterSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAfterSaleReq.ProtoReflect.Descriptor instead.
+func (*ApplyAfterSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyAfterSaleReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ApplyAfterSaleReq) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ApplyAfterSaleReq) GetType() AfterSaleType {
+	if x != nil {
+		return x.Type
+	}
+	return AfterSaleType_AFTER_SALE_TYPE_UNKNOWN
+}
+
+func (x *ApplyAfterSaleReq) GetReason() AfterSaleReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AfterSaleReason_AFTER_SALE_REASON_UNKNOWN
+}
+
+func (x *ApplyAfterSaleReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApplyAfterSaleReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ApplyAfterSaleReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ApplyAfterSaleReq) GetEvidenceFileIds() []string {
+	if x != nil {
+		return x.EvidenceFileIds
+	}
+	return nil
+}
+
+// 申请售后响应
+type ApplyAfterSaleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyAfterSaleResp) Reset() {
+	*x = ApplyAfterSaleResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyAfterSaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAfterSaleResp) ProtoMessage() {}
+
+func (x *ApplyAfterSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAfterSaleResp.ProtoReflect.Descriptor instead.
+func (*ApplyAfterSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyAfterSaleResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 获取售后详情请求
+type GetAfterSaleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId   string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAfterSaleReq) Reset() {
+	*x = GetAfterSaleReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAfterSaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfterSaleReq) ProtoMessage() {}
+
+func (x *GetAfterSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfterSaleReq.ProtoReflect.Descriptor instead.
+func (*GetAfterSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAfterSaleReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+// 获取售后详情响应
+type GetAfterSaleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAfterSaleResp) Reset() {
+	*x = GetAfterSaleResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAfterSaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAfterSaleResp) ProtoMessage() {}
+
+func (x *GetAfterSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAfterSaleResp.ProtoReflect.Descriptor instead.
+func (*GetAfterSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAfterSaleResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 获取售后列表请求
+type ListAfterSalesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 指定订单时忽略分页和状态筛选
+	Status        AfterSaleStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=order.aftersale.AfterSaleStatus" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAfterSalesReq) Reset() {
+	*x = ListAfterSalesReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAfterSalesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAfterSalesReq) ProtoMessage() {}
+
+func (x *ListAfterSalesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAfterSalesReq.ProtoReflect.Descriptor instead.
+func (*ListAfterSalesReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAfterSalesReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListAfterSalesReq) GetStatus() AfterSaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AfterSaleStatus_AFTER_SALE_STATUS_UNKNOWN
+}
+
+func (x *ListAfterSalesReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAfterSalesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取售后列表响应
+type ListAfterSalesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSales    []*AfterSale           `protobuf:"bytes,1,rep,name=after_sales,json=afterSales,proto3" json:"after_sales,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAfterSalesResp) Reset() {
+	*x = ListAfterSalesResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAfterSalesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAfterSalesResp) ProtoMessage() {}
+
+func (x *ListAfterSalesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAfterSalesResp.ProtoReflect.Descriptor instead.
+func (*ListAfterSalesResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAfterSalesResp) GetAfterSales() []*AfterSale {
+	if x != nil {
+		return x.AfterSales
+	}
+	return nil
+}
+
+func (x *ListAfterSalesResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 撤销售后请求
+type CancelAfterSaleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId   string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAfterSaleReq) Reset() {
+	*x = CancelAfterSaleReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAfterSaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAfterSaleReq) ProtoMessage() {}
+
+func (x *CancelAfterSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAfterSaleReq.ProtoReflect.Descriptor instead.
+func (*CancelAfterSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelAfterSaleReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+// 撤销售后响应
+type CancelAfterSaleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAfterSaleResp) Reset() {
+	*x = CancelAfterSaleResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAfterSaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAfterSaleResp) ProtoMessage() {}
+
+func (x *CancelAfterSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAfterSaleResp.ProtoReflect.Descriptor instead.
+func (*CancelAfterSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelAfterSaleResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 填写退货物流请求
+type SubmitReturnReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId    string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	CarrierCode    string                 `protobuf:"bytes,2,opt,name=carrier_code,json=carrierCode,proto3" json:"carrier_code,omitempty"`          // 承运商编码
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"` // 物流单号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitReturnReq) Reset() {
+	*x = SubmitReturnReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReturnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReturnReq) ProtoMessage() {}
+
+func (x *SubmitReturnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReturnReq.ProtoReflect.Descriptor instead.
+func (*SubmitReturnReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitReturnReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+func (x *SubmitReturnReq) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *SubmitReturnReq) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+// 填写退货物流响应
+type SubmitReturnResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReturnResp) Reset() {
+	*x = SubmitReturnResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReturnResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReturnResp) ProtoMessage() {}
+
+func (x *SubmitReturnResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReturnResp.ProtoReflect.Descriptor instead.
+func (*SubmitReturnResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitReturnResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 审核售后请求
+type ReviewAfterSaleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId   string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`                            // true同意，false拒绝
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 同意退款的金额，为空时按申请金额退款
+	RejectReason  string                 `protobuf:"bytes,4,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"` // 拒绝原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAfterSaleReq) Reset() {
+	*x = ReviewAfterSaleReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAfterSaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAfterSaleReq) ProtoMessage() {}
+
+func (x *ReviewAfterSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAfterSaleReq.ProtoReflect.Descriptor instead.
+func (*ReviewAfterSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReviewAfterSaleReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+func (x *ReviewAfterSaleReq) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ReviewAfterSaleReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReviewAfterSaleReq) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+// 审核售后响应
+type ReviewAfterSaleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAfterSaleResp) Reset() {
+	*x = ReviewAfterSaleResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAfterSaleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAfterSaleResp) ProtoMessage() {}
+
+func (x *ReviewAfterSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAfterSaleResp.ProtoReflect.Descriptor instead.
+func (*ReviewAfterSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewAfterSaleResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 确认收到退货请求
+type ReceiveReturnReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId   string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnReq) Reset() {
+	*x = ReceiveReturnReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnReq) ProtoMessage() {}
+
+func (x *ReceiveReturnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnReq.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReceiveReturnReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+// 确认收到退货响应
+type ReceiveReturnResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnResp) Reset() {
+	*x = ReceiveReturnResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnResp) ProtoMessage() {}
+
+func (x *ReceiveReturnResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnResp.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReceiveReturnResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+// 重试退款请求
+type RetryRefundReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSaleId   string                 `protobuf:"bytes,1,opt,name=after_sale_id,json=afterSaleId,proto3" json:"after_sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryRefundReq) Reset() {
+	*x = RetryRefundReq{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryRefundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryRefundReq) ProtoMessage() {}
+
+func (x *RetryRefundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryRefundReq.ProtoReflect.Descriptor instead.
+func (*RetryRefundReq) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetryRefundReq) GetAfterSaleId() string {
+	if x != nil {
+		return x.AfterSaleId
+	}
+	return ""
+}
+
+// 重试退款响应
+type RetryRefundResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSale     *AfterSale             `protobuf:"bytes,1,opt,name=after_sale,json=afterSale,proto3" json:"after_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryRefundResp) Reset() {
+	*x = RetryRefundResp{}
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryRefundResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryRefundResp) ProtoMessage() {}
+
+func (x *RetryRefundResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_aftersale_aftersale_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryRefundResp.ProtoReflect.Descriptor instead.
+func (*RetryRefundResp) Descriptor() ([]byte, []int) {
+	return file_order_aftersale_aftersale_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RetryRefundResp) GetAfterSale() *AfterSale {
+	if x != nil {
+		return x.AfterSale
+	}
+	return nil
+}
+
+var File_order_aftersale_aftersale_proto protoreflect.FileDescriptor
+
+const file_order_aftersale_aftersale_proto_rawDesc = "" +
+	"\n" +
+	"\x1forder/aftersale/aftersale.proto\x12\x0forder.aftersale\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xae\a\n" +
+	"\tAfterSale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\"\n" +
+	"\rorder_item_id\x18\x03 \x01(\tR\vorderItemId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x122\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1e.order.aftersale.AfterSaleTypeR\x04type\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .order.aftersale.AfterSaleStatusR\x06status\x128\n" +
+	"\x06reason\x18\a \x01(\x0e2 .order.aftersale.AfterSaleReasonR\x06reason\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\t \x01(\x05R\bquantity\x12)\n" +
+	"\x10requested_amount\x18\n" +
+	" \x01(\tR\x0frequestedAmount\x12'\n" +
+	"\x0fapproved_amount\x18\v \x01(\tR\x0eapprovedAmount\x12*\n" +
+	"\x11evidence_file_ids\x18\f \x03(\tR\x0fevidenceFileIds\x12#\n" +
+	"\rreject_reason\x18\r \x01(\tR\frejectReason\x12.\n" +
+	"\x13return_carrier_code\x18\x0e \x01(\tR\x11returnCarrierCode\x124\n" +
+	"\x16return_tracking_number\x18\x0f \x01(\tR\x14returnTrackingNumber\x12\x1b\n" +
+	"\trefund_id\x18\x10 \x01(\tR\brefundId\x12;\n" +
+	"\vreviewed_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12;\n" +
+	"\vreceived_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12;\n" +
+	"\vrefunded_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"refundedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc2\x02\n" +
+	"\x11ApplyAfterSaleReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\"\n" +
+	"\rorder_item_id\x18\x02 \x01(\tR\vorderItemId\x122\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1e.order.aftersale.AfterSaleTypeR\x04type\x128\n" +
+	"\x06reason\x18\x04 \x01(\x0e2 .order.aftersale.AfterSaleReasonR\x06reason\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12*\n" +
+	"\x11evidence_file_ids\x18\b \x03(\tR\x0fevidenceFileIds\"O\n" +
+	"\x12ApplyAfterSaleResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"5\n" +
+	"\x0fGetAfterSaleReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\"M\n" +
+	"\x10GetAfterSaleResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"\x99\x01\n" +
+	"\x11ListAfterSalesReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2 .order.aftersale.AfterSaleStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"g\n" +
+	"\x12ListAfterSalesResp\x12;\n" +
+	"\vafter_sales\x18\x01 \x03(\v2\x1a.order.aftersale.AfterSaleR\n" +
+	"afterSales\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"8\n" +
+	"\x12CancelAfterSaleReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\"P\n" +
+	"\x13CancelAfterSaleResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"\x81\x01\n" +
+	"\x0fSubmitReturnReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\x12!\n" +
+	"\fcarrier_code\x18\x02 \x01(\tR\vcarrierCode\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\"M\n" +
+	"\x10SubmitReturnResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"\x91\x01\n" +
+	"\x12ReviewAfterSaleReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12#\n" +
+	"\rreject_reason\x18\x04 \x01(\tR\frejectReason\"P\n" +
+	"\x13ReviewAfterSaleResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"6\n" +
+	"\x10ReceiveReturnReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\"N\n" +
+	"\x11ReceiveReturnResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale\"4\n" +
+	"\x0eRetryRefundReq\x12\"\n" +
+	"\rafter_sale_id\x18\x01 \x01(\tR\vafterSaleId\"L\n" +
+	"\x0fRetryRefundResp\x129\n" +
+	"\n" +
+	"after_sale\x18\x01 \x01(\v2\x1a.order.aftersale.AfterSaleR\tafterSale*t\n" +
+	"\rAfterSaleType\x12\x1b\n" +
+	"\x17AFTER_SALE_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bAFTER_SALE_TYPE_REFUND_ONLY\x10\x01\x12%\n" +
+	"!AFTER_SALE_TYPE_RETURN_AND_REFUND\x10\x02*\x91\x02\n" +
+	"\x0fAfterSaleStatus\x12\x1d\n" +
+	"\x19AFTER_SALE_STATUS_UNKNOWN\x10\x00\x12\x1d\n" +
+	"\x19AFTER_SALE_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aAFTER_SALE_STATUS_APPROVED\x10\x02\x12\x1e\n" +
+	"\x1aAFTER_SALE_STATUS_REJECTED\x10\x03\x12\x1e\n" +
+	"\x1aAFTER_SALE_STATUS_RETURNED\x10\x04\x12\x1f\n" +
+	"\x1bAFTER_SALE_STATUS_REFUNDING\x10\x05\x12\x1e\n" +
+	"\x1aAFTER_SALE_STATUS_REFUNDED\x10\x06\x12\x1f\n" +
+	"\x1bAFTER_SALE_STATUS_CANCELLED\x10\a*\xa7\x02\n" +
+	"\x0fAfterSaleReason\x12\x1d\n" +
+	"\x19AFTER_SALE_REASON_UNKNOWN\x10\x00\x12#\n" +
+	"\x1fAFTER_SALE_REASON_QUALITY_ISSUE\x10\x01\x12\x1d\n" +
+	"\x19AFTER_SALE_REASON_DAMAGED\x10\x02\x12 \n" +
+	"\x1cAFTER_SALE_REASON_WRONG_ITEM\x10\x03\x12&\n" +
+	"\"AFTER_SALE_REASON_NOT_AS_DESCRIBED\x10\x04\x12&\n" +
+	"\"AFTER_SALE_REASON_NO_LONGER_NEEDED\x10\x05\x12\"\n" +
+	"\x1eAFTER_SALE_REASON_NOT_RECEIVED\x10\x06\x12\x1b\n" +
+	"\x17AFTER_SALE_REASON_OTHER\x10\a2\xab\x0e\n" +
+	"\x10AfterSaleService\x12\xef\x01\n" +
+	"\x0eApplyAfterSale\x12\".order.aftersale.ApplyAfterSaleReq\x1a#.order.aftersale.ApplyAfterSaleResp\"\x93\x01\x92Ar\x12\f申请售后\x1ab针对订单商品项申请仅退款或退货退款，凭证图片为oss-infra上传后的文件ID\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/after-sales\x12\xb9\x01\n" +
+	"\fGetAfterSale\x12 .order.aftersale.GetAfterSaleReq\x1a!.order.aftersale.GetAfterSaleResp\"d\x92A6\x12\x12获取售后详情\x1a 根据售后申请ID获取详情\x82\xd3\xe4\x93\x02%\x12#/api/v1/after-sales/{after_sale_id}\x12\xe1\x01\n" +
+	"\x0eListAfterSales\x12\".order.aftersale.ListAfterSalesReq\x1a#.order.aftersale.ListAfterSalesResp\"\x85\x01\x92Ag\x12\x12获取售后列表\x1aQ获取用户的售后申请，指定订单时返回该订单的全部售后申请\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/after-sales\x12\xd6\x01\n" +
+	"\x0fCancelAfterSale\x12#.order.aftersale.CancelAfterSaleReq\x1a$.order.aftersale.CancelAfterSaleResp\"x\x92A@\x12\f撤销售后\x1a0买家撤销待审核或待退货的售后申请\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/after-sales/{after_sale_id}/cancel\x12\xef\x01\n" +
+	"\fSubmitReturn\x12 .order.aftersale.SubmitReturnReq\x1a!.order.aftersale.SubmitReturnResp\"\x99\x01\x92Aa\x12\x12填写退货物流\x1aK退货退款申请审核通过后，买家寄回商品并填写物流单号\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/after-sales/{after_sale_id}/return\x12\xf2\x01\n" +
+	"\x0fReviewAfterSale\x12#.order.aftersale.ReviewAfterSaleReq\x1a$.order.aftersale.ReviewAfterSaleResp\"\x93\x01\x92A[\x12\f审核售后\x1aK运营同意或拒绝售后申请，仅退款的申请同意后立即退款\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/after-sales/{after_sale_id}/review\x12\xed\x01\n" +
+	"\rReceiveReturn\x12!.order.aftersale.ReceiveReturnReq\x1a\".order.aftersale.ReceiveReturnResp\"\x94\x01\x92A[\x12\x12确认收到退货\x1aE运营确认收到退货，商品重新入库并按审核金额退款\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/after-sales/{after_sale_id}/receive\x12\xd4\x01\n" +
+	"\vRetryRefund\x12\x1f.order.aftersale.RetryRefundReq\x1a .order.aftersale.RetryRefundResp\"\x81\x01\x92AI\x12\f重试退款\x1a9退款失败时重新发起退款中售后申请的退款\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/after-sales/{after_sale_id}/refundBLZJgithub.com/people257/poor-guy-shop/order-service/gen/proto/order/aftersaleb\x06proto3"
+
+var (
+	file_order_aftersale_aftersale_proto_rawDescOnce sync.Once
+	file_order_aftersale_aftersale_proto_rawDescData []byte
+)
+
+func file_order_aftersale_aftersale_proto_rawDescGZIP() []byte {
+	file_order_aftersale_aftersale_proto_rawDescOnce.Do(func() {
+		file_order_aftersale_aftersale_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_order_aftersale_aftersale_proto_rawDesc), len(file_order_aftersale_aftersale_proto_rawDesc)))
+	})
+	return file_order_aftersale_aftersale_proto_rawDescData
+}
+
+var file_order_aftersale_aftersale_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_order_aftersale_aftersale_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_order_aftersale_aftersale_proto_goTypes = []any{
+	(AfterSaleType)(0),            // 0: order.aftersale.AfterSaleType
+	(AfterSaleStatus)(0),          // 1: order.aftersale.AfterSaleStatus
+	(AfterSaleReason)(0),          // 2: order.aftersale.AfterSaleReason
+	(*AfterSale)(nil),             // 3: order.aftersale.AfterSale
+	(*ApplyAfterSaleReq)(nil),     // 4: order.aftersale.ApplyAfterSaleReq
+	(*ApplyAfterSaleResp)(nil),    // 5: order.aftersale.ApplyAfterSaleResp
+	(*GetAfterSaleReq)(nil),       // 6: order.aftersale.GetAfterSaleReq
+	(*GetAfterSaleResp)(nil),      // 7: order.aftersale.GetAfterSaleResp
+	(*ListAfterSalesReq)(nil),     // 8: order.aftersale.ListAfterSalesReq
+	(*ListAfterSalesResp)(nil),    // 9: order.aftersale.ListAfterSalesResp
+	(*CancelAfterSaleReq)(nil),    // 10: order.aftersale.CancelAfterSaleReq
+	(*CancelAfterSaleResp)(nil),   // 11: order.aftersale.CancelAfterSaleResp
+	(*SubmitReturnReq)(nil),       // 12: order.aftersale.SubmitReturnReq
+	(*SubmitReturnResp)(nil),      // 13: order.aftersale.SubmitReturnResp
+	(*ReviewAfterSaleReq)(nil),    // 14: order.aftersale.ReviewAfterSaleReq
+	(*ReviewAfterSaleResp)(nil),   // 15: order.aftersale.ReviewAfterSaleResp
+	(*ReceiveReturnReq)(nil),      // 16: order.aftersale.ReceiveReturnReq
+	(*ReceiveReturnResp)(nil),     // 17: order.aftersale.ReceiveReturnResp
+	(*RetryRefundReq)(nil),        // 18: order.aftersale.RetryRefundReq
+	(*RetryRefundResp)(nil),       // 19: order.aftersale.RetryRefundResp
+	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
+}
+var file_order_aftersale_aftersale_proto_depIdxs = []int32{
+	0,  // 0: order.aftersale.AfterSale.type:type_name -> order.aftersale.AfterSaleType
+	1,  // 1: order.aftersale.AfterSale.status:type_name -> order.aftersale.AfterSaleStatus
+	2,  // 2: order.aftersale.AfterSale.reason:type_name -> order.aftersale.AfterSaleReason
+	20, // 3: order.aftersale.AfterSale.reviewed_at:type_name -> google.protobuf.Timestamp
+	20, // 4: order.aftersale.AfterSale.received_at:type_name -> google.protobuf.Timestamp
+	20, // 5: order.aftersale.AfterSale.refunded_at:type_name -> google.protobuf.Timestamp
+	20, // 6: order.aftersale.AfterSale.created_at:type_name -> google.protobuf.Timestamp
+	20, // 7: order.aftersale.AfterSale.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: order.aftersale.ApplyAfterSaleReq.type:type_name -> order.aftersale.AfterSaleType
+	2,  // 9: order.aftersale.ApplyAfterSaleReq.reason:type_name -> order.aftersale.AfterSaleReason
+	3,  // 10: order.aftersale.ApplyAfterSaleResp.after_sale:type_name -> order.aftersale.AfterSale
+	3,  // 11: order.aftersale.GetAfterSaleResp.after_sale:type_name -> order.aftersale.AfterSale
+	1,  // 12: order.aftersale.ListAfterSalesReq.status:type_name -> order.aftersale.AfterSaleStatus
+	3,  // 13: order.aftersale.ListAfterSalesResp.after_sales:type_name -> order.aftersale.AfterSale
+	3,  // 14: order.aftersale.CancelAfterSaleResp.after_sale:type_name -> order.aftersale.AfterSale
+	3,  // 15: order.aftersale.SubmitReturnResp.after_sale:type_name -> order.aftersale.AfterSale
+	3,  // 16: order.aftersale.ReviewAfterSaleResp.after_sale:type_name -> order.aftersale.AfterSale
+	3,  // 17: order.aftersale.ReceiveReturnResp.after_sale:type_name -> order.aftersale.AfterSale
+	3,  // 18: order.aftersale.RetryRefundResp.after_sale:type_name -> order.aftersale.AfterSale
+	4,  // 19: order.aftersale.AfterSaleService.ApplyAfterSale:input_type -> order.aftersale.ApplyAfterSaleReq
+	6,  // 20: order.aftersale.AfterSaleService.GetAfterSale:input_type -> order.aftersale.GetAfterSaleReq
+	8,  // 21: order.aftersale.AfterSaleService.ListAfterSales:input_type -> order.aftersale.ListAfterSalesReq
+	10, // 22: order.aftersale.AfterSaleService.CancelAfterSale:input_type -> order.aftersale.CancelAfterSaleReq
+	12, // 23: order.aftersale.AfterSaleService.SubmitReturn:input_type -> order.aftersale.SubmitReturnReq
+	14, // 24: order.aftersale.AfterSaleService.ReviewAfterSale:input_type -> order.aftersale.ReviewAfterSaleReq
+	16, // 25: order.aftersale.AfterSaleService.ReceiveReturn:input_type -> order.aftersale.ReceiveReturnReq
+	18, // 26: order.aftersale.AfterSaleService.RetryRefund:input_type -> order.aftersale.RetryRefundReq
+	5,  // 27: order.aftersale.AfterSaleService.ApplyAfterSale:output_type -> order.aftersale.ApplyAfterSaleResp
+	7,  // 28: order.aftersale.AfterSaleService.GetAfterSale:output_type -> order.aftersale.GetAfterSaleResp
+	9,  // 29: order.aftersale.AfterSaleService.ListAfterSales:output_type -> order.aftersale.ListAfterSalesResp
+	11, // 30: order.aftersale.AfterSaleService.CancelAfterSale:output_type -> order.aftersale.CancelAfterSaleResp
+	13, // 31: order.aftersale.AfterSaleService.SubmitReturn:output_type -> order.aftersale.SubmitReturnResp
+	15, // 32: order.aftersale.AfterSaleService.ReviewAfterSale:output_type -> order.aftersale.ReviewAfterSaleResp
+	17, // 33: order.aftersale.AfterSaleService.ReceiveReturn:output_type -> order.aftersale.ReceiveReturnResp
+	19, // 34: order.aftersale.AfterSaleService.RetryRefund:output_type -> order.aftersale.RetryRefundResp
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_order_aftersale_aftersale_proto_init() }
+func file_order_aftersale_aftersale_proto_init() {
+	if File_order_aftersale_aftersale_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_aftersale_aftersale_proto_rawDesc), len(file_order_aftersale_aftersale_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_order_aftersale_aftersale_proto_goTypes,
+		DependencyIndexes: file_order_aftersale_aftersale_proto_depIdxs,
+		EnumInfos:         file_order_aftersale_aftersale_proto_enumTypes,
+		MessageInfos:      file_order_aftersale_aftersale_proto_msgTypes,
+	}.Build()
+	File_order_aftersale_aftersale_proto = out.File
+	file_order_aftersale_aftersale_proto_goTypes = nil
+	file_order_aftersale_aftersale_proto_depIdxs = nil
+}
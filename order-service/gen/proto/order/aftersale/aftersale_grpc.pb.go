@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: order/aftersale/aftersale.proto
+
+package aftersale
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AfterSaleService_ApplyAfterSale_FullMethodName  = "/order.aftersale.AfterSaleService/ApplyAfterSale"
+	AfterSaleService_GetAfterSale_FullMethodName    = "/order.aftersale.AfterSaleService/GetAfterSale"
+	AfterSaleService_ListAfterSales_FullMethodName  = "/order.aftersale.AfterSaleService/ListAfterSales"
+	AfterSaleService_CancelAfterSale_FullMethodName = "/order.aftersale.AfterSaleService/CancelAfterSale"
+	AfterSaleService_SubmitReturn_FullMethodName    = "/order.aftersale.AfterSaleService/SubmitReturn"
+	AfterSaleService_ReviewAfterSale_FullMethodName = "/order.aftersale.AfterSaleService/ReviewAfterSale"
+	AfterSaleService_ReceiveReturn_FullMethodName   = "/order.aftersale.AfterSaleService/ReceiveReturn"
+	AfterSaleService_RetryRefund_FullMethodName     = "/order.aftersale.AfterSaleService/RetryRefund"
+)
+
+// AfterSaleServiceClient is the client API for AfterSaleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 售后服务
+type AfterSaleServiceClient interface {
+	// 申请售后
+	ApplyAfterSale(ctx context.Context, in *ApplyAfterSaleReq, opts ...grpc.CallOption) (*ApplyAfterSaleResp, error)
+	// 获取售后详情
+	GetAfterSale(ctx context.Context, in *GetAfterSaleReq, opts ...grpc.CallOption) (*GetAfterSaleResp, error)
+	// 获取售后列表
+	ListAfterSales(ctx context.Context, in *ListAfterSalesReq, opts ...grpc.CallOption) (*ListAfterSalesResp, error)
+	// 撤销售后
+	CancelAfterSale(ctx context.Context, in *CancelAfterSaleReq, opts ...grpc.CallOption) (*CancelAfterSaleResp, error)
+	// 填写退货物流
+	SubmitReturn(ctx context.Context, in *SubmitReturnReq, opts ...grpc.CallOption) (*SubmitReturnResp, error)
+	// 审核售后
+	ReviewAfterSale(ctx context.Context, in *ReviewAfterSaleReq, opts ...grpc.CallOption) (*ReviewAfterSaleResp, error)
+	// 确认收到退货
+	ReceiveReturn(ctx context.Context, in *ReceiveReturnReq, opts ...grpc.CallOption) (*ReceiveReturnResp, error)
+	// 重试退款
+	RetryRefund(ctx context.Context, in *RetryRefundReq, opts ...grpc.CallOption) (*RetryRefundResp, error)
+}
+
+type afterSaleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAfterSaleServiceClient(cc grpc.ClientConnInterface) AfterSaleServiceClient {
+	return &afterSaleServiceClient{cc}
+}
+
+func (c *afterSaleServiceClient) ApplyAfterSale(ctx context.Context, in *ApplyAfterSaleReq, opts ...grpc.CallOption) (*ApplyAfterSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyAfterSaleResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_ApplyAfterSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) GetAfterSale(ctx context.Context, in *GetAfterSaleReq, opts ...grpc.CallOption) (*GetAfterSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAfterSaleResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_GetAfterSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) ListAfterSales(ctx context.Context, in *ListAfterSalesReq, opts ...grpc.CallOption) (*ListAfterSalesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAfterSalesResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_ListAfterSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) CancelAfterSale(ctx context.Context, in *CancelAfterSaleReq, opts ...grpc.CallOption) (*CancelAfterSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAfterSaleResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_CancelAfterSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) SubmitReturn(ctx context.Context, in *SubmitReturnReq, opts ...grpc.CallOption) (*SubmitReturnResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReturnResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_SubmitReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) ReviewAfterSale(ctx context.Context, in *ReviewAfterSaleReq, opts ...grpc.CallOption) (*ReviewAfterSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewAfterSaleResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_ReviewAfterSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) ReceiveReturn(ctx context.Context, in *ReceiveReturnReq, opts ...grpc.CallOption) (*ReceiveReturnResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveReturnResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_ReceiveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *afterSaleServiceClient) RetryRefund(ctx context.Context, in *RetryRefundReq, opts ...grpc.CallOption) (*RetryRefundResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryRefundResp)
+	err := c.cc.Invoke(ctx, AfterSaleService_RetryRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AfterSaleServiceServer is the server API for AfterSaleService service.
+// All implementations should embed UnimplementedAfterSaleServiceServer
+// for forward compatibility.
+//
+// 售后服务
+type AfterSaleServiceServer interface {
+	// 申请售后
+	ApplyAfterSale(context.Context, *ApplyAfterSaleReq) (*ApplyAfterSaleResp, error)
+	// 获取售后详情
+	GetAfterSale(context.Context, *GetAfterSaleReq) (*GetAfterSaleResp, error)
+	// 获取售后列表
+	ListAfterSales(context.Context, *ListAfterSalesReq) (*ListAfterSalesResp, error)
+	// 撤销售后
+	CancelAfterSale(context.Context, *CancelAfterSaleReq) (*CancelAfterSaleResp, error)
+	// 填写退货物流
+	SubmitReturn(context.Context, *SubmitReturnReq) (*SubmitReturnResp, error)
+	// 审核售后
+	ReviewAfterSale(context.Context, *ReviewAfterSaleReq) (*ReviewAfterSaleResp, error)
+	// 确认收到退货
+	ReceiveReturn(context.Context, *ReceiveReturnReq) (*ReceiveReturnResp, error)
+	// 重试退款
+	RetryRefund(context.Context, *RetryRefundReq) (*RetryRefundResp, error)
+}
+
+// UnimplementedAfterSaleServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAfterSaleServiceServer struct{}
+
+func (UnimplementedAfterSaleServiceServer) ApplyAfterSale(context.Context, *ApplyAfterSaleReq) (*ApplyAfterSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAfterSale not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) GetAfterSale(context.Context, *GetAfterSaleReq) (*GetAfterSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAfterSale not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) ListAfterSales(context.Context, *ListAfterSalesReq) (*ListAfterSalesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAfterSales not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) CancelAfterSale(context.Context, *CancelAfterSaleReq) (*CancelAfterSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAfterSale not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) SubmitReturn(context.Context, *SubmitReturnReq) (*SubmitReturnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReturn not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) ReviewAfterSale(context.Context, *ReviewAfterSaleReq) (*ReviewAfterSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewAfterSale not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) ReceiveReturn(context.Context, *ReceiveReturnReq) (*ReceiveReturnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) RetryRefund(context.Context, *RetryRefundReq) (*RetryRefundResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryRefund not implemented")
+}
+func (UnimplementedAfterSaleServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAfterSaleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AfterSaleServiceServer will
+// result in compilation errors.
+type UnsafeAfterSaleServiceServer interface {
+	mustEmbedUnimplementedAfterSaleServiceServer()
+}
+
+func RegisterAfterSaleServiceServer(s grpc.ServiceRegistrar, srv AfterSaleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAfterSaleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AfterSaleService_ServiceDesc, srv)
+}
+
+func _AfterSaleService_ApplyAfterSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyAfterSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).ApplyAfterSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_ApplyAfterSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).ApplyAfterSale(ctx, req.(*ApplyAfterSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_GetAfterSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAfterSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).GetAfterSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_GetAfterSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).GetAfterSale(ctx, req.(*GetAfterSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_ListAfterSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAfterSalesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).ListAfterSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_ListAfterSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).ListAfterSales(ctx, req.(*ListAfterSalesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_CancelAfterSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAfterSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).CancelAfterSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_CancelAfterSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).CancelAfterSale(ctx, req.(*CancelAfterSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_SubmitReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReturnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).SubmitReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_SubmitReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).SubmitReturn(ctx, req.(*SubmitReturnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_ReviewAfterSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAfterSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).ReviewAfterSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_ReviewAfterSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).ReviewAfterSale(ctx, req.(*ReviewAfterSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveReturnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).ReceiveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_ReceiveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).ReceiveReturn(ctx, req.(*ReceiveReturnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AfterSaleService_RetryRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryRefundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AfterSaleServiceServer).RetryRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AfterSaleService_RetryRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AfterSaleServiceServer).RetryRefund(ctx, req.(*RetryRefundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AfterSaleService_ServiceDesc is the grpc.ServiceDesc for AfterSaleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AfterSaleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.aftersale.AfterSaleService",
+	HandlerType: (*AfterSaleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyAfterSale",
+			Handler:    _AfterSaleService_ApplyAfterSale_Handler,
+		},
+		{
+			MethodName: "GetAfterSale",
+			Handler:    _AfterSaleService_GetAfterSale_Handler,
+		},
+		{
+			MethodName: "ListAfterSales",
+			Handler:    _AfterSaleService_ListAfterSales_Handler,
+		},
+		{
+			MethodName: "CancelAfterSale",
+			Handler:    _AfterSaleService_CancelAfterSale_Handler,
+		},
+		{
+			MethodName: "SubmitReturn",
+			Handler:    _AfterSaleService_SubmitReturn_Handler,
+		},
+		{
+			MethodName: "ReviewAfterSale",
+			Handler:    _AfterSaleService_ReviewAfterSale_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _AfterSaleService_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "RetryRefund",
+			Handler:    _AfterSaleService_RetryRefund_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "order/aftersale/aftersale.proto",
+}
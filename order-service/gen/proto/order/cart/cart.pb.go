@@ -26,23 +26,27 @@ const (
 
 // 购物车商品信息
 type CartItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	SkuId         string                 `protobuf:"bytes,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`    // 冗余字段，从product-service获取
-	ProductImage  string                 `protobuf:"bytes,6,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"` // 商品主图
-	SkuName       string                 `protobuf:"bytes,7,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`                // SKU名称
-	Price         string                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`                                   // 当前价格
-	Quantity      int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	TotalAmount   string                 `protobuf:"bytes,10,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // 小计金额
-	Selected      bool                   `protobuf:"varint,11,opt,name=selected,proto3" json:"selected,omitempty"`                         // 是否选中
-	Available     bool                   `protobuf:"varint,12,opt,name=available,proto3" json:"available,omitempty"`                       // 商品是否可用（库存充足等）
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId             string                 `protobuf:"bytes,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`    // 冗余字段，从product-service获取
+	ProductImage      string                 `protobuf:"bytes,6,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"` // 商品主图
+	SkuName           string                 `protobuf:"bytes,7,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`                // SKU名称
+	Price             string                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`                                   // 当前价格
+	Quantity          int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TotalAmount       string                 `protobuf:"bytes,10,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // 小计金额
+	Selected          bool                   `protobuf:"varint,11,opt,name=selected,proto3" json:"selected,omitempty"`                         // 是否选中
+	Available         bool                   `protobuf:"varint,12,opt,name=available,proto3" json:"available,omitempty"`                       // 商品是否可用（库存充足等）
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AddedPrice        string                 `protobuf:"bytes,15,opt,name=added_price,json=addedPrice,proto3" json:"added_price,omitempty"`                      // 加入购物车时的价格
+	PriceDelta        string                 `protobuf:"bytes,16,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`                      // 当前价格与加购价格的差额，正数表示涨价
+	PriceChanged      bool                   `protobuf:"varint,17,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`               // 加入购物车后价格是否变化
+	UnavailableReason string                 `protobuf:"bytes,18,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"` // 商品不可用的原因
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
@@ -173,6 +177,34 @@ func (x *CartItem) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CartItem) GetAddedPrice() string {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return ""
+}
+
+func (x *CartItem) GetPriceDelta() string {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return ""
+}
+
+func (x *CartItem) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartItem) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
 // 购物车汇总信息
 type CartSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -610,6 +642,7 @@ type GetCartResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Summary       *CartSummary           `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Refreshed     bool                   `protobuf:"varint,3,opt,name=refreshed,proto3" json:"refreshed,omitempty"` // 是否获取到实时价格和库存，为false时展示的是加购时的价格
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -658,6 +691,13 @@ func (x *GetCartResp) GetSummary() *CartSummary {
 	return nil
 }
 
+func (x *GetCartResp) GetRefreshed() bool {
+	if x != nil {
+		return x.Refreshed
+	}
+	return false
+}
+
 // 清空购物车请求
 type ClearCartReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -862,12 +902,110 @@ func (x *SelectCartItemsResp) GetSummary() *CartSummary {
 	return nil
 }
 
+// 合并游客购物车请求
+type MergeCartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"` // 游客购物车令牌，为空时使用网关传入的令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartReq) Reset() {
+	*x = MergeCartReq{}
+	mi := &file_order_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartReq) ProtoMessage() {}
+
+func (x *MergeCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartReq.ProtoReflect.Descriptor instead.
+func (*MergeCartReq) Descriptor() ([]byte, []int) {
+	return file_order_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MergeCartReq) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+// 合并游客购物车响应
+type MergeCartResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergedItems   int32                  `protobuf:"varint,1,opt,name=merged_items,json=mergedItems,proto3" json:"merged_items,omitempty"` // 合并的商品项数量
+	CappedItems   int32                  `protobuf:"varint,2,opt,name=capped_items,json=cappedItems,proto3" json:"capped_items,omitempty"` // 因超过限购数量被截断的商品项数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartResp) Reset() {
+	*x = MergeCartResp{}
+	mi := &file_order_cart_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartResp) ProtoMessage() {}
+
+func (x *MergeCartResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_cart_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartResp.ProtoReflect.Descriptor instead.
+func (*MergeCartResp) Descriptor() ([]byte, []int) {
+	return file_order_cart_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MergeCartResp) GetMergedItems() int32 {
+	if x != nil {
+		return x.MergedItems
+	}
+	return 0
+}
+
+func (x *MergeCartResp) GetCappedItems() int32 {
+	if x != nil {
+		return x.CappedItems
+	}
+	return 0
+}
+
 var File_order_cart_cart_proto protoreflect.FileDescriptor
 
 const file_order_cart_cart_proto_rawDesc = "" +
 	"\n" +
 	"\x15order/cart/cart.proto\x12\n" +
-	"order.cart\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd1\x03\n" +
+	"order.cart\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe7\x04\n" +
 	"\bCartItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -886,7 +1024,13 @@ const file_order_cart_cart_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa1\x01\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1f\n" +
+	"\vadded_price\x18\x0f \x01(\tR\n" +
+	"addedPrice\x12\x1f\n" +
+	"\vprice_delta\x18\x10 \x01(\tR\n" +
+	"priceDelta\x12#\n" +
+	"\rprice_changed\x18\x11 \x01(\bR\fpriceChanged\x12-\n" +
+	"\x12unavailable_reason\x18\x12 \x01(\tR\x11unavailableReason\"\xa1\x01\n" +
 	"\vCartSummary\x12\x1f\n" +
 	"\vtotal_items\x18\x01 \x01(\x05R\n" +
 	"totalItems\x12%\n" +
@@ -914,10 +1058,11 @@ const file_order_cart_cart_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"%\n" +
 	"\n" +
 	"GetCartReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"l\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8a\x01\n" +
 	"\vGetCartResp\x12*\n" +
 	"\x05items\x18\x01 \x03(\v2\x14.order.cart.CartItemR\x05items\x121\n" +
-	"\asummary\x18\x02 \x01(\v2\x17.order.cart.CartSummaryR\asummary\"'\n" +
+	"\asummary\x18\x02 \x01(\v2\x17.order.cart.CartSummaryR\asummary\x12\x1c\n" +
+	"\trefreshed\x18\x03 \x01(\bR\trefreshed\"'\n" +
 	"\fClearCartReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\")\n" +
 	"\rClearCartResp\x12\x18\n" +
@@ -928,14 +1073,22 @@ const file_order_cart_cart_proto_rawDesc = "" +
 	"\bselected\x18\x03 \x01(\bR\bselected\"b\n" +
 	"\x13SelectCartItemsResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x121\n" +
-	"\asummary\x18\x02 \x01(\v2\x17.order.cart.CartSummaryR\asummary2\x83\b\n" +
+	"\asummary\x18\x02 \x01(\v2\x17.order.cart.CartSummaryR\asummary\"-\n" +
+	"\fMergeCartReq\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\"U\n" +
+	"\rMergeCartResp\x12!\n" +
+	"\fmerged_items\x18\x01 \x01(\x05R\vmergedItems\x12!\n" +
+	"\fcapped_items\x18\x02 \x01(\x05R\vcappedItems2\xc8\n" +
+	"\n" +
 	"\vCartService\x12\xa5\x01\n" +
 	"\vAddCartItem\x12\x1a.order.cart.AddCartItemReq\x1a\x1b.order.cart.AddCartItemResp\"]\x92A=\x12\x18添加商品到购物车\x1a!将商品添加到用户购物车\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cart/items\x12\xb5\x01\n" +
 	"\x0eUpdateCartItem\x12\x1d.order.cart.UpdateCartItemReq\x1a\x1e.order.cart.UpdateCartItemResp\"d\x92A:\x12\x15更新购物车商品\x1a!更新购物车中商品的数量\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/cart/items/{item_id}\x12\xb2\x01\n" +
-	"\x0eRemoveCartItem\x12\x1d.order.cart.RemoveCartItemReq\x1a\x1e.order.cart.RemoveCartItemResp\"a\x92A:\x12\x15删除购物车商品\x1a!从购物车中删除指定商品\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/cart/items/{item_id}\x12\x8d\x01\n" +
-	"\aGetCart\x12\x16.order.cart.GetCartReq\x1a\x17.order.cart.GetCartResp\"Q\x92A:\x12\x0f获取购物车\x1a'获取用户购物车中的所有商品\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/cart\x12\x93\x01\n" +
+	"\x0eRemoveCartItem\x12\x1d.order.cart.RemoveCartItemReq\x1a\x1e.order.cart.RemoveCartItemResp\"a\x92A:\x12\x15删除购物车商品\x1a!从购物车中删除指定商品\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/cart/items/{item_id}\x12\xd7\x01\n" +
+	"\aGetCart\x12\x16.order.cart.GetCartReq\x1a\x17.order.cart.GetCartResp\"\x9a\x01\x92A\x82\x01\x12\x0f获取购物车\x1ao获取用户购物车中的所有商品，价格和库存实时刷新，不可购买的商品自动取消选中\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/cart\x12\x93\x01\n" +
 	"\tClearCart\x12\x18.order.cart.ClearCartReq\x1a\x19.order.cart.ClearCartResp\"Q\x92A:\x12\x0f清空购物车\x1a'清空用户购物车中的所有商品\x82\xd3\xe4\x93\x02\x0e*\f/api/v1/cart\x12\xb8\x01\n" +
-	"\x0fSelectCartItems\x12\x1e.order.cart.SelectCartItemsReq\x1a\x1f.order.cart.SelectCartItemsResp\"d\x92AC\x12\x15选择购物车商品\x1a*选择或取消选择购物车中的商品\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/cart/selectBGZEgithub.com/people257/poor-guy-shop/order-service/gen/proto/order/cartb\x06proto3"
+	"\x0fSelectCartItems\x12\x1e.order.cart.SelectCartItemsReq\x1a\x1f.order.cart.SelectCartItemsResp\"d\x92AC\x12\x15选择购物车商品\x1a*选择或取消选择购物车中的商品\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/cart/select\x12\xf8\x01\n" +
+	"\tMergeCart\x12\x18.order.cart.MergeCartReq\x1a\x19.order.cart.MergeCartResp\"\xb5\x01\x92A\x94\x01\x12\x15合并游客购物车\x1a{登录后将游客购物车合并到用户购物车，相同商品数量相加，超过限购数量的按限购数量保留\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/cart/mergeBGZEgithub.com/people257/poor-guy-shop/order-service/gen/proto/order/cartb\x06proto3"
 
 var (
 	file_order_cart_cart_proto_rawDescOnce sync.Once
@@ -949,7 +1102,7 @@ func file_order_cart_cart_proto_rawDescGZIP() []byte {
 	return file_order_cart_cart_proto_rawDescData
 }
 
-var file_order_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_order_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_order_cart_cart_proto_goTypes = []any{
 	(*CartItem)(nil),              // 0: order.cart.CartItem
 	(*CartSummary)(nil),           // 1: order.cart.CartSummary
@@ -965,11 +1118,13 @@ var file_order_cart_cart_proto_goTypes = []any{
 	(*ClearCartResp)(nil),         // 11: order.cart.ClearCartResp
 	(*SelectCartItemsReq)(nil),    // 12: order.cart.SelectCartItemsReq
 	(*SelectCartItemsResp)(nil),   // 13: order.cart.SelectCartItemsResp
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*MergeCartReq)(nil),          // 14: order.cart.MergeCartReq
+	(*MergeCartResp)(nil),         // 15: order.cart.MergeCartResp
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_order_cart_cart_proto_depIdxs = []int32{
-	14, // 0: order.cart.CartItem.created_at:type_name -> google.protobuf.Timestamp
-	14, // 1: order.cart.CartItem.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 0: order.cart.CartItem.created_at:type_name -> google.protobuf.Timestamp
+	16, // 1: order.cart.CartItem.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: order.cart.AddCartItemResp.item:type_name -> order.cart.CartItem
 	0,  // 3: order.cart.UpdateCartItemResp.item:type_name -> order.cart.CartItem
 	0,  // 4: order.cart.GetCartResp.items:type_name -> order.cart.CartItem
@@ -981,14 +1136,16 @@ var file_order_cart_cart_proto_depIdxs = []int32{
 	8,  // 10: order.cart.CartService.GetCart:input_type -> order.cart.GetCartReq
 	10, // 11: order.cart.CartService.ClearCart:input_type -> order.cart.ClearCartReq
 	12, // 12: order.cart.CartService.SelectCartItems:input_type -> order.cart.SelectCartItemsReq
-	3,  // 13: order.cart.CartService.AddCartItem:output_type -> order.cart.AddCartItemResp
-	5,  // 14: order.cart.CartService.UpdateCartItem:output_type -> order.cart.UpdateCartItemResp
-	7,  // 15: order.cart.CartService.RemoveCartItem:output_type -> order.cart.RemoveCartItemResp
-	9,  // 16: order.cart.CartService.GetCart:output_type -> order.cart.GetCartResp
-	11, // 17: order.cart.CartService.ClearCart:output_type -> order.cart.ClearCartResp
-	13, // 18: order.cart.CartService.SelectCartItems:output_type -> order.cart.SelectCartItemsResp
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
+	14, // 13: order.cart.CartService.MergeCart:input_type -> order.cart.MergeCartReq
+	3,  // 14: order.cart.CartService.AddCartItem:output_type -> order.cart.AddCartItemResp
+	5,  // 15: order.cart.CartService.UpdateCartItem:output_type -> order.cart.UpdateCartItemResp
+	7,  // 16: order.cart.CartService.RemoveCartItem:output_type -> order.cart.RemoveCartItemResp
+	9,  // 17: order.cart.CartService.GetCart:output_type -> order.cart.GetCartResp
+	11, // 18: order.cart.CartService.ClearCart:output_type -> order.cart.ClearCartResp
+	13, // 19: order.cart.CartService.SelectCartItems:output_type -> order.cart.SelectCartItemsResp
+	15, // 20: order.cart.CartService.MergeCart:output_type -> order.cart.MergeCartResp
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -1005,7 +1162,7 @@ func file_order_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_cart_cart_proto_rawDesc), len(file_order_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
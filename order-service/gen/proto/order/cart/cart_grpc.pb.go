@@ -25,6 +25,7 @@ const (
 	CartService_GetCart_FullMethodName         = "/order.cart.CartService/GetCart"
 	CartService_ClearCart_FullMethodName       = "/order.cart.CartService/ClearCart"
 	CartService_SelectCartItems_FullMethodName = "/order.cart.CartService/SelectCartItems"
+	CartService_MergeCart_FullMethodName       = "/order.cart.CartService/MergeCart"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -45,6 +46,8 @@ type CartServiceClient interface {
 	ClearCart(ctx context.Context, in *ClearCartReq, opts ...grpc.CallOption) (*ClearCartResp, error)
 	// 选择/取消选择购物车商品
 	SelectCartItems(ctx context.Context, in *SelectCartItemsReq, opts ...grpc.CallOption) (*SelectCartItemsResp, error)
+	// 合并游客购物车
+	MergeCart(ctx context.Context, in *MergeCartReq, opts ...grpc.CallOption) (*MergeCartResp, error)
 }
 
 type cartServiceClient struct {
@@ -115,6 +118,16 @@ func (c *cartServiceClient) SelectCartItems(ctx context.Context, in *SelectCartI
 	return out, nil
 }
 
+func (c *cartServiceClient) MergeCart(ctx context.Context, in *MergeCartReq, opts ...grpc.CallOption) (*MergeCartResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCartResp)
+	err := c.cc.Invoke(ctx, CartService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations should embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -133,6 +146,8 @@ type CartServiceServer interface {
 	ClearCart(context.Context, *ClearCartReq) (*ClearCartResp, error)
 	// 选择/取消选择购物车商品
 	SelectCartItems(context.Context, *SelectCartItemsReq) (*SelectCartItemsResp, error)
+	// 合并游客购物车
+	MergeCart(context.Context, *MergeCartReq) (*MergeCartResp, error)
 }
 
 // UnimplementedCartServiceServer should be embedded to have
@@ -160,6 +175,9 @@ func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartReq)
 func (UnimplementedCartServiceServer) SelectCartItems(context.Context, *SelectCartItemsReq) (*SelectCartItemsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectCartItems not implemented")
 }
+func (UnimplementedCartServiceServer) MergeCart(context.Context, *MergeCartReq) (*MergeCartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCart not implemented")
+}
 func (UnimplementedCartServiceServer) testEmbeddedByValue() {}
 
 // UnsafeCartServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -288,6 +306,24 @@ func _CartService_SelectCartItems_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCart(ctx, req.(*MergeCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +355,10 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SelectCartItems",
 			Handler:    _CartService_SelectCartItems_Handler,
 		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _CartService_MergeCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/cart/cart.proto",
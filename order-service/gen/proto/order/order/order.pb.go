@@ -35,6 +35,7 @@ const (
 	OrderStatus_ORDER_STATUS_DELIVERED       OrderStatus = 4 // 已收货
 	OrderStatus_ORDER_STATUS_CANCELLED       OrderStatus = 5 // 已取消
 	OrderStatus_ORDER_STATUS_REFUNDED        OrderStatus = 6 // 已退款
+	OrderStatus_ORDER_STATUS_DEPOSIT_PAID    OrderStatus = 7 // 定金已付，待付尾款
 )
 
 // Enum value maps for OrderStatus.
@@ -47,6 +48,7 @@ var (
 		4: "ORDER_STATUS_DELIVERED",
 		5: "ORDER_STATUS_CANCELLED",
 		6: "ORDER_STATUS_REFUNDED",
+		7: "ORDER_STATUS_DEPOSIT_PAID",
 	}
 	OrderStatus_value = map[string]int32{
 		"ORDER_STATUS_UNKNOWN":         0,
@@ -56,6 +58,7 @@ var (
 		"ORDER_STATUS_DELIVERED":       4,
 		"ORDER_STATUS_CANCELLED":       5,
 		"ORDER_STATUS_REFUNDED":        6,
+		"ORDER_STATUS_DEPOSIT_PAID":    7,
 	}
 )
 
@@ -139,31 +142,510 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_order_order_order_proto_rawDescGZIP(), []int{1}
 }
 
+// 订单时间线事件类型
+type TimelineEventType int32
+
+const (
+	TimelineEventType_TIMELINE_EVENT_TYPE_UNKNOWN         TimelineEventType = 0
+	TimelineEventType_TIMELINE_EVENT_TYPE_STATUS_CHANGED  TimelineEventType = 1 // 状态变更
+	TimelineEventType_TIMELINE_EVENT_TYPE_PAID            TimelineEventType = 2 // 支付成功
+	TimelineEventType_TIMELINE_EVENT_TYPE_SHIPPED         TimelineEventType = 3 // 包裹发出
+	TimelineEventType_TIMELINE_EVENT_TYPE_ADDRESS_CHANGED TimelineEventType = 4 // 修改收货地址
+)
+
+// Enum value maps for TimelineEventType.
+var (
+	TimelineEventType_name = map[int32]string{
+		0: "TIMELINE_EVENT_TYPE_UNKNOWN",
+		1: "TIMELINE_EVENT_TYPE_STATUS_CHANGED",
+		2: "TIMELINE_EVENT_TYPE_PAID",
+		3: "TIMELINE_EVENT_TYPE_SHIPPED",
+		4: "TIMELINE_EVENT_TYPE_ADDRESS_CHANGED",
+	}
+	TimelineEventType_value = map[string]int32{
+		"TIMELINE_EVENT_TYPE_UNKNOWN":         0,
+		"TIMELINE_EVENT_TYPE_STATUS_CHANGED":  1,
+		"TIMELINE_EVENT_TYPE_PAID":            2,
+		"TIMELINE_EVENT_TYPE_SHIPPED":         3,
+		"TIMELINE_EVENT_TYPE_ADDRESS_CHANGED": 4,
+	}
+)
+
+func (x TimelineEventType) Enum() *TimelineEventType {
+	p := new(TimelineEventType)
+	*p = x
+	return p
+}
+
+func (x TimelineEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[2].Descriptor()
+}
+
+func (TimelineEventType) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[2]
+}
+
+func (x TimelineEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEventType.Descriptor instead.
+func (TimelineEventType) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{2}
+}
+
+// 统计范围
+type StatsScope int32
+
+const (
+	StatsScope_STATS_SCOPE_UNKNOWN StatsScope = 0 // 按当前用户统计
+	StatsScope_STATS_SCOPE_USER    StatsScope = 1 // 当前用户的订单
+	StatsScope_STATS_SCOPE_GLOBAL  StatsScope = 2 // 全部用户的订单，供运营看板使用；仅运营人员可用
+)
+
+// Enum value maps for StatsScope.
+var (
+	StatsScope_name = map[int32]string{
+		0: "STATS_SCOPE_UNKNOWN",
+		1: "STATS_SCOPE_USER",
+		2: "STATS_SCOPE_GLOBAL",
+	}
+	StatsScope_value = map[string]int32{
+		"STATS_SCOPE_UNKNOWN": 0,
+		"STATS_SCOPE_USER":    1,
+		"STATS_SCOPE_GLOBAL":  2,
+	}
+)
+
+func (x StatsScope) Enum() *StatsScope {
+	p := new(StatsScope)
+	*p = x
+	return p
+}
+
+func (x StatsScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[3].Descriptor()
+}
+
+func (StatsScope) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[3]
+}
+
+func (x StatsScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsScope.Descriptor instead.
+func (StatsScope) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{3}
+}
+
+// 金额汇总周期
+type StatsGranularity int32
+
+const (
+	StatsGranularity_STATS_GRANULARITY_UNKNOWN StatsGranularity = 0 // 按天汇总
+	StatsGranularity_STATS_GRANULARITY_DAY     StatsGranularity = 1
+	StatsGranularity_STATS_GRANULARITY_WEEK    StatsGranularity = 2 // 自然周，从周一开始
+	StatsGranularity_STATS_GRANULARITY_MONTH   StatsGranularity = 3
+)
+
+// Enum value maps for StatsGranularity.
+var (
+	StatsGranularity_name = map[int32]string{
+		0: "STATS_GRANULARITY_UNKNOWN",
+		1: "STATS_GRANULARITY_DAY",
+		2: "STATS_GRANULARITY_WEEK",
+		3: "STATS_GRANULARITY_MONTH",
+	}
+	StatsGranularity_value = map[string]int32{
+		"STATS_GRANULARITY_UNKNOWN": 0,
+		"STATS_GRANULARITY_DAY":     1,
+		"STATS_GRANULARITY_WEEK":    2,
+		"STATS_GRANULARITY_MONTH":   3,
+	}
+)
+
+func (x StatsGranularity) Enum() *StatsGranularity {
+	p := new(StatsGranularity)
+	*p = x
+	return p
+}
+
+func (x StatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[4].Descriptor()
+}
+
+func (StatsGranularity) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[4]
+}
+
+func (x StatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGranularity.Descriptor instead.
+func (StatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+// 优惠券类型
+type CouponType int32
+
+const (
+	CouponType_COUPON_TYPE_UNKNOWN       CouponType = 0
+	CouponType_COUPON_TYPE_FIXED         CouponType = 1 // 立减券
+	CouponType_COUPON_TYPE_PERCENT       CouponType = 2 // 折扣券
+	CouponType_COUPON_TYPE_SPEND_AND_GET CouponType = 3 // 满减券
+)
+
+// Enum value maps for CouponType.
+var (
+	CouponType_name = map[int32]string{
+		0: "COUPON_TYPE_UNKNOWN",
+		1: "COUPON_TYPE_FIXED",
+		2: "COUPON_TYPE_PERCENT",
+		3: "COUPON_TYPE_SPEND_AND_GET",
+	}
+	CouponType_value = map[string]int32{
+		"COUPON_TYPE_UNKNOWN":       0,
+		"COUPON_TYPE_FIXED":         1,
+		"COUPON_TYPE_PERCENT":       2,
+		"COUPON_TYPE_SPEND_AND_GET": 3,
+	}
+)
+
+func (x CouponType) Enum() *CouponType {
+	p := new(CouponType)
+	*p = x
+	return p
+}
+
+func (x CouponType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[5].Descriptor()
+}
+
+func (CouponType) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[5]
+}
+
+func (x CouponType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponType.Descriptor instead.
+func (CouponType) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{5}
+}
+
+// 优惠券适用范围
+type CouponScopeType int32
+
+const (
+	CouponScopeType_COUPON_SCOPE_ALL      CouponScopeType = 0 // 全部商品
+	CouponScopeType_COUPON_SCOPE_CATEGORY CouponScopeType = 1 // 指定分类
+	CouponScopeType_COUPON_SCOPE_BRAND    CouponScopeType = 2 // 指定品牌
+	CouponScopeType_COUPON_SCOPE_SKU      CouponScopeType = 3 // 指定SKU
+)
+
+// Enum value maps for CouponScopeType.
+var (
+	CouponScopeType_name = map[int32]string{
+		0: "COUPON_SCOPE_ALL",
+		1: "COUPON_SCOPE_CATEGORY",
+		2: "COUPON_SCOPE_BRAND",
+		3: "COUPON_SCOPE_SKU",
+	}
+	CouponScopeType_value = map[string]int32{
+		"COUPON_SCOPE_ALL":      0,
+		"COUPON_SCOPE_CATEGORY": 1,
+		"COUPON_SCOPE_BRAND":    2,
+		"COUPON_SCOPE_SKU":      3,
+	}
+)
+
+func (x CouponScopeType) Enum() *CouponScopeType {
+	p := new(CouponScopeType)
+	*p = x
+	return p
+}
+
+func (x CouponScopeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponScopeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[6].Descriptor()
+}
+
+func (CouponScopeType) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[6]
+}
+
+func (x CouponScopeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponScopeType.Descriptor instead.
+func (CouponScopeType) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+// 用户优惠券状态
+type CouponStatus int32
+
+const (
+	CouponStatus_COUPON_STATUS_UNKNOWN   CouponStatus = 0
+	CouponStatus_COUPON_STATUS_AVAILABLE CouponStatus = 1 // 可使用
+	CouponStatus_COUPON_STATUS_LOCKED    CouponStatus = 2 // 已锁定：下单未支付，订单取消后退回
+	CouponStatus_COUPON_STATUS_USED      CouponStatus = 3 // 已使用
+)
+
+// Enum value maps for CouponStatus.
+var (
+	CouponStatus_name = map[int32]string{
+		0: "COUPON_STATUS_UNKNOWN",
+		1: "COUPON_STATUS_AVAILABLE",
+		2: "COUPON_STATUS_LOCKED",
+		3: "COUPON_STATUS_USED",
+	}
+	CouponStatus_value = map[string]int32{
+		"COUPON_STATUS_UNKNOWN":   0,
+		"COUPON_STATUS_AVAILABLE": 1,
+		"COUPON_STATUS_LOCKED":    2,
+		"COUPON_STATUS_USED":      3,
+	}
+)
+
+func (x CouponStatus) Enum() *CouponStatus {
+	p := new(CouponStatus)
+	*p = x
+	return p
+}
+
+func (x CouponStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[7].Descriptor()
+}
+
+func (CouponStatus) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[7]
+}
+
+func (x CouponStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponStatus.Descriptor instead.
+func (CouponStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+// 抢购记录状态
+type FlashSaleTicketStatus int32
+
+const (
+	FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_UNKNOWN  FlashSaleTicketStatus = 0
+	FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_QUEUED   FlashSaleTicketStatus = 1 // 排队中
+	FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_CREATED  FlashSaleTicketStatus = 2 // 已创建订单
+	FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_FAILED   FlashSaleTicketStatus = 3 // 创建订单失败
+	FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_RELEASED FlashSaleTicketStatus = 4 // 订单已取消
+)
+
+// Enum value maps for FlashSaleTicketStatus.
+var (
+	FlashSaleTicketStatus_name = map[int32]string{
+		0: "FLASH_SALE_TICKET_STATUS_UNKNOWN",
+		1: "FLASH_SALE_TICKET_STATUS_QUEUED",
+		2: "FLASH_SALE_TICKET_STATUS_CREATED",
+		3: "FLASH_SALE_TICKET_STATUS_FAILED",
+		4: "FLASH_SALE_TICKET_STATUS_RELEASED",
+	}
+	FlashSaleTicketStatus_value = map[string]int32{
+		"FLASH_SALE_TICKET_STATUS_UNKNOWN":  0,
+		"FLASH_SALE_TICKET_STATUS_QUEUED":   1,
+		"FLASH_SALE_TICKET_STATUS_CREATED":  2,
+		"FLASH_SALE_TICKET_STATUS_FAILED":   3,
+		"FLASH_SALE_TICKET_STATUS_RELEASED": 4,
+	}
+)
+
+func (x FlashSaleTicketStatus) Enum() *FlashSaleTicketStatus {
+	p := new(FlashSaleTicketStatus)
+	*p = x
+	return p
+}
+
+func (x FlashSaleTicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlashSaleTicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[8].Descriptor()
+}
+
+func (FlashSaleTicketStatus) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[8]
+}
+
+func (x FlashSaleTicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlashSaleTicketStatus.Descriptor instead.
+func (FlashSaleTicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+// 支付阶段
+type PaymentStage int32
+
+const (
+	PaymentStage_PAYMENT_STAGE_FULL    PaymentStage = 0 // 全款
+	PaymentStage_PAYMENT_STAGE_DEPOSIT PaymentStage = 1 // 定金
+	PaymentStage_PAYMENT_STAGE_BALANCE PaymentStage = 2 // 尾款
+)
+
+// Enum value maps for PaymentStage.
+var (
+	PaymentStage_name = map[int32]string{
+		0: "PAYMENT_STAGE_FULL",
+		1: "PAYMENT_STAGE_DEPOSIT",
+		2: "PAYMENT_STAGE_BALANCE",
+	}
+	PaymentStage_value = map[string]int32{
+		"PAYMENT_STAGE_FULL":    0,
+		"PAYMENT_STAGE_DEPOSIT": 1,
+		"PAYMENT_STAGE_BALANCE": 2,
+	}
+)
+
+func (x PaymentStage) Enum() *PaymentStage {
+	p := new(PaymentStage)
+	*p = x
+	return p
+}
+
+func (x PaymentStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[9].Descriptor()
+}
+
+func (PaymentStage) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[9]
+}
+
+func (x PaymentStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStage.Descriptor instead.
+func (PaymentStage) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+// 定金规则
+type DepositPolicy int32
+
+const (
+	DepositPolicy_DEPOSIT_POLICY_UNKNOWN DepositPolicy = 0
+	DepositPolicy_DEPOSIT_POLICY_FORFEIT DepositPolicy = 1 // 买家取消或超时未付尾款时定金不退，运营取消时退还
+	DepositPolicy_DEPOSIT_POLICY_REFUND  DepositPolicy = 2 // 订单取消时退还定金
+)
+
+// Enum value maps for DepositPolicy.
+var (
+	DepositPolicy_name = map[int32]string{
+		0: "DEPOSIT_POLICY_UNKNOWN",
+		1: "DEPOSIT_POLICY_FORFEIT",
+		2: "DEPOSIT_POLICY_REFUND",
+	}
+	DepositPolicy_value = map[string]int32{
+		"DEPOSIT_POLICY_UNKNOWN": 0,
+		"DEPOSIT_POLICY_FORFEIT": 1,
+		"DEPOSIT_POLICY_REFUND":  2,
+	}
+)
+
+func (x DepositPolicy) Enum() *DepositPolicy {
+	p := new(DepositPolicy)
+	*p = x
+	return p
+}
+
+func (x DepositPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_order_order_order_proto_enumTypes[10].Descriptor()
+}
+
+func (DepositPolicy) Type() protoreflect.EnumType {
+	return &file_order_order_order_proto_enumTypes[10]
+}
+
+func (x DepositPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositPolicy.Descriptor instead.
+func (DepositPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{10}
+}
+
 // 订单信息
 type Order struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderNo        string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status         OrderStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=order.order.OrderStatus" json:"status,omitempty"`
-	TotalAmount    string                 `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`          // 使用string存储decimal
-	DiscountAmount string                 `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 优惠金额
-	ShippingFee    string                 `protobuf:"bytes,7,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`          // 运费
-	ActualAmount   string                 `protobuf:"bytes,8,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`       // 实付金额
-	PaymentMethod  PaymentMethod          `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=order.order.PaymentMethod" json:"payment_method,omitempty"`
-	PaymentStatus  int32                  `protobuf:"varint,10,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
-	PaymentTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=payment_time,json=paymentTime,proto3" json:"payment_time,omitempty"`
-	DeliveryTime   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
-	ReceiveTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
-	CancelTime     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=cancel_time,json=cancelTime,proto3" json:"cancel_time,omitempty"`
-	CancelReason   string                 `protobuf:"bytes,15,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
-	Remark         string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Items          []*OrderItem           `protobuf:"bytes,19,rep,name=items,proto3" json:"items,omitempty"`
-	Address        *OrderAddress          `protobuf:"bytes,20,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderNo             string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	UserId              string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status              OrderStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=order.order.OrderStatus" json:"status,omitempty"`
+	TotalAmount         string                 `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`          // 使用string存储decimal
+	DiscountAmount      string                 `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 优惠金额
+	ShippingFee         string                 `protobuf:"bytes,7,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`          // 运费
+	ActualAmount        string                 `protobuf:"bytes,8,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`       // 实付金额
+	PaymentMethod       PaymentMethod          `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=order.order.PaymentMethod" json:"payment_method,omitempty"`
+	PaymentStatus       int32                  `protobuf:"varint,10,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	PaymentTime         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=payment_time,json=paymentTime,proto3" json:"payment_time,omitempty"`
+	DeliveryTime        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
+	ReceiveTime         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
+	CancelTime          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=cancel_time,json=cancelTime,proto3" json:"cancel_time,omitempty"`
+	CancelReason        string                 `protobuf:"bytes,15,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	Remark              string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items               []*OrderItem           `protobuf:"bytes,19,rep,name=items,proto3" json:"items,omitempty"`
+	Address             *OrderAddress          `protobuf:"bytes,20,opt,name=address,proto3" json:"address,omitempty"`
+	PaymentDeadline     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=payment_deadline,json=paymentDeadline,proto3" json:"payment_deadline,omitempty"`               // 支付截止时间，超时未支付自动取消
+	AutoConfirmDeadline *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=auto_confirm_deadline,json=autoConfirmDeadline,proto3" json:"auto_confirm_deadline,omitempty"` // 自动确认收货时间，仅已发货订单返回
+	ReceiveExtended     bool                   `protobuf:"varint,23,opt,name=receive_extended,json=receiveExtended,proto3" json:"receive_extended,omitempty"`              // 买家是否已延长收货
+	PresaleId           string                 `protobuf:"bytes,24,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`                                 // 预售活动ID，仅预售订单返回
+	PaymentStages       []*OrderPaymentStage   `protobuf:"bytes,25,rep,name=payment_stages,json=paymentStages,proto3" json:"payment_stages,omitempty"`                     // 支付阶段，仅预售订单返回
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -336,23 +818,58 @@ func (x *Order) GetAddress() *OrderAddress {
 	return nil
 }
 
-// 订单商品信息
-type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	SkuId         string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductImage  string                 `protobuf:"bytes,5,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"`
-	SkuName       string                 `protobuf:"bytes,6,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
-	Price         string                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"` // 使用string存储decimal
-	Quantity      int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	TotalAmount   string                 `protobuf:"bytes,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // 小计金额
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Order) GetPaymentDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDeadline
+	}
+	return nil
 }
 
-func (x *OrderItem) Reset() {
+func (x *Order) GetAutoConfirmDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AutoConfirmDeadline
+	}
+	return nil
+}
+
+func (x *Order) GetReceiveExtended() bool {
+	if x != nil {
+		return x.ReceiveExtended
+	}
+	return false
+}
+
+func (x *Order) GetPresaleId() string {
+	if x != nil {
+		return x.PresaleId
+	}
+	return ""
+}
+
+func (x *Order) GetPaymentStages() []*OrderPaymentStage {
+	if x != nil {
+		return x.PaymentStages
+	}
+	return nil
+}
+
+// 订单商品信息
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductImage  string                 `protobuf:"bytes,5,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"`
+	SkuName       string                 `protobuf:"bytes,6,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	Price         string                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"` // 使用string存储decimal
+	Quantity      int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // 小计金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
 	*x = OrderItem{}
 	mi := &file_order_order_order_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,99 +899,4962 @@ func (*OrderItem) Descriptor() ([]byte, []int) {
 	return file_order_order_order_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *OrderItem) GetId() string {
+func (x *OrderItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetProductImage() string {
+	if x != nil {
+		return x.ProductImage
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+// 订单收货地址
+type OrderAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceiverName  string                 `protobuf:"bytes,2,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
+	ReceiverPhone string                 `protobuf:"bytes,3,opt,name=receiver_phone,json=receiverPhone,proto3" json:"receiver_phone,omitempty"`
+	Province      string                 `protobuf:"bytes,4,opt,name=province,proto3" json:"province,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	District      string                 `protobuf:"bytes,6,opt,name=district,proto3" json:"district,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAddress) Reset() {
+	*x = OrderAddress{}
+	mi := &file_order_order_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAddress) ProtoMessage() {}
+
+func (x *OrderAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAddress.ProtoReflect.Descriptor instead.
+func (*OrderAddress) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderAddress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetReceiverPhone() string {
+	if x != nil {
+		return x.ReceiverPhone
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OrderAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+// 创建订单请求
+type CreateOrderReq struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Items  []*OrderItemReq        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                 // 订单商品项
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	Address       *OrderAddressReq `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                  // 已废弃：手工填写的收货地址，请改用 address_id
+	PaymentMethod string           `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"` // 支付方式
+	Remark        string           `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                                    // 订单备注
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	DiscountAmount string `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 已废弃：优惠金额由服务端计算
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	ShippingFee    string   `protobuf:"bytes,7,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`           // 已废弃：运费由服务端计算
+	ExpectedAmount string   `protobuf:"bytes,8,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`  // 客户端预期实付金额，非空时与服务端计算结果不一致则拒绝下单
+	QuoteToken     string   `protobuf:"bytes,9,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`              // QuoteOrder 返回的报价令牌，有效期内按报价金额和报价中的优惠券下单
+	IdempotencyKey string   `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客户端幂等键，有效期内相同请求的重放返回首次创建的订单
+	CouponIds      []string `protobuf:"bytes,11,rep,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`                // 使用的优惠券，为空时自动选择优惠最大的组合；携带报价令牌时忽略
+	SkipCoupons    bool     `protobuf:"varint,12,opt,name=skip_coupons,json=skipCoupons,proto3" json:"skip_coupons,omitempty"`         // 不使用优惠券
+	AddressId      string   `protobuf:"bytes,13,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`                // 用户服务中的收货地址ID，下单时保存地址快照；非空时忽略 address
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOrderReq) Reset() {
+	*x = CreateOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderReq) ProtoMessage() {}
+
+func (x *CreateOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderReq.ProtoReflect.Descriptor instead.
+func (*CreateOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetItems() []*OrderItemReq {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *CreateOrderReq) GetAddress() *OrderAddressReq {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateOrderReq) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *CreateOrderReq) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *CreateOrderReq) GetShippingFee() string {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetExpectedAmount() string {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateOrderReq) GetCouponIds() []string {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *CreateOrderReq) GetSkipCoupons() bool {
+	if x != nil {
+		return x.SkipCoupons
+	}
+	return false
+}
+
+func (x *CreateOrderReq) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+// 订单商品项请求
+type OrderItemReq struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // 商品ID
+	SkuId     string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`             // SKU ID
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // 数量
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"` // 已废弃：以商品服务当前售价为准
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	ProductName string `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 已废弃：以商品服务为准
+	// Deprecated: Marked as deprecated in order/order/order.proto.
+	SkuName       string `protobuf:"bytes,6,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"` // 已废弃：以商品服务为准
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemReq) Reset() {
+	*x = OrderItemReq{}
+	mi := &file_order_order_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemReq) ProtoMessage() {}
+
+func (x *OrderItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemReq.ProtoReflect.Descriptor instead.
+func (*OrderItemReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderItemReq) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderItemReq) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *OrderItemReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *OrderItemReq) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *OrderItemReq) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order/order.proto.
+func (x *OrderItemReq) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+// 订单地址请求
+type OrderAddressReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiverName  string                 `protobuf:"bytes,1,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`    // 收货人姓名
+	ReceiverPhone string                 `protobuf:"bytes,2,opt,name=receiver_phone,json=receiverPhone,proto3" json:"receiver_phone,omitempty"` // 收货人电话
+	Province      string                 `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`                                // 省份
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`                                        // 城市
+	District      string                 `protobuf:"bytes,5,opt,name=district,proto3" json:"district,omitempty"`                                // 区县
+	DetailAddress string                 `protobuf:"bytes,6,opt,name=detail_address,json=detailAddress,proto3" json:"detail_address,omitempty"` // 详细地址
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`          // 邮政编码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAddressReq) Reset() {
+	*x = OrderAddressReq{}
+	mi := &file_order_order_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAddressReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAddressReq) ProtoMessage() {}
+
+func (x *OrderAddressReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAddressReq.ProtoReflect.Descriptor instead.
+func (*OrderAddressReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderAddressReq) GetReceiverName() string {
+	if x != nil {
+		return x.ReceiverName
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetReceiverPhone() string {
+	if x != nil {
+		return x.ReceiverPhone
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetDetailAddress() string {
+	if x != nil {
+		return x.DetailAddress
+	}
+	return ""
+}
+
+func (x *OrderAddressReq) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+// 创建订单响应
+type CreateOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResp) Reset() {
+	*x = CreateOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResp) ProtoMessage() {}
+
+func (x *CreateOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResp.ProtoReflect.Descriptor instead.
+func (*CreateOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrderResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 下单报价请求
+type QuoteOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OrderItemReq        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                 // 订单商品项
+	Address       *OrderAddressReq       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`                             // 收货地址
+	CouponIds     []string               `protobuf:"bytes,3,rep,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`        // 使用的优惠券，为空时自动选择优惠最大的组合
+	SkipCoupons   bool                   `protobuf:"varint,4,opt,name=skip_coupons,json=skipCoupons,proto3" json:"skip_coupons,omitempty"` // 不使用优惠券
+	AddressId     string                 `protobuf:"bytes,5,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`        // 用户服务中的收货地址ID，非空时忽略 address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteOrderReq) Reset() {
+	*x = QuoteOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderReq) ProtoMessage() {}
+
+func (x *QuoteOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderReq.ProtoReflect.Descriptor instead.
+func (*QuoteOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuoteOrderReq) GetItems() []*OrderItemReq {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QuoteOrderReq) GetAddress() *OrderAddressReq {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *QuoteOrderReq) GetCouponIds() []string {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *QuoteOrderReq) GetSkipCoupons() bool {
+	if x != nil {
+		return x.SkipCoupons
+	}
+	return false
+}
+
+func (x *QuoteOrderReq) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+// 报价商品项
+type QuoteItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	SkuName       string                 `protobuf:"bytes,4,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`
+	ProductImage  string                 `protobuf:"bytes,5,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"`
+	Price         string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"` // 单价
+	Quantity      int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Subtotal      string                 `protobuf:"bytes,8,opt,name=subtotal,proto3" json:"subtotal,omitempty"` // 小计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteItem) Reset() {
+	*x = QuoteItem{}
+	mi := &file_order_order_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteItem) ProtoMessage() {}
+
+func (x *QuoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteItem.ProtoReflect.Descriptor instead.
+func (*QuoteItem) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuoteItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetProductImage() string {
+	if x != nil {
+		return x.ProductImage
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteItem) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+// 不可购买的商品项
+type UnavailableItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // 不可购买原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnavailableItem) Reset() {
+	*x = UnavailableItem{}
+	mi := &file_order_order_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnavailableItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnavailableItem) ProtoMessage() {}
+
+func (x *UnavailableItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnavailableItem.ProtoReflect.Descriptor instead.
+func (*UnavailableItem) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnavailableItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnavailableItem) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *UnavailableItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 下单报价响应
+type QuoteOrderResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Items            []*QuoteItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                               // 可购买的商品项
+	UnavailableItems []*UnavailableItem     `protobuf:"bytes,2,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"` // 不可购买的商品项
+	TotalAmount      string                 `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                // 商品总额
+	DiscountAmount   string                 `protobuf:"bytes,4,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`       // 优惠金额
+	ShippingFee      string                 `protobuf:"bytes,5,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`                // 运费
+	PayableAmount    string                 `protobuf:"bytes,6,opt,name=payable_amount,json=payableAmount,proto3" json:"payable_amount,omitempty"`          // 应付金额
+	QuoteToken       string                 `protobuf:"bytes,7,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`                   // 报价令牌，存在不可购买商品时为空
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                      // 报价令牌过期时间
+	Coupons          []*AppliedCoupon       `protobuf:"bytes,9,rep,name=coupons,proto3" json:"coupons,omitempty"`                                           // 使用的优惠券
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuoteOrderResp) Reset() {
+	*x = QuoteOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderResp) ProtoMessage() {}
+
+func (x *QuoteOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderResp.ProtoReflect.Descriptor instead.
+func (*QuoteOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QuoteOrderResp) GetItems() []*QuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QuoteOrderResp) GetUnavailableItems() []*UnavailableItem {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+func (x *QuoteOrderResp) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *QuoteOrderResp) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *QuoteOrderResp) GetShippingFee() string {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return ""
+}
+
+func (x *QuoteOrderResp) GetPayableAmount() string {
+	if x != nil {
+		return x.PayableAmount
+	}
+	return ""
+}
+
+func (x *QuoteOrderResp) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *QuoteOrderResp) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *QuoteOrderResp) GetCoupons() []*AppliedCoupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+// 订单使用的优惠券
+type AppliedCoupon struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CouponId       string                 `protobuf:"bytes,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountAmount string                 `protobuf:"bytes,3,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 该券的优惠金额
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AppliedCoupon) Reset() {
+	*x = AppliedCoupon{}
+	mi := &file_order_order_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedCoupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedCoupon) ProtoMessage() {}
+
+func (x *AppliedCoupon) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedCoupon.ProtoReflect.Descriptor instead.
+func (*AppliedCoupon) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AppliedCoupon) GetCouponId() string {
+	if x != nil {
+		return x.CouponId
+	}
+	return ""
+}
+
+func (x *AppliedCoupon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedCoupon) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+// 购物车结算请求
+type CheckoutCartReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CartItemIds    []string               `protobuf:"bytes,1,rep,name=cart_item_ids,json=cartItemIds,proto3" json:"cart_item_ids,omitempty"`        // 结算的购物车项ID，为空时结算所有选中的商品
+	AddressId      string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`                // 用户服务中保存的收货地址ID
+	PaymentMethod  string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`    // 支付方式
+	Remark         string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                                       // 订单备注
+	ExpectedAmount string                 `protobuf:"bytes,5,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"` // 客户端预期实付金额，非空时与服务端计算结果不一致则拒绝下单
+	CouponIds      []string               `protobuf:"bytes,6,rep,name=coupon_ids,json=couponIds,proto3" json:"coupon_ids,omitempty"`                // 使用的优惠券，为空时自动选择优惠最大的组合
+	SkipCoupons    bool                   `protobuf:"varint,7,opt,name=skip_coupons,json=skipCoupons,proto3" json:"skip_coupons,omitempty"`         // 不使用优惠券
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckoutCartReq) Reset() {
+	*x = CheckoutCartReq{}
+	mi := &file_order_order_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartReq) ProtoMessage() {}
+
+func (x *CheckoutCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartReq.ProtoReflect.Descriptor instead.
+func (*CheckoutCartReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckoutCartReq) GetCartItemIds() []string {
+	if x != nil {
+		return x.CartItemIds
+	}
+	return nil
+}
+
+func (x *CheckoutCartReq) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *CheckoutCartReq) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CheckoutCartReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CheckoutCartReq) GetExpectedAmount() string {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return ""
+}
+
+func (x *CheckoutCartReq) GetCouponIds() []string {
+	if x != nil {
+		return x.CouponIds
+	}
+	return nil
+}
+
+func (x *CheckoutCartReq) GetSkipCoupons() bool {
+	if x != nil {
+		return x.SkipCoupons
+	}
+	return false
+}
+
+// 购物车结算响应
+type CheckoutCartResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutCartResp) Reset() {
+	*x = CheckoutCartResp{}
+	mi := &file_order_order_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartResp) ProtoMessage() {}
+
+func (x *CheckoutCartResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartResp.ProtoReflect.Descriptor instead.
+func (*CheckoutCartResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckoutCartResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 获取订单详情请求
+type GetOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReq) Reset() {
+	*x = GetOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReq) ProtoMessage() {}
+
+func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
+func (*GetOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 获取订单详情响应
+type GetOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResp) Reset() {
+	*x = GetOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResp) ProtoMessage() {}
+
+func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResp.ProtoReflect.Descriptor instead.
+func (*GetOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOrderResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 获取订单列表请求
+type ListOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersReq) Reset() {
+	*x = ListOrdersReq{}
+	mi := &file_order_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersReq) ProtoMessage() {}
+
+func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersReq.ProtoReflect.Descriptor instead.
+func (*ListOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListOrdersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListOrdersReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListOrdersReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 获取订单列表响应
+type ListOrdersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResp) Reset() {
+	*x = ListOrdersResp{}
+	mi := &file_order_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResp) ProtoMessage() {}
+
+func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResp.ProtoReflect.Descriptor instead.
+func (*ListOrdersResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOrdersResp) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOrdersResp) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrdersResp) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersResp) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// 取消订单请求
+type CancelOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderReq) Reset() {
+	*x = CancelOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderReq) ProtoMessage() {}
+
+func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderReq.ProtoReflect.Descriptor instead.
+func (*CancelOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelOrderReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 取消订单响应
+type CancelOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResp) Reset() {
+	*x = CancelOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResp) ProtoMessage() {}
+
+func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResp.ProtoReflect.Descriptor instead.
+func (*CancelOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelOrderResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 确认收货请求
+type ConfirmOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderReq) Reset() {
+	*x = ConfirmOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderReq) ProtoMessage() {}
+
+func (x *ConfirmOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderReq.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConfirmOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// 确认收货响应
+type ConfirmOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderResp) Reset() {
+	*x = ConfirmOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderResp) ProtoMessage() {}
+
+func (x *ConfirmOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderResp.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfirmOrderResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 延长收货请求
+type ExtendReceiveReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendReceiveReq) Reset() {
+	*x = ExtendReceiveReq{}
+	mi := &file_order_order_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendReceiveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendReceiveReq) ProtoMessage() {}
+
+func (x *ExtendReceiveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendReceiveReq.ProtoReflect.Descriptor instead.
+func (*ExtendReceiveReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExtendReceiveReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// 延长收货响应
+type ExtendReceiveResp struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AutoConfirmDeadline *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=auto_confirm_deadline,json=autoConfirmDeadline,proto3" json:"auto_confirm_deadline,omitempty"` // 延长后的自动确认收货时间
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExtendReceiveResp) Reset() {
+	*x = ExtendReceiveResp{}
+	mi := &file_order_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendReceiveResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendReceiveResp) ProtoMessage() {}
+
+func (x *ExtendReceiveResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendReceiveResp.ProtoReflect.Descriptor instead.
+func (*ExtendReceiveResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExtendReceiveResp) GetAutoConfirmDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AutoConfirmDeadline
+	}
+	return nil
+}
+
+// 支付订单请求
+type PayOrderReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentMethod  string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客户端幂等键，有效期内相同请求的重放返回首次支付的结果
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PayOrderReq) Reset() {
+	*x = PayOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderReq) ProtoMessage() {}
+
+func (x *PayOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderReq.ProtoReflect.Descriptor instead.
+func (*PayOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PayOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PayOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PayOrderReq) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PayOrderReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// 支付订单响应
+type PayOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PaymentNo     string                 `protobuf:"bytes,2,opt,name=payment_no,json=paymentNo,proto3" json:"payment_no,omitempty"`               // 支付流水号
+	ThirdPartyUrl string                 `protobuf:"bytes,3,opt,name=third_party_url,json=thirdPartyUrl,proto3" json:"third_party_url,omitempty"` // 第三方支付URL（如支付宝、微信）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayOrderResp) Reset() {
+	*x = PayOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderResp) ProtoMessage() {}
+
+func (x *PayOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderResp.ProtoReflect.Descriptor instead.
+func (*PayOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PayOrderResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PayOrderResp) GetPaymentNo() string {
+	if x != nil {
+		return x.PaymentNo
+	}
+	return ""
+}
+
+func (x *PayOrderResp) GetThirdPartyUrl() string {
+	if x != nil {
+		return x.ThirdPartyUrl
+	}
+	return ""
+}
+
+// 更新订单状态请求
+type UpdateOrderStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 用户ID
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                 // 新状态
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                  // 更新原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusReq) Reset() {
+	*x = UpdateOrderStatusReq{}
+	mi := &file_order_order_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusReq) ProtoMessage() {}
+
+func (x *UpdateOrderStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusReq.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateOrderStatusReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateOrderStatusReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 更新订单状态响应
+type UpdateOrderStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResp) Reset() {
+	*x = UpdateOrderStatusResp{}
+	mi := &file_order_order_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResp) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResp.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateOrderStatusResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 发货包裹
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CarrierCode    string                 `protobuf:"bytes,3,opt,name=carrier_code,json=carrierCode,proto3" json:"carrier_code,omitempty"`          // 承运商编码
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"` // 物流单号
+	Items          []*ShipmentItem        `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`                                         // 包裹中的商品
+	ShippedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`                // 发货时间
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_order_order_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Shipment) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 包裹商品项
+type ShipmentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"` // 订单商品项ID
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                           // 发货数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
+	mi := &file_order_order_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentItem) ProtoMessage() {}
+
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ShipmentItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *ShipmentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// 订单发货请求
+type ShipOrderReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CarrierCode    string                 `protobuf:"bytes,2,opt,name=carrier_code,json=carrierCode,proto3" json:"carrier_code,omitempty"`          // 承运商编码
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"` // 物流单号
+	Items          []*ShipmentItem        `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                                         // 本次发货的商品，为空时发出所有未发货的商品
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShipOrderReq) Reset() {
+	*x = ShipOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipOrderReq) ProtoMessage() {}
+
+func (x *ShipOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipOrderReq.ProtoReflect.Descriptor instead.
+func (*ShipOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ShipOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ShipOrderReq) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *ShipOrderReq) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *ShipOrderReq) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 订单发货响应
+type ShipOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *Shipment              `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	OrderStatus   OrderStatus            `protobuf:"varint,2,opt,name=order_status,json=orderStatus,proto3,enum=order.order.OrderStatus" json:"order_status,omitempty"` // 发货后的订单状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipOrderResp) Reset() {
+	*x = ShipOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipOrderResp) ProtoMessage() {}
+
+func (x *ShipOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipOrderResp.ProtoReflect.Descriptor instead.
+func (*ShipOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ShipOrderResp) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+func (x *ShipOrderResp) GetOrderStatus() OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+// 修改收货地址请求
+type ChangeOrderAddressReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Address       *OrderAddressReq       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // 新的收货地址
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeOrderAddressReq) Reset() {
+	*x = ChangeOrderAddressReq{}
+	mi := &file_order_order_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrderAddressReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrderAddressReq) ProtoMessage() {}
+
+func (x *ChangeOrderAddressReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrderAddressReq.ProtoReflect.Descriptor instead.
+func (*ChangeOrderAddressReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChangeOrderAddressReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ChangeOrderAddressReq) GetAddress() *OrderAddressReq {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// 修改收货地址响应
+type ChangeOrderAddressResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *OrderAddress          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeOrderAddressResp) Reset() {
+	*x = ChangeOrderAddressResp{}
+	mi := &file_order_order_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrderAddressResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrderAddressResp) ProtoMessage() {}
+
+func (x *ChangeOrderAddressResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrderAddressResp.ProtoReflect.Descriptor instead.
+func (*ChangeOrderAddressResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChangeOrderAddressResp) GetAddress() *OrderAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// 订单时间线事件
+type TimelineEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TimelineEventType      `protobuf:"varint,1,opt,name=type,proto3,enum=order.order.TimelineEventType" json:"type,omitempty"`
+	FromStatus    OrderStatus            `protobuf:"varint,2,opt,name=from_status,json=fromStatus,proto3,enum=order.order.OrderStatus" json:"from_status,omitempty"`            // 变更前状态，订单创建时为空
+	ToStatus      OrderStatus            `protobuf:"varint,3,opt,name=to_status,json=toStatus,proto3,enum=order.order.OrderStatus" json:"to_status,omitempty"`                  // 变更后状态
+	OperatorId    string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`                                          // 操作人ID，系统操作时为空
+	OperatorType  string                 `protobuf:"bytes,5,opt,name=operator_type,json=operatorType,proto3" json:"operator_type,omitempty"`                                    // 操作人类型：user、admin、system
+	Remark        string                 `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`                                                                    // 变更原因
+	PaymentMethod PaymentMethod          `protobuf:"varint,7,opt,name=payment_method,json=paymentMethod,proto3,enum=order.order.PaymentMethod" json:"payment_method,omitempty"` // 支付方式，支付事件
+	Amount        string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`                                                                    // 支付金额，支付事件
+	Shipment      *Shipment              `protobuf:"bytes,9,opt,name=shipment,proto3" json:"shipment,omitempty"`                                                                // 发出的包裹，发货事件
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                         // 发生时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_order_order_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TimelineEvent) GetType() TimelineEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEventType_TIMELINE_EVENT_TYPE_UNKNOWN
+}
+
+func (x *TimelineEvent) GetFromStatus() OrderStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+func (x *TimelineEvent) GetToStatus() OrderStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+func (x *TimelineEvent) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetOperatorType() string {
+	if x != nil {
+		return x.OperatorType
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNKNOWN
+}
+
+func (x *TimelineEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetShipment() *Shipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+func (x *TimelineEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// 获取订单时间线请求
+type GetOrderTimelineReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineReq) Reset() {
+	*x = GetOrderTimelineReq{}
+	mi := &file_order_order_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderTimelineReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineReq) ProtoMessage() {}
+
+func (x *GetOrderTimelineReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineReq.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetOrderTimelineReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// 获取订单时间线响应
+type GetOrderTimelineResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*TimelineEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineResp) Reset() {
+	*x = GetOrderTimelineResp{}
+	mi := &file_order_order_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderTimelineResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineResp) ProtoMessage() {}
+
+func (x *GetOrderTimelineResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineResp.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOrderTimelineResp) GetEvents() []*TimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// 订单搜索条件，未设置的字段不参与过滤，时间范围为左闭右开区间
+type OrderSearchFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Statuses      []OrderStatus          `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=order.order.OrderStatus" json:"statuses,omitempty"` // 订单状态，满足其一即可
+	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	MinAmount     string                 `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`       // 实付金额下限
+	MaxAmount     string                 `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`       // 实付金额上限
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // 下单时间起
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // 下单时间止
+	PaidFrom      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=paid_from,json=paidFrom,proto3" json:"paid_from,omitempty"`          // 支付时间起
+	PaidTo        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=paid_to,json=paidTo,proto3" json:"paid_to,omitempty"`               // 支付时间止
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderSearchFilter) Reset() {
+	*x = OrderSearchFilter{}
+	mi := &file_order_order_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSearchFilter) ProtoMessage() {}
+
+func (x *OrderSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSearchFilter.ProtoReflect.Descriptor instead.
+func (*OrderSearchFilter) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OrderSearchFilter) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *OrderSearchFilter) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OrderSearchFilter) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *OrderSearchFilter) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *OrderSearchFilter) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *OrderSearchFilter) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *OrderSearchFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *OrderSearchFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *OrderSearchFilter) GetPaidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidFrom
+	}
+	return nil
+}
+
+func (x *OrderSearchFilter) GetPaidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidTo
+	}
+	return nil
+}
+
+// 运营搜索订单请求
+type SearchOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *OrderSearchFilter     `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // 上一页返回的 next_cursor，为空时查询第一页
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 默认 20，最大 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersReq) Reset() {
+	*x = SearchOrdersReq{}
+	mi := &file_order_order_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersReq) ProtoMessage() {}
+
+func (x *SearchOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersReq.ProtoReflect.Descriptor instead.
+func (*SearchOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SearchOrdersReq) GetFilter() *OrderSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchOrdersReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchOrdersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 运营搜索订单响应
+type SearchOrdersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示没有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersResp) Reset() {
+	*x = SearchOrdersResp{}
+	mi := &file_order_order_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersResp) ProtoMessage() {}
+
+func (x *SearchOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersResp.ProtoReflect.Descriptor instead.
+func (*SearchOrdersResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchOrdersResp) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SearchOrdersResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 导出订单请求
+type ExportOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *OrderSearchFilter     `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersReq) Reset() {
+	*x = ExportOrdersReq{}
+	mi := &file_order_order_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersReq) ProtoMessage() {}
+
+func (x *ExportOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersReq.ProtoReflect.Descriptor instead.
+func (*ExportOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportOrdersReq) GetFilter() *OrderSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// 导出订单响应，按顺序拼接所有 chunk 得到完整的 CSV 文件
+type ExportOrdersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrdersResp) Reset() {
+	*x = ExportOrdersResp{}
+	mi := &file_order_order_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrdersResp) ProtoMessage() {}
+
+func (x *ExportOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrdersResp.ProtoReflect.Descriptor instead.
+func (*ExportOrdersResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportOrdersResp) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// 订单统计请求
+type GetOrderStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         StatsScope             `protobuf:"varint,1,opt,name=scope,proto3,enum=order.order.StatsScope" json:"scope,omitempty"`
+	Granularity   StatsGranularity       `protobuf:"varint,2,opt,name=granularity,proto3,enum=order.order.StatsGranularity" json:"granularity,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 默认为结束时间前 30 天，向前对齐到所在周期的开始
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 默认为当前时间，不含
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderStatsReq) Reset() {
+	*x = GetOrderStatsReq{}
+	mi := &file_order_order_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderStatsReq) ProtoMessage() {}
+
+func (x *GetOrderStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderStatsReq.ProtoReflect.Descriptor instead.
+func (*GetOrderStatsReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOrderStatsReq) GetScope() StatsScope {
+	if x != nil {
+		return x.Scope
+	}
+	return StatsScope_STATS_SCOPE_UNKNOWN
+}
+
+func (x *GetOrderStatsReq) GetGranularity() StatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return StatsGranularity_STATS_GRANULARITY_UNKNOWN
+}
+
+func (x *GetOrderStatsReq) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetOrderStatsReq) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// 各状态订单数
+type OrderStatusCounts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalOrders     int64                  `protobuf:"varint,1,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	PendingOrders   int64                  `protobuf:"varint,2,opt,name=pending_orders,json=pendingOrders,proto3" json:"pending_orders,omitempty"`
+	PaidOrders      int64                  `protobuf:"varint,3,opt,name=paid_orders,json=paidOrders,proto3" json:"paid_orders,omitempty"`
+	ShippedOrders   int64                  `protobuf:"varint,4,opt,name=shipped_orders,json=shippedOrders,proto3" json:"shipped_orders,omitempty"`
+	DeliveredOrders int64                  `protobuf:"varint,5,opt,name=delivered_orders,json=deliveredOrders,proto3" json:"delivered_orders,omitempty"`
+	CancelledOrders int64                  `protobuf:"varint,6,opt,name=cancelled_orders,json=cancelledOrders,proto3" json:"cancelled_orders,omitempty"`
+	RefundedOrders  int64                  `protobuf:"varint,7,opt,name=refunded_orders,json=refundedOrders,proto3" json:"refunded_orders,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderStatusCounts) Reset() {
+	*x = OrderStatusCounts{}
+	mi := &file_order_order_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusCounts) ProtoMessage() {}
+
+func (x *OrderStatusCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusCounts.ProtoReflect.Descriptor instead.
+func (*OrderStatusCounts) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OrderStatusCounts) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetPendingOrders() int64 {
+	if x != nil {
+		return x.PendingOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetPaidOrders() int64 {
+	if x != nil {
+		return x.PaidOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetShippedOrders() int64 {
+	if x != nil {
+		return x.ShippedOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetDeliveredOrders() int64 {
+	if x != nil {
+		return x.DeliveredOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetCancelledOrders() int64 {
+	if x != nil {
+		return x.CancelledOrders
+	}
+	return 0
+}
+
+func (x *OrderStatusCounts) GetRefundedOrders() int64 {
+	if x != nil {
+		return x.RefundedOrders
+	}
+	return 0
+}
+
+// 一个周期内的金额汇总
+type AmountBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                 // 周期起始日期，格式 YYYY-MM-DD
+	Gmv           string                 `protobuf:"bytes,2,opt,name=gmv,proto3" json:"gmv,omitempty"`                                       // 下单金额，含未支付和已取消的订单
+	PaidAmount    string                 `protobuf:"bytes,3,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`       // 支付金额，按支付时间统计
+	RefundAmount  string                 `protobuf:"bytes,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"` // 售后退款金额，按退款完成时间统计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmountBucket) Reset() {
+	*x = AmountBucket{}
+	mi := &file_order_order_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmountBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmountBucket) ProtoMessage() {}
+
+func (x *AmountBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmountBucket.ProtoReflect.Descriptor instead.
+func (*AmountBucket) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AmountBucket) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AmountBucket) GetGmv() string {
+	if x != nil {
+		return x.Gmv
+	}
+	return ""
+}
+
+func (x *AmountBucket) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *AmountBucket) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+// 订单统计响应
+type GetOrderStatsResp struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Counts            *OrderStatusCounts     `protobuf:"bytes,1,opt,name=counts,proto3" json:"counts,omitempty"` // 统计范围内创建的订单数
+	Buckets           []*AmountBucket        `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalGmv          string                 `protobuf:"bytes,3,opt,name=total_gmv,json=totalGmv,proto3" json:"total_gmv,omitempty"`
+	TotalPaidAmount   string                 `protobuf:"bytes,4,opt,name=total_paid_amount,json=totalPaidAmount,proto3" json:"total_paid_amount,omitempty"`
+	TotalRefundAmount string                 `protobuf:"bytes,5,opt,name=total_refund_amount,json=totalRefundAmount,proto3" json:"total_refund_amount,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 实际统计的起始时间
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetOrderStatsResp) Reset() {
+	*x = GetOrderStatsResp{}
+	mi := &file_order_order_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderStatsResp) ProtoMessage() {}
+
+func (x *GetOrderStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderStatsResp.ProtoReflect.Descriptor instead.
+func (*GetOrderStatsResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetOrderStatsResp) GetCounts() *OrderStatusCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetOrderStatsResp) GetBuckets() []*AmountBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetOrderStatsResp) GetTotalGmv() string {
+	if x != nil {
+		return x.TotalGmv
+	}
+	return ""
+}
+
+func (x *GetOrderStatsResp) GetTotalPaidAmount() string {
+	if x != nil {
+		return x.TotalPaidAmount
+	}
+	return ""
+}
+
+func (x *GetOrderStatsResp) GetTotalRefundAmount() string {
+	if x != nil {
+		return x.TotalRefundAmount
+	}
+	return ""
+}
+
+func (x *GetOrderStatsResp) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetOrderStatsResp) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// 限购规则
+type PurchaseLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MaxPerOrder   int32                  `protobuf:"varint,3,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"` // 每单限购数量，0表示不限
+	MaxPerUser    int32                  `protobuf:"varint,4,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`    // 每人在统计周期内的限购数量，0表示不限
+	WindowDays    int32                  `protobuf:"varint,5,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`      // 每人限购的统计周期（天），0表示不限时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseLimit) Reset() {
+	*x = PurchaseLimit{}
+	mi := &file_order_order_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseLimit) ProtoMessage() {}
+
+func (x *PurchaseLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseLimit.ProtoReflect.Descriptor instead.
+func (*PurchaseLimit) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PurchaseLimit) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *PurchaseLimit) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PurchaseLimit) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *PurchaseLimit) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *PurchaseLimit) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *PurchaseLimit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 设置限购规则请求
+type SetPurchaseLimitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MaxPerOrder   int32                  `protobuf:"varint,3,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	MaxPerUser    int32                  `protobuf:"varint,4,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,5,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitReq) Reset() {
+	*x = SetPurchaseLimitReq{}
+	mi := &file_order_order_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitReq) ProtoMessage() {}
+
+func (x *SetPurchaseLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitReq.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetPurchaseLimitReq) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+func (x *SetPurchaseLimitReq) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPurchaseLimitReq) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitReq) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitReq) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+// 设置限购规则响应
+type SetPurchaseLimitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *PurchaseLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitResp) Reset() {
+	*x = SetPurchaseLimitResp{}
+	mi := &file_order_order_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitResp) ProtoMessage() {}
+
+func (x *SetPurchaseLimitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitResp.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetPurchaseLimitResp) GetLimit() *PurchaseLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// 获取限购规则请求
+type GetPurchaseLimitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseLimitReq) Reset() {
+	*x = GetPurchaseLimitReq{}
+	mi := &file_order_order_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseLimitReq) ProtoMessage() {}
+
+func (x *GetPurchaseLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseLimitReq.ProtoReflect.Descriptor instead.
+func (*GetPurchaseLimitReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPurchaseLimitReq) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+// 获取限购规则响应
+type GetPurchaseLimitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *PurchaseLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseLimitResp) Reset() {
+	*x = GetPurchaseLimitResp{}
+	mi := &file_order_order_order_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseLimitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseLimitResp) ProtoMessage() {}
+
+func (x *GetPurchaseLimitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseLimitResp.ProtoReflect.Descriptor instead.
+func (*GetPurchaseLimitResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPurchaseLimitResp) GetLimit() *PurchaseLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// 删除限购规则请求
+type DeletePurchaseLimitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         string                 `protobuf:"bytes,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePurchaseLimitReq) Reset() {
+	*x = DeletePurchaseLimitReq{}
+	mi := &file_order_order_order_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePurchaseLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePurchaseLimitReq) ProtoMessage() {}
+
+func (x *DeletePurchaseLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePurchaseLimitReq.ProtoReflect.Descriptor instead.
+func (*DeletePurchaseLimitReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeletePurchaseLimitReq) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
+}
+
+// 删除限购规则响应
+type DeletePurchaseLimitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePurchaseLimitResp) Reset() {
+	*x = DeletePurchaseLimitResp{}
+	mi := &file_order_order_order_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePurchaseLimitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePurchaseLimitResp) ProtoMessage() {}
+
+func (x *DeletePurchaseLimitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePurchaseLimitResp.ProtoReflect.Descriptor instead.
+func (*DeletePurchaseLimitResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeletePurchaseLimitResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 优惠券模板
+type CouponTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           CouponType             `protobuf:"varint,3,opt,name=type,proto3,enum=order.order.CouponType" json:"type,omitempty"`
+	Amount         string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                              // 立减券、满减券的减免金额
+	PercentOff     int32                  `protobuf:"varint,5,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`   // 折扣券的减免比例（1-99），15表示85折
+	Threshold      string                 `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛，适用商品原价合计满该金额才可使用，0表示无门槛
+	MaxDiscount    string                 `protobuf:"bytes,7,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 单张券的最高优惠金额，0表示不限
+	Repeatable     bool                   `protobuf:"varint,8,opt,name=repeatable,proto3" json:"repeatable,omitempty"`                     // 满减券是否每满门槛金额减一次
+	ScopeType      CouponScopeType        `protobuf:"varint,9,opt,name=scope_type,json=scopeType,proto3,enum=order.order.CouponScopeType" json:"scope_type,omitempty"`
+	ScopeIds       []string               `protobuf:"bytes,10,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`                    // 适用的分类、品牌或SKU ID
+	Stackable      bool                   `protobuf:"varint,11,opt,name=stackable,proto3" json:"stackable,omitempty"`                                 // 能否与其他可叠加的优惠券同时使用
+	TotalQuantity  int32                  `protobuf:"varint,12,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`    // 发行总量，0表示不限
+	IssuedQuantity int32                  `protobuf:"varint,13,opt,name=issued_quantity,json=issuedQuantity,proto3" json:"issued_quantity,omitempty"` // 已领取数量
+	PerUserLimit   int32                  `protobuf:"varint,14,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`     // 每人限领张数，0表示不限
+	ValidFrom      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                 // 领取开始时间
+	ValidTo        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`                       // 领取截止时间
+	ValidDays      int32                  `protobuf:"varint,17,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`                // 领取后的有效天数，0表示在领取截止时间过期
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponTemplate) Reset() {
+	*x = CouponTemplate{}
+	mi := &file_order_order_order_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplate) ProtoMessage() {}
+
+func (x *CouponTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplate.ProtoReflect.Descriptor instead.
+func (*CouponTemplate) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CouponTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetType() CouponType {
+	if x != nil {
+		return x.Type
+	}
+	return CouponType_COUPON_TYPE_UNKNOWN
+}
+
+func (x *CouponTemplate) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetMaxDiscount() string {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return ""
+}
+
+func (x *CouponTemplate) GetRepeatable() bool {
+	if x != nil {
+		return x.Repeatable
+	}
+	return false
+}
+
+func (x *CouponTemplate) GetScopeType() CouponScopeType {
+	if x != nil {
+		return x.ScopeType
+	}
+	return CouponScopeType_COUPON_SCOPE_ALL
+}
+
+func (x *CouponTemplate) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CouponTemplate) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *CouponTemplate) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetIssuedQuantity() int32 {
+	if x != nil {
+		return x.IssuedQuantity
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CouponTemplate) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CouponTemplate) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 用户优惠券
+type Coupon struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Template       *CouponTemplate        `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Status         CouponStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=order.order.CouponStatus" json:"status,omitempty"`
+	Expired        bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`                                    // 可使用状态的优惠券是否已过期
+	OrderId        string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                      // 锁定或使用该券的订单
+	DiscountAmount string                 `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 在订单中的优惠金额
+	ValidFrom      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_order_order_order_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Coupon) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Coupon) GetTemplate() *CouponTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *Coupon) GetStatus() CouponStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CouponStatus_COUPON_STATUS_UNKNOWN
+}
+
+func (x *Coupon) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *Coupon) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Coupon) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *Coupon) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Coupon) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Coupon) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 创建优惠券模板请求
+type CreateCouponTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CouponType             `protobuf:"varint,2,opt,name=type,proto3,enum=order.order.CouponType" json:"type,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PercentOff    int32                  `protobuf:"varint,4,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	Threshold     string                 `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MaxDiscount   string                 `protobuf:"bytes,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	Repeatable    bool                   `protobuf:"varint,7,opt,name=repeatable,proto3" json:"repeatable,omitempty"`
+	ScopeType     CouponScopeType        `protobuf:"varint,8,opt,name=scope_type,json=scopeType,proto3,enum=order.order.CouponScopeType" json:"scope_type,omitempty"`
+	ScopeIds      []string               `protobuf:"bytes,9,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty"`
+	Stackable     bool                   `protobuf:"varint,10,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	TotalQuantity int32                  `protobuf:"varint,11,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,12,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	ValidDays     int32                  `protobuf:"varint,15,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponTemplateReq) Reset() {
+	*x = CreateCouponTemplateReq{}
+	mi := &file_order_order_order_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponTemplateReq) ProtoMessage() {}
+
+func (x *CreateCouponTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponTemplateReq.ProtoReflect.Descriptor instead.
+func (*CreateCouponTemplateReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateCouponTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateReq) GetType() CouponType {
+	if x != nil {
+		return x.Type
+	}
+	return CouponType_COUPON_TYPE_UNKNOWN
+}
+
+func (x *CreateCouponTemplateReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateReq) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateReq) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateReq) GetMaxDiscount() string {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return ""
+}
+
+func (x *CreateCouponTemplateReq) GetRepeatable() bool {
+	if x != nil {
+		return x.Repeatable
+	}
+	return false
+}
+
+func (x *CreateCouponTemplateReq) GetScopeType() CouponScopeType {
+	if x != nil {
+		return x.ScopeType
+	}
+	return CouponScopeType_COUPON_SCOPE_ALL
+}
+
+func (x *CreateCouponTemplateReq) GetScopeIds() []string {
+	if x != nil {
+		return x.ScopeIds
+	}
+	return nil
+}
+
+func (x *CreateCouponTemplateReq) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *CreateCouponTemplateReq) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateReq) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreateCouponTemplateReq) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateCouponTemplateReq) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CreateCouponTemplateReq) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+// 创建优惠券模板响应
+type CreateCouponTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *CouponTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponTemplateResp) Reset() {
+	*x = CreateCouponTemplateResp{}
+	mi := &file_order_order_order_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponTemplateResp) ProtoMessage() {}
+
+func (x *CreateCouponTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponTemplateResp.ProtoReflect.Descriptor instead.
+func (*CreateCouponTemplateResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateCouponTemplateResp) GetTemplate() *CouponTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 领取优惠券请求
+type ClaimCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponReq) Reset() {
+	*x = ClaimCouponReq{}
+	mi := &file_order_order_order_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponReq) ProtoMessage() {}
+
+func (x *ClaimCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponReq.ProtoReflect.Descriptor instead.
+func (*ClaimCouponReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ClaimCouponReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// 领取优惠券响应
+type ClaimCouponResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimCouponResp) Reset() {
+	*x = ClaimCouponResp{}
+	mi := &file_order_order_order_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimCouponResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimCouponResp) ProtoMessage() {}
+
+func (x *ClaimCouponResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimCouponResp.ProtoReflect.Descriptor instead.
+func (*ClaimCouponResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ClaimCouponResp) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// 我的优惠券请求
+type ListMyCouponsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        CouponStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=order.order.CouponStatus" json:"status,omitempty"` // 为空时返回全部状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCouponsReq) Reset() {
+	*x = ListMyCouponsReq{}
+	mi := &file_order_order_order_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCouponsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCouponsReq) ProtoMessage() {}
+
+func (x *ListMyCouponsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCouponsReq.ProtoReflect.Descriptor instead.
+func (*ListMyCouponsReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListMyCouponsReq) GetStatus() CouponStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CouponStatus_COUPON_STATUS_UNKNOWN
+}
+
+// 我的优惠券响应
+type ListMyCouponsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupons       []*Coupon              `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCouponsResp) Reset() {
+	*x = ListMyCouponsResp{}
+	mi := &file_order_order_order_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCouponsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCouponsResp) ProtoMessage() {}
+
+func (x *ListMyCouponsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCouponsResp.ProtoReflect.Descriptor instead.
+func (*ListMyCouponsResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListMyCouponsResp) GetCoupons() []*Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+// 运费计费规则
+type FreightRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstFee      string                 `protobuf:"bytes,1,opt,name=first_fee,json=firstFee,proto3" json:"first_fee,omitempty"`                // 首重运费
+	AdditionalFee string                 `protobuf:"bytes,2,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee,omitempty"` // 每个续重单位的运费
+	FreeThreshold string                 `protobuf:"bytes,3,opt,name=free_threshold,json=freeThreshold,proto3" json:"free_threshold,omitempty"` // 包邮门槛，商品原价合计满该金额免运费，0表示不包邮
+	Surcharge     string                 `protobuf:"bytes,4,opt,name=surcharge,proto3" json:"surcharge,omitempty"`                              // 偏远地区附加费，包邮时也收取
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreightRule) Reset() {
+	*x = FreightRule{}
+	mi := &file_order_order_order_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreightRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightRule) ProtoMessage() {}
+
+func (x *FreightRule) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightRule.ProtoReflect.Descriptor instead.
+func (*FreightRule) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FreightRule) GetFirstFee() string {
+	if x != nil {
+		return x.FirstFee
+	}
+	return ""
+}
+
+func (x *FreightRule) GetAdditionalFee() string {
+	if x != nil {
+		return x.AdditionalFee
+	}
+	return ""
+}
+
+func (x *FreightRule) GetFreeThreshold() string {
+	if x != nil {
+		return x.FreeThreshold
+	}
+	return ""
+}
+
+func (x *FreightRule) GetSurcharge() string {
+	if x != nil {
+		return x.Surcharge
+	}
+	return ""
+}
+
+// 指定地区的运费计费规则，先按市匹配，再按省匹配
+type FreightRegion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provinces     []string               `protobuf:"bytes,1,rep,name=provinces,proto3" json:"provinces,omitempty"`
+	Cities        []string               `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
+	Rule          *FreightRule           `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreightRegion) Reset() {
+	*x = FreightRegion{}
+	mi := &file_order_order_order_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreightRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightRegion) ProtoMessage() {}
+
+func (x *FreightRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightRegion.ProtoReflect.Descriptor instead.
+func (*FreightRegion) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FreightRegion) GetProvinces() []string {
+	if x != nil {
+		return x.Provinces
+	}
+	return nil
+}
+
+func (x *FreightRegion) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *FreightRegion) GetRule() *FreightRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// 运费模板
+type FreightTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FirstWeight      int32                  `protobuf:"varint,3,opt,name=first_weight,json=firstWeight,proto3" json:"first_weight,omitempty"`                // 首重（克）
+	AdditionalWeight int32                  `protobuf:"varint,4,opt,name=additional_weight,json=additionalWeight,proto3" json:"additional_weight,omitempty"` // 续重单位（克），不足一个单位按一个单位计费
+	DefaultRule      *FreightRule           `protobuf:"bytes,5,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`                 // 未匹配指定地区时使用
+	Regions          []*FreightRegion       `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty"`
+	IsDefault        bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // 未绑定运费模板的商品使用默认模板
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FreightTemplate) Reset() {
+	*x = FreightTemplate{}
+	mi := &file_order_order_order_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreightTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreightTemplate) ProtoMessage() {}
+
+func (x *FreightTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreightTemplate.ProtoReflect.Descriptor instead.
+func (*FreightTemplate) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FreightTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreightTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FreightTemplate) GetFirstWeight() int32 {
+	if x != nil {
+		return x.FirstWeight
+	}
+	return 0
+}
+
+func (x *FreightTemplate) GetAdditionalWeight() int32 {
+	if x != nil {
+		return x.AdditionalWeight
+	}
+	return 0
+}
+
+func (x *FreightTemplate) GetDefaultRule() *FreightRule {
+	if x != nil {
+		return x.DefaultRule
+	}
+	return nil
+}
+
+func (x *FreightTemplate) GetRegions() []*FreightRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *FreightTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *FreightTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FreightTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 创建运费模板请求
+type CreateFreightTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *FreightTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // 忽略 id 和时间字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFreightTemplateReq) Reset() {
+	*x = CreateFreightTemplateReq{}
+	mi := &file_order_order_order_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFreightTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFreightTemplateReq) ProtoMessage() {}
+
+func (x *CreateFreightTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFreightTemplateReq.ProtoReflect.Descriptor instead.
+func (*CreateFreightTemplateReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateFreightTemplateReq) GetTemplate() *FreightTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 创建运费模板响应
+type CreateFreightTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *FreightTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFreightTemplateResp) Reset() {
+	*x = CreateFreightTemplateResp{}
+	mi := &file_order_order_order_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFreightTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFreightTemplateResp) ProtoMessage() {}
+
+func (x *CreateFreightTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFreightTemplateResp.ProtoReflect.Descriptor instead.
+func (*CreateFreightTemplateResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateFreightTemplateResp) GetTemplate() *FreightTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 更新运费模板请求
+type UpdateFreightTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Template      *FreightTemplate       `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"` // 忽略 id 和时间字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFreightTemplateReq) Reset() {
+	*x = UpdateFreightTemplateReq{}
+	mi := &file_order_order_order_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFreightTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreightTemplateReq) ProtoMessage() {}
+
+func (x *UpdateFreightTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreightTemplateReq.ProtoReflect.Descriptor instead.
+func (*UpdateFreightTemplateReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateFreightTemplateReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFreightTemplateReq) GetTemplate() *FreightTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 更新运费模板响应
+type UpdateFreightTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *FreightTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFreightTemplateResp) Reset() {
+	*x = UpdateFreightTemplateResp{}
+	mi := &file_order_order_order_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFreightTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFreightTemplateResp) ProtoMessage() {}
+
+func (x *UpdateFreightTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFreightTemplateResp.ProtoReflect.Descriptor instead.
+func (*UpdateFreightTemplateResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateFreightTemplateResp) GetTemplate() *FreightTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 运费模板列表请求
+type ListFreightTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreightTemplatesReq) Reset() {
+	*x = ListFreightTemplatesReq{}
+	mi := &file_order_order_order_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreightTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreightTemplatesReq) ProtoMessage() {}
+
+func (x *ListFreightTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreightTemplatesReq.ProtoReflect.Descriptor instead.
+func (*ListFreightTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{68}
+}
+
+// 运费模板列表响应
+type ListFreightTemplatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*FreightTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreightTemplatesResp) Reset() {
+	*x = ListFreightTemplatesResp{}
+	mi := &file_order_order_order_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreightTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreightTemplatesResp) ProtoMessage() {}
+
+func (x *ListFreightTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreightTemplatesResp.ProtoReflect.Descriptor instead.
+func (*ListFreightTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListFreightTemplatesResp) GetTemplates() []*FreightTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// 设置商品运费模板请求
+type SetProductFreightTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 为空时解除绑定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductFreightTemplateReq) Reset() {
+	*x = SetProductFreightTemplateReq{}
+	mi := &file_order_order_order_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductFreightTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductFreightTemplateReq) ProtoMessage() {}
+
+func (x *SetProductFreightTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductFreightTemplateReq.ProtoReflect.Descriptor instead.
+func (*SetProductFreightTemplateReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetProductFreightTemplateReq) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductFreightTemplateReq) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// 设置商品运费模板响应
+type SetProductFreightTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductFreightTemplateResp) Reset() {
+	*x = SetProductFreightTemplateResp{}
+	mi := &file_order_order_order_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductFreightTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductFreightTemplateResp) ProtoMessage() {}
+
+func (x *SetProductFreightTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductFreightTemplateResp.ProtoReflect.Descriptor instead.
+func (*SetProductFreightTemplateResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetProductFreightTemplateResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 秒杀活动
+type FlashSale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                                      // 秒杀价
+	Quota         int32                  `protobuf:"varint,6,opt,name=quota,proto3" json:"quota,omitempty"`                                     // 活动库存
+	Stock         int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`                                     // 实际预热的库存，不超过库存服务中的可用库存
+	Remaining     int32                  `protobuf:"varint,8,opt,name=remaining,proto3" json:"remaining,omitempty"`                             // 剩余活动库存
+	PerUserLimit  int32                  `protobuf:"varint,9,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 每人限购数量，0表示不限
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Preloaded     bool                   `protobuf:"varint,12,opt,name=preloaded,proto3" json:"preloaded,omitempty"` // 库存是否已预热
+	Settled       bool                   `protobuf:"varint,13,opt,name=settled,proto3" json:"settled,omitempty"`     // 活动是否已结算
+	Sold          int32                  `protobuf:"varint,14,opt,name=sold,proto3" json:"sold,omitempty"`           // 结算后的销量
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSale) Reset() {
+	*x = FlashSale{}
+	mi := &file_order_order_order_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSale) ProtoMessage() {}
+
+func (x *FlashSale) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSale.ProtoReflect.Descriptor instead.
+func (*FlashSale) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FlashSale) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlashSale) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OrderItem) GetProductId() string {
+func (x *FlashSale) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *OrderItem) GetSkuId() string {
+func (x *FlashSale) GetSkuId() string {
 	if x != nil {
 		return x.SkuId
 	}
 	return ""
 }
 
-func (x *OrderItem) GetProductName() string {
+func (x *FlashSale) GetPrice() string {
 	if x != nil {
-		return x.ProductName
+		return x.Price
 	}
 	return ""
 }
 
-func (x *OrderItem) GetProductImage() string {
+func (x *FlashSale) GetQuota() int32 {
 	if x != nil {
-		return x.ProductImage
+		return x.Quota
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderItem) GetSkuName() string {
+func (x *FlashSale) GetStock() int32 {
 	if x != nil {
-		return x.SkuName
+		return x.Stock
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderItem) GetPrice() string {
+func (x *FlashSale) GetRemaining() int32 {
 	if x != nil {
-		return x.Price
+		return x.Remaining
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *FlashSale) GetPerUserLimit() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.PerUserLimit
 	}
 	return 0
 }
 
-func (x *OrderItem) GetTotalAmount() string {
+func (x *FlashSale) GetStartAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalAmount
+		return x.StartAt
 	}
-	return ""
+	return nil
 }
 
-// 订单收货地址
-type OrderAddress struct {
+func (x *FlashSale) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *FlashSale) GetPreloaded() bool {
+	if x != nil {
+		return x.Preloaded
+	}
+	return false
+}
+
+func (x *FlashSale) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *FlashSale) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *FlashSale) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 抢购记录
+type FlashSaleTicket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ReceiverName  string                 `protobuf:"bytes,2,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`
-	ReceiverPhone string                 `protobuf:"bytes,3,opt,name=receiver_phone,json=receiverPhone,proto3" json:"receiver_phone,omitempty"`
-	Province      string                 `protobuf:"bytes,4,opt,name=province,proto3" json:"province,omitempty"`
-	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
-	District      string                 `protobuf:"bytes,6,opt,name=district,proto3" json:"district,omitempty"`
-	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	SaleId        string                 `protobuf:"bytes,2,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        FlashSaleTicketStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=order.order.FlashSaleTicketStatus" json:"status,omitempty"`
+	OrderId       string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 已创建的订单ID
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                  // 创建订单失败的原因
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderAddress) Reset() {
-	*x = OrderAddress{}
-	mi := &file_order_order_order_proto_msgTypes[2]
+func (x *FlashSaleTicket) Reset() {
+	*x = FlashSaleTicket{}
+	mi := &file_order_order_order_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderAddress) String() string {
+func (x *FlashSaleTicket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderAddress) ProtoMessage() {}
+func (*FlashSaleTicket) ProtoMessage() {}
 
-func (x *OrderAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[2]
+func (x *FlashSaleTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,96 +5865,97 @@ func (x *OrderAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderAddress.ProtoReflect.Descriptor instead.
-func (*OrderAddress) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use FlashSaleTicket.ProtoReflect.Descriptor instead.
+func (*FlashSaleTicket) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *OrderAddress) GetId() string {
+func (x *FlashSaleTicket) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *OrderAddress) GetReceiverName() string {
+func (x *FlashSaleTicket) GetSaleId() string {
 	if x != nil {
-		return x.ReceiverName
+		return x.SaleId
 	}
 	return ""
 }
 
-func (x *OrderAddress) GetReceiverPhone() string {
+func (x *FlashSaleTicket) GetQuantity() int32 {
 	if x != nil {
-		return x.ReceiverPhone
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderAddress) GetProvince() string {
+func (x *FlashSaleTicket) GetStatus() FlashSaleTicketStatus {
 	if x != nil {
-		return x.Province
+		return x.Status
 	}
-	return ""
+	return FlashSaleTicketStatus_FLASH_SALE_TICKET_STATUS_UNKNOWN
 }
 
-func (x *OrderAddress) GetCity() string {
+func (x *FlashSaleTicket) GetOrderId() string {
 	if x != nil {
-		return x.City
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderAddress) GetDistrict() string {
+func (x *FlashSaleTicket) GetReason() string {
 	if x != nil {
-		return x.District
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *OrderAddress) GetAddress() string {
+func (x *FlashSaleTicket) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Address
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderAddress) GetPostalCode() string {
+func (x *FlashSaleTicket) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PostalCode
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// 创建订单请求
-type CreateOrderReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // 用户ID
-	Items          []*OrderItemReq        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                         // 订单商品项
-	Address        *OrderAddressReq       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                     // 收货地址
-	PaymentMethod  string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`    // 支付方式
-	Remark         string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                                       // 订单备注
-	DiscountAmount string                 `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 优惠金额
-	ShippingFee    string                 `protobuf:"bytes,7,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`          // 运费
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 创建秒杀活动请求
+type CreateFlashSaleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId         string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price         string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Quota         int32                  `protobuf:"varint,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	PerUserLimit  int32                  `protobuf:"varint,6,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderReq) Reset() {
-	*x = CreateOrderReq{}
-	mi := &file_order_order_order_proto_msgTypes[3]
+func (x *CreateFlashSaleReq) Reset() {
+	*x = CreateFlashSaleReq{}
+	mi := &file_order_order_order_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderReq) String() string {
+func (x *CreateFlashSaleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderReq) ProtoMessage() {}
+func (*CreateFlashSaleReq) ProtoMessage() {}
 
-func (x *CreateOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[3]
+func (x *CreateFlashSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,88 +5966,90 @@ func (x *CreateOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderReq.ProtoReflect.Descriptor instead.
-func (*CreateOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateFlashSaleReq.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateOrderReq) GetUserId() string {
+func (x *CreateFlashSaleReq) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateOrderReq) GetItems() []*OrderItemReq {
+func (x *CreateFlashSaleReq) GetProductId() string {
 	if x != nil {
-		return x.Items
+		return x.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateOrderReq) GetAddress() *OrderAddressReq {
+func (x *CreateFlashSaleReq) GetSkuId() string {
 	if x != nil {
-		return x.Address
+		return x.SkuId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateOrderReq) GetPaymentMethod() string {
+func (x *CreateFlashSaleReq) GetPrice() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Price
 	}
 	return ""
 }
 
-func (x *CreateOrderReq) GetRemark() string {
+func (x *CreateFlashSaleReq) GetQuota() int32 {
 	if x != nil {
-		return x.Remark
+		return x.Quota
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderReq) GetDiscountAmount() string {
+func (x *CreateFlashSaleReq) GetPerUserLimit() int32 {
 	if x != nil {
-		return x.DiscountAmount
+		return x.PerUserLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrderReq) GetShippingFee() string {
+func (x *CreateFlashSaleReq) GetStartAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ShippingFee
+		return x.StartAt
 	}
-	return ""
+	return nil
 }
 
-// 订单商品项请求
-type OrderItemReq struct {
+func (x *CreateFlashSaleReq) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+// 创建秒杀活动响应
+type CreateFlashSaleResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`       // 商品ID
-	SkuId         string                 `protobuf:"bytes,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`                   // SKU ID
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                         // 数量
-	Price         string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                // 价格
-	ProductName   string                 `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // 商品名称
-	SkuName       string                 `protobuf:"bytes,6,opt,name=sku_name,json=skuName,proto3" json:"sku_name,omitempty"`             // SKU名称
+	Sale          *FlashSale             `protobuf:"bytes,1,opt,name=sale,proto3" json:"sale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItemReq) Reset() {
-	*x = OrderItemReq{}
-	mi := &file_order_order_order_proto_msgTypes[4]
+func (x *CreateFlashSaleResp) Reset() {
+	*x = CreateFlashSaleResp{}
+	mi := &file_order_order_order_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemReq) String() string {
+func (x *CreateFlashSaleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItemReq) ProtoMessage() {}
+func (*CreateFlashSaleResp) ProtoMessage() {}
 
-func (x *OrderItemReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[4]
+func (x *CreateFlashSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,82 +6060,78 @@ func (x *OrderItemReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItemReq.ProtoReflect.Descriptor instead.
-func (*OrderItemReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateFlashSaleResp.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *OrderItemReq) GetProductId() string {
+func (x *CreateFlashSaleResp) GetSale() *FlashSale {
 	if x != nil {
-		return x.ProductId
+		return x.Sale
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderItemReq) GetSkuId() string {
-	if x != nil {
-		return x.SkuId
-	}
-	return ""
+// 秒杀活动列表请求
+type ListFlashSalesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItemReq) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
+func (x *ListFlashSalesReq) Reset() {
+	*x = ListFlashSalesReq{}
+	mi := &file_order_order_order_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItemReq) GetPrice() string {
-	if x != nil {
-		return x.Price
-	}
-	return ""
+func (x *ListFlashSalesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrderItemReq) GetProductName() string {
+func (*ListFlashSalesReq) ProtoMessage() {}
+
+func (x *ListFlashSalesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[76]
 	if x != nil {
-		return x.ProductName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderItemReq) GetSkuName() string {
-	if x != nil {
-		return x.SkuName
-	}
-	return ""
+// Deprecated: Use ListFlashSalesReq.ProtoReflect.Descriptor instead.
+func (*ListFlashSalesReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{76}
 }
 
-// 订单地址请求
-type OrderAddressReq struct {
+// 秒杀活动列表响应
+type ListFlashSalesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReceiverName  string                 `protobuf:"bytes,1,opt,name=receiver_name,json=receiverName,proto3" json:"receiver_name,omitempty"`    // 收货人姓名
-	ReceiverPhone string                 `protobuf:"bytes,2,opt,name=receiver_phone,json=receiverPhone,proto3" json:"receiver_phone,omitempty"` // 收货人电话
-	Province      string                 `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`                                // 省份
-	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`                                        // 城市
-	District      string                 `protobuf:"bytes,5,opt,name=district,proto3" json:"district,omitempty"`                                // 区县
-	DetailAddress string                 `protobuf:"bytes,6,opt,name=detail_address,json=detailAddress,proto3" json:"detail_address,omitempty"` // 详细地址
-	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`          // 邮政编码
+	Sales         []*FlashSale           `protobuf:"bytes,1,rep,name=sales,proto3" json:"sales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderAddressReq) Reset() {
-	*x = OrderAddressReq{}
-	mi := &file_order_order_order_proto_msgTypes[5]
+func (x *ListFlashSalesResp) Reset() {
+	*x = ListFlashSalesResp{}
+	mi := &file_order_order_order_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderAddressReq) String() string {
+func (x *ListFlashSalesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderAddressReq) ProtoMessage() {}
+func (*ListFlashSalesResp) ProtoMessage() {}
 
-func (x *OrderAddressReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[5]
+func (x *ListFlashSalesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,83 +6142,110 @@ func (x *OrderAddressReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderAddressReq.ProtoReflect.Descriptor instead.
-func (*OrderAddressReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListFlashSalesResp.ProtoReflect.Descriptor instead.
+func (*ListFlashSalesResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *OrderAddressReq) GetReceiverName() string {
+func (x *ListFlashSalesResp) GetSales() []*FlashSale {
 	if x != nil {
-		return x.ReceiverName
+		return x.Sales
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderAddressReq) GetReceiverPhone() string {
-	if x != nil {
-		return x.ReceiverPhone
-	}
-	return ""
+// 抢购请求
+type PurchaseFlashSaleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        string                 `protobuf:"bytes,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AddressId     string                 `protobuf:"bytes,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 用户服务中的收货地址ID
+	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderAddressReq) GetProvince() string {
+func (x *PurchaseFlashSaleReq) Reset() {
+	*x = PurchaseFlashSaleReq{}
+	mi := &file_order_order_order_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseFlashSaleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseFlashSaleReq) ProtoMessage() {}
+
+func (x *PurchaseFlashSaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[78]
 	if x != nil {
-		return x.Province
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderAddressReq) GetCity() string {
+// Deprecated: Use PurchaseFlashSaleReq.ProtoReflect.Descriptor instead.
+func (*PurchaseFlashSaleReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PurchaseFlashSaleReq) GetSaleId() string {
 	if x != nil {
-		return x.City
+		return x.SaleId
 	}
 	return ""
 }
 
-func (x *OrderAddressReq) GetDistrict() string {
+func (x *PurchaseFlashSaleReq) GetQuantity() int32 {
 	if x != nil {
-		return x.District
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderAddressReq) GetDetailAddress() string {
+func (x *PurchaseFlashSaleReq) GetAddressId() string {
 	if x != nil {
-		return x.DetailAddress
+		return x.AddressId
 	}
 	return ""
 }
 
-func (x *OrderAddressReq) GetPostalCode() string {
+func (x *PurchaseFlashSaleReq) GetPaymentMethod() string {
 	if x != nil {
-		return x.PostalCode
+		return x.PaymentMethod
 	}
 	return ""
 }
 
-// 创建订单响应
-type CreateOrderResp struct {
+// 抢购响应
+type PurchaseFlashSaleResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Ticket        *FlashSaleTicket       `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResp) Reset() {
-	*x = CreateOrderResp{}
-	mi := &file_order_order_order_proto_msgTypes[6]
+func (x *PurchaseFlashSaleResp) Reset() {
+	*x = PurchaseFlashSaleResp{}
+	mi := &file_order_order_order_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResp) String() string {
+func (x *PurchaseFlashSaleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResp) ProtoMessage() {}
+func (*PurchaseFlashSaleResp) ProtoMessage() {}
 
-func (x *CreateOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[6]
+func (x *PurchaseFlashSaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,42 +6256,41 @@ func (x *CreateOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResp.ProtoReflect.Descriptor instead.
-func (*CreateOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PurchaseFlashSaleResp.ProtoReflect.Descriptor instead.
+func (*PurchaseFlashSaleResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateOrderResp) GetOrder() *Order {
+func (x *PurchaseFlashSaleResp) GetTicket() *FlashSaleTicket {
 	if x != nil {
-		return x.Order
+		return x.Ticket
 	}
 	return nil
 }
 
-// 获取订单详情请求
-type GetOrderReq struct {
+// 抢购结果请求
+type GetFlashSaleTicketReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderReq) Reset() {
-	*x = GetOrderReq{}
-	mi := &file_order_order_order_proto_msgTypes[7]
+func (x *GetFlashSaleTicketReq) Reset() {
+	*x = GetFlashSaleTicketReq{}
+	mi := &file_order_order_order_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderReq) String() string {
+func (x *GetFlashSaleTicketReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderReq) ProtoMessage() {}
+func (*GetFlashSaleTicketReq) ProtoMessage() {}
 
-func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[7]
+func (x *GetFlashSaleTicketReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,48 +6301,41 @@ func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
-func (*GetOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetOrderReq) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
+// Deprecated: Use GetFlashSaleTicketReq.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleTicketReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetOrderReq) GetUserId() string {
+func (x *GetFlashSaleTicketReq) GetTicketId() string {
 	if x != nil {
-		return x.UserId
+		return x.TicketId
 	}
 	return ""
 }
 
-// 获取订单详情响应
-type GetOrderResp struct {
+// 抢购结果响应
+type GetFlashSaleTicketResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Ticket        *FlashSaleTicket       `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResp) Reset() {
-	*x = GetOrderResp{}
-	mi := &file_order_order_order_proto_msgTypes[8]
+func (x *GetFlashSaleTicketResp) Reset() {
+	*x = GetFlashSaleTicketResp{}
+	mi := &file_order_order_order_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResp) String() string {
+func (x *GetFlashSaleTicketResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResp) ProtoMessage() {}
+func (*GetFlashSaleTicketResp) ProtoMessage() {}
 
-func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[8]
+func (x *GetFlashSaleTicketResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,46 +6346,49 @@ func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResp.ProtoReflect.Descriptor instead.
-func (*GetOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetFlashSaleTicketResp.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleTicketResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetOrderResp) GetOrder() *Order {
+func (x *GetFlashSaleTicketResp) GetTicket() *FlashSaleTicket {
 	if x != nil {
-		return x.Order
+		return x.Ticket
 	}
 	return nil
 }
 
-// 获取订单列表请求
-type ListOrdersReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 订单支付阶段，每个阶段在支付服务中对应一个支付单，支付单的业务订单号为阶段ID
+type OrderPaymentStage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage          PaymentStage           `protobuf:"varint,2,opt,name=stage,proto3,enum=order.order.PaymentStage" json:"stage,omitempty"`
+	Amount         string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentStatus  int32                  `protobuf:"varint,4,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"` // 0未支付 1已支付 2退款中 3已退款 4定金不退
+	RefundedAmount string                 `protobuf:"bytes,5,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	PayableFrom    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=payable_from,json=payableFrom,proto3" json:"payable_from,omitempty"` // 开始支付时间
+	Deadline       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`                          // 支付截止时间
+	PaidAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Forfeitable    bool                   `protobuf:"varint,9,opt,name=forfeitable,proto3" json:"forfeitable,omitempty"` // 未付尾款的订单取消时定金是否不退
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListOrdersReq) Reset() {
-	*x = ListOrdersReq{}
-	mi := &file_order_order_order_proto_msgTypes[9]
+func (x *OrderPaymentStage) Reset() {
+	*x = OrderPaymentStage{}
+	mi := &file_order_order_order_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersReq) String() string {
+func (x *OrderPaymentStage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersReq) ProtoMessage() {}
+func (*OrderPaymentStage) ProtoMessage() {}
 
-func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[9]
+func (x *OrderPaymentStage) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,80 +6399,108 @@ func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersReq.ProtoReflect.Descriptor instead.
-func (*ListOrdersReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use OrderPaymentStage.ProtoReflect.Descriptor instead.
+func (*OrderPaymentStage) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ListOrdersReq) GetUserId() string {
+func (x *OrderPaymentStage) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListOrdersReq) GetPage() int32 {
+func (x *OrderPaymentStage) GetStage() PaymentStage {
 	if x != nil {
-		return x.Page
+		return x.Stage
 	}
-	return 0
+	return PaymentStage_PAYMENT_STAGE_FULL
 }
 
-func (x *ListOrdersReq) GetPageSize() int32 {
+func (x *OrderPaymentStage) GetAmount() string {
 	if x != nil {
-		return x.PageSize
+		return x.Amount
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersReq) GetStatus() int32 {
+func (x *OrderPaymentStage) GetPaymentStatus() int32 {
 	if x != nil {
-		return x.Status
+		return x.PaymentStatus
 	}
 	return 0
 }
 
-func (x *ListOrdersReq) GetStartDate() string {
+func (x *OrderPaymentStage) GetRefundedAmount() string {
 	if x != nil {
-		return x.StartDate
+		return x.RefundedAmount
 	}
 	return ""
 }
 
-func (x *ListOrdersReq) GetEndDate() string {
+func (x *OrderPaymentStage) GetPayableFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndDate
+		return x.PayableFrom
 	}
-	return ""
+	return nil
 }
 
-// 获取订单列表响应
-type ListOrdersResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderPaymentStage) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
 }
 
-func (x *ListOrdersResp) Reset() {
-	*x = ListOrdersResp{}
-	mi := &file_order_order_order_proto_msgTypes[10]
+func (x *OrderPaymentStage) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *OrderPaymentStage) GetForfeitable() bool {
+	if x != nil {
+		return x.Forfeitable
+	}
+	return false
+}
+
+// 预售活动
+type Presale struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProductId      string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId          string                 `protobuf:"bytes,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price          string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`     // 预售价
+	Deposit        string                 `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"` // 每件定金
+	DepositPolicy  DepositPolicy          `protobuf:"varint,7,opt,name=deposit_policy,json=depositPolicy,proto3,enum=order.order.DepositPolicy" json:"deposit_policy,omitempty"`
+	DepositStartAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deposit_start_at,json=depositStartAt,proto3" json:"deposit_start_at,omitempty"`  // 定金期开始
+	DepositEndAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deposit_end_at,json=depositEndAt,proto3" json:"deposit_end_at,omitempty"`        // 定金期结束
+	BalanceStartAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=balance_start_at,json=balanceStartAt,proto3" json:"balance_start_at,omitempty"` // 尾款期开始
+	BalanceEndAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=balance_end_at,json=balanceEndAt,proto3" json:"balance_end_at,omitempty"`       // 尾款期结束
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Presale) Reset() {
+	*x = Presale{}
+	mi := &file_order_order_order_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResp) String() string {
+func (x *Presale) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResp) ProtoMessage() {}
+func (*Presale) ProtoMessage() {}
 
-func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[10]
+func (x *Presale) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,130 +6511,127 @@ func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResp.ProtoReflect.Descriptor instead.
-func (*ListOrdersResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Presale.ProtoReflect.Descriptor instead.
+func (*Presale) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ListOrdersResp) GetOrders() []*Order {
+func (x *Presale) GetId() string {
 	if x != nil {
-		return x.Orders
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListOrdersResp) GetTotal() int64 {
+func (x *Presale) GetName() string {
 	if x != nil {
-		return x.Total
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResp) GetPage() int32 {
+func (x *Presale) GetProductId() string {
 	if x != nil {
-		return x.Page
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResp) GetPageSize() int32 {
+func (x *Presale) GetSkuId() string {
 	if x != nil {
-		return x.PageSize
+		return x.SkuId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListOrdersResp) GetTotalPages() int32 {
+func (x *Presale) GetPrice() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Price
 	}
-	return 0
-}
-
-// 取消订单请求
-type CancelOrderReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *CancelOrderReq) Reset() {
-	*x = CancelOrderReq{}
-	mi := &file_order_order_order_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Presale) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
 }
 
-func (x *CancelOrderReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Presale) GetDepositPolicy() DepositPolicy {
+	if x != nil {
+		return x.DepositPolicy
+	}
+	return DepositPolicy_DEPOSIT_POLICY_UNKNOWN
 }
 
-func (*CancelOrderReq) ProtoMessage() {}
-
-func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[11]
+func (x *Presale) GetDepositStartAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DepositStartAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelOrderReq.ProtoReflect.Descriptor instead.
-func (*CancelOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{11}
+func (x *Presale) GetDepositEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepositEndAt
+	}
+	return nil
 }
 
-func (x *CancelOrderReq) GetOrderId() string {
+func (x *Presale) GetBalanceStartAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderId
+		return x.BalanceStartAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelOrderReq) GetUserId() string {
+func (x *Presale) GetBalanceEndAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.BalanceEndAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelOrderReq) GetReason() string {
+func (x *Presale) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reason
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// 取消订单响应
-type CancelOrderResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 创建预售活动请求
+type CreatePresaleReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SkuId          string                 `protobuf:"bytes,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Deposit        string                 `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	DepositPolicy  DepositPolicy          `protobuf:"varint,6,opt,name=deposit_policy,json=depositPolicy,proto3,enum=order.order.DepositPolicy" json:"deposit_policy,omitempty"`
+	DepositStartAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deposit_start_at,json=depositStartAt,proto3" json:"deposit_start_at,omitempty"`
+	DepositEndAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deposit_end_at,json=depositEndAt,proto3" json:"deposit_end_at,omitempty"`
+	BalanceStartAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=balance_start_at,json=balanceStartAt,proto3" json:"balance_start_at,omitempty"`
+	BalanceEndAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=balance_end_at,json=balanceEndAt,proto3" json:"balance_end_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CancelOrderResp) Reset() {
-	*x = CancelOrderResp{}
-	mi := &file_order_order_order_proto_msgTypes[12]
+func (x *CreatePresaleReq) Reset() {
+	*x = CreatePresaleReq{}
+	mi := &file_order_order_order_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderResp) String() string {
+func (x *CreatePresaleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResp) ProtoMessage() {}
+func (*CreatePresaleReq) ProtoMessage() {}
 
-func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[12]
+func (x *CreatePresaleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,86 +6642,104 @@ func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResp.ProtoReflect.Descriptor instead.
-func (*CancelOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreatePresaleReq.ProtoReflect.Descriptor instead.
+func (*CreatePresaleReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreatePresaleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePresaleReq) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreatePresaleReq) GetSkuId() string {
+	if x != nil {
+		return x.SkuId
+	}
+	return ""
 }
 
-func (x *CancelOrderResp) GetSuccess() bool {
+func (x *CreatePresaleReq) GetPrice() string {
 	if x != nil {
-		return x.Success
+		return x.Price
 	}
-	return false
+	return ""
 }
 
-// 确认收货请求
-type ConfirmOrderReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreatePresaleReq) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
 }
 
-func (x *ConfirmOrderReq) Reset() {
-	*x = ConfirmOrderReq{}
-	mi := &file_order_order_order_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreatePresaleReq) GetDepositPolicy() DepositPolicy {
+	if x != nil {
+		return x.DepositPolicy
+	}
+	return DepositPolicy_DEPOSIT_POLICY_UNKNOWN
 }
 
-func (x *ConfirmOrderReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreatePresaleReq) GetDepositStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepositStartAt
+	}
+	return nil
 }
 
-func (*ConfirmOrderReq) ProtoMessage() {}
-
-func (x *ConfirmOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[13]
+func (x *CreatePresaleReq) GetDepositEndAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DepositEndAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ConfirmOrderReq.ProtoReflect.Descriptor instead.
-func (*ConfirmOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{13}
+func (x *CreatePresaleReq) GetBalanceStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceStartAt
+	}
+	return nil
 }
 
-func (x *ConfirmOrderReq) GetOrderId() string {
+func (x *CreatePresaleReq) GetBalanceEndAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderId
+		return x.BalanceEndAt
 	}
-	return ""
+	return nil
 }
 
-// 确认收货响应
-type ConfirmOrderResp struct {
+// 创建预售活动响应
+type CreatePresaleResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Presale       *Presale               `protobuf:"bytes,1,opt,name=presale,proto3" json:"presale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmOrderResp) Reset() {
-	*x = ConfirmOrderResp{}
-	mi := &file_order_order_order_proto_msgTypes[14]
+func (x *CreatePresaleResp) Reset() {
+	*x = CreatePresaleResp{}
+	mi := &file_order_order_order_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmOrderResp) String() string {
+func (x *CreatePresaleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmOrderResp) ProtoMessage() {}
+func (*CreatePresaleResp) ProtoMessage() {}
 
-func (x *ConfirmOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[14]
+func (x *CreatePresaleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,43 +6750,40 @@ func (x *ConfirmOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmOrderResp.ProtoReflect.Descriptor instead.
-func (*ConfirmOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreatePresaleResp.ProtoReflect.Descriptor instead.
+func (*CreatePresaleResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ConfirmOrderResp) GetSuccess() bool {
+func (x *CreatePresaleResp) GetPresale() *Presale {
 	if x != nil {
-		return x.Success
+		return x.Presale
 	}
-	return false
+	return nil
 }
 
-// 支付订单请求
-type PayOrderReq struct {
+// 预售活动列表请求
+type ListPresalesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PayOrderReq) Reset() {
-	*x = PayOrderReq{}
-	mi := &file_order_order_order_proto_msgTypes[15]
+func (x *ListPresalesReq) Reset() {
+	*x = ListPresalesReq{}
+	mi := &file_order_order_order_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PayOrderReq) String() string {
+func (x *ListPresalesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayOrderReq) ProtoMessage() {}
+func (*ListPresalesReq) ProtoMessage() {}
 
-func (x *PayOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[15]
+func (x *ListPresalesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,57 +6794,34 @@ func (x *PayOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayOrderReq.ProtoReflect.Descriptor instead.
-func (*PayOrderReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *PayOrderReq) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *PayOrderReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *PayOrderReq) GetPaymentMethod() string {
-	if x != nil {
-		return x.PaymentMethod
-	}
-	return ""
+// Deprecated: Use ListPresalesReq.ProtoReflect.Descriptor instead.
+func (*ListPresalesReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{86}
 }
 
-// 支付订单响应
-type PayOrderResp struct {
+// 预售活动列表响应
+type ListPresalesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	PaymentNo     string                 `protobuf:"bytes,2,opt,name=payment_no,json=paymentNo,proto3" json:"payment_no,omitempty"`               // 支付流水号
-	ThirdPartyUrl string                 `protobuf:"bytes,3,opt,name=third_party_url,json=thirdPartyUrl,proto3" json:"third_party_url,omitempty"` // 第三方支付URL（如支付宝、微信）
+	Presales      []*Presale             `protobuf:"bytes,1,rep,name=presales,proto3" json:"presales,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PayOrderResp) Reset() {
-	*x = PayOrderResp{}
-	mi := &file_order_order_order_proto_msgTypes[16]
+func (x *ListPresalesResp) Reset() {
+	*x = ListPresalesResp{}
+	mi := &file_order_order_order_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PayOrderResp) String() string {
+func (x *ListPresalesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayOrderResp) ProtoMessage() {}
+func (*ListPresalesResp) ProtoMessage() {}
 
-func (x *PayOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[16]
+func (x *ListPresalesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,58 +6832,46 @@ func (x *PayOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayOrderResp.ProtoReflect.Descriptor instead.
-func (*PayOrderResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *PayOrderResp) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *PayOrderResp) GetPaymentNo() string {
-	if x != nil {
-		return x.PaymentNo
-	}
-	return ""
+// Deprecated: Use ListPresalesResp.ProtoReflect.Descriptor instead.
+func (*ListPresalesResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *PayOrderResp) GetThirdPartyUrl() string {
+func (x *ListPresalesResp) GetPresales() []*Presale {
 	if x != nil {
-		return x.ThirdPartyUrl
+		return x.Presales
 	}
-	return ""
+	return nil
 }
 
-// 更新订单状态请求
-type UpdateOrderStatusReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 用户ID
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`                 // 新状态
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                  // 更新原因
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 预售下单请求
+type CreatePresaleOrderReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PresaleId      string                 `protobuf:"bytes,1,opt,name=presale_id,json=presaleId,proto3" json:"presale_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AddressId      string                 `protobuf:"bytes,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 用户服务中的收货地址ID
+	PaymentMethod  string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Remark         string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客户端幂等键，有效期内相同请求的重放返回首次创建的订单
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusReq) Reset() {
-	*x = UpdateOrderStatusReq{}
-	mi := &file_order_order_order_proto_msgTypes[17]
+func (x *CreatePresaleOrderReq) Reset() {
+	*x = CreatePresaleOrderReq{}
+	mi := &file_order_order_order_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusReq) String() string {
+func (x *CreatePresaleOrderReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusReq) ProtoMessage() {}
+func (*CreatePresaleOrderReq) ProtoMessage() {}
 
-func (x *UpdateOrderStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[17]
+func (x *CreatePresaleOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,62 +6882,76 @@ func (x *UpdateOrderStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusReq.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusReq) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreatePresaleOrderReq.ProtoReflect.Descriptor instead.
+func (*CreatePresaleOrderReq) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UpdateOrderStatusReq) GetOrderId() string {
+func (x *CreatePresaleOrderReq) GetPresaleId() string {
 	if x != nil {
-		return x.OrderId
+		return x.PresaleId
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusReq) GetUserId() string {
+func (x *CreatePresaleOrderReq) GetQuantity() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreatePresaleOrderReq) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusReq) GetStatus() int32 {
+func (x *CreatePresaleOrderReq) GetPaymentMethod() string {
 	if x != nil {
-		return x.Status
+		return x.PaymentMethod
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateOrderStatusReq) GetReason() string {
+func (x *CreatePresaleOrderReq) GetRemark() string {
 	if x != nil {
-		return x.Reason
+		return x.Remark
 	}
 	return ""
 }
 
-// 更新订单状态响应
-type UpdateOrderStatusResp struct {
+func (x *CreatePresaleOrderReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// 预售下单响应
+type CreatePresaleOrderResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResp) Reset() {
-	*x = UpdateOrderStatusResp{}
-	mi := &file_order_order_order_proto_msgTypes[18]
+func (x *CreatePresaleOrderResp) Reset() {
+	*x = CreatePresaleOrderResp{}
+	mi := &file_order_order_order_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResp) String() string {
+func (x *CreatePresaleOrderResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResp) ProtoMessage() {}
+func (*CreatePresaleOrderResp) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_order_proto_msgTypes[18]
+func (x *CreatePresaleOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_order_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,23 +6962,23 @@ func (x *UpdateOrderStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResp.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResp) Descriptor() ([]byte, []int) {
-	return file_order_order_order_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreatePresaleOrderResp.ProtoReflect.Descriptor instead.
+func (*CreatePresaleOrderResp) Descriptor() ([]byte, []int) {
+	return file_order_order_order_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateOrderStatusResp) GetSuccess() bool {
+func (x *CreatePresaleOrderResp) GetOrder() *Order {
 	if x != nil {
-		return x.Success
+		return x.Order
 	}
-	return false
+	return nil
 }
 
 var File_order_order_order_proto protoreflect.FileDescriptor
 
 const file_order_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x17order/order/order.proto\x12\vorder.order\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8d\a\n" +
+	"\x17order/order/order.proto\x12\vorder.order\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb5\t\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x17\n" +
@@ -1583,7 +7003,13 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
 	"\x05items\x18\x13 \x03(\v2\x16.order.order.OrderItemR\x05items\x123\n" +
-	"\aaddress\x18\x14 \x01(\v2\x19.order.order.OrderAddressR\aaddress\"\x89\x02\n" +
+	"\aaddress\x18\x14 \x01(\v2\x19.order.order.OrderAddressR\aaddress\x12E\n" +
+	"\x10payment_deadline\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpaymentDeadline\x12N\n" +
+	"\x15auto_confirm_deadline\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\x13autoConfirmDeadline\x12)\n" +
+	"\x10receive_extended\x18\x17 \x01(\bR\x0freceiveExtended\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x18 \x01(\tR\tpresaleId\x12E\n" +
+	"\x0epayment_stages\x18\x19 \x03(\v2\x1e.order.order.OrderPaymentStageR\rpaymentStages\"\x89\x02\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1604,23 +7030,33 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\bdistrict\x18\x06 \x01(\tR\bdistrict\x12\x18\n" +
 	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1f\n" +
 	"\vpostal_code\x18\b \x01(\tR\n" +
-	"postalCode\"\x9d\x02\n" +
+	"postalCode\"\xfd\x03\n" +
 	"\x0eCreateOrderReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
-	"\x05items\x18\x02 \x03(\v2\x19.order.order.OrderItemReqR\x05items\x126\n" +
-	"\aaddress\x18\x03 \x01(\v2\x1c.order.order.OrderAddressReqR\aaddress\x12%\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.order.order.OrderItemReqR\x05items\x12:\n" +
+	"\aaddress\x18\x03 \x01(\v2\x1c.order.order.OrderAddressReqB\x02\x18\x01R\aaddress\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12\x16\n" +
-	"\x06remark\x18\x05 \x01(\tR\x06remark\x12'\n" +
-	"\x0fdiscount_amount\x18\x06 \x01(\tR\x0ediscountAmount\x12!\n" +
-	"\fshipping_fee\x18\a \x01(\tR\vshippingFee\"\xb4\x01\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12+\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\tB\x02\x18\x01R\x0ediscountAmount\x12%\n" +
+	"\fshipping_fee\x18\a \x01(\tB\x02\x18\x01R\vshippingFee\x12'\n" +
+	"\x0fexpected_amount\x18\b \x01(\tR\x0eexpectedAmount\x12\x1f\n" +
+	"\vquote_token\x18\t \x01(\tR\n" +
+	"quoteToken\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\x12\x1d\n" +
+	"\n" +
+	"coupon_ids\x18\v \x03(\tR\tcouponIds\x12!\n" +
+	"\fskip_coupons\x18\f \x01(\bR\vskipCoupons\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\r \x01(\tR\taddressId\"\xc0\x01\n" +
 	"\fOrderItemReq\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x15\n" +
 	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\tR\x05price\x12!\n" +
-	"\fproduct_name\x18\x05 \x01(\tR\vproductName\x12\x19\n" +
-	"\bsku_name\x18\x06 \x01(\tR\askuName\"\xf1\x01\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\tB\x02\x18\x01R\x05price\x12%\n" +
+	"\fproduct_name\x18\x05 \x01(\tB\x02\x18\x01R\vproductName\x12\x1d\n" +
+	"\bsku_name\x18\x06 \x01(\tB\x02\x18\x01R\askuName\"\xf1\x01\n" +
 	"\x0fOrderAddressReq\x12#\n" +
 	"\rreceiver_name\x18\x01 \x01(\tR\freceiverName\x12%\n" +
 	"\x0ereceiver_phone\x18\x02 \x01(\tR\rreceiverPhone\x12\x1a\n" +
@@ -1631,6 +7067,57 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\vpostal_code\x18\a \x01(\tR\n" +
 	"postalCode\";\n" +
 	"\x0fCreateOrderResp\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.order.order.OrderR\x05order\"\xd9\x01\n" +
+	"\rQuoteOrderReq\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.order.order.OrderItemReqR\x05items\x126\n" +
+	"\aaddress\x18\x02 \x01(\v2\x1c.order.order.OrderAddressReqR\aaddress\x12\x1d\n" +
+	"\n" +
+	"coupon_ids\x18\x03 \x03(\tR\tcouponIds\x12!\n" +
+	"\fskip_coupons\x18\x04 \x01(\bR\vskipCoupons\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x05 \x01(\tR\taddressId\"\xf2\x01\n" +
+	"\tQuoteItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x19\n" +
+	"\bsku_name\x18\x04 \x01(\tR\askuName\x12#\n" +
+	"\rproduct_image\x18\x05 \x01(\tR\fproductImage\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\tR\x05price\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12\x1a\n" +
+	"\bsubtotal\x18\b \x01(\tR\bsubtotal\"_\n" +
+	"\x0fUnavailableItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\tR\x05skuId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb1\x03\n" +
+	"\x0eQuoteOrderResp\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.order.order.QuoteItemR\x05items\x12I\n" +
+	"\x11unavailable_items\x18\x02 \x03(\v2\x1c.order.order.UnavailableItemR\x10unavailableItems\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\tR\vtotalAmount\x12'\n" +
+	"\x0fdiscount_amount\x18\x04 \x01(\tR\x0ediscountAmount\x12!\n" +
+	"\fshipping_fee\x18\x05 \x01(\tR\vshippingFee\x12%\n" +
+	"\x0epayable_amount\x18\x06 \x01(\tR\rpayableAmount\x12\x1f\n" +
+	"\vquote_token\x18\a \x01(\tR\n" +
+	"quoteToken\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x124\n" +
+	"\acoupons\x18\t \x03(\v2\x1a.order.order.AppliedCouponR\acoupons\"i\n" +
+	"\rAppliedCoupon\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\tR\bcouponId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fdiscount_amount\x18\x03 \x01(\tR\x0ediscountAmount\"\xfe\x01\n" +
+	"\x0fCheckoutCartReq\x12\"\n" +
+	"\rcart_item_ids\x18\x01 \x03(\tR\vcartItemIds\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06remark\x18\x04 \x01(\tR\x06remark\x12'\n" +
+	"\x0fexpected_amount\x18\x05 \x01(\tR\x0eexpectedAmount\x12\x1d\n" +
+	"\n" +
+	"coupon_ids\x18\x06 \x03(\tR\tcouponIds\x12!\n" +
+	"\fskip_coupons\x18\a \x01(\bR\vskipCoupons\"<\n" +
+	"\x10CheckoutCartResp\x12(\n" +
 	"\x05order\x18\x01 \x01(\v2\x12.order.order.OrderR\x05order\"A\n" +
 	"\vGetOrderReq\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
@@ -1661,11 +7148,16 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\x0fConfirmOrderReq\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\",\n" +
 	"\x10ConfirmOrderResp\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"h\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x10ExtendReceiveReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"c\n" +
+	"\x11ExtendReceiveResp\x12N\n" +
+	"\x15auto_confirm_deadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x13autoConfirmDeadline\"\x91\x01\n" +
 	"\vPayOrderReq\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
-	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\"o\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"o\n" +
 	"\fPayOrderResp\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
@@ -1677,7 +7169,367 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\"1\n" +
 	"\x15UpdateOrderStatusResp\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*\xcd\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa8\x02\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12!\n" +
+	"\fcarrier_code\x18\x03 \x01(\tR\vcarrierCode\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x12/\n" +
+	"\x05items\x18\x05 \x03(\v2\x19.order.order.ShipmentItemR\x05items\x129\n" +
+	"\n" +
+	"shipped_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"N\n" +
+	"\fShipmentItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xa6\x01\n" +
+	"\fShipOrderReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12!\n" +
+	"\fcarrier_code\x18\x02 \x01(\tR\vcarrierCode\x12'\n" +
+	"\x0ftracking_number\x18\x03 \x01(\tR\x0etrackingNumber\x12/\n" +
+	"\x05items\x18\x04 \x03(\v2\x19.order.order.ShipmentItemR\x05items\"\x7f\n" +
+	"\rShipOrderResp\x121\n" +
+	"\bshipment\x18\x01 \x01(\v2\x15.order.order.ShipmentR\bshipment\x12;\n" +
+	"\forder_status\x18\x02 \x01(\x0e2\x18.order.order.OrderStatusR\vorderStatus\"j\n" +
+	"\x15ChangeOrderAddressReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x126\n" +
+	"\aaddress\x18\x02 \x01(\v2\x1c.order.order.OrderAddressReqR\aaddress\"M\n" +
+	"\x16ChangeOrderAddressResp\x123\n" +
+	"\aaddress\x18\x01 \x01(\v2\x19.order.order.OrderAddressR\aaddress\"\xde\x03\n" +
+	"\rTimelineEvent\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.order.order.TimelineEventTypeR\x04type\x129\n" +
+	"\vfrom_status\x18\x02 \x01(\x0e2\x18.order.order.OrderStatusR\n" +
+	"fromStatus\x125\n" +
+	"\tto_status\x18\x03 \x01(\x0e2\x18.order.order.OrderStatusR\btoStatus\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\x12#\n" +
+	"\roperator_type\x18\x05 \x01(\tR\foperatorType\x12\x16\n" +
+	"\x06remark\x18\x06 \x01(\tR\x06remark\x12A\n" +
+	"\x0epayment_method\x18\a \x01(\x0e2\x1a.order.order.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x121\n" +
+	"\bshipment\x18\t \x01(\v2\x15.order.order.ShipmentR\bshipment\x12;\n" +
+	"\voccurred_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"0\n" +
+	"\x13GetOrderTimelineReq\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"J\n" +
+	"\x14GetOrderTimelineResp\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.order.order.TimelineEventR\x06events\"\xca\x03\n" +
+	"\x11OrderSearchFilter\x12\x19\n" +
+	"\border_no\x18\x01 \x01(\tR\aorderNo\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x18.order.order.OrderStatusR\bstatuses\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\tR\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\tR\tmaxAmount\x12=\n" +
+	"\fcreated_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x127\n" +
+	"\tpaid_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bpaidFrom\x123\n" +
+	"\apaid_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06paidTo\"~\n" +
+	"\x0fSearchOrdersReq\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.order.order.OrderSearchFilterR\x06filter\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"_\n" +
+	"\x10SearchOrdersResp\x12*\n" +
+	"\x06orders\x18\x01 \x03(\v2\x12.order.order.OrderR\x06orders\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"I\n" +
+	"\x0fExportOrdersReq\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.order.order.OrderSearchFilterR\x06filter\"(\n" +
+	"\x10ExportOrdersResp\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xf4\x01\n" +
+	"\x10GetOrderStatsReq\x12-\n" +
+	"\x05scope\x18\x01 \x01(\x0e2\x17.order.order.StatsScopeR\x05scope\x12?\n" +
+	"\vgranularity\x18\x02 \x01(\x0e2\x1d.order.order.StatsGranularityR\vgranularity\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xa4\x02\n" +
+	"\x11OrderStatusCounts\x12!\n" +
+	"\ftotal_orders\x18\x01 \x01(\x03R\vtotalOrders\x12%\n" +
+	"\x0epending_orders\x18\x02 \x01(\x03R\rpendingOrders\x12\x1f\n" +
+	"\vpaid_orders\x18\x03 \x01(\x03R\n" +
+	"paidOrders\x12%\n" +
+	"\x0eshipped_orders\x18\x04 \x01(\x03R\rshippedOrders\x12)\n" +
+	"\x10delivered_orders\x18\x05 \x01(\x03R\x0fdeliveredOrders\x12)\n" +
+	"\x10cancelled_orders\x18\x06 \x01(\x03R\x0fcancelledOrders\x12'\n" +
+	"\x0frefunded_orders\x18\a \x01(\x03R\x0erefundedOrders\"~\n" +
+	"\fAmountBucket\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x10\n" +
+	"\x03gmv\x18\x02 \x01(\tR\x03gmv\x12\x1f\n" +
+	"\vpaid_amount\x18\x03 \x01(\tR\n" +
+	"paidAmount\x12#\n" +
+	"\rrefund_amount\x18\x04 \x01(\tR\frefundAmount\"\xeb\x02\n" +
+	"\x11GetOrderStatsResp\x126\n" +
+	"\x06counts\x18\x01 \x01(\v2\x1e.order.order.OrderStatusCountsR\x06counts\x123\n" +
+	"\abuckets\x18\x02 \x03(\v2\x19.order.order.AmountBucketR\abuckets\x12\x1b\n" +
+	"\ttotal_gmv\x18\x03 \x01(\tR\btotalGmv\x12*\n" +
+	"\x11total_paid_amount\x18\x04 \x01(\tR\x0ftotalPaidAmount\x12.\n" +
+	"\x13total_refund_amount\x18\x05 \x01(\tR\x11totalRefundAmount\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xa2\x02\n" +
+	"\rPurchaseLimit\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\"\n" +
+	"\rmax_per_order\x18\x03 \x01(\x05R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\x04 \x01(\x05R\n" +
+	"maxPerUser\x12\x1f\n" +
+	"\vwindow_days\x18\x05 \x01(\x05R\n" +
+	"windowDays\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb2\x01\n" +
+	"\x13SetPurchaseLimitReq\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\"\n" +
+	"\rmax_per_order\x18\x03 \x01(\x05R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\x04 \x01(\x05R\n" +
+	"maxPerUser\x12\x1f\n" +
+	"\vwindow_days\x18\x05 \x01(\x05R\n" +
+	"windowDays\"H\n" +
+	"\x14SetPurchaseLimitResp\x120\n" +
+	"\x05limit\x18\x01 \x01(\v2\x1a.order.order.PurchaseLimitR\x05limit\",\n" +
+	"\x13GetPurchaseLimitReq\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\"H\n" +
+	"\x14GetPurchaseLimitResp\x120\n" +
+	"\x05limit\x18\x01 \x01(\v2\x1a.order.order.PurchaseLimitR\x05limit\"/\n" +
+	"\x16DeletePurchaseLimitReq\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\tR\x05skuId\"3\n" +
+	"\x17DeletePurchaseLimitResp\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb5\x05\n" +
+	"\x0eCouponTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x17.order.order.CouponTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vpercent_off\x18\x05 \x01(\x05R\n" +
+	"percentOff\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\tR\tthreshold\x12!\n" +
+	"\fmax_discount\x18\a \x01(\tR\vmaxDiscount\x12\x1e\n" +
+	"\n" +
+	"repeatable\x18\b \x01(\bR\n" +
+	"repeatable\x12;\n" +
+	"\n" +
+	"scope_type\x18\t \x01(\x0e2\x1c.order.order.CouponScopeTypeR\tscopeType\x12\x1b\n" +
+	"\tscope_ids\x18\n" +
+	" \x03(\tR\bscopeIds\x12\x1c\n" +
+	"\tstackable\x18\v \x01(\bR\tstackable\x12%\n" +
+	"\x0etotal_quantity\x18\f \x01(\x05R\rtotalQuantity\x12'\n" +
+	"\x0fissued_quantity\x18\r \x01(\x05R\x0eissuedQuantity\x12$\n" +
+	"\x0eper_user_limit\x18\x0e \x01(\x05R\fperUserLimit\x129\n" +
+	"\n" +
+	"valid_from\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x11 \x01(\x05R\tvalidDays\x129\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8f\x03\n" +
+	"\x06Coupon\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\btemplate\x18\x02 \x01(\v2\x1b.order.order.CouponTemplateR\btemplate\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.order.order.CouponStatusR\x06status\x12\x18\n" +
+	"\aexpired\x18\x04 \x01(\bR\aexpired\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12'\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\tR\x0ediscountAmount\x129\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xca\x04\n" +
+	"\x17CreateCouponTemplateReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.order.order.CouponTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1f\n" +
+	"\vpercent_off\x18\x04 \x01(\x05R\n" +
+	"percentOff\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\tR\tthreshold\x12!\n" +
+	"\fmax_discount\x18\x06 \x01(\tR\vmaxDiscount\x12\x1e\n" +
+	"\n" +
+	"repeatable\x18\a \x01(\bR\n" +
+	"repeatable\x12;\n" +
+	"\n" +
+	"scope_type\x18\b \x01(\x0e2\x1c.order.order.CouponScopeTypeR\tscopeType\x12\x1b\n" +
+	"\tscope_ids\x18\t \x03(\tR\bscopeIds\x12\x1c\n" +
+	"\tstackable\x18\n" +
+	" \x01(\bR\tstackable\x12%\n" +
+	"\x0etotal_quantity\x18\v \x01(\x05R\rtotalQuantity\x12$\n" +
+	"\x0eper_user_limit\x18\f \x01(\x05R\fperUserLimit\x129\n" +
+	"\n" +
+	"valid_from\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\x0f \x01(\x05R\tvalidDays\"S\n" +
+	"\x18CreateCouponTemplateResp\x127\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1b.order.order.CouponTemplateR\btemplate\"1\n" +
+	"\x0eClaimCouponReq\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\">\n" +
+	"\x0fClaimCouponResp\x12+\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x13.order.order.CouponR\x06coupon\"E\n" +
+	"\x10ListMyCouponsReq\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.order.order.CouponStatusR\x06status\"B\n" +
+	"\x11ListMyCouponsResp\x12-\n" +
+	"\acoupons\x18\x01 \x03(\v2\x13.order.order.CouponR\acoupons\"\x96\x01\n" +
+	"\vFreightRule\x12\x1b\n" +
+	"\tfirst_fee\x18\x01 \x01(\tR\bfirstFee\x12%\n" +
+	"\x0eadditional_fee\x18\x02 \x01(\tR\radditionalFee\x12%\n" +
+	"\x0efree_threshold\x18\x03 \x01(\tR\rfreeThreshold\x12\x1c\n" +
+	"\tsurcharge\x18\x04 \x01(\tR\tsurcharge\"s\n" +
+	"\rFreightRegion\x12\x1c\n" +
+	"\tprovinces\x18\x01 \x03(\tR\tprovinces\x12\x16\n" +
+	"\x06cities\x18\x02 \x03(\tR\x06cities\x12,\n" +
+	"\x04rule\x18\x03 \x01(\v2\x18.order.order.FreightRuleR\x04rule\"\x8d\x03\n" +
+	"\x0fFreightTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\ffirst_weight\x18\x03 \x01(\x05R\vfirstWeight\x12+\n" +
+	"\x11additional_weight\x18\x04 \x01(\x05R\x10additionalWeight\x12;\n" +
+	"\fdefault_rule\x18\x05 \x01(\v2\x18.order.order.FreightRuleR\vdefaultRule\x124\n" +
+	"\aregions\x18\x06 \x03(\v2\x1a.order.order.FreightRegionR\aregions\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"T\n" +
+	"\x18CreateFreightTemplateReq\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.order.order.FreightTemplateR\btemplate\"U\n" +
+	"\x19CreateFreightTemplateResp\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.order.order.FreightTemplateR\btemplate\"d\n" +
+	"\x18UpdateFreightTemplateReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\btemplate\x18\x02 \x01(\v2\x1c.order.order.FreightTemplateR\btemplate\"U\n" +
+	"\x19UpdateFreightTemplateResp\x128\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1c.order.order.FreightTemplateR\btemplate\"\x19\n" +
+	"\x17ListFreightTemplatesReq\"V\n" +
+	"\x18ListFreightTemplatesResp\x12:\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1c.order.order.FreightTemplateR\ttemplates\"^\n" +
+	"\x1cSetProductFreightTemplateReq\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"9\n" +
+	"\x1dSetProductFreightTemplateResp\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdc\x03\n" +
+	"\tFlashSale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x04 \x01(\tR\x05skuId\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x14\n" +
+	"\x05quota\x18\x06 \x01(\x05R\x05quota\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x05R\x05stock\x12\x1c\n" +
+	"\tremaining\x18\b \x01(\x05R\tremaining\x12$\n" +
+	"\x0eper_user_limit\x18\t \x01(\x05R\fperUserLimit\x125\n" +
+	"\bstart_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x1c\n" +
+	"\tpreloaded\x18\f \x01(\bR\tpreloaded\x12\x18\n" +
+	"\asettled\x18\r \x01(\bR\asettled\x12\x12\n" +
+	"\x04sold\x18\x0e \x01(\x05R\x04sold\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbb\x02\n" +
+	"\x0fFlashSaleTicket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\asale_id\x18\x02 \x01(\tR\x06saleId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".order.order.FlashSaleTicketStatusR\x06status\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9a\x02\n" +
+	"\x12CreateFlashSaleReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\tR\x05skuId\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12\x14\n" +
+	"\x05quota\x18\x05 \x01(\x05R\x05quota\x12$\n" +
+	"\x0eper_user_limit\x18\x06 \x01(\x05R\fperUserLimit\x125\n" +
+	"\bstart_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\"A\n" +
+	"\x13CreateFlashSaleResp\x12*\n" +
+	"\x04sale\x18\x01 \x01(\v2\x16.order.order.FlashSaleR\x04sale\"\x13\n" +
+	"\x11ListFlashSalesReq\"B\n" +
+	"\x12ListFlashSalesResp\x12,\n" +
+	"\x05sales\x18\x01 \x03(\v2\x16.order.order.FlashSaleR\x05sales\"\x91\x01\n" +
+	"\x14PurchaseFlashSaleReq\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\tR\x06saleId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\tR\taddressId\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\"M\n" +
+	"\x15PurchaseFlashSaleResp\x124\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x1c.order.order.FlashSaleTicketR\x06ticket\"4\n" +
+	"\x15GetFlashSaleTicketReq\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\"N\n" +
+	"\x16GetFlashSaleTicketResp\x124\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x1c.order.order.FlashSaleTicketR\x06ticket\"\x8a\x03\n" +
+	"\x11OrderPaymentStage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x19.order.order.PaymentStageR\x05stage\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12%\n" +
+	"\x0epayment_status\x18\x04 \x01(\x05R\rpaymentStatus\x12'\n" +
+	"\x0frefunded_amount\x18\x05 \x01(\tR\x0erefundedAmount\x12=\n" +
+	"\fpayable_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vpayableFrom\x126\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x123\n" +
+	"\apaid_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12 \n" +
+	"\vforfeitable\x18\t \x01(\bR\vforfeitable\"\xa1\x04\n" +
+	"\aPresale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x04 \x01(\tR\x05skuId\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x18\n" +
+	"\adeposit\x18\x06 \x01(\tR\adeposit\x12A\n" +
+	"\x0edeposit_policy\x18\a \x01(\x0e2\x1a.order.order.DepositPolicyR\rdepositPolicy\x12D\n" +
+	"\x10deposit_start_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0edepositStartAt\x12@\n" +
+	"\x0edeposit_end_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fdepositEndAt\x12D\n" +
+	"\x10balance_start_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0ebalanceStartAt\x12@\n" +
+	"\x0ebalance_end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fbalanceEndAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdf\x03\n" +
+	"\x10CreatePresaleReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\tR\x05skuId\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12\x18\n" +
+	"\adeposit\x18\x05 \x01(\tR\adeposit\x12A\n" +
+	"\x0edeposit_policy\x18\x06 \x01(\x0e2\x1a.order.order.DepositPolicyR\rdepositPolicy\x12D\n" +
+	"\x10deposit_start_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0edepositStartAt\x12@\n" +
+	"\x0edeposit_end_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fdepositEndAt\x12D\n" +
+	"\x10balance_start_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0ebalanceStartAt\x12@\n" +
+	"\x0ebalance_end_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fbalanceEndAt\"C\n" +
+	"\x11CreatePresaleResp\x12.\n" +
+	"\apresale\x18\x01 \x01(\v2\x14.order.order.PresaleR\apresale\"\x11\n" +
+	"\x0fListPresalesReq\"D\n" +
+	"\x10ListPresalesResp\x120\n" +
+	"\bpresales\x18\x01 \x03(\v2\x14.order.order.PresaleR\bpresales\"\xd9\x01\n" +
+	"\x15CreatePresaleOrderReq\x12\x1d\n" +
+	"\n" +
+	"presale_id\x18\x01 \x01(\tR\tpresaleId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\tR\taddressId\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"B\n" +
+	"\x16CreatePresaleOrderResp\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.order.order.OrderR\x05order*\xec\x01\n" +
 	"\vOrderStatus\x12\x18\n" +
 	"\x14ORDER_STATUS_UNKNOWN\x10\x00\x12 \n" +
 	"\x1cORDER_STATUS_PENDING_PAYMENT\x10\x01\x12\x15\n" +
@@ -1685,21 +7537,95 @@ const file_order_order_order_proto_rawDesc = "" +
 	"\x14ORDER_STATUS_SHIPPED\x10\x03\x12\x1a\n" +
 	"\x16ORDER_STATUS_DELIVERED\x10\x04\x12\x1a\n" +
 	"\x16ORDER_STATUS_CANCELLED\x10\x05\x12\x19\n" +
-	"\x15ORDER_STATUS_REFUNDED\x10\x06*}\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x06\x12\x1d\n" +
+	"\x19ORDER_STATUS_DEPOSIT_PAID\x10\a*}\n" +
 	"\rPaymentMethod\x12\x1a\n" +
 	"\x16PAYMENT_METHOD_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15PAYMENT_METHOD_ALIPAY\x10\x01\x12\x19\n" +
 	"\x15PAYMENT_METHOD_WECHAT\x10\x02\x12\x1a\n" +
-	"\x16PAYMENT_METHOD_BALANCE\x10\x032\x82\t\n" +
+	"\x16PAYMENT_METHOD_BALANCE\x10\x03*\xc4\x01\n" +
+	"\x11TimelineEventType\x12\x1f\n" +
+	"\x1bTIMELINE_EVENT_TYPE_UNKNOWN\x10\x00\x12&\n" +
+	"\"TIMELINE_EVENT_TYPE_STATUS_CHANGED\x10\x01\x12\x1c\n" +
+	"\x18TIMELINE_EVENT_TYPE_PAID\x10\x02\x12\x1f\n" +
+	"\x1bTIMELINE_EVENT_TYPE_SHIPPED\x10\x03\x12'\n" +
+	"#TIMELINE_EVENT_TYPE_ADDRESS_CHANGED\x10\x04*S\n" +
+	"\n" +
+	"StatsScope\x12\x17\n" +
+	"\x13STATS_SCOPE_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10STATS_SCOPE_USER\x10\x01\x12\x16\n" +
+	"\x12STATS_SCOPE_GLOBAL\x10\x02*\x85\x01\n" +
+	"\x10StatsGranularity\x12\x1d\n" +
+	"\x19STATS_GRANULARITY_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15STATS_GRANULARITY_DAY\x10\x01\x12\x1a\n" +
+	"\x16STATS_GRANULARITY_WEEK\x10\x02\x12\x1b\n" +
+	"\x17STATS_GRANULARITY_MONTH\x10\x03*t\n" +
+	"\n" +
+	"CouponType\x12\x17\n" +
+	"\x13COUPON_TYPE_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11COUPON_TYPE_FIXED\x10\x01\x12\x17\n" +
+	"\x13COUPON_TYPE_PERCENT\x10\x02\x12\x1d\n" +
+	"\x19COUPON_TYPE_SPEND_AND_GET\x10\x03*p\n" +
+	"\x0fCouponScopeType\x12\x14\n" +
+	"\x10COUPON_SCOPE_ALL\x10\x00\x12\x19\n" +
+	"\x15COUPON_SCOPE_CATEGORY\x10\x01\x12\x16\n" +
+	"\x12COUPON_SCOPE_BRAND\x10\x02\x12\x14\n" +
+	"\x10COUPON_SCOPE_SKU\x10\x03*x\n" +
+	"\fCouponStatus\x12\x19\n" +
+	"\x15COUPON_STATUS_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17COUPON_STATUS_AVAILABLE\x10\x01\x12\x18\n" +
+	"\x14COUPON_STATUS_LOCKED\x10\x02\x12\x16\n" +
+	"\x12COUPON_STATUS_USED\x10\x03*\xd4\x01\n" +
+	"\x15FlashSaleTicketStatus\x12$\n" +
+	" FLASH_SALE_TICKET_STATUS_UNKNOWN\x10\x00\x12#\n" +
+	"\x1fFLASH_SALE_TICKET_STATUS_QUEUED\x10\x01\x12$\n" +
+	" FLASH_SALE_TICKET_STATUS_CREATED\x10\x02\x12#\n" +
+	"\x1fFLASH_SALE_TICKET_STATUS_FAILED\x10\x03\x12%\n" +
+	"!FLASH_SALE_TICKET_STATUS_RELEASED\x10\x04*\\\n" +
+	"\fPaymentStage\x12\x16\n" +
+	"\x12PAYMENT_STAGE_FULL\x10\x00\x12\x19\n" +
+	"\x15PAYMENT_STAGE_DEPOSIT\x10\x01\x12\x19\n" +
+	"\x15PAYMENT_STAGE_BALANCE\x10\x02*b\n" +
+	"\rDepositPolicy\x12\x1a\n" +
+	"\x16DEPOSIT_POLICY_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16DEPOSIT_POLICY_FORFEIT\x10\x01\x12\x19\n" +
+	"\x15DEPOSIT_POLICY_REFUND\x10\x022\xc7<\n" +
 	"\fOrderService\x12\x9a\x01\n" +
-	"\vCreateOrder\x12\x1b.order.order.CreateOrderReq\x1a\x1c.order.order.CreateOrderResp\"P\x92A4\x12\f创建订单\x1a$根据购物车商品创建新订单\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12\xa1\x01\n" +
+	"\vCreateOrder\x12\x1b.order.order.CreateOrderReq\x1a\x1c.order.order.CreateOrderResp\"P\x92A4\x12\f创建订单\x1a$根据购物车商品创建新订单\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/orders\x12\xd7\x01\n" +
+	"\fCheckoutCart\x12\x1c.order.order.CheckoutCartReq\x1a\x1d.order.order.CheckoutCartResp\"\x89\x01\x92Ad\x12\x0f购物车结算\x1aQ将购物车中选中的商品下单，并从购物车中移除已结算的商品\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/orders/checkout\x12\xd1\x01\n" +
+	"\n" +
+	"QuoteOrder\x12\x1a.order.order.QuoteOrderReq\x1a\x1b.order.order.QuoteOrderResp\"\x89\x01\x92Ag\x12\f下单报价\x1aW计算订单金额明细和不可购买的商品，返回可用于下单的报价令牌\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/orders/quote\x12\xa1\x01\n" +
 	"\bGetOrder\x12\x18.order.order.GetOrderReq\x1a\x19.order.order.GetOrderResp\"`\x92A<\x12\x12获取订单详情\x1a&根据订单ID获取订单详细信息\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/orders/{order_id}\x12\xa6\x01\n" +
 	"\n" +
 	"ListOrders\x12\x1a.order.order.ListOrdersReq\x1a\x1b.order.order.ListOrdersResp\"_\x92AF\x12\x12获取订单列表\x1a0获取用户订单列表，支持分页和筛选\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/orders\x12\x9a\x01\n" +
 	"\vCancelOrder\x12\x1b.order.order.CancelOrderReq\x1a\x1c.order.order.CancelOrderResp\"P\x92A\"\x12\f取消订单\x1a\x12取消指定订单\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/orders/{order_id}/cancel\x12\xa4\x01\n" +
-	"\fConfirmOrder\x12\x1c.order.order.ConfirmOrderReq\x1a\x1d.order.order.ConfirmOrderResp\"W\x92A(\x12\f确认收货\x1a\x18确认收货完成订单\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/orders/{order_id}/confirm\x12\x8e\x01\n" +
-	"\bPayOrder\x12\x18.order.order.PayOrderReq\x1a\x19.order.order.PayOrderResp\"M\x92A\"\x12\f支付订单\x1a\x12处理订单支付\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/orders/{order_id}/pay\x12\xb2\x01\n" +
-	"\x11UpdateOrderStatus\x12!.order.order.UpdateOrderStatusReq\x1a\".order.order.UpdateOrderStatusResp\"V\x92A(\x12\x12更新订单状态\x1a\x12更新订单状态\x82\xd3\xe4\x93\x02%:\x01*\x1a /api/v1/orders/{order_id}/statusBHZFgithub.com/people257/poor-guy-shop/order-service/gen/proto/order/orderb\x06proto3"
+	"\fConfirmOrder\x12\x1c.order.order.ConfirmOrderReq\x1a\x1d.order.order.ConfirmOrderResp\"W\x92A(\x12\f确认收货\x1a\x18确认收货完成订单\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/orders/{order_id}/confirm\x12\xe8\x01\n" +
+	"\rExtendReceive\x12\x1d.order.order.ExtendReceiveReq\x1a\x1e.order.order.ExtendReceiveResp\"\x97\x01\x92Aa\x12\f延长收货\x1aQ顺延已发货订单的自动确认收货时间，每个订单只能延长一次\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/orders/{order_id}/extend-receive\x12\x8e\x01\n" +
+	"\bPayOrder\x12\x18.order.order.PayOrderReq\x1a\x19.order.order.PayOrderResp\"M\x92A\"\x12\f支付订单\x1a\x12处理订单支付\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/orders/{order_id}/pay\x12\x94\x02\n" +
+	"\x11UpdateOrderStatus\x12!.order.order.UpdateOrderStatusReq\x1a\".order.order.UpdateOrderStatusResp\"\xb7\x01\x92A\x88\x01\x12\x12更新订单状态\x1ar买家只能取消订单或确认收货，支付、发货和退款分别通过支付、发货和售后接口完成\x82\xd3\xe4\x93\x02%:\x01*\x1a /api/v1/orders/{order_id}/status\x12\xe6\x01\n" +
+	"\tShipOrder\x12\x19.order.order.ShipOrderReq\x1a\x1a.order.order.ShipOrderResp\"\xa1\x01\x92Ap\x12\f订单发货\x1a`运营录入物流信息发货，支持分批发货，全部商品发出后订单变为已发货\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/orders/{order_id}/shipments\x12\xff\x01\n" +
+	"\x12ChangeOrderAddress\x12\".order.order.ChangeOrderAddressReq\x1a#.order.order.ChangeOrderAddressResp\"\x9f\x01\x92Ap\x12\x12修改收货地址\x1aZ运营在发货前修改订单收货地址，修改前后的地址记录在状态日志中\x82\xd3\xe4\x93\x02&:\x01*\x1a!/api/v1/orders/{order_id}/address\x12\xe2\x01\n" +
+	"\x10GetOrderTimeline\x12 .order.order.GetOrderTimelineReq\x1a!.order.order.GetOrderTimelineResp\"\x88\x01\x92A[\x12\x15获取订单时间线\x1aB按时间顺序返回订单的状态变更、支付和发货记录\x82\xd3\xe4\x93\x02$\x12\"/api/v1/orders/{order_id}/timeline\x12\x93\x02\n" +
+	"\fSearchOrders\x12\x1c.order.order.SearchOrdersReq\x1a\x1d.order.order.SearchOrdersResp\"\xc5\x01\x92A\x9b\x01\x12\x12运营搜索订单\x1a\x84\x01按订单号、用户、状态、支付方式、金额和时间范围搜索所有用户的订单，按下单时间倒序游标翻页\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/orders/search\x12\xb9\x01\n" +
+	"\fExportOrders\x12\x1c.order.order.ExportOrdersReq\x1a\x1d.order.order.ExportOrdersResp\"j\x92AA\x12\f导出订单\x1a1按搜索条件流式导出 CSV，供财务对账\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/orders/export0\x01\x12\xf9\x01\n" +
+	"\rGetOrderStats\x12\x1d.order.order.GetOrderStatsReq\x1a\x1e.order.order.GetOrderStatsResp\"\xa8\x01\x92A\x88\x01\x12\f订单统计\x1ax统计时间范围内各状态的订单数，以及按天、周、月汇总的下单金额、支付金额和退款金额\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/orders/stats\x12\x87\x02\n" +
+	"\x10SetPurchaseLimit\x12 .order.order.SetPurchaseLimitReq\x1a!.order.order.SetPurchaseLimitResp\"\xad\x01\x92Ay\x12\x12设置限购规则\x1ac设置商品SKU的每单限购数量和每人在统计周期内的限购数量，已存在时覆盖\x82\xd3\xe4\x93\x02+:\x01*\x1a&/api/v1/admin/purchase-limits/{sku_id}\x12\xbe\x01\n" +
+	"\x10GetPurchaseLimit\x12 .order.order.GetPurchaseLimitReq\x1a!.order.order.GetPurchaseLimitResp\"e\x92A4\x12\x12获取限购规则\x1a\x1e获取商品SKU的限购规则\x82\xd3\xe4\x93\x02(\x12&/api/v1/admin/purchase-limits/{sku_id}\x12\xdf\x01\n" +
+	"\x13DeletePurchaseLimit\x12#.order.order.DeletePurchaseLimitReq\x1a$.order.order.DeletePurchaseLimitResp\"}\x92AL\x12\x12删除限购规则\x1a6删除商品SKU的限购规则，删除后不再限购\x82\xd3\xe4\x93\x02(*&/api/v1/admin/purchase-limits/{sku_id}\x12\xb7\x02\n" +
+	"\x14CreateCouponTemplate\x12$.order.order.CreateCouponTemplateReq\x1a%.order.order.CreateCouponTemplateResp\"\xd1\x01\x92A\xa4\x01\x12\x15创建优惠券模板\x1a\x8a\x01创建立减券、折扣券或满减券，可限定适用的分类、品牌或SKU，设置发行总量、每人限领和能否叠加使用\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/admin/coupon-templates\x12\xf2\x01\n" +
+	"\vClaimCoupon\x12\x1b.order.order.ClaimCouponReq\x1a\x1c.order.order.ClaimCouponResp\"\xa7\x01\x92Am\x12\x0f领取优惠券\x1aZ领取优惠券到当前用户的券包，超过发行总量或每人限领时领取失败\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/coupon-templates/{template_id}/claim\x12\xbc\x01\n" +
+	"\rListMyCoupons\x12\x1d.order.order.ListMyCouponsReq\x1a\x1e.order.order.ListMyCouponsResp\"l\x92AR\x12\x0f我的优惠券\x1a?获取当前用户券包中的优惠券，按过期时间正序\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/coupons\x12\xbe\x02\n" +
+	"\x15CreateFreightTemplate\x12%.order.order.CreateFreightTemplateReq\x1a&.order.order.CreateFreightTemplateResp\"\xd5\x01\x92A\xa7\x01\x12\x12创建运费模板\x1a\x90\x01按首重、续重计费，可按省市设置不同的价格、包邮门槛和偏远地区附加费；设为默认模板时取消原默认模板\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/freight-templates\x12\x82\x02\n" +
+	"\x15UpdateFreightTemplate\x12%.order.order.UpdateFreightTemplateReq\x1a&.order.order.UpdateFreightTemplateResp\"\x99\x01\x92Ag\x12\x12更新运费模板\x1aQ覆盖运费模板的全部计费规则，已签发的报价仍按原运费下单\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/admin/freight-templates/{id}\x12\xd2\x01\n" +
+	"\x14ListFreightTemplates\x12$.order.order.ListFreightTemplatesReq\x1a%.order.order.ListFreightTemplatesResp\"m\x92AC\x12\x12运费模板列表\x1a-获取全部运费模板，默认模板在前\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/admin/freight-templates\x12\xbf\x02\n" +
+	"\x19SetProductFreightTemplate\x12).order.order.SetProductFreightTemplateReq\x1a*.order.order.SetProductFreightTemplateResp\"\xca\x01\x92A\x87\x01\x12\x18设置商品运费模板\x1ak为商品绑定运费模板，模板ID为空时解除绑定，改用默认模板；虚拟商品不计运费\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/admin/products/{product_id}/freight-template\x12\x97\x02\n" +
+	"\x0fCreateFlashSale\x12\x1f.order.order.CreateFlashSaleReq\x1a .order.order.CreateFlashSaleResp\"\xc0\x01\x92A\x98\x01\x12\x12创建秒杀活动\x1a\x81\x01为单个SKU创建秒杀活动，活动开始前库存自动预热到 Redis，预热数量不超过库存服务中的可用库存\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/admin/flash-sales\x12\xb7\x01\n" +
+	"\x0eListFlashSales\x12\x1e.order.order.ListFlashSalesReq\x1a\x1f.order.order.ListFlashSalesResp\"d\x92AF\x12\x12秒杀活动列表\x1a0获取全部秒杀活动，按开始时间倒序\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/flash-sales\x12\x90\x02\n" +
+	"\x11PurchaseFlashSale\x12!.order.order.PurchaseFlashSaleReq\x1a\".order.order.PurchaseFlashSaleResp\"\xb3\x01\x92A\x7f\x12\x06抢购\x1au扣减活动库存后排队异步创建订单，返回排队中的抢购记录，通过抢购结果接口查询订单\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/flash-sales/{sale_id}/purchase\x12\xe2\x01\n" +
+	"\x12GetFlashSaleTicket\x12\".order.order.GetFlashSaleTicketReq\x1a#.order.order.GetFlashSaleTicketResp\"\x82\x01\x92AQ\x12\f抢购结果\x1aA获取抢购记录的状态，订单创建成功后返回订单ID\x82\xd3\xe4\x93\x02(\x12&/api/v1/flash-sale-tickets/{ticket_id}\x12\x94\x02\n" +
+	"\rCreatePresale\x12\x1d.order.order.CreatePresaleReq\x1a\x1e.order.order.CreatePresaleResp\"\xc3\x01\x92A\x9e\x01\x12\x12创建预售活动\x1a\x87\x01为单个SKU创建定金预售活动，定金期在尾款期之前，定金规则决定未付尾款的订单取消时定金是否退还\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/admin/presales\x12\xb4\x01\n" +
+	"\fListPresales\x12\x1c.order.order.ListPresalesReq\x1a\x1d.order.order.ListPresalesResp\"g\x92AL\x12\x12预售活动列表\x1a6获取全部预售活动，按定金开始时间倒序\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/presales\x12\x97\x03\n" +
+	"\x12CreatePresaleOrder\x12\".order.order.CreatePresaleOrderReq\x1a#.order.order.CreatePresaleOrderResp\"\xb7\x02\x92A\x84\x02\x12\f预售下单\x1a\xf3\x01定金期内按预售价下单，订单分定金、尾款两个支付阶段，在支付服务中对应两个支付单；尾款期开始后自动创建尾款支付单，尾款截止前未支付的订单自动取消，库存保留到尾款截止\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/presales/{presale_id}/ordersBHZFgithub.com/people257/poor-guy-shop/order-service/gen/proto/order/orderb\x06proto3"
 
 var (
 	file_order_order_order_proto_rawDescOnce sync.Once
@@ -1713,67 +7639,303 @@ func file_order_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_order_proto_rawDescData
 }
 
-var file_order_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_order_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_order_order_order_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_order_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_order_order_order_proto_goTypes = []any{
-	(OrderStatus)(0),              // 0: order.order.OrderStatus
-	(PaymentMethod)(0),            // 1: order.order.PaymentMethod
-	(*Order)(nil),                 // 2: order.order.Order
-	(*OrderItem)(nil),             // 3: order.order.OrderItem
-	(*OrderAddress)(nil),          // 4: order.order.OrderAddress
-	(*CreateOrderReq)(nil),        // 5: order.order.CreateOrderReq
-	(*OrderItemReq)(nil),          // 6: order.order.OrderItemReq
-	(*OrderAddressReq)(nil),       // 7: order.order.OrderAddressReq
-	(*CreateOrderResp)(nil),       // 8: order.order.CreateOrderResp
-	(*GetOrderReq)(nil),           // 9: order.order.GetOrderReq
-	(*GetOrderResp)(nil),          // 10: order.order.GetOrderResp
-	(*ListOrdersReq)(nil),         // 11: order.order.ListOrdersReq
-	(*ListOrdersResp)(nil),        // 12: order.order.ListOrdersResp
-	(*CancelOrderReq)(nil),        // 13: order.order.CancelOrderReq
-	(*CancelOrderResp)(nil),       // 14: order.order.CancelOrderResp
-	(*ConfirmOrderReq)(nil),       // 15: order.order.ConfirmOrderReq
-	(*ConfirmOrderResp)(nil),      // 16: order.order.ConfirmOrderResp
-	(*PayOrderReq)(nil),           // 17: order.order.PayOrderReq
-	(*PayOrderResp)(nil),          // 18: order.order.PayOrderResp
-	(*UpdateOrderStatusReq)(nil),  // 19: order.order.UpdateOrderStatusReq
-	(*UpdateOrderStatusResp)(nil), // 20: order.order.UpdateOrderStatusResp
-	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(OrderStatus)(0),                      // 0: order.order.OrderStatus
+	(PaymentMethod)(0),                    // 1: order.order.PaymentMethod
+	(TimelineEventType)(0),                // 2: order.order.TimelineEventType
+	(StatsScope)(0),                       // 3: order.order.StatsScope
+	(StatsGranularity)(0),                 // 4: order.order.StatsGranularity
+	(CouponType)(0),                       // 5: order.order.CouponType
+	(CouponScopeType)(0),                  // 6: order.order.CouponScopeType
+	(CouponStatus)(0),                     // 7: order.order.CouponStatus
+	(FlashSaleTicketStatus)(0),            // 8: order.order.FlashSaleTicketStatus
+	(PaymentStage)(0),                     // 9: order.order.PaymentStage
+	(DepositPolicy)(0),                    // 10: order.order.DepositPolicy
+	(*Order)(nil),                         // 11: order.order.Order
+	(*OrderItem)(nil),                     // 12: order.order.OrderItem
+	(*OrderAddress)(nil),                  // 13: order.order.OrderAddress
+	(*CreateOrderReq)(nil),                // 14: order.order.CreateOrderReq
+	(*OrderItemReq)(nil),                  // 15: order.order.OrderItemReq
+	(*OrderAddressReq)(nil),               // 16: order.order.OrderAddressReq
+	(*CreateOrderResp)(nil),               // 17: order.order.CreateOrderResp
+	(*QuoteOrderReq)(nil),                 // 18: order.order.QuoteOrderReq
+	(*QuoteItem)(nil),                     // 19: order.order.QuoteItem
+	(*UnavailableItem)(nil),               // 20: order.order.UnavailableItem
+	(*QuoteOrderResp)(nil),                // 21: order.order.QuoteOrderResp
+	(*AppliedCoupon)(nil),                 // 22: order.order.AppliedCoupon
+	(*CheckoutCartReq)(nil),               // 23: order.order.CheckoutCartReq
+	(*CheckoutCartResp)(nil),              // 24: order.order.CheckoutCartResp
+	(*GetOrderReq)(nil),                   // 25: order.order.GetOrderReq
+	(*GetOrderResp)(nil),                  // 26: order.order.GetOrderResp
+	(*ListOrdersReq)(nil),                 // 27: order.order.ListOrdersReq
+	(*ListOrdersResp)(nil),                // 28: order.order.ListOrdersResp
+	(*CancelOrderReq)(nil),                // 29: order.order.CancelOrderReq
+	(*CancelOrderResp)(nil),               // 30: order.order.CancelOrderResp
+	(*ConfirmOrderReq)(nil),               // 31: order.order.ConfirmOrderReq
+	(*ConfirmOrderResp)(nil),              // 32: order.order.ConfirmOrderResp
+	(*ExtendReceiveReq)(nil),              // 33: order.order.ExtendReceiveReq
+	(*ExtendReceiveResp)(nil),             // 34: order.order.ExtendReceiveResp
+	(*PayOrderReq)(nil),                   // 35: order.order.PayOrderReq
+	(*PayOrderResp)(nil),                  // 36: order.order.PayOrderResp
+	(*UpdateOrderStatusReq)(nil),          // 37: order.order.UpdateOrderStatusReq
+	(*UpdateOrderStatusResp)(nil),         // 38: order.order.UpdateOrderStatusResp
+	(*Shipment)(nil),                      // 39: order.order.Shipment
+	(*ShipmentItem)(nil),                  // 40: order.order.ShipmentItem
+	(*ShipOrderReq)(nil),                  // 41: order.order.ShipOrderReq
+	(*ShipOrderResp)(nil),                 // 42: order.order.ShipOrderResp
+	(*ChangeOrderAddressReq)(nil),         // 43: order.order.ChangeOrderAddressReq
+	(*ChangeOrderAddressResp)(nil),        // 44: order.order.ChangeOrderAddressResp
+	(*TimelineEvent)(nil),                 // 45: order.order.TimelineEvent
+	(*GetOrderTimelineReq)(nil),           // 46: order.order.GetOrderTimelineReq
+	(*GetOrderTimelineResp)(nil),          // 47: order.order.GetOrderTimelineResp
+	(*OrderSearchFilter)(nil),             // 48: order.order.OrderSearchFilter
+	(*SearchOrdersReq)(nil),               // 49: order.order.SearchOrdersReq
+	(*SearchOrdersResp)(nil),              // 50: order.order.SearchOrdersResp
+	(*ExportOrdersReq)(nil),               // 51: order.order.ExportOrdersReq
+	(*ExportOrdersResp)(nil),              // 52: order.order.ExportOrdersResp
+	(*GetOrderStatsReq)(nil),              // 53: order.order.GetOrderStatsReq
+	(*OrderStatusCounts)(nil),             // 54: order.order.OrderStatusCounts
+	(*AmountBucket)(nil),                  // 55: order.order.AmountBucket
+	(*GetOrderStatsResp)(nil),             // 56: order.order.GetOrderStatsResp
+	(*PurchaseLimit)(nil),                 // 57: order.order.PurchaseLimit
+	(*SetPurchaseLimitReq)(nil),           // 58: order.order.SetPurchaseLimitReq
+	(*SetPurchaseLimitResp)(nil),          // 59: order.order.SetPurchaseLimitResp
+	(*GetPurchaseLimitReq)(nil),           // 60: order.order.GetPurchaseLimitReq
+	(*GetPurchaseLimitResp)(nil),          // 61: order.order.GetPurchaseLimitResp
+	(*DeletePurchaseLimitReq)(nil),        // 62: order.order.DeletePurchaseLimitReq
+	(*DeletePurchaseLimitResp)(nil),       // 63: order.order.DeletePurchaseLimitResp
+	(*CouponTemplate)(nil),                // 64: order.order.CouponTemplate
+	(*Coupon)(nil),                        // 65: order.order.Coupon
+	(*CreateCouponTemplateReq)(nil),       // 66: order.order.CreateCouponTemplateReq
+	(*CreateCouponTemplateResp)(nil),      // 67: order.order.CreateCouponTemplateResp
+	(*ClaimCouponReq)(nil),                // 68: order.order.ClaimCouponReq
+	(*ClaimCouponResp)(nil),               // 69: order.order.ClaimCouponResp
+	(*ListMyCouponsReq)(nil),              // 70: order.order.ListMyCouponsReq
+	(*ListMyCouponsResp)(nil),             // 71: order.order.ListMyCouponsResp
+	(*FreightRule)(nil),                   // 72: order.order.FreightRule
+	(*FreightRegion)(nil),                 // 73: order.order.FreightRegion
+	(*FreightTemplate)(nil),               // 74: order.order.FreightTemplate
+	(*CreateFreightTemplateReq)(nil),      // 75: order.order.CreateFreightTemplateReq
+	(*CreateFreightTemplateResp)(nil),     // 76: order.order.CreateFreightTemplateResp
+	(*UpdateFreightTemplateReq)(nil),      // 77: order.order.UpdateFreightTemplateReq
+	(*UpdateFreightTemplateResp)(nil),     // 78: order.order.UpdateFreightTemplateResp
+	(*ListFreightTemplatesReq)(nil),       // 79: order.order.ListFreightTemplatesReq
+	(*ListFreightTemplatesResp)(nil),      // 80: order.order.ListFreightTemplatesResp
+	(*SetProductFreightTemplateReq)(nil),  // 81: order.order.SetProductFreightTemplateReq
+	(*SetProductFreightTemplateResp)(nil), // 82: order.order.SetProductFreightTemplateResp
+	(*FlashSale)(nil),                     // 83: order.order.FlashSale
+	(*FlashSaleTicket)(nil),               // 84: order.order.FlashSaleTicket
+	(*CreateFlashSaleReq)(nil),            // 85: order.order.CreateFlashSaleReq
+	(*CreateFlashSaleResp)(nil),           // 86: order.order.CreateFlashSaleResp
+	(*ListFlashSalesReq)(nil),             // 87: order.order.ListFlashSalesReq
+	(*ListFlashSalesResp)(nil),            // 88: order.order.ListFlashSalesResp
+	(*PurchaseFlashSaleReq)(nil),          // 89: order.order.PurchaseFlashSaleReq
+	(*PurchaseFlashSaleResp)(nil),         // 90: order.order.PurchaseFlashSaleResp
+	(*GetFlashSaleTicketReq)(nil),         // 91: order.order.GetFlashSaleTicketReq
+	(*GetFlashSaleTicketResp)(nil),        // 92: order.order.GetFlashSaleTicketResp
+	(*OrderPaymentStage)(nil),             // 93: order.order.OrderPaymentStage
+	(*Presale)(nil),                       // 94: order.order.Presale
+	(*CreatePresaleReq)(nil),              // 95: order.order.CreatePresaleReq
+	(*CreatePresaleResp)(nil),             // 96: order.order.CreatePresaleResp
+	(*ListPresalesReq)(nil),               // 97: order.order.ListPresalesReq
+	(*ListPresalesResp)(nil),              // 98: order.order.ListPresalesResp
+	(*CreatePresaleOrderReq)(nil),         // 99: order.order.CreatePresaleOrderReq
+	(*CreatePresaleOrderResp)(nil),        // 100: order.order.CreatePresaleOrderResp
+	(*timestamppb.Timestamp)(nil),         // 101: google.protobuf.Timestamp
 }
 var file_order_order_order_proto_depIdxs = []int32{
-	0,  // 0: order.order.Order.status:type_name -> order.order.OrderStatus
-	1,  // 1: order.order.Order.payment_method:type_name -> order.order.PaymentMethod
-	21, // 2: order.order.Order.payment_time:type_name -> google.protobuf.Timestamp
-	21, // 3: order.order.Order.delivery_time:type_name -> google.protobuf.Timestamp
-	21, // 4: order.order.Order.receive_time:type_name -> google.protobuf.Timestamp
-	21, // 5: order.order.Order.cancel_time:type_name -> google.protobuf.Timestamp
-	21, // 6: order.order.Order.created_at:type_name -> google.protobuf.Timestamp
-	21, // 7: order.order.Order.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 8: order.order.Order.items:type_name -> order.order.OrderItem
-	4,  // 9: order.order.Order.address:type_name -> order.order.OrderAddress
-	6,  // 10: order.order.CreateOrderReq.items:type_name -> order.order.OrderItemReq
-	7,  // 11: order.order.CreateOrderReq.address:type_name -> order.order.OrderAddressReq
-	2,  // 12: order.order.CreateOrderResp.order:type_name -> order.order.Order
-	2,  // 13: order.order.GetOrderResp.order:type_name -> order.order.Order
-	2,  // 14: order.order.ListOrdersResp.orders:type_name -> order.order.Order
-	5,  // 15: order.order.OrderService.CreateOrder:input_type -> order.order.CreateOrderReq
-	9,  // 16: order.order.OrderService.GetOrder:input_type -> order.order.GetOrderReq
-	11, // 17: order.order.OrderService.ListOrders:input_type -> order.order.ListOrdersReq
-	13, // 18: order.order.OrderService.CancelOrder:input_type -> order.order.CancelOrderReq
-	15, // 19: order.order.OrderService.ConfirmOrder:input_type -> order.order.ConfirmOrderReq
-	17, // 20: order.order.OrderService.PayOrder:input_type -> order.order.PayOrderReq
-	19, // 21: order.order.OrderService.UpdateOrderStatus:input_type -> order.order.UpdateOrderStatusReq
-	8,  // 22: order.order.OrderService.CreateOrder:output_type -> order.order.CreateOrderResp
-	10, // 23: order.order.OrderService.GetOrder:output_type -> order.order.GetOrderResp
-	12, // 24: order.order.OrderService.ListOrders:output_type -> order.order.ListOrdersResp
-	14, // 25: order.order.OrderService.CancelOrder:output_type -> order.order.CancelOrderResp
-	16, // 26: order.order.OrderService.ConfirmOrder:output_type -> order.order.ConfirmOrderResp
-	18, // 27: order.order.OrderService.PayOrder:output_type -> order.order.PayOrderResp
-	20, // 28: order.order.OrderService.UpdateOrderStatus:output_type -> order.order.UpdateOrderStatusResp
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	0,   // 0: order.order.Order.status:type_name -> order.order.OrderStatus
+	1,   // 1: order.order.Order.payment_method:type_name -> order.order.PaymentMethod
+	101, // 2: order.order.Order.payment_time:type_name -> google.protobuf.Timestamp
+	101, // 3: order.order.Order.delivery_time:type_name -> google.protobuf.Timestamp
+	101, // 4: order.order.Order.receive_time:type_name -> google.protobuf.Timestamp
+	101, // 5: order.order.Order.cancel_time:type_name -> google.protobuf.Timestamp
+	101, // 6: order.order.Order.created_at:type_name -> google.protobuf.Timestamp
+	101, // 7: order.order.Order.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 8: order.order.Order.items:type_name -> order.order.OrderItem
+	13,  // 9: order.order.Order.address:type_name -> order.order.OrderAddress
+	101, // 10: order.order.Order.payment_deadline:type_name -> google.protobuf.Timestamp
+	101, // 11: order.order.Order.auto_confirm_deadline:type_name -> google.protobuf.Timestamp
+	93,  // 12: order.order.Order.payment_stages:type_name -> order.order.OrderPaymentStage
+	15,  // 13: order.order.CreateOrderReq.items:type_name -> order.order.OrderItemReq
+	16,  // 14: order.order.CreateOrderReq.address:type_name -> order.order.OrderAddressReq
+	11,  // 15: order.order.CreateOrderResp.order:type_name -> order.order.Order
+	15,  // 16: order.order.QuoteOrderReq.items:type_name -> order.order.OrderItemReq
+	16,  // 17: order.order.QuoteOrderReq.address:type_name -> order.order.OrderAddressReq
+	19,  // 18: order.order.QuoteOrderResp.items:type_name -> order.order.QuoteItem
+	20,  // 19: order.order.QuoteOrderResp.unavailable_items:type_name -> order.order.UnavailableItem
+	101, // 20: order.order.QuoteOrderResp.expires_at:type_name -> google.protobuf.Timestamp
+	22,  // 21: order.order.QuoteOrderResp.coupons:type_name -> order.order.AppliedCoupon
+	11,  // 22: order.order.CheckoutCartResp.order:type_name -> order.order.Order
+	11,  // 23: order.order.GetOrderResp.order:type_name -> order.order.Order
+	11,  // 24: order.order.ListOrdersResp.orders:type_name -> order.order.Order
+	101, // 25: order.order.ExtendReceiveResp.auto_confirm_deadline:type_name -> google.protobuf.Timestamp
+	40,  // 26: order.order.Shipment.items:type_name -> order.order.ShipmentItem
+	101, // 27: order.order.Shipment.shipped_at:type_name -> google.protobuf.Timestamp
+	101, // 28: order.order.Shipment.created_at:type_name -> google.protobuf.Timestamp
+	40,  // 29: order.order.ShipOrderReq.items:type_name -> order.order.ShipmentItem
+	39,  // 30: order.order.ShipOrderResp.shipment:type_name -> order.order.Shipment
+	0,   // 31: order.order.ShipOrderResp.order_status:type_name -> order.order.OrderStatus
+	16,  // 32: order.order.ChangeOrderAddressReq.address:type_name -> order.order.OrderAddressReq
+	13,  // 33: order.order.ChangeOrderAddressResp.address:type_name -> order.order.OrderAddress
+	2,   // 34: order.order.TimelineEvent.type:type_name -> order.order.TimelineEventType
+	0,   // 35: order.order.TimelineEvent.from_status:type_name -> order.order.OrderStatus
+	0,   // 36: order.order.TimelineEvent.to_status:type_name -> order.order.OrderStatus
+	1,   // 37: order.order.TimelineEvent.payment_method:type_name -> order.order.PaymentMethod
+	39,  // 38: order.order.TimelineEvent.shipment:type_name -> order.order.Shipment
+	101, // 39: order.order.TimelineEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	45,  // 40: order.order.GetOrderTimelineResp.events:type_name -> order.order.TimelineEvent
+	0,   // 41: order.order.OrderSearchFilter.statuses:type_name -> order.order.OrderStatus
+	101, // 42: order.order.OrderSearchFilter.created_from:type_name -> google.protobuf.Timestamp
+	101, // 43: order.order.OrderSearchFilter.created_to:type_name -> google.protobuf.Timestamp
+	101, // 44: order.order.OrderSearchFilter.paid_from:type_name -> google.protobuf.Timestamp
+	101, // 45: order.order.OrderSearchFilter.paid_to:type_name -> google.protobuf.Timestamp
+	48,  // 46: order.order.SearchOrdersReq.filter:type_name -> order.order.OrderSearchFilter
+	11,  // 47: order.order.SearchOrdersResp.orders:type_name -> order.order.Order
+	48,  // 48: order.order.ExportOrdersReq.filter:type_name -> order.order.OrderSearchFilter
+	3,   // 49: order.order.GetOrderStatsReq.scope:type_name -> order.order.StatsScope
+	4,   // 50: order.order.GetOrderStatsReq.granularity:type_name -> order.order.StatsGranularity
+	101, // 51: order.order.GetOrderStatsReq.start_time:type_name -> google.protobuf.Timestamp
+	101, // 52: order.order.GetOrderStatsReq.end_time:type_name -> google.protobuf.Timestamp
+	54,  // 53: order.order.GetOrderStatsResp.counts:type_name -> order.order.OrderStatusCounts
+	55,  // 54: order.order.GetOrderStatsResp.buckets:type_name -> order.order.AmountBucket
+	101, // 55: order.order.GetOrderStatsResp.start_time:type_name -> google.protobuf.Timestamp
+	101, // 56: order.order.GetOrderStatsResp.end_time:type_name -> google.protobuf.Timestamp
+	101, // 57: order.order.PurchaseLimit.created_at:type_name -> google.protobuf.Timestamp
+	101, // 58: order.order.PurchaseLimit.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 59: order.order.SetPurchaseLimitResp.limit:type_name -> order.order.PurchaseLimit
+	57,  // 60: order.order.GetPurchaseLimitResp.limit:type_name -> order.order.PurchaseLimit
+	5,   // 61: order.order.CouponTemplate.type:type_name -> order.order.CouponType
+	6,   // 62: order.order.CouponTemplate.scope_type:type_name -> order.order.CouponScopeType
+	101, // 63: order.order.CouponTemplate.valid_from:type_name -> google.protobuf.Timestamp
+	101, // 64: order.order.CouponTemplate.valid_to:type_name -> google.protobuf.Timestamp
+	101, // 65: order.order.CouponTemplate.created_at:type_name -> google.protobuf.Timestamp
+	64,  // 66: order.order.Coupon.template:type_name -> order.order.CouponTemplate
+	7,   // 67: order.order.Coupon.status:type_name -> order.order.CouponStatus
+	101, // 68: order.order.Coupon.valid_from:type_name -> google.protobuf.Timestamp
+	101, // 69: order.order.Coupon.valid_to:type_name -> google.protobuf.Timestamp
+	101, // 70: order.order.Coupon.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 71: order.order.CreateCouponTemplateReq.type:type_name -> order.order.CouponType
+	6,   // 72: order.order.CreateCouponTemplateReq.scope_type:type_name -> order.order.CouponScopeType
+	101, // 73: order.order.CreateCouponTemplateReq.valid_from:type_name -> google.protobuf.Timestamp
+	101, // 74: order.order.CreateCouponTemplateReq.valid_to:type_name -> google.protobuf.Timestamp
+	64,  // 75: order.order.CreateCouponTemplateResp.template:type_name -> order.order.CouponTemplate
+	65,  // 76: order.order.ClaimCouponResp.coupon:type_name -> order.order.Coupon
+	7,   // 77: order.order.ListMyCouponsReq.status:type_name -> order.order.CouponStatus
+	65,  // 78: order.order.ListMyCouponsResp.coupons:type_name -> order.order.Coupon
+	72,  // 79: order.order.FreightRegion.rule:type_name -> order.order.FreightRule
+	72,  // 80: order.order.FreightTemplate.default_rule:type_name -> order.order.FreightRule
+	73,  // 81: order.order.FreightTemplate.regions:type_name -> order.order.FreightRegion
+	101, // 82: order.order.FreightTemplate.created_at:type_name -> google.protobuf.Timestamp
+	101, // 83: order.order.FreightTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 84: order.order.CreateFreightTemplateReq.template:type_name -> order.order.FreightTemplate
+	74,  // 85: order.order.CreateFreightTemplateResp.template:type_name -> order.order.FreightTemplate
+	74,  // 86: order.order.UpdateFreightTemplateReq.template:type_name -> order.order.FreightTemplate
+	74,  // 87: order.order.UpdateFreightTemplateResp.template:type_name -> order.order.FreightTemplate
+	74,  // 88: order.order.ListFreightTemplatesResp.templates:type_name -> order.order.FreightTemplate
+	101, // 89: order.order.FlashSale.start_at:type_name -> google.protobuf.Timestamp
+	101, // 90: order.order.FlashSale.end_at:type_name -> google.protobuf.Timestamp
+	101, // 91: order.order.FlashSale.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 92: order.order.FlashSaleTicket.status:type_name -> order.order.FlashSaleTicketStatus
+	101, // 93: order.order.FlashSaleTicket.created_at:type_name -> google.protobuf.Timestamp
+	101, // 94: order.order.FlashSaleTicket.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 95: order.order.CreateFlashSaleReq.start_at:type_name -> google.protobuf.Timestamp
+	101, // 96: order.order.CreateFlashSaleReq.end_at:type_name -> google.protobuf.Timestamp
+	83,  // 97: order.order.CreateFlashSaleResp.sale:type_name -> order.order.FlashSale
+	83,  // 98: order.order.ListFlashSalesResp.sales:type_name -> order.order.FlashSale
+	84,  // 99: order.order.PurchaseFlashSaleResp.ticket:type_name -> order.order.FlashSaleTicket
+	84,  // 100: order.order.GetFlashSaleTicketResp.ticket:type_name -> order.order.FlashSaleTicket
+	9,   // 101: order.order.OrderPaymentStage.stage:type_name -> order.order.PaymentStage
+	101, // 102: order.order.OrderPaymentStage.payable_from:type_name -> google.protobuf.Timestamp
+	101, // 103: order.order.OrderPaymentStage.deadline:type_name -> google.protobuf.Timestamp
+	101, // 104: order.order.OrderPaymentStage.paid_at:type_name -> google.protobuf.Timestamp
+	10,  // 105: order.order.Presale.deposit_policy:type_name -> order.order.DepositPolicy
+	101, // 106: order.order.Presale.deposit_start_at:type_name -> google.protobuf.Timestamp
+	101, // 107: order.order.Presale.deposit_end_at:type_name -> google.protobuf.Timestamp
+	101, // 108: order.order.Presale.balance_start_at:type_name -> google.protobuf.Timestamp
+	101, // 109: order.order.Presale.balance_end_at:type_name -> google.protobuf.Timestamp
+	101, // 110: order.order.Presale.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 111: order.order.CreatePresaleReq.deposit_policy:type_name -> order.order.DepositPolicy
+	101, // 112: order.order.CreatePresaleReq.deposit_start_at:type_name -> google.protobuf.Timestamp
+	101, // 113: order.order.CreatePresaleReq.deposit_end_at:type_name -> google.protobuf.Timestamp
+	101, // 114: order.order.CreatePresaleReq.balance_start_at:type_name -> google.protobuf.Timestamp
+	101, // 115: order.order.CreatePresaleReq.balance_end_at:type_name -> google.protobuf.Timestamp
+	94,  // 116: order.order.CreatePresaleResp.presale:type_name -> order.order.Presale
+	94,  // 117: order.order.ListPresalesResp.presales:type_name -> order.order.Presale
+	11,  // 118: order.order.CreatePresaleOrderResp.order:type_name -> order.order.Order
+	14,  // 119: order.order.OrderService.CreateOrder:input_type -> order.order.CreateOrderReq
+	23,  // 120: order.order.OrderService.CheckoutCart:input_type -> order.order.CheckoutCartReq
+	18,  // 121: order.order.OrderService.QuoteOrder:input_type -> order.order.QuoteOrderReq
+	25,  // 122: order.order.OrderService.GetOrder:input_type -> order.order.GetOrderReq
+	27,  // 123: order.order.OrderService.ListOrders:input_type -> order.order.ListOrdersReq
+	29,  // 124: order.order.OrderService.CancelOrder:input_type -> order.order.CancelOrderReq
+	31,  // 125: order.order.OrderService.ConfirmOrder:input_type -> order.order.ConfirmOrderReq
+	33,  // 126: order.order.OrderService.ExtendReceive:input_type -> order.order.ExtendReceiveReq
+	35,  // 127: order.order.OrderService.PayOrder:input_type -> order.order.PayOrderReq
+	37,  // 128: order.order.OrderService.UpdateOrderStatus:input_type -> order.order.UpdateOrderStatusReq
+	41,  // 129: order.order.OrderService.ShipOrder:input_type -> order.order.ShipOrderReq
+	43,  // 130: order.order.OrderService.ChangeOrderAddress:input_type -> order.order.ChangeOrderAddressReq
+	46,  // 131: order.order.OrderService.GetOrderTimeline:input_type -> order.order.GetOrderTimelineReq
+	49,  // 132: order.order.OrderService.SearchOrders:input_type -> order.order.SearchOrdersReq
+	51,  // 133: order.order.OrderService.ExportOrders:input_type -> order.order.ExportOrdersReq
+	53,  // 134: order.order.OrderService.GetOrderStats:input_type -> order.order.GetOrderStatsReq
+	58,  // 135: order.order.OrderService.SetPurchaseLimit:input_type -> order.order.SetPurchaseLimitReq
+	60,  // 136: order.order.OrderService.GetPurchaseLimit:input_type -> order.order.GetPurchaseLimitReq
+	62,  // 137: order.order.OrderService.DeletePurchaseLimit:input_type -> order.order.DeletePurchaseLimitReq
+	66,  // 138: order.order.OrderService.CreateCouponTemplate:input_type -> order.order.CreateCouponTemplateReq
+	68,  // 139: order.order.OrderService.ClaimCoupon:input_type -> order.order.ClaimCouponReq
+	70,  // 140: order.order.OrderService.ListMyCoupons:input_type -> order.order.ListMyCouponsReq
+	75,  // 141: order.order.OrderService.CreateFreightTemplate:input_type -> order.order.CreateFreightTemplateReq
+	77,  // 142: order.order.OrderService.UpdateFreightTemplate:input_type -> order.order.UpdateFreightTemplateReq
+	79,  // 143: order.order.OrderService.ListFreightTemplates:input_type -> order.order.ListFreightTemplatesReq
+	81,  // 144: order.order.OrderService.SetProductFreightTemplate:input_type -> order.order.SetProductFreightTemplateReq
+	85,  // 145: order.order.OrderService.CreateFlashSale:input_type -> order.order.CreateFlashSaleReq
+	87,  // 146: order.order.OrderService.ListFlashSales:input_type -> order.order.ListFlashSalesReq
+	89,  // 147: order.order.OrderService.PurchaseFlashSale:input_type -> order.order.PurchaseFlashSaleReq
+	91,  // 148: order.order.OrderService.GetFlashSaleTicket:input_type -> order.order.GetFlashSaleTicketReq
+	95,  // 149: order.order.OrderService.CreatePresale:input_type -> order.order.CreatePresaleReq
+	97,  // 150: order.order.OrderService.ListPresales:input_type -> order.order.ListPresalesReq
+	99,  // 151: order.order.OrderService.CreatePresaleOrder:input_type -> order.order.CreatePresaleOrderReq
+	17,  // 152: order.order.OrderService.CreateOrder:output_type -> order.order.CreateOrderResp
+	24,  // 153: order.order.OrderService.CheckoutCart:output_type -> order.order.CheckoutCartResp
+	21,  // 154: order.order.OrderService.QuoteOrder:output_type -> order.order.QuoteOrderResp
+	26,  // 155: order.order.OrderService.GetOrder:output_type -> order.order.GetOrderResp
+	28,  // 156: order.order.OrderService.ListOrders:output_type -> order.order.ListOrdersResp
+	30,  // 157: order.order.OrderService.CancelOrder:output_type -> order.order.CancelOrderResp
+	32,  // 158: order.order.OrderService.ConfirmOrder:output_type -> order.order.ConfirmOrderResp
+	34,  // 159: order.order.OrderService.ExtendReceive:output_type -> order.order.ExtendReceiveResp
+	36,  // 160: order.order.OrderService.PayOrder:output_type -> order.order.PayOrderResp
+	38,  // 161: order.order.OrderService.UpdateOrderStatus:output_type -> order.order.UpdateOrderStatusResp
+	42,  // 162: order.order.OrderService.ShipOrder:output_type -> order.order.ShipOrderResp
+	44,  // 163: order.order.OrderService.ChangeOrderAddress:output_type -> order.order.ChangeOrderAddressResp
+	47,  // 164: order.order.OrderService.GetOrderTimeline:output_type -> order.order.GetOrderTimelineResp
+	50,  // 165: order.order.OrderService.SearchOrders:output_type -> order.order.SearchOrdersResp
+	52,  // 166: order.order.OrderService.ExportOrders:output_type -> order.order.ExportOrdersResp
+	56,  // 167: order.order.OrderService.GetOrderStats:output_type -> order.order.GetOrderStatsResp
+	59,  // 168: order.order.OrderService.SetPurchaseLimit:output_type -> order.order.SetPurchaseLimitResp
+	61,  // 169: order.order.OrderService.GetPurchaseLimit:output_type -> order.order.GetPurchaseLimitResp
+	63,  // 170: order.order.OrderService.DeletePurchaseLimit:output_type -> order.order.DeletePurchaseLimitResp
+	67,  // 171: order.order.OrderService.CreateCouponTemplate:output_type -> order.order.CreateCouponTemplateResp
+	69,  // 172: order.order.OrderService.ClaimCoupon:output_type -> order.order.ClaimCouponResp
+	71,  // 173: order.order.OrderService.ListMyCoupons:output_type -> order.order.ListMyCouponsResp
+	76,  // 174: order.order.OrderService.CreateFreightTemplate:output_type -> order.order.CreateFreightTemplateResp
+	78,  // 175: order.order.OrderService.UpdateFreightTemplate:output_type -> order.order.UpdateFreightTemplateResp
+	80,  // 176: order.order.OrderService.ListFreightTemplates:output_type -> order.order.ListFreightTemplatesResp
+	82,  // 177: order.order.OrderService.SetProductFreightTemplate:output_type -> order.order.SetProductFreightTemplateResp
+	86,  // 178: order.order.OrderService.CreateFlashSale:output_type -> order.order.CreateFlashSaleResp
+	88,  // 179: order.order.OrderService.ListFlashSales:output_type -> order.order.ListFlashSalesResp
+	90,  // 180: order.order.OrderService.PurchaseFlashSale:output_type -> order.order.PurchaseFlashSaleResp
+	92,  // 181: order.order.OrderService.GetFlashSaleTicket:output_type -> order.order.GetFlashSaleTicketResp
+	96,  // 182: order.order.OrderService.CreatePresale:output_type -> order.order.CreatePresaleResp
+	98,  // 183: order.order.OrderService.ListPresales:output_type -> order.order.ListPresalesResp
+	100, // 184: order.order.OrderService.CreatePresaleOrder:output_type -> order.order.CreatePresaleOrderResp
+	152, // [152:185] is the sub-list for method output_type
+	119, // [119:152] is the sub-list for method input_type
+	119, // [119:119] is the sub-list for extension type_name
+	119, // [119:119] is the sub-list for extension extendee
+	0,   // [0:119] is the sub-list for field type_name
 }
 
 func init() { file_order_order_order_proto_init() }
@@ -1786,8 +7948,8 @@ func file_order_order_order_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_order_proto_rawDesc), len(file_order_order_order_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      11,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
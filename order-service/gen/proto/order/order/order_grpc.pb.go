@@ -19,13 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName       = "/order.order.OrderService/CreateOrder"
-	OrderService_GetOrder_FullMethodName          = "/order.order.OrderService/GetOrder"
-	OrderService_ListOrders_FullMethodName        = "/order.order.OrderService/ListOrders"
-	OrderService_CancelOrder_FullMethodName       = "/order.order.OrderService/CancelOrder"
-	OrderService_ConfirmOrder_FullMethodName      = "/order.order.OrderService/ConfirmOrder"
-	OrderService_PayOrder_FullMethodName          = "/order.order.OrderService/PayOrder"
-	OrderService_UpdateOrderStatus_FullMethodName = "/order.order.OrderService/UpdateOrderStatus"
+	OrderService_CreateOrder_FullMethodName               = "/order.order.OrderService/CreateOrder"
+	OrderService_CheckoutCart_FullMethodName              = "/order.order.OrderService/CheckoutCart"
+	OrderService_QuoteOrder_FullMethodName                = "/order.order.OrderService/QuoteOrder"
+	OrderService_GetOrder_FullMethodName                  = "/order.order.OrderService/GetOrder"
+	OrderService_ListOrders_FullMethodName                = "/order.order.OrderService/ListOrders"
+	OrderService_CancelOrder_FullMethodName               = "/order.order.OrderService/CancelOrder"
+	OrderService_ConfirmOrder_FullMethodName              = "/order.order.OrderService/ConfirmOrder"
+	OrderService_ExtendReceive_FullMethodName             = "/order.order.OrderService/ExtendReceive"
+	OrderService_PayOrder_FullMethodName                  = "/order.order.OrderService/PayOrder"
+	OrderService_UpdateOrderStatus_FullMethodName         = "/order.order.OrderService/UpdateOrderStatus"
+	OrderService_ShipOrder_FullMethodName                 = "/order.order.OrderService/ShipOrder"
+	OrderService_ChangeOrderAddress_FullMethodName        = "/order.order.OrderService/ChangeOrderAddress"
+	OrderService_GetOrderTimeline_FullMethodName          = "/order.order.OrderService/GetOrderTimeline"
+	OrderService_SearchOrders_FullMethodName              = "/order.order.OrderService/SearchOrders"
+	OrderService_ExportOrders_FullMethodName              = "/order.order.OrderService/ExportOrders"
+	OrderService_GetOrderStats_FullMethodName             = "/order.order.OrderService/GetOrderStats"
+	OrderService_SetPurchaseLimit_FullMethodName          = "/order.order.OrderService/SetPurchaseLimit"
+	OrderService_GetPurchaseLimit_FullMethodName          = "/order.order.OrderService/GetPurchaseLimit"
+	OrderService_DeletePurchaseLimit_FullMethodName       = "/order.order.OrderService/DeletePurchaseLimit"
+	OrderService_CreateCouponTemplate_FullMethodName      = "/order.order.OrderService/CreateCouponTemplate"
+	OrderService_ClaimCoupon_FullMethodName               = "/order.order.OrderService/ClaimCoupon"
+	OrderService_ListMyCoupons_FullMethodName             = "/order.order.OrderService/ListMyCoupons"
+	OrderService_CreateFreightTemplate_FullMethodName     = "/order.order.OrderService/CreateFreightTemplate"
+	OrderService_UpdateFreightTemplate_FullMethodName     = "/order.order.OrderService/UpdateFreightTemplate"
+	OrderService_ListFreightTemplates_FullMethodName      = "/order.order.OrderService/ListFreightTemplates"
+	OrderService_SetProductFreightTemplate_FullMethodName = "/order.order.OrderService/SetProductFreightTemplate"
+	OrderService_CreateFlashSale_FullMethodName           = "/order.order.OrderService/CreateFlashSale"
+	OrderService_ListFlashSales_FullMethodName            = "/order.order.OrderService/ListFlashSales"
+	OrderService_PurchaseFlashSale_FullMethodName         = "/order.order.OrderService/PurchaseFlashSale"
+	OrderService_GetFlashSaleTicket_FullMethodName        = "/order.order.OrderService/GetFlashSaleTicket"
+	OrderService_CreatePresale_FullMethodName             = "/order.order.OrderService/CreatePresale"
+	OrderService_ListPresales_FullMethodName              = "/order.order.OrderService/ListPresales"
+	OrderService_CreatePresaleOrder_FullMethodName        = "/order.order.OrderService/CreatePresaleOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -36,6 +62,10 @@ const (
 type OrderServiceClient interface {
 	// 创建订单
 	CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*CreateOrderResp, error)
+	// 购物车结算
+	CheckoutCart(ctx context.Context, in *CheckoutCartReq, opts ...grpc.CallOption) (*CheckoutCartResp, error)
+	// 下单报价
+	QuoteOrder(ctx context.Context, in *QuoteOrderReq, opts ...grpc.CallOption) (*QuoteOrderResp, error)
 	// 获取订单详情
 	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error)
 	// 获取订单列表
@@ -44,10 +74,58 @@ type OrderServiceClient interface {
 	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error)
 	// 确认收货
 	ConfirmOrder(ctx context.Context, in *ConfirmOrderReq, opts ...grpc.CallOption) (*ConfirmOrderResp, error)
+	// 延长收货
+	ExtendReceive(ctx context.Context, in *ExtendReceiveReq, opts ...grpc.CallOption) (*ExtendReceiveResp, error)
 	// 支付订单
 	PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderResp, error)
 	// 更新订单状态
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusReq, opts ...grpc.CallOption) (*UpdateOrderStatusResp, error)
+	// 订单发货
+	ShipOrder(ctx context.Context, in *ShipOrderReq, opts ...grpc.CallOption) (*ShipOrderResp, error)
+	// 修改收货地址
+	ChangeOrderAddress(ctx context.Context, in *ChangeOrderAddressReq, opts ...grpc.CallOption) (*ChangeOrderAddressResp, error)
+	// 获取订单时间线
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineReq, opts ...grpc.CallOption) (*GetOrderTimelineResp, error)
+	// 运营搜索订单
+	SearchOrders(ctx context.Context, in *SearchOrdersReq, opts ...grpc.CallOption) (*SearchOrdersResp, error)
+	// 导出订单
+	ExportOrders(ctx context.Context, in *ExportOrdersReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersResp], error)
+	// 订单统计
+	GetOrderStats(ctx context.Context, in *GetOrderStatsReq, opts ...grpc.CallOption) (*GetOrderStatsResp, error)
+	// 设置限购规则
+	SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitReq, opts ...grpc.CallOption) (*SetPurchaseLimitResp, error)
+	// 获取限购规则
+	GetPurchaseLimit(ctx context.Context, in *GetPurchaseLimitReq, opts ...grpc.CallOption) (*GetPurchaseLimitResp, error)
+	// 删除限购规则
+	DeletePurchaseLimit(ctx context.Context, in *DeletePurchaseLimitReq, opts ...grpc.CallOption) (*DeletePurchaseLimitResp, error)
+	// 创建优惠券模板
+	CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateReq, opts ...grpc.CallOption) (*CreateCouponTemplateResp, error)
+	// 领取优惠券
+	ClaimCoupon(ctx context.Context, in *ClaimCouponReq, opts ...grpc.CallOption) (*ClaimCouponResp, error)
+	// 我的优惠券
+	ListMyCoupons(ctx context.Context, in *ListMyCouponsReq, opts ...grpc.CallOption) (*ListMyCouponsResp, error)
+	// 创建运费模板
+	CreateFreightTemplate(ctx context.Context, in *CreateFreightTemplateReq, opts ...grpc.CallOption) (*CreateFreightTemplateResp, error)
+	// 更新运费模板
+	UpdateFreightTemplate(ctx context.Context, in *UpdateFreightTemplateReq, opts ...grpc.CallOption) (*UpdateFreightTemplateResp, error)
+	// 运费模板列表
+	ListFreightTemplates(ctx context.Context, in *ListFreightTemplatesReq, opts ...grpc.CallOption) (*ListFreightTemplatesResp, error)
+	// 设置商品运费模板
+	SetProductFreightTemplate(ctx context.Context, in *SetProductFreightTemplateReq, opts ...grpc.CallOption) (*SetProductFreightTemplateResp, error)
+	// 创建秒杀活动
+	CreateFlashSale(ctx context.Context, in *CreateFlashSaleReq, opts ...grpc.CallOption) (*CreateFlashSaleResp, error)
+	// 秒杀活动列表
+	ListFlashSales(ctx context.Context, in *ListFlashSalesReq, opts ...grpc.CallOption) (*ListFlashSalesResp, error)
+	// 抢购
+	PurchaseFlashSale(ctx context.Context, in *PurchaseFlashSaleReq, opts ...grpc.CallOption) (*PurchaseFlashSaleResp, error)
+	// 抢购结果
+	GetFlashSaleTicket(ctx context.Context, in *GetFlashSaleTicketReq, opts ...grpc.CallOption) (*GetFlashSaleTicketResp, error)
+	// 创建预售活动
+	CreatePresale(ctx context.Context, in *CreatePresaleReq, opts ...grpc.CallOption) (*CreatePresaleResp, error)
+	// 预售活动列表
+	ListPresales(ctx context.Context, in *ListPresalesReq, opts ...grpc.CallOption) (*ListPresalesResp, error)
+	// 预售下单
+	CreatePresaleOrder(ctx context.Context, in *CreatePresaleOrderReq, opts ...grpc.CallOption) (*CreatePresaleOrderResp, error)
 }
 
 type orderServiceClient struct {
@@ -68,6 +146,26 @@ func (c *orderServiceClient) CreateOrder(ctx context.Context, in *CreateOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) CheckoutCart(ctx context.Context, in *CheckoutCartReq, opts ...grpc.CallOption) (*CheckoutCartResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutCartResp)
+	err := c.cc.Invoke(ctx, OrderService_CheckoutCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderReq, opts ...grpc.CallOption) (*QuoteOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_QuoteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrderResp)
@@ -108,6 +206,16 @@ func (c *orderServiceClient) ConfirmOrder(ctx context.Context, in *ConfirmOrderR
 	return out, nil
 }
 
+func (c *orderServiceClient) ExtendReceive(ctx context.Context, in *ExtendReceiveReq, opts ...grpc.CallOption) (*ExtendReceiveResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendReceiveResp)
+	err := c.cc.Invoke(ctx, OrderService_ExtendReceive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PayOrderResp)
@@ -128,6 +236,245 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *orderServiceClient) ShipOrder(ctx context.Context, in *ShipOrderReq, opts ...grpc.CallOption) (*ShipOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_ShipOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ChangeOrderAddress(ctx context.Context, in *ChangeOrderAddressReq, opts ...grpc.CallOption) (*ChangeOrderAddressResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeOrderAddressResp)
+	err := c.cc.Invoke(ctx, OrderService_ChangeOrderAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineReq, opts ...grpc.CallOption) (*GetOrderTimelineResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderTimelineResp)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersReq, opts ...grpc.CallOption) (*SearchOrdersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrdersResp)
+	err := c.cc.Invoke(ctx, OrderService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_ExportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportOrdersReq, ExportOrdersResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ExportOrdersClient = grpc.ServerStreamingClient[ExportOrdersResp]
+
+func (c *orderServiceClient) GetOrderStats(ctx context.Context, in *GetOrderStatsReq, opts ...grpc.CallOption) (*GetOrderStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderStatsResp)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitReq, opts ...grpc.CallOption) (*SetPurchaseLimitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPurchaseLimitResp)
+	err := c.cc.Invoke(ctx, OrderService_SetPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPurchaseLimit(ctx context.Context, in *GetPurchaseLimitReq, opts ...grpc.CallOption) (*GetPurchaseLimitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPurchaseLimitResp)
+	err := c.cc.Invoke(ctx, OrderService_GetPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeletePurchaseLimit(ctx context.Context, in *DeletePurchaseLimitReq, opts ...grpc.CallOption) (*DeletePurchaseLimitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePurchaseLimitResp)
+	err := c.cc.Invoke(ctx, OrderService_DeletePurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateReq, opts ...grpc.CallOption) (*CreateCouponTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCouponTemplateResp)
+	err := c.cc.Invoke(ctx, OrderService_CreateCouponTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ClaimCoupon(ctx context.Context, in *ClaimCouponReq, opts ...grpc.CallOption) (*ClaimCouponResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimCouponResp)
+	err := c.cc.Invoke(ctx, OrderService_ClaimCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListMyCoupons(ctx context.Context, in *ListMyCouponsReq, opts ...grpc.CallOption) (*ListMyCouponsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyCouponsResp)
+	err := c.cc.Invoke(ctx, OrderService_ListMyCoupons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateFreightTemplate(ctx context.Context, in *CreateFreightTemplateReq, opts ...grpc.CallOption) (*CreateFreightTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFreightTemplateResp)
+	err := c.cc.Invoke(ctx, OrderService_CreateFreightTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateFreightTemplate(ctx context.Context, in *UpdateFreightTemplateReq, opts ...grpc.CallOption) (*UpdateFreightTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFreightTemplateResp)
+	err := c.cc.Invoke(ctx, OrderService_UpdateFreightTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListFreightTemplates(ctx context.Context, in *ListFreightTemplatesReq, opts ...grpc.CallOption) (*ListFreightTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFreightTemplatesResp)
+	err := c.cc.Invoke(ctx, OrderService_ListFreightTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetProductFreightTemplate(ctx context.Context, in *SetProductFreightTemplateReq, opts ...grpc.CallOption) (*SetProductFreightTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductFreightTemplateResp)
+	err := c.cc.Invoke(ctx, OrderService_SetProductFreightTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleReq, opts ...grpc.CallOption) (*CreateFlashSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFlashSaleResp)
+	err := c.cc.Invoke(ctx, OrderService_CreateFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListFlashSales(ctx context.Context, in *ListFlashSalesReq, opts ...grpc.CallOption) (*ListFlashSalesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlashSalesResp)
+	err := c.cc.Invoke(ctx, OrderService_ListFlashSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PurchaseFlashSale(ctx context.Context, in *PurchaseFlashSaleReq, opts ...grpc.CallOption) (*PurchaseFlashSaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseFlashSaleResp)
+	err := c.cc.Invoke(ctx, OrderService_PurchaseFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetFlashSaleTicket(ctx context.Context, in *GetFlashSaleTicketReq, opts ...grpc.CallOption) (*GetFlashSaleTicketResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlashSaleTicketResp)
+	err := c.cc.Invoke(ctx, OrderService_GetFlashSaleTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePresale(ctx context.Context, in *CreatePresaleReq, opts ...grpc.CallOption) (*CreatePresaleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePresaleResp)
+	err := c.cc.Invoke(ctx, OrderService_CreatePresale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListPresales(ctx context.Context, in *ListPresalesReq, opts ...grpc.CallOption) (*ListPresalesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPresalesResp)
+	err := c.cc.Invoke(ctx, OrderService_ListPresales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePresaleOrder(ctx context.Context, in *CreatePresaleOrderReq, opts ...grpc.CallOption) (*CreatePresaleOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePresaleOrderResp)
+	err := c.cc.Invoke(ctx, OrderService_CreatePresaleOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations should embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -136,6 +483,10 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 type OrderServiceServer interface {
 	// 创建订单
 	CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderResp, error)
+	// 购物车结算
+	CheckoutCart(context.Context, *CheckoutCartReq) (*CheckoutCartResp, error)
+	// 下单报价
+	QuoteOrder(context.Context, *QuoteOrderReq) (*QuoteOrderResp, error)
 	// 获取订单详情
 	GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error)
 	// 获取订单列表
@@ -144,10 +495,58 @@ type OrderServiceServer interface {
 	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error)
 	// 确认收货
 	ConfirmOrder(context.Context, *ConfirmOrderReq) (*ConfirmOrderResp, error)
+	// 延长收货
+	ExtendReceive(context.Context, *ExtendReceiveReq) (*ExtendReceiveResp, error)
 	// 支付订单
 	PayOrder(context.Context, *PayOrderReq) (*PayOrderResp, error)
 	// 更新订单状态
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusReq) (*UpdateOrderStatusResp, error)
+	// 订单发货
+	ShipOrder(context.Context, *ShipOrderReq) (*ShipOrderResp, error)
+	// 修改收货地址
+	ChangeOrderAddress(context.Context, *ChangeOrderAddressReq) (*ChangeOrderAddressResp, error)
+	// 获取订单时间线
+	GetOrderTimeline(context.Context, *GetOrderTimelineReq) (*GetOrderTimelineResp, error)
+	// 运营搜索订单
+	SearchOrders(context.Context, *SearchOrdersReq) (*SearchOrdersResp, error)
+	// 导出订单
+	ExportOrders(*ExportOrdersReq, grpc.ServerStreamingServer[ExportOrdersResp]) error
+	// 订单统计
+	GetOrderStats(context.Context, *GetOrderStatsReq) (*GetOrderStatsResp, error)
+	// 设置限购规则
+	SetPurchaseLimit(context.Context, *SetPurchaseLimitReq) (*SetPurchaseLimitResp, error)
+	// 获取限购规则
+	GetPurchaseLimit(context.Context, *GetPurchaseLimitReq) (*GetPurchaseLimitResp, error)
+	// 删除限购规则
+	DeletePurchaseLimit(context.Context, *DeletePurchaseLimitReq) (*DeletePurchaseLimitResp, error)
+	// 创建优惠券模板
+	CreateCouponTemplate(context.Context, *CreateCouponTemplateReq) (*CreateCouponTemplateResp, error)
+	// 领取优惠券
+	ClaimCoupon(context.Context, *ClaimCouponReq) (*ClaimCouponResp, error)
+	// 我的优惠券
+	ListMyCoupons(context.Context, *ListMyCouponsReq) (*ListMyCouponsResp, error)
+	// 创建运费模板
+	CreateFreightTemplate(context.Context, *CreateFreightTemplateReq) (*CreateFreightTemplateResp, error)
+	// 更新运费模板
+	UpdateFreightTemplate(context.Context, *UpdateFreightTemplateReq) (*UpdateFreightTemplateResp, error)
+	// 运费模板列表
+	ListFreightTemplates(context.Context, *ListFreightTemplatesReq) (*ListFreightTemplatesResp, error)
+	// 设置商品运费模板
+	SetProductFreightTemplate(context.Context, *SetProductFreightTemplateReq) (*SetProductFreightTemplateResp, error)
+	// 创建秒杀活动
+	CreateFlashSale(context.Context, *CreateFlashSaleReq) (*CreateFlashSaleResp, error)
+	// 秒杀活动列表
+	ListFlashSales(context.Context, *ListFlashSalesReq) (*ListFlashSalesResp, error)
+	// 抢购
+	PurchaseFlashSale(context.Context, *PurchaseFlashSaleReq) (*PurchaseFlashSaleResp, error)
+	// 抢购结果
+	GetFlashSaleTicket(context.Context, *GetFlashSaleTicketReq) (*GetFlashSaleTicketResp, error)
+	// 创建预售活动
+	CreatePresale(context.Context, *CreatePresaleReq) (*CreatePresaleResp, error)
+	// 预售活动列表
+	ListPresales(context.Context, *ListPresalesReq) (*ListPresalesResp, error)
+	// 预售下单
+	CreatePresaleOrder(context.Context, *CreatePresaleOrderReq) (*CreatePresaleOrderResp, error)
 }
 
 // UnimplementedOrderServiceServer should be embedded to have
@@ -160,6 +559,12 @@ type UnimplementedOrderServiceServer struct{}
 func (UnimplementedOrderServiceServer) CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) CheckoutCart(context.Context, *CheckoutCartReq) (*CheckoutCartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutCart not implemented")
+}
+func (UnimplementedOrderServiceServer) QuoteOrder(context.Context, *QuoteOrderReq) (*QuoteOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
@@ -172,12 +577,84 @@ func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrder
 func (UnimplementedOrderServiceServer) ConfirmOrder(context.Context, *ConfirmOrderReq) (*ConfirmOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) ExtendReceive(context.Context, *ExtendReceiveReq) (*ExtendReceiveResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendReceive not implemented")
+}
 func (UnimplementedOrderServiceServer) PayOrder(context.Context, *PayOrderReq) (*PayOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
 }
 func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusReq) (*UpdateOrderStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) ShipOrder(context.Context, *ShipOrderReq) (*ShipOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) ChangeOrderAddress(context.Context, *ChangeOrderAddressReq) (*ChangeOrderAddressResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeOrderAddress not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderTimeline(context.Context, *GetOrderTimelineReq) (*GetOrderTimelineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedOrderServiceServer) SearchOrders(context.Context, *SearchOrdersReq) (*SearchOrdersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportOrders(*ExportOrdersReq, grpc.ServerStreamingServer[ExportOrdersResp]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderStats(context.Context, *GetOrderStatsReq) (*GetOrderStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStats not implemented")
+}
+func (UnimplementedOrderServiceServer) SetPurchaseLimit(context.Context, *SetPurchaseLimitReq) (*SetPurchaseLimitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPurchaseLimit not implemented")
+}
+func (UnimplementedOrderServiceServer) GetPurchaseLimit(context.Context, *GetPurchaseLimitReq) (*GetPurchaseLimitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseLimit not implemented")
+}
+func (UnimplementedOrderServiceServer) DeletePurchaseLimit(context.Context, *DeletePurchaseLimitReq) (*DeletePurchaseLimitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePurchaseLimit not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateCouponTemplate(context.Context, *CreateCouponTemplateReq) (*CreateCouponTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCouponTemplate not implemented")
+}
+func (UnimplementedOrderServiceServer) ClaimCoupon(context.Context, *ClaimCouponReq) (*ClaimCouponResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCoupon not implemented")
+}
+func (UnimplementedOrderServiceServer) ListMyCoupons(context.Context, *ListMyCouponsReq) (*ListMyCouponsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCoupons not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateFreightTemplate(context.Context, *CreateFreightTemplateReq) (*CreateFreightTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFreightTemplate not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateFreightTemplate(context.Context, *UpdateFreightTemplateReq) (*UpdateFreightTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFreightTemplate not implemented")
+}
+func (UnimplementedOrderServiceServer) ListFreightTemplates(context.Context, *ListFreightTemplatesReq) (*ListFreightTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFreightTemplates not implemented")
+}
+func (UnimplementedOrderServiceServer) SetProductFreightTemplate(context.Context, *SetProductFreightTemplateReq) (*SetProductFreightTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductFreightTemplate not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateFlashSale(context.Context, *CreateFlashSaleReq) (*CreateFlashSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlashSale not implemented")
+}
+func (UnimplementedOrderServiceServer) ListFlashSales(context.Context, *ListFlashSalesReq) (*ListFlashSalesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlashSales not implemented")
+}
+func (UnimplementedOrderServiceServer) PurchaseFlashSale(context.Context, *PurchaseFlashSaleReq) (*PurchaseFlashSaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseFlashSale not implemented")
+}
+func (UnimplementedOrderServiceServer) GetFlashSaleTicket(context.Context, *GetFlashSaleTicketReq) (*GetFlashSaleTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlashSaleTicket not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePresale(context.Context, *CreatePresaleReq) (*CreatePresaleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePresale not implemented")
+}
+func (UnimplementedOrderServiceServer) ListPresales(context.Context, *ListPresalesReq) (*ListPresalesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPresales not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePresaleOrder(context.Context, *CreatePresaleOrderReq) (*CreatePresaleOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePresaleOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +693,42 @@ func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CheckoutCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CheckoutCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CheckoutCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CheckoutCart(ctx, req.(*CheckoutCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, req.(*QuoteOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderReq)
 	if err := dec(in); err != nil {
@@ -288,6 +801,24 @@ func _OrderService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ExtendReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendReceiveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ExtendReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ExtendReceive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ExtendReceive(ctx, req.(*ExtendReceiveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayOrderReq)
 	if err := dec(in); err != nil {
@@ -324,17 +855,432 @@ func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var OrderService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "order.order.OrderService",
+func _OrderService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ShipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ShipOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ShipOrder(ctx, req.(*ShipOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ChangeOrderAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrderAddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ChangeOrderAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ChangeOrderAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ChangeOrderAddress(ctx, req.(*ChangeOrderAddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SearchOrders(ctx, req.(*SearchOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).ExportOrders(m, &grpc.GenericServerStream[ExportOrdersReq, ExportOrdersResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ExportOrdersServer = grpc.ServerStreamingServer[ExportOrdersResp]
+
+func _OrderService_GetOrderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderStats(ctx, req.(*GetOrderStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPurchaseLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SetPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetPurchaseLimit(ctx, req.(*SetPurchaseLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPurchaseLimit(ctx, req.(*GetPurchaseLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeletePurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePurchaseLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeletePurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeletePurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeletePurchaseLimit(ctx, req.(*DeletePurchaseLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateCouponTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateCouponTemplate(ctx, req.(*CreateCouponTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ClaimCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ClaimCoupon(ctx, req.(*ClaimCouponReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListMyCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCouponsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListMyCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListMyCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListMyCoupons(ctx, req.(*ListMyCouponsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateFreightTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFreightTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateFreightTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateFreightTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateFreightTemplate(ctx, req.(*CreateFreightTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateFreightTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFreightTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateFreightTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateFreightTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateFreightTemplate(ctx, req.(*UpdateFreightTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListFreightTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFreightTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListFreightTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListFreightTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListFreightTemplates(ctx, req.(*ListFreightTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SetProductFreightTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductFreightTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetProductFreightTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SetProductFreightTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetProductFreightTemplate(ctx, req.(*SetProductFreightTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlashSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateFlashSale(ctx, req.(*CreateFlashSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListFlashSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlashSalesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListFlashSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListFlashSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListFlashSales(ctx, req.(*ListFlashSalesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PurchaseFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseFlashSaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PurchaseFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PurchaseFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PurchaseFlashSale(ctx, req.(*PurchaseFlashSaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetFlashSaleTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlashSaleTicketReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetFlashSaleTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetFlashSaleTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetFlashSaleTicket(ctx, req.(*GetFlashSaleTicketReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePresale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePresaleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePresale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePresale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePresale(ctx, req.(*CreatePresaleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListPresales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPresalesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListPresales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListPresales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListPresales(ctx, req.(*ListPresalesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePresaleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePresaleOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePresaleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePresaleOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePresaleOrder(ctx, req.(*CreatePresaleOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "order.order.OrderService",
 	HandlerType: (*OrderServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CreateOrder",
 			Handler:    _OrderService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "CheckoutCart",
+			Handler:    _OrderService_CheckoutCart_Handler,
+		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _OrderService_QuoteOrder_Handler,
+		},
 		{
 			MethodName: "GetOrder",
 			Handler:    _OrderService_GetOrder_Handler,
@@ -351,6 +1297,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmOrder",
 			Handler:    _OrderService_ConfirmOrder_Handler,
 		},
+		{
+			MethodName: "ExtendReceive",
+			Handler:    _OrderService_ExtendReceive_Handler,
+		},
 		{
 			MethodName: "PayOrder",
 			Handler:    _OrderService_PayOrder_Handler,
@@ -359,7 +1309,101 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _OrderService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "ShipOrder",
+			Handler:    _OrderService_ShipOrder_Handler,
+		},
+		{
+			MethodName: "ChangeOrderAddress",
+			Handler:    _OrderService_ChangeOrderAddress_Handler,
+		},
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _OrderService_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrderService_SearchOrders_Handler,
+		},
+		{
+			MethodName: "GetOrderStats",
+			Handler:    _OrderService_GetOrderStats_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimit",
+			Handler:    _OrderService_SetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "GetPurchaseLimit",
+			Handler:    _OrderService_GetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "DeletePurchaseLimit",
+			Handler:    _OrderService_DeletePurchaseLimit_Handler,
+		},
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _OrderService_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _OrderService_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "ListMyCoupons",
+			Handler:    _OrderService_ListMyCoupons_Handler,
+		},
+		{
+			MethodName: "CreateFreightTemplate",
+			Handler:    _OrderService_CreateFreightTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateFreightTemplate",
+			Handler:    _OrderService_UpdateFreightTemplate_Handler,
+		},
+		{
+			MethodName: "ListFreightTemplates",
+			Handler:    _OrderService_ListFreightTemplates_Handler,
+		},
+		{
+			MethodName: "SetProductFreightTemplate",
+			Handler:    _OrderService_SetProductFreightTemplate_Handler,
+		},
+		{
+			MethodName: "CreateFlashSale",
+			Handler:    _OrderService_CreateFlashSale_Handler,
+		},
+		{
+			MethodName: "ListFlashSales",
+			Handler:    _OrderService_ListFlashSales_Handler,
+		},
+		{
+			MethodName: "PurchaseFlashSale",
+			Handler:    _OrderService_PurchaseFlashSale_Handler,
+		},
+		{
+			MethodName: "GetFlashSaleTicket",
+			Handler:    _OrderService_GetFlashSaleTicket_Handler,
+		},
+		{
+			MethodName: "CreatePresale",
+			Handler:    _OrderService_CreatePresale_Handler,
+		},
+		{
+			MethodName: "ListPresales",
+			Handler:    _OrderService_ListPresales_Handler,
+		},
+		{
+			MethodName: "CreatePresaleOrder",
+			Handler:    _OrderService_CreatePresaleOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _OrderService_ExportOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/order/order.proto",
 }
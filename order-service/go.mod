@@ -3,17 +3,30 @@ module github.com/people257/poor-guy-shop/order-service
 go 1.24.4
 
 require (
+	github.com/alicebob/miniredis/v2 v2.35.0
+	github.com/google/uuid v1.6.0
 	github.com/google/wire v0.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.2
 	github.com/knadh/koanf/parsers/yaml v1.1.0
 	github.com/knadh/koanf/providers/file v1.2.0
 	github.com/knadh/koanf/v2 v2.2.2
+	github.com/labstack/echo/v4 v4.13.4
 	github.com/people257/poor-guy-shop/common/auth v0.0.0-20250811164443-5059310f3e47
 	github.com/people257/poor-guy-shop/common/db v0.0.0-20250820165901-4f14d03768c9
+	github.com/people257/poor-guy-shop/common/rate v0.0.0-00010101000000-000000000000
 	github.com/people257/poor-guy-shop/common/server v0.0.0-20250805161543-d606e53d9e9b
+	github.com/people257/poor-guy-shop/inventory-service v0.0.0-00010101000000-000000000000
+	github.com/people257/poor-guy-shop/oss-infra v0.0.0-00010101000000-000000000000
+	github.com/people257/poor-guy-shop/payment-service v0.0.0-00010101000000-000000000000
+	github.com/people257/poor-guy-shop/product-service v0.0.0-00010101000000-000000000000
+	github.com/people257/poor-guy-shop/user-service v0.0.0-20250902141745-8b28c0fe3f9c
+	github.com/redis/go-redis/v9 v9.12.1
 	github.com/shopspring/decimal v1.4.0
 	github.com/spf13/viper v1.20.1
+	github.com/stretchr/testify v1.10.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20250818200422-3122310a409c
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250818200422-3122310a409c
+	golang.org/x/sync v0.16.0
 	google.golang.org/grpc v1.75.0
 	google.golang.org/protobuf v1.36.7
 	gorm.io/driver/postgres v1.6.0
@@ -34,6 +47,7 @@ require (
 	github.com/armon/go-metrics v0.4.1 // indirect
 	github.com/cenkalti/backoff/v5 v5.0.2 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/fatih/color v1.18.0 // indirect
 	github.com/fsnotify/fsnotify v1.9.0 // indirect
@@ -45,7 +59,6 @@ require (
 	github.com/go-viper/mapstructure/v2 v2.3.0 // indirect
 	github.com/google/btree v1.1.3 // indirect
 	github.com/google/cel-go v0.25.0 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.2 // indirect
 	github.com/hashicorp/consul/api v1.32.1 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
@@ -66,7 +79,6 @@ require (
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/knadh/koanf/maps v0.1.2 // indirect
-	github.com/labstack/echo/v4 v4.13.4 // indirect
 	github.com/labstack/gommon v0.4.2 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
@@ -76,11 +88,10 @@ require (
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
 	github.com/paulmach/orb v0.11.1 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
-	github.com/people257/poor-guy-shop/user-service v0.0.0-20250902141745-8b28c0fe3f9c // indirect
 	github.com/pierrec/lz4/v4 v4.1.22 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/redis/go-redis/extra/rediscmd/v9 v9.12.1 // indirect
 	github.com/redis/go-redis/extra/redisotel/v9 v9.12.1 // indirect
-	github.com/redis/go-redis/v9 v9.12.1 // indirect
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
 	github.com/sagikazarmark/locafero v0.7.0 // indirect
 	github.com/segmentio/asm v1.2.0 // indirect
@@ -92,6 +103,7 @@ require (
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/valyala/bytebufferpool v1.0.0 // indirect
 	github.com/valyala/fasttemplate v1.2.2 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
 	go.opentelemetry.io/contrib/bridges/otelzap v0.12.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.62.0 // indirect
@@ -115,11 +127,9 @@ require (
 	golang.org/x/exp v0.0.0-20250506013437-ce4c2cf36ca6 // indirect
 	golang.org/x/mod v0.27.0 // indirect
 	golang.org/x/net v0.43.0 // indirect
-	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
 	golang.org/x/tools v0.36.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250818200422-3122310a409c // indirect
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	gorm.io/datatypes v1.2.6 // indirect
@@ -140,6 +150,16 @@ replace github.com/people257/poor-guy-shop/common/gateway => ../common/gateway
 
 replace github.com/people257/poor-guy-shop/common/server => ../common/server
 
+replace github.com/people257/poor-guy-shop/common/rate => ../common/rate
+
 replace gorm.io/plugin/dbresolver => gorm.io/plugin/dbresolver v1.6.0
 
 replace github.com/people257/poor-guy-shop/common/auth => ../common/auth
+
+replace github.com/people257/poor-guy-shop/product-service => ../product-service
+
+replace github.com/people257/poor-guy-shop/inventory-service => ../inventory-service
+
+replace github.com/people257/poor-guy-shop/payment-service => ../payment-service
+
+replace github.com/people257/poor-guy-shop/oss-infra => ../oss-infra
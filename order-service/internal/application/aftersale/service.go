@@ -0,0 +1,461 @@
+package aftersale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+)
+
+// Service 售后应用服务
+type Service struct {
+	afterSaleRepo   aftersale.Repository
+	afterSaleDS     aftersale.DomainService
+	orderRepo       order.Repository
+	orderDS         order.DomainService
+	inventoryClient *client.InventoryServiceClient
+	paymentClient   *client.PaymentServiceClient
+	fileClient      *client.FileServiceClient
+}
+
+// NewService 创建售后应用服务
+func NewService(
+	afterSaleRepo aftersale.Repository,
+	afterSaleDS aftersale.DomainService,
+	orderRepo order.Repository,
+	orderDS order.DomainService,
+	inventoryClient *client.InventoryServiceClient,
+	paymentClient *client.PaymentServiceClient,
+	fileClient *client.FileServiceClient,
+) *Service {
+	return &Service{
+		afterSaleRepo:   afterSaleRepo,
+		afterSaleDS:     afterSaleDS,
+		orderRepo:       orderRepo,
+		orderDS:         orderDS,
+		inventoryClient: inventoryClient,
+		paymentClient:   paymentClient,
+		fileClient:      fileClient,
+	}
+}
+
+// ApplyAfterSaleRequest 申请售后请求
+type ApplyAfterSaleRequest struct {
+	UserID      string `json:"user_id"`
+	OrderID     string `json:"order_id"`
+	OrderItemID string `json:"order_item_id"`
+	Type        int32  `json:"type"`
+	Reason      int32  `json:"reason"`
+	Description string `json:"description"`
+	Quantity    int32  `json:"quantity"`
+	// Amount 申请退款金额，为零时按可退金额退款
+	Amount          decimal.Decimal `json:"amount"`
+	EvidenceFileIDs []string        `json:"evidence_file_ids"`
+}
+
+// ApplyAfterSale 买家针对订单商品项申请售后
+func (s *Service) ApplyAfterSale(ctx context.Context, req ApplyAfterSaleRequest) (*aftersale.AfterSale, error) {
+	orderEntity, err := s.orderRepo.GetByID(ctx, req.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	if orderEntity.UserID != req.UserID {
+		return nil, order.ErrOrderNotFound
+	}
+
+	items, err := s.orderRepo.GetOrderItems(ctx, orderEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单商品失败: %w", err)
+	}
+	if err := s.checkEvidenceFiles(ctx, req.UserID, req.EvidenceFileIDs); err != nil {
+		return nil, err
+	}
+
+	afterSale := &aftersale.AfterSale{
+		OrderItemID:     req.OrderItemID,
+		UserID:          req.UserID,
+		Type:            req.Type,
+		Reason:          req.Reason,
+		Description:     req.Description,
+		Quantity:        req.Quantity,
+		RequestedAmount: req.Amount,
+		EvidenceFileIDs: req.EvidenceFileIDs,
+	}
+	if err := s.afterSaleDS.Apply(ctx, orderEntity, items, afterSale); err != nil {
+		return nil, err
+	}
+
+	return afterSale, nil
+}
+
+// checkEvidenceFiles 向 oss-infra 确认凭证文件存在、属于申请人且为图片
+func (s *Service) checkEvidenceFiles(ctx context.Context, userID string, fileIDs []string) error {
+	if err := aftersale.ValidateEvidenceFileIDs(fileIDs); err != nil {
+		return err
+	}
+
+	for _, fileID := range fileIDs {
+		info, err := s.fileClient.GetFileInfo(ctx, fileID)
+		if err != nil {
+			if errors.Is(err, client.ErrFileNotFound) {
+				return fmt.Errorf("%w: %s 不存在", aftersale.ErrInvalidEvidenceFile, fileID)
+			}
+			return fmt.Errorf("校验售后凭证失败: %w", err)
+		}
+		if info.OwnerID != userID {
+			return fmt.Errorf("%w: %s 不属于当前用户", aftersale.ErrInvalidEvidenceFile, fileID)
+		}
+		if !strings.HasPrefix(info.MimeType, "image/") {
+			return fmt.Errorf("%w: %s 不是图片", aftersale.ErrInvalidEvidenceFile, fileID)
+		}
+	}
+	return nil
+}
+
+// GetAfterSale 获取售后申请，userID 为空时不校验归属（运营查看）
+func (s *Service) GetAfterSale(ctx context.Context, afterSaleID, userID string) (*aftersale.AfterSale, error) {
+	afterSale, err := s.afterSaleRepo.GetByID(ctx, afterSaleID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != "" && afterSale.UserID != userID {
+		return nil, aftersale.ErrAfterSaleNotFound
+	}
+
+	return afterSale, nil
+}
+
+// ListAfterSalesRequest 售后申请列表请求
+type ListAfterSalesRequest struct {
+	UserID   string `json:"user_id"`
+	OrderID  string `json:"order_id"`
+	Status   int32  `json:"status"`
+	Page     int32  `json:"page"`
+	PageSize int32  `json:"page_size"`
+}
+
+// ListAfterSalesResponse 售后申请列表响应
+type ListAfterSalesResponse struct {
+	AfterSales []*aftersale.AfterSale `json:"after_sales"`
+	Total      int64                  `json:"total"`
+}
+
+// ListAfterSales 获取用户的售后申请，指定订单时返回该订单的全部售后申请
+func (s *Service) ListAfterSales(ctx context.Context, req ListAfterSalesRequest) (*ListAfterSalesResponse, error) {
+	if req.OrderID != "" {
+		orderEntity, err := s.orderRepo.GetByID(ctx, req.OrderID)
+		if err != nil {
+			return nil, fmt.Errorf("获取订单失败: %w", err)
+		}
+		if orderEntity.UserID != req.UserID {
+			return nil, order.ErrOrderNotFound
+		}
+
+		afterSales, err := s.afterSaleRepo.ListByOrderID(ctx, req.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		return &ListAfterSalesResponse{
+			AfterSales: afterSales,
+			Total:      int64(len(afterSales)),
+		}, nil
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	afterSales, total, err := s.afterSaleRepo.List(ctx, req.UserID, req.Status, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAfterSalesResponse{
+		AfterSales: afterSales,
+		Total:      total,
+	}, nil
+}
+
+// CancelAfterSale 买家撤销售后申请
+func (s *Service) CancelAfterSale(ctx context.Context, afterSaleID, userID string) (*aftersale.AfterSale, error) {
+	afterSale, err := s.GetAfterSale(ctx, afterSaleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := afterSale.Cancel(); err != nil {
+		return nil, err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return nil, err
+	}
+
+	return afterSale, nil
+}
+
+// ReviewAfterSaleRequest 审核售后请求
+type ReviewAfterSaleRequest struct {
+	AfterSaleID string `json:"after_sale_id"`
+	OperatorID  string `json:"operator_id"`
+	Approved    bool   `json:"approved"`
+	// Amount 同意退款的金额，为零时按申请金额退款
+	Amount       decimal.Decimal `json:"amount"`
+	RejectReason string          `json:"reject_reason"`
+}
+
+// ReviewAfterSale 运营审核售后申请，仅退款的申请审核通过后立即退款
+func (s *Service) ReviewAfterSale(ctx context.Context, req ReviewAfterSaleRequest) (*aftersale.AfterSale, error) {
+	afterSale, err := s.afterSaleRepo.GetByID(ctx, req.AfterSaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if req.Approved {
+		err = afterSale.Approve(req.OperatorID, req.Amount, now)
+	} else {
+		err = afterSale.Reject(req.OperatorID, req.RejectReason, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return nil, err
+	}
+
+	if afterSale.Status == int32(aftersale.AfterSaleStatusRefunding) {
+		return s.refund(ctx, afterSale)
+	}
+	return afterSale, nil
+}
+
+// SubmitReturnRequest 填写退货物流请求
+type SubmitReturnRequest struct {
+	AfterSaleID    string `json:"after_sale_id"`
+	UserID         string `json:"user_id"`
+	CarrierCode    string `json:"carrier_code"`
+	TrackingNumber string `json:"tracking_number"`
+}
+
+// SubmitReturn 买家寄回商品后填写退货物流
+func (s *Service) SubmitReturn(ctx context.Context, req SubmitReturnRequest) (*aftersale.AfterSale, error) {
+	afterSale, err := s.GetAfterSale(ctx, req.AfterSaleID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := afterSale.SubmitReturn(req.CarrierCode, req.TrackingNumber); err != nil {
+		return nil, err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return nil, err
+	}
+
+	return afterSale, nil
+}
+
+// ReceiveReturn 运营确认收到退货，商品重新入库后按审核金额退款
+func (s *Service) ReceiveReturn(ctx context.Context, afterSaleID, operatorID string) (*aftersale.AfterSale, error) {
+	afterSale, err := s.afterSaleRepo.GetByID(ctx, afterSaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.orderRepo.GetOrderItems(ctx, afterSale.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单商品失败: %w", err)
+	}
+	var item *order.OrderItem
+	for _, orderItem := range items {
+		if orderItem.ID == afterSale.OrderItemID {
+			item = orderItem
+			break
+		}
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", order.ErrOrderItemNotFound, afterSale.OrderItemID)
+	}
+
+	// 先按乐观锁更新状态，保证同一退货只入库一次
+	previous := *afterSale
+	if err := afterSale.Receive(operatorID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("售后退货入库: %s", afterSale.ID)
+	if err := s.inventoryClient.RestockInventory(ctx, item.SkuID, afterSale.Quantity, reason); err != nil {
+		// 入库失败时回退到收货前的状态，便于运营重试
+		previous.Version = afterSale.Version
+		if rollbackErr := s.afterSaleRepo.Update(ctx, &previous); rollbackErr != nil {
+			log.Printf("Failed to roll back after-sale %s after restock failure: %v", afterSale.ID, rollbackErr)
+		}
+		return nil, fmt.Errorf("退货入库失败: %w", err)
+	}
+
+	return s.refund(ctx, afterSale)
+}
+
+// RetryRefund 重新发起退款中售后申请的退款
+func (s *Service) RetryRefund(ctx context.Context, afterSaleID string) (*aftersale.AfterSale, error) {
+	afterSale, err := s.afterSaleRepo.GetByID(ctx, afterSaleID)
+	if err != nil {
+		return nil, err
+	}
+	if afterSale.Status != int32(aftersale.AfterSaleStatusRefunding) {
+		return nil, aftersale.ErrInvalidAfterSaleStatus
+	}
+
+	return s.refund(ctx, afterSale)
+}
+
+// refund 调用支付服务退款，订单商品全部退款后订单变为已退款
+// 退款失败时售后申请停留在退款中状态，可通过 RetryRefund 重试。
+func (s *Service) refund(ctx context.Context, afterSale *aftersale.AfterSale) (*aftersale.AfterSale, error) {
+	payments, err := s.orderRepo.GetPayments(ctx, afterSale.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var refundID string
+	if len(payments) > 0 {
+		refundID, err = s.refundStages(ctx, afterSale, payments)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		refundID, err = s.refundOrder(ctx, afterSale)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := afterSale.MarkRefunded(refundID, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return nil, err
+	}
+
+	if err := s.completeOrderRefund(ctx, afterSale.OrderID); err != nil {
+		log.Printf("Failed to mark order %s refunded: %v", afterSale.OrderID, err)
+	}
+	return afterSale, nil
+}
+
+// refundOrder 按业务订单整单支付原路退款
+// 调用支付服务前先持久化退款发起时间：若退款成功后更新售后单失败，重试会被拒绝而不是再次退款，
+// 需与支付服务对账后处理。
+func (s *Service) refundOrder(ctx context.Context, afterSale *aftersale.AfterSale) (string, error) {
+	if err := afterSale.StartRefund(time.Now()); err != nil {
+		return "", err
+	}
+	if err := s.afterSaleRepo.Update(ctx, afterSale); err != nil {
+		return "", err
+	}
+
+	resp, err := s.paymentClient.CreateRefund(ctx, &client.RefundRequest{
+		OrderID: afterSale.OrderID,
+		Amount:  afterSale.ApprovedAmount.StringFixed(2),
+		Reason:  fmt.Sprintf("售后退款: %s", afterSale.ID),
+	})
+	if err != nil {
+		// 超时等情况无法确定支付服务是否已受理，保留发起记录等待对账
+		if !refundOutcomeUnknown(err) {
+			afterSale.AbortRefund()
+			if resetErr := s.afterSaleRepo.Update(ctx, afterSale); resetErr != nil {
+				log.Printf("Failed to reset refund state of after-sale %s: %v", afterSale.ID, resetErr)
+			}
+		}
+		return "", fmt.Errorf("发起退款失败: %w", err)
+	}
+	return resp.RefundID, nil
+}
+
+// refundOutcomeUnknown 支付服务调用超时或中断时无法确定退款是否已受理
+func refundOutcomeUnknown(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// refundStages 分阶段支付的订单按支付阶段原路退款，先退尾款再退定金，返回以逗号连接的退款单号
+// 每个阶段退款成功后立即记录退款金额；重试时扣除本售后单已退部分，避免重复退款。
+func (s *Service) refundStages(ctx context.Context, afterSale *aftersale.AfterSale, payments []*order.OrderPayment) (string, error) {
+	afterSales, err := s.afterSaleRepo.ListByOrderID(ctx, afterSale.OrderID)
+	if err != nil {
+		return "", err
+	}
+	refunded := order.RefundedTotal(payments)
+	for _, other := range afterSales {
+		if other.ID != afterSale.ID && other.Status == int32(aftersale.AfterSaleStatusRefunded) {
+			refunded = refunded.Sub(other.ApprovedAmount)
+		}
+	}
+
+	allocations, err := order.AllocateRefund(payments, afterSale.ApprovedAmount.Sub(decimal.Max(refunded, decimal.Zero)))
+	if err != nil {
+		return "", err
+	}
+
+	refundIDs := make([]string, 0, len(allocations))
+	for _, allocation := range allocations {
+		resp, err := s.paymentClient.CreateRefund(ctx, &client.RefundRequest{
+			OrderID: allocation.Payment.ID,
+			Amount:  allocation.Amount.StringFixed(2),
+			Reason:  fmt.Sprintf("售后退款: %s", afterSale.ID),
+		})
+		if err != nil {
+			return "", fmt.Errorf("发起退款失败: %w", err)
+		}
+
+		allocation.Payment.Refund(allocation.Amount, time.Now())
+		if err := s.orderRepo.UpdatePayment(ctx, allocation.Payment); err != nil {
+			return "", err
+		}
+		refundIDs = append(refundIDs, resp.RefundID)
+	}
+	return strings.Join(refundIDs, ","), nil
+}
+
+// completeOrderRefund 订单商品全部退款后将订单标记为已退款
+func (s *Service) completeOrderRefund(ctx context.Context, orderID string) error {
+	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	items, err := s.orderRepo.GetOrderItems(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	afterSales, err := s.afterSaleRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if !aftersale.RefundedInFull(items, afterSales) {
+		return nil
+	}
+	return s.orderDS.UpdateOrderStatus(ctx, orderEntity, int32(order.OrderStatusRefunded), "订单商品已全部售后退款", order.SystemOperator)
+}
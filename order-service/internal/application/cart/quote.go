@@ -0,0 +1,80 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+)
+
+// quoteItems 批量获取购物车商品的实时售价和库存
+// 商品信息和库存并发获取；商品或SKU不存在、售价无法解析的商品不出现在结果中，按不可用处理。
+func (s *Service) quoteItems(ctx context.Context, items []*cart.ShoppingCart) (map[string]*cart.SkuQuote, error) {
+	quotes := make(map[string]*cart.SkuQuote, len(items))
+	if len(items) == 0 {
+		return quotes, nil
+	}
+
+	productIDs := make([]string, 0, len(items))
+	skuIDs := make([]string, 0, len(items))
+	seenProducts := make(map[string]bool, len(items))
+	wanted := make(map[string]bool, len(items))
+	for _, item := range items {
+		if !seenProducts[item.ProductID] {
+			seenProducts[item.ProductID] = true
+			productIDs = append(productIDs, item.ProductID)
+		}
+		key := cart.QuoteKey(item.ProductID, item.SkuID)
+		if !wanted[key] {
+			wanted[key] = true
+			skuIDs = append(skuIDs, item.SkuID)
+		}
+	}
+
+	var (
+		products map[string]*client.Product
+		stock    map[string]int32
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		products, err = s.productClient.BatchGetProducts(gctx, productIDs)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		stock, err = s.inventoryClient.BatchGetAvailable(gctx, skuIDs)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, product := range products {
+		for _, sku := range product.SKUs {
+			key := cart.QuoteKey(product.ID, sku.ID)
+			if !wanted[key] {
+				continue
+			}
+			price, err := decimal.NewFromString(sku.SalePrice)
+			if err != nil {
+				continue
+			}
+			quotes[key] = &cart.SkuQuote{
+				ProductID:    product.ID,
+				SkuID:        sku.ID,
+				ProductName:  product.Name,
+				SkuName:      sku.Name,
+				ProductImage: product.MainImageURL,
+				Price:        price,
+				Stock:        stock[sku.ID],
+				OnSale:       product.Status == client.ProductStatusActive && sku.IsActive,
+			}
+		}
+	}
+
+	return quotes, nil
+}
@@ -2,48 +2,108 @@ package cart
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
-	"github.com/shopspring/decimal"
-
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
 )
 
 // Service 购物车应用服务
+// 已登录用户使用用户购物车，未登录的游客使用网关签发的购物车令牌对应的游客购物车。
 type Service struct {
-	cartRepo cart.Repository
-	cartDS   cart.DomainService
+	cartRepo        cart.Repository
+	cartDS          cart.DomainService
+	guestRepo       cart.GuestRepository
+	mergeQueue      cart.MergeQueue
+	limitDS         purchaselimit.DomainService
+	productClient   *client.ProductServiceClient
+	inventoryClient *client.InventoryServiceClient
 }
 
 // NewService 创建购物车应用服务
-func NewService(cartRepo cart.Repository, cartDS cart.DomainService) *Service {
+func NewService(
+	cartRepo cart.Repository,
+	cartDS cart.DomainService,
+	guestRepo cart.GuestRepository,
+	mergeQueue cart.MergeQueue,
+	limitDS purchaselimit.DomainService,
+	productClient *client.ProductServiceClient,
+	inventoryClient *client.InventoryServiceClient,
+) *Service {
 	return &Service{
-		cartRepo: cartRepo,
-		cartDS:   cartDS,
+		cartRepo:        cartRepo,
+		cartDS:          cartDS,
+		guestRepo:       guestRepo,
+		mergeQueue:      mergeQueue,
+		limitDS:         limitDS,
+		productClient:   productClient,
+		inventoryClient: inventoryClient,
+	}
+}
+
+// cartOf 返回请求对应的购物车仓储和领域服务，userID 为空时使用 cartToken 对应的游客购物车
+func (s *Service) cartOf(userID, cartToken string) (cart.Repository, cart.DomainService, error) {
+	if userID != "" {
+		return s.cartRepo, s.cartDS, nil
+	}
+	if cartToken == "" {
+		return nil, nil, cart.ErrInvalidCartToken
 	}
+
+	guestCart := s.guestRepo.ForToken(cartToken)
+	return guestCart, cart.NewDomainService(guestCart), nil
 }
 
 // AddToCartRequest 添加到购物车请求
 type AddToCartRequest struct {
-	UserID    string          `json:"user_id"`
-	ProductID string          `json:"product_id"`
-	SkuID     string          `json:"sku_id"`
-	Quantity  int32           `json:"quantity"`
-	Price     decimal.Decimal `json:"price"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	ProductID string `json:"product_id"`
+	SkuID     string `json:"sku_id"`
+	Quantity  int32  `json:"quantity"`
 }
 
 // AddToCart 添加商品到购物车
+// 新加入的商品以商品服务的当前售价记录加购价格，之后获取购物车时据此提示价格变化。
 func (s *Service) AddToCart(ctx context.Context, req AddToCartRequest) (*cart.ShoppingCart, error) {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查购物车中是否已存在该商品
-	existingItem, err := s.cartRepo.GetByUserAndProduct(ctx, req.UserID, req.ProductID, req.SkuID)
+	existingItem, err := cartRepo.GetByUserAndProduct(ctx, req.UserID, req.ProductID, req.SkuID)
 	if err != nil && err != cart.ErrCartItemNotFound {
 		return nil, fmt.Errorf("检查购物车商品失败: %w", err)
 	}
 
+	quantity := req.Quantity
+	if existingItem != nil {
+		quantity += existingItem.Quantity
+	}
+
+	// 获取实时售价并检查能否购买
+	quotes, err := s.quoteItems(ctx, []*cart.ShoppingCart{{ProductID: req.ProductID, SkuID: req.SkuID}})
+	if err != nil {
+		return nil, fmt.Errorf("获取商品价格失败: %w", err)
+	}
+	quote := quotes[cart.QuoteKey(req.ProductID, req.SkuID)]
+	if err := cart.CheckAvailable(quote, quantity); err != nil {
+		return nil, err
+	}
+
+	// 检查限购，购物车中的数量按一笔订单计算
+	if err := s.limitDS.Check(ctx, req.UserID, []purchaselimit.Item{{SkuID: req.SkuID, Quantity: quantity}}); err != nil {
+		return nil, err
+	}
+
 	if existingItem != nil {
 		// 如果已存在，更新数量
-		return s.cartDS.UpdateQuantity(ctx, existingItem, existingItem.Quantity+req.Quantity)
+		return cartDS.UpdateQuantity(ctx, existingItem, quantity)
 	}
 
 	// 创建新的购物车项
@@ -52,26 +112,32 @@ func (s *Service) AddToCart(ctx context.Context, req AddToCartRequest) (*cart.Sh
 		ProductID: req.ProductID,
 		SkuID:     req.SkuID,
 		Quantity:  req.Quantity,
-		Price:     req.Price,
+		Price:     quote.Price,
 		Selected:  true,
 		CreatedAt: time.Now().Format("2006-01-02 15:04:05"),
 		UpdatedAt: time.Now().Format("2006-01-02 15:04:05"),
 	}
 
-	return s.cartDS.AddToCart(ctx, cartItem)
+	return cartDS.AddToCart(ctx, cartItem)
 }
 
 // UpdateQuantityRequest 更新购物车商品数量请求
 type UpdateQuantityRequest struct {
-	CartID   string `json:"cart_id"`
-	UserID   string `json:"user_id"`
-	Quantity int32  `json:"quantity"`
+	CartID    string `json:"cart_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	Quantity  int32  `json:"quantity"`
 }
 
 // UpdateQuantity 更新购物车商品数量
 func (s *Service) UpdateQuantity(ctx context.Context, req UpdateQuantityRequest) (*cart.ShoppingCart, error) {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取购物车项
-	cartItem, err := s.cartRepo.GetByID(ctx, req.CartID)
+	cartItem, err := cartRepo.GetByID(ctx, req.CartID)
 	if err != nil {
 		return nil, fmt.Errorf("获取购物车商品失败: %w", err)
 	}
@@ -81,21 +147,34 @@ func (s *Service) UpdateQuantity(ctx context.Context, req UpdateQuantityRequest)
 		return nil, cart.ErrCartItemNotFound
 	}
 
+	// 增加数量时检查限购，减少数量总是允许
+	if req.Quantity > cartItem.Quantity {
+		if err := s.limitDS.Check(ctx, req.UserID, []purchaselimit.Item{{SkuID: cartItem.SkuID, Quantity: req.Quantity}}); err != nil {
+			return nil, err
+		}
+	}
+
 	// 使用领域服务更新数量
-	return s.cartDS.UpdateQuantity(ctx, cartItem, req.Quantity)
+	return cartDS.UpdateQuantity(ctx, cartItem, req.Quantity)
 }
 
 // UpdateSelectionRequest 更新购物车商品选中状态请求
 type UpdateSelectionRequest struct {
-	CartID   string `json:"cart_id"`
-	UserID   string `json:"user_id"`
-	Selected bool   `json:"selected"`
+	CartID    string `json:"cart_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
+	Selected  bool   `json:"selected"`
 }
 
 // UpdateSelection 更新购物车商品选中状态
 func (s *Service) UpdateSelection(ctx context.Context, req UpdateSelectionRequest) (*cart.ShoppingCart, error) {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取购物车项
-	cartItem, err := s.cartRepo.GetByID(ctx, req.CartID)
+	cartItem, err := cartRepo.GetByID(ctx, req.CartID)
 	if err != nil {
 		return nil, fmt.Errorf("获取购物车商品失败: %w", err)
 	}
@@ -106,19 +185,25 @@ func (s *Service) UpdateSelection(ctx context.Context, req UpdateSelectionReques
 	}
 
 	// 使用领域服务更新选中状态
-	return s.cartDS.UpdateSelection(ctx, cartItem, req.Selected)
+	return cartDS.UpdateSelection(ctx, cartItem, req.Selected)
 }
 
 // RemoveFromCartRequest 从购物车移除商品请求
 type RemoveFromCartRequest struct {
-	CartID string `json:"cart_id"`
-	UserID string `json:"user_id"`
+	CartID    string `json:"cart_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
 }
 
 // RemoveFromCart 从购物车移除商品
 func (s *Service) RemoveFromCart(ctx context.Context, req RemoveFromCartRequest) error {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return err
+	}
+
 	// 获取购物车项
-	cartItem, err := s.cartRepo.GetByID(ctx, req.CartID)
+	cartItem, err := cartRepo.GetByID(ctx, req.CartID)
 	if err != nil {
 		return fmt.Errorf("获取购物车商品失败: %w", err)
 	}
@@ -129,69 +214,90 @@ func (s *Service) RemoveFromCart(ctx context.Context, req RemoveFromCartRequest)
 	}
 
 	// 使用领域服务移除商品
-	return s.cartDS.RemoveFromCart(ctx, cartItem)
+	return cartDS.RemoveFromCart(ctx, cartItem)
 }
 
 // GetCartRequest 获取购物车请求
 type GetCartRequest struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
 }
 
 // GetCartResponse 获取购物车响应
 type GetCartResponse struct {
-	Items       []*cart.ShoppingCart `json:"items"`
-	TotalAmount decimal.Decimal      `json:"total_amount"`
-	TotalCount  int32                `json:"total_count"`
+	Items   []*cart.CartItem `json:"items"`
+	Summary cart.CartSummary `json:"summary"`
+	// Refreshed 是否获取到实时价格和库存，为 false 时展示的是加购时的价格
+	Refreshed bool `json:"refreshed"`
 }
 
 // GetCart 获取用户购物车
+// 以商品服务和库存服务的实时数据刷新价格和可用性，不可购买的商品自动取消选中。
+// 实时数据获取失败时按加购价格返回购物车，不影响浏览。
 func (s *Service) GetCart(ctx context.Context, req GetCartRequest) (*GetCartResponse, error) {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取用户购物车商品
-	items, err := s.cartRepo.GetByUserID(ctx, req.UserID)
+	items, err := cartRepo.GetByUserID(ctx, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("获取购物车失败: %w", err)
 	}
 
-	// 计算总金额和总数量
-	var totalAmount decimal.Decimal
-	var totalCount int32
+	quotes, err := s.quoteItems(ctx, items)
+	if err != nil {
+		log.Printf("Failed to refresh cart prices: %v", err)
+	}
 
-	for _, item := range items {
-		if item.Selected {
-			subtotal := item.Price.Mul(decimal.NewFromInt32(item.Quantity))
-			totalAmount = totalAmount.Add(subtotal)
-			totalCount += item.Quantity
+	result := cart.Refresh(items, quotes)
+	if len(result.Unselected) > 0 {
+		if err := cartDS.BatchUpdateSelection(ctx, result.Unselected, false); err != nil {
+			return nil, fmt.Errorf("取消选中不可购买商品失败: %w", err)
 		}
 	}
 
 	return &GetCartResponse{
-		Items:       items,
-		TotalAmount: totalAmount,
-		TotalCount:  totalCount,
+		Items:     result.Items,
+		Summary:   result.Summary,
+		Refreshed: quotes != nil,
 	}, nil
 }
 
 // ClearCartRequest 清空购物车请求
 type ClearCartRequest struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
 }
 
 // ClearCart 清空用户购物车
 func (s *Service) ClearCart(ctx context.Context, req ClearCartRequest) error {
-	return s.cartDS.ClearCart(ctx, req.UserID)
+	_, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return err
+	}
+
+	return cartDS.ClearCart(ctx, req.UserID)
 }
 
 // BatchUpdateSelectionRequest 批量更新选中状态请求
 type BatchUpdateSelectionRequest struct {
-	UserID   string   `json:"user_id"`
-	CartIDs  []string `json:"cart_ids"`
-	Selected bool     `json:"selected"`
+	UserID    string   `json:"user_id"`
+	CartToken string   `json:"cart_token"`
+	CartIDs   []string `json:"cart_ids"`
+	Selected  bool     `json:"selected"`
 }
 
 // BatchUpdateSelection 批量更新购物车商品选中状态
 func (s *Service) BatchUpdateSelection(ctx context.Context, req BatchUpdateSelectionRequest) error {
+	cartRepo, cartDS, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return err
+	}
+
 	// 获取用户购物车商品
-	items, err := s.cartRepo.GetByUserID(ctx, req.UserID)
+	items, err := cartRepo.GetByUserID(ctx, req.UserID)
 	if err != nil {
 		return fmt.Errorf("获取购物车失败: %w", err)
 	}
@@ -208,18 +314,24 @@ func (s *Service) BatchUpdateSelection(ctx context.Context, req BatchUpdateSelec
 	}
 
 	// 使用领域服务批量更新
-	return s.cartDS.BatchUpdateSelection(ctx, targetItems, req.Selected)
+	return cartDS.BatchUpdateSelection(ctx, targetItems, req.Selected)
 }
 
 // GetSelectedItemsRequest 获取选中商品请求
 type GetSelectedItemsRequest struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
 }
 
 // GetSelectedItems 获取用户购物车中选中的商品
 func (s *Service) GetSelectedItems(ctx context.Context, req GetSelectedItemsRequest) ([]*cart.ShoppingCart, error) {
+	cartRepo, _, err := s.cartOf(req.UserID, req.CartToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取用户购物车商品
-	items, err := s.cartRepo.GetByUserID(ctx, req.UserID)
+	items, err := cartRepo.GetByUserID(ctx, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("获取购物车失败: %w", err)
 	}
@@ -234,3 +346,73 @@ func (s *Service) GetSelectedItems(ctx context.Context, req GetSelectedItemsRequ
 
 	return selectedItems, nil
 }
+
+// MergeCartRequest 合并游客购物车请求
+type MergeCartRequest struct {
+	UserID    string `json:"user_id"`
+	CartToken string `json:"cart_token"`
+}
+
+// MergeCart 登录后将游客购物车合并到用户购物车，合并完成后删除游客购物车
+// 删除失败时再次合并会重复累加数量，累加结果仍受限购数量约束。
+func (s *Service) MergeCart(ctx context.Context, req MergeCartRequest) (*cart.MergeResult, error) {
+	if req.CartToken == "" {
+		return nil, cart.ErrInvalidCartToken
+	}
+
+	guestCart := s.guestRepo.ForToken(req.CartToken)
+	guestItems, err := guestCart.GetByUserID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(guestItems) == 0 {
+		return &cart.MergeResult{}, nil
+	}
+
+	result, err := s.cartDS.MergeCart(ctx, req.UserID, guestItems)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := guestCart.DeleteByUserID(ctx, ""); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// maxMergeAttempts 登录合并请求的最大尝试次数，超过后放弃，游客购物车保留，客户端仍可调用 MergeCart
+const maxMergeAttempts = 5
+
+// ProcessLoginMerges 合并用户服务在登录时提交的游客购物车，返回合并成功的数量
+// 合并失败的请求重新排队，令牌无效的请求和超过最大尝试次数的请求直接丢弃。
+func (s *Service) ProcessLoginMerges(ctx context.Context, limit int) (int, error) {
+	requests, err := s.mergeQueue.Dequeue(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	merged := 0
+	for _, req := range requests {
+		_, err := s.MergeCart(ctx, MergeCartRequest{UserID: req.UserID, CartToken: req.CartToken})
+		if err == nil {
+			merged++
+			continue
+		}
+		if errors.Is(err, cart.ErrInvalidCartToken) || req.UserID == "" {
+			log.Printf("Discard guest cart merge for user %q: %v", req.UserID, err)
+			continue
+		}
+
+		req.Attempts++
+		if req.Attempts >= maxMergeAttempts {
+			log.Printf("Give up guest cart merge for user %s after %d attempts: %v", req.UserID, req.Attempts, err)
+			continue
+		}
+		log.Printf("Failed to merge guest cart for user %s: %v", req.UserID, err)
+		if err := s.mergeQueue.Requeue(ctx, req); err != nil {
+			log.Printf("Failed to requeue guest cart merge for user %s: %v", req.UserID, err)
+		}
+	}
+	return merged, nil
+}
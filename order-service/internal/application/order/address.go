@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// ChangeOrderAddressRequest 运营修改收货地址请求
+type ChangeOrderAddressRequest struct {
+	OrderID    string                    `json:"order_id"`
+	OperatorID string                    `json:"operator_id"`
+	Address    CreateOrderAddressRequest `json:"address"`
+}
+
+// ChangeOrderAddress 运营在发货前修改收货地址，修改前后的地址记录在状态日志中
+func (s *Service) ChangeOrderAddress(ctx context.Context, req ChangeOrderAddressRequest) (*order.OrderAddress, error) {
+	orderEntity, err := s.orderRepo.GetByID(ctx, req.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+
+	address := &order.OrderAddress{
+		ReceiverName:  req.Address.ReceiverName,
+		ReceiverPhone: req.Address.ReceiverPhone,
+		Province:      req.Address.Province,
+		City:          req.Address.City,
+		District:      req.Address.District,
+		DetailAddress: req.Address.DetailAddress,
+		PostalCode:    req.Address.PostalCode,
+	}
+	if err := s.orderDS.ChangeAddress(ctx, orderEntity, address, order.AdminOperator(req.OperatorID)); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// autoConfirmWindow 发货后自动确认收货的时限
+func (s *Service) autoConfirmWindow() time.Duration {
+	return time.Duration(s.orderConfig.AutoConfirmDays) * 24 * time.Hour
+}
+
+// AutoConfirmDeadline 返回已发货订单的自动确认收货时间
+func (s *Service) AutoConfirmDeadline(orderEntity *order.Order) (time.Time, bool) {
+	return orderEntity.AutoConfirmDeadline(s.autoConfirmWindow())
+}
+
+// ExtendReceiveRequest 延长收货请求
+type ExtendReceiveRequest struct {
+	OrderID string `json:"order_id"`
+	UserID  string `json:"user_id"`
+}
+
+// ExtendReceive 买家延长收货，自动确认收货时间顺延，每个订单只能延长一次
+func (s *Service) ExtendReceive(ctx context.Context, req ExtendReceiveRequest) (*order.Order, error) {
+	orderEntity, err := s.GetOrder(ctx, GetOrderRequest{OrderID: req.OrderID, UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	extension := time.Duration(s.orderConfig.ReceiveExtensionDays) * 24 * time.Hour
+	if err := s.orderDS.ExtendReceiveDeadline(ctx, orderEntity, s.autoConfirmWindow(), extension); err != nil {
+		return nil, err
+	}
+
+	return orderEntity, nil
+}
+
+// ConfirmOverdueReceipts 确认收货超过自动确认时间的已发货订单，返回确认的数量
+func (s *Service) ConfirmOverdueReceipts(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+	orders, err := s.orderRepo.ListReceiveOverdue(ctx, now.Add(-s.autoConfirmWindow()), now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	confirmed := 0
+	for _, orderEntity := range orders {
+		// 订单更新带乐观锁，与买家确认收货或延长收货并发时只有一方成功
+		err := s.orderDS.UpdateOrderStatus(ctx, orderEntity, int32(order.OrderStatusDelivered), "超过确认收货期限，系统自动确认收货", order.SystemOperator)
+		if err != nil {
+			if !errors.Is(err, order.ErrOrderConflict) {
+				log.Printf("Failed to auto confirm order %s: %v", orderEntity.ID, err)
+			}
+			continue
+		}
+		confirmed++
+	}
+
+	return confirmed, nil
+}
@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// CheckoutCartRequest 购物车结算请求
+type CheckoutCartRequest struct {
+	UserID string `json:"user_id"`
+	// CartItemIDs 结算的购物车项ID，为空时结算所有选中的商品
+	CartItemIDs   []string `json:"cart_item_ids"`
+	AddressID     string   `json:"address_id"`
+	PaymentMethod string   `json:"payment_method"`
+	Remark        string   `json:"remark"`
+	// ExpectedAmount 客户端预期的实付金额，为空时不校验
+	ExpectedAmount *decimal.Decimal `json:"expected_amount"`
+	// CouponIDs 使用的优惠券，为空时自动选择优惠最大的组合
+	CouponIDs []string `json:"coupon_ids"`
+	// SkipCoupons 不使用优惠券
+	SkipCoupons bool `json:"skip_coupons"`
+}
+
+// CheckoutCart 购物车结算：以服务端价格创建订单，并在订单落库的事务中移除已结算的购物车项
+func (s *Service) CheckoutCart(ctx context.Context, req CheckoutCartRequest) (*order.Order, error) {
+	// 1. 获取结算的购物车项
+	cartItems, err := s.loadCheckoutItems(ctx, req.UserID, req.CartItemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]CreateOrderItemRequest, 0, len(cartItems))
+	cartItemIDs := make([]string, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		if cartItem.SkuID == "" {
+			return nil, fmt.Errorf("%w: 购物车项 %s 未选择商品规格", order.ErrProductUnavailable, cartItem.ID)
+		}
+		items = append(items, CreateOrderItemRequest{
+			ProductID: cartItem.ProductID,
+			SkuID:     cartItem.SkuID,
+			Quantity:  cartItem.Quantity,
+		})
+		cartItemIDs = append(cartItemIDs, cartItem.ID)
+	}
+
+	// 2. 获取用户保存的收货地址
+	address, err := s.resolveAddress(ctx, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 创建订单
+	return s.createOrder(ctx, CreateOrderRequest{
+		UserID:         req.UserID,
+		Items:          items,
+		AddressID:      req.AddressID,
+		Address:        address,
+		PaymentMethod:  req.PaymentMethod,
+		Remark:         req.Remark,
+		ExpectedAmount: req.ExpectedAmount,
+		CouponIDs:      req.CouponIDs,
+		SkipCoupons:    req.SkipCoupons,
+	}, cartItemIDs)
+}
+
+// loadCheckoutItems 获取用户的结算购物车项，未指定ID时取选中的商品
+func (s *Service) loadCheckoutItems(ctx context.Context, userID string, cartItemIDs []string) ([]*cart.ShoppingCart, error) {
+	if len(cartItemIDs) == 0 {
+		cartItems, err := s.cartRepo.GetSelectedItems(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("获取购物车选中商品失败: %w", err)
+		}
+		if len(cartItems) == 0 {
+			return nil, cart.ErrNoSelectedItems
+		}
+		return cartItems, nil
+	}
+
+	seen := make(map[string]bool, len(cartItemIDs))
+	cartItems := make([]*cart.ShoppingCart, 0, len(cartItemIDs))
+	for _, id := range cartItemIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		cartItem, err := s.cartRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// 不属于该用户的购物车项按不存在处理
+		if cartItem.UserID != userID {
+			return nil, cart.ErrCartItemNotFound
+		}
+		cartItems = append(cartItems, cartItem)
+	}
+
+	return cartItems, nil
+}
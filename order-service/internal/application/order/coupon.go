@@ -0,0 +1,63 @@
+package order
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+)
+
+// CreateCouponTemplateRequest 创建优惠券模板请求
+type CreateCouponTemplateRequest struct {
+	Name          string               `json:"name"`
+	Type          promotion.CouponType `json:"type"`
+	Amount        decimal.Decimal      `json:"amount"`
+	PercentOff    int32                `json:"percent_off"`
+	Threshold     decimal.Decimal      `json:"threshold"`
+	MaxDiscount   decimal.Decimal      `json:"max_discount"`
+	Repeatable    bool                 `json:"repeatable"`
+	ScopeType     promotion.ScopeType  `json:"scope_type"`
+	ScopeIDs      []string             `json:"scope_ids"`
+	Stackable     bool                 `json:"stackable"`
+	TotalQuantity int32                `json:"total_quantity"`
+	PerUserLimit  int32                `json:"per_user_limit"`
+	ValidFrom     string               `json:"valid_from"`
+	ValidTo       string               `json:"valid_to"`
+	ValidDays     int32                `json:"valid_days"`
+}
+
+// CreateCouponTemplate 创建优惠券模板
+func (s *Service) CreateCouponTemplate(ctx context.Context, req CreateCouponTemplateRequest) (*promotion.Template, error) {
+	template := &promotion.Template{
+		Name:          req.Name,
+		Type:          req.Type,
+		Amount:        req.Amount,
+		PercentOff:    req.PercentOff,
+		Threshold:     req.Threshold,
+		MaxDiscount:   req.MaxDiscount,
+		Repeatable:    req.Repeatable,
+		ScopeType:     req.ScopeType,
+		ScopeIDs:      req.ScopeIDs,
+		Stackable:     req.Stackable,
+		TotalQuantity: req.TotalQuantity,
+		PerUserLimit:  req.PerUserLimit,
+		ValidFrom:     req.ValidFrom,
+		ValidTo:       req.ValidTo,
+		ValidDays:     req.ValidDays,
+	}
+	if err := s.promotionDS.CreateTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ClaimCoupon 用户领取优惠券
+func (s *Service) ClaimCoupon(ctx context.Context, userID, templateID string) (*promotion.Coupon, error) {
+	return s.promotionDS.Claim(ctx, userID, templateID)
+}
+
+// ListCoupons 获取用户的优惠券，status 为 0 时返回全部状态
+func (s *Service) ListCoupons(ctx context.Context, userID string, status promotion.CouponStatus) ([]*promotion.Coupon, error) {
+	return s.promotionRepo.ListByUserID(ctx, userID, status)
+}
@@ -0,0 +1,99 @@
+package order
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// 事件投递重试参数：失败后按指数退避重试，超过最大次数转为死信等待人工处理
+const (
+	publishRetryBaseDelay = 2 * time.Second
+	publishRetryMaxDelay  = 10 * time.Minute
+	publishMaxAttempts    = 20
+)
+
+// EventRelay 订单事件投递器，将发件箱中待投递的事件发布到消息中间件
+// 先发布再标记已投递，标记失败时事件会被重复发布，消费方按事件ID去重。
+type EventRelay struct {
+	outboxRepo order.OutboxRepository
+	publisher  order.EventPublisher
+	retention  time.Duration
+}
+
+// NewEventRelay 创建订单事件投递器
+func NewEventRelay(outboxRepo order.OutboxRepository, publisher order.EventPublisher, orderConfig *config.OrderConfig) *EventRelay {
+	return &EventRelay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		retention:  orderConfig.Events.Retention,
+	}
+}
+
+// PublishPending 发布待投递的事件，返回发布成功的数量
+// 同一订单的事件按写入顺序发布，某个事件发布失败时跳过该订单后续的事件，退避重试期间保持顺序。
+// 事件转为死信后不再阻塞该订单后续的事件，死信需人工排查后重新投递。
+func (r *EventRelay) PublishPending(ctx context.Context, limit int) (int, error) {
+	messages, err := r.outboxRepo.ListPending(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	blocked := make(map[string]bool)
+	published := make([]string, 0, len(messages))
+	for _, message := range messages {
+		if blocked[message.OrderID] {
+			continue
+		}
+
+		if err := r.publisher.Publish(ctx, message); err != nil {
+			blocked[message.OrderID] = true
+			r.recordFailure(ctx, message, err)
+			continue
+		}
+		published = append(published, message.ID)
+	}
+
+	if err := r.outboxRepo.MarkPublished(ctx, published); err != nil {
+		return 0, err
+	}
+
+	return len(published), nil
+}
+
+// recordFailure 记录投递失败，未超过最大次数时按指数退避安排重试，否则转为死信
+func (r *EventRelay) recordFailure(ctx context.Context, message *order.OutboxMessage, publishErr error) {
+	attempts := message.Attempts + 1
+	if attempts >= publishMaxAttempts {
+		log.Printf("Order event %s moved to dead letter after %d attempts: %v", message.ID, attempts, publishErr)
+		if err := r.outboxRepo.MarkDead(ctx, message.ID, publishErr.Error()); err != nil {
+			log.Printf("Failed to dead-letter order event %s: %v", message.ID, err)
+		}
+		return
+	}
+
+	retryAt := time.Now().Add(publishRetryDelay(attempts))
+	if err := r.outboxRepo.MarkFailed(ctx, message.ID, publishErr.Error(), retryAt); err != nil {
+		log.Printf("Failed to record publish failure of order event %s: %v", message.ID, err)
+	}
+}
+
+// publishRetryDelay 第 attempts 次失败后的重试间隔
+func publishRetryDelay(attempts int32) time.Duration {
+	delay := publishRetryBaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= publishRetryMaxDelay {
+			return publishRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// PurgePublished 删除超过保留时长的已投递事件
+func (r *EventRelay) PurgePublished(ctx context.Context, limit int) (int64, error) {
+	return r.outboxRepo.DeletePublished(ctx, time.Now().Add(-r.retention), limit)
+}
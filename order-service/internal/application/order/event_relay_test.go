@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/eventbus"
+)
+
+// memoryOutboxRepo 内存事件发件箱
+type memoryOutboxRepo struct {
+	messages  []*order.OutboxMessage
+	published map[string]bool
+	dead      map[string]bool
+	failures  map[string]string
+	retryAt   map[string]time.Time
+}
+
+func newMemoryOutboxRepo(messages ...*order.OutboxMessage) *memoryOutboxRepo {
+	return &memoryOutboxRepo{
+		messages:  messages,
+		published: make(map[string]bool),
+		dead:      make(map[string]bool),
+		failures:  make(map[string]string),
+		retryAt:   make(map[string]time.Time),
+	}
+}
+
+func (r *memoryOutboxRepo) ListPending(_ context.Context, limit int) ([]*order.OutboxMessage, error) {
+	var pending []*order.OutboxMessage
+	waiting := make(map[string]bool)
+	for _, message := range r.messages {
+		if r.published[message.ID] || r.dead[message.ID] || waiting[message.OrderID] {
+			continue
+		}
+		if retryAt, ok := r.retryAt[message.ID]; ok && retryAt.After(time.Now()) {
+			waiting[message.OrderID] = true
+			continue
+		}
+		if len(pending) < limit {
+			pending = append(pending, message)
+		}
+	}
+	return pending, nil
+}
+
+// elapseBackoff 模拟退避时间已过
+func (r *memoryOutboxRepo) elapseBackoff() {
+	clear(r.retryAt)
+}
+
+func (r *memoryOutboxRepo) MarkPublished(_ context.Context, ids []string) error {
+	for _, id := range ids {
+		r.published[id] = true
+	}
+	return nil
+}
+
+func (r *memoryOutboxRepo) MarkFailed(_ context.Context, id string, reason string, retryAt time.Time) error {
+	r.failures[id] = reason
+	r.retryAt[id] = retryAt
+	r.addAttempt(id)
+	return nil
+}
+
+func (r *memoryOutboxRepo) MarkDead(_ context.Context, id string, reason string) error {
+	r.failures[id] = reason
+	r.dead[id] = true
+	r.addAttempt(id)
+	return nil
+}
+
+func (r *memoryOutboxRepo) addAttempt(id string) {
+	for _, message := range r.messages {
+		if message.ID == id {
+			message.Attempts++
+		}
+	}
+}
+
+func (r *memoryOutboxRepo) DeletePublished(context.Context, time.Time, int) (int64, error) {
+	return 0, nil
+}
+
+func TestEventRelay_PublishPending(t *testing.T) {
+	ctx := context.Background()
+	outbox := newMemoryOutboxRepo(
+		&order.OutboxMessage{ID: "e1", Type: order.EventOrderCreated, OrderID: "o1"},
+		&order.OutboxMessage{ID: "e2", Type: order.EventOrderCreated, OrderID: "o2"},
+		&order.OutboxMessage{ID: "e3", Type: order.EventOrderPaid, OrderID: "o1"},
+	)
+	publisher := eventbus.NewMemoryPublisher()
+	relay := &EventRelay{outboxRepo: outbox, publisher: publisher}
+
+	t.Run("broker unavailable keeps events pending", func(t *testing.T) {
+		publisher.SetError(errors.New("connection refused"))
+		published, err := relay.PublishPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, published)
+		assert.Empty(t, publisher.Messages())
+
+		// o1 的第一个事件失败后跳过它后续的事件
+		assert.Equal(t, map[string]string{"e1": "connection refused", "e2": "connection refused"}, outbox.failures)
+	})
+
+	t.Run("failed events wait for backoff", func(t *testing.T) {
+		publisher.SetError(nil)
+		published, err := relay.PublishPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, published)
+		assert.Empty(t, publisher.Messages())
+	})
+
+	t.Run("retry publishes in order", func(t *testing.T) {
+		outbox.elapseBackoff()
+		published, err := relay.PublishPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, published)
+
+		var ids []string
+		for _, message := range publisher.Messages() {
+			ids = append(ids, message.ID)
+		}
+		assert.Equal(t, []string{"e1", "e2", "e3"}, ids)
+	})
+
+	t.Run("published events are not sent again", func(t *testing.T) {
+		published, err := relay.PublishPending(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, published)
+		assert.Len(t, publisher.Messages(), 3)
+	})
+}
+
+func TestEventRelay_DeadLetter(t *testing.T) {
+	ctx := context.Background()
+	outbox := newMemoryOutboxRepo(
+		&order.OutboxMessage{ID: "e1", Type: order.EventOrderCreated, OrderID: "o1", Attempts: publishMaxAttempts - 1},
+		&order.OutboxMessage{ID: "e2", Type: order.EventOrderPaid, OrderID: "o1"},
+	)
+	publisher := eventbus.NewMemoryPublisher()
+	relay := &EventRelay{outboxRepo: outbox, publisher: publisher}
+
+	// 最后一次重试失败后转为死信，不再自动重试
+	publisher.SetError(errors.New("payload rejected"))
+	published, err := relay.PublishPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+	assert.True(t, outbox.dead["e1"])
+	assert.False(t, outbox.dead["e2"])
+
+	// 死信不再阻塞同一订单后续的事件
+	publisher.SetError(nil)
+	published, err = relay.PublishPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	require.Len(t, publisher.Messages(), 1)
+	assert.Equal(t, "e2", publisher.Messages()[0].ID)
+}
+
+func TestPublishRetryDelay(t *testing.T) {
+	assert.Equal(t, 2*time.Second, publishRetryDelay(1))
+	assert.Equal(t, 4*time.Second, publishRetryDelay(2))
+	assert.Equal(t, 16*time.Second, publishRetryDelay(4))
+	assert.Equal(t, publishRetryMaxDelay, publishRetryDelay(publishMaxAttempts))
+}
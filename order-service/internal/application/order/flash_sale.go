@@ -0,0 +1,317 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/common/rate"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// flashSaleRateKey 抢购限流键前缀，按用户计数
+const flashSaleRateKey = "flashsale:user:"
+
+// CreateFlashSaleRequest 创建秒杀活动请求
+type CreateFlashSaleRequest struct {
+	Name         string          `json:"name"`
+	ProductID    string          `json:"product_id"`
+	SkuID        string          `json:"sku_id"`
+	Price        decimal.Decimal `json:"price"`
+	Quota        int32           `json:"quota"`
+	PerUserLimit int32           `json:"per_user_limit"`
+	StartAt      string          `json:"start_at"`
+	EndAt        string          `json:"end_at"`
+}
+
+// CreateFlashSale 创建秒杀活动，活动开始前由对账任务预热库存
+func (s *Service) CreateFlashSale(ctx context.Context, req CreateFlashSaleRequest) (*flashsale.Sale, error) {
+	sale := &flashsale.Sale{
+		Name:         req.Name,
+		ProductID:    req.ProductID,
+		SkuID:        req.SkuID,
+		Price:        req.Price,
+		Quota:        req.Quota,
+		PerUserLimit: req.PerUserLimit,
+		StartAt:      req.StartAt,
+		EndAt:        req.EndAt,
+	}
+	if err := s.flashSaleDS.CreateSale(ctx, sale); err != nil {
+		return nil, err
+	}
+	return sale, nil
+}
+
+// FlashSaleInfo 秒杀活动及剩余活动库存
+type FlashSaleInfo struct {
+	Sale      *flashsale.Sale `json:"sale"`
+	Remaining int32           `json:"remaining"`
+}
+
+// ListFlashSales 获取秒杀活动列表，剩余活动库存从 Redis 中读取，未预热时为活动库存
+func (s *Service) ListFlashSales(ctx context.Context) ([]*FlashSaleInfo, error) {
+	sales, err := s.flashSaleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*FlashSaleInfo, 0, len(sales))
+	for _, sale := range sales {
+		info := &FlashSaleInfo{Sale: sale, Remaining: sale.Quota}
+		if sale.Preloaded {
+			if info.Remaining, err = s.flashSaleStock.Remaining(ctx, sale.ID); err != nil {
+				return nil, err
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// PurchaseFlashSaleRequest 抢购请求
+type PurchaseFlashSaleRequest struct {
+	UserID        string `json:"user_id"`
+	SaleID        string `json:"sale_id"`
+	Quantity      int32  `json:"quantity"`
+	AddressID     string `json:"address_id"`
+	PaymentMethod string `json:"payment_method"`
+}
+
+// PurchaseFlashSale 抢购：按用户限流后在 Redis 中扣减活动库存，抢到的请求排队异步创建订单
+// 返回的抢购记录处于排队状态，客户端通过 GetFlashSaleTicket 查询下单结果。
+func (s *Service) PurchaseFlashSale(ctx context.Context, req PurchaseFlashSaleRequest) (*flashsale.Ticket, error) {
+	if !s.limiter.Allow(ctx, flashSaleRateKey+req.UserID, rate.PerSecond(s.orderConfig.FlashSale.RateLimit)) {
+		return nil, flashsale.ErrTooManyRequests
+	}
+
+	sale, err := s.flashSaleRepo.Get(ctx, req.SaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 异步下单时没有用户的登录凭证，收货地址在抢购时获取
+	address, err := s.resolveAddress(ctx, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := &flashsale.Ticket{
+		UserID:        req.UserID,
+		Quantity:      req.Quantity,
+		PaymentMethod: req.PaymentMethod,
+		Address: flashsale.Address{
+			ReceiverName:  address.ReceiverName,
+			ReceiverPhone: address.ReceiverPhone,
+			Province:      address.Province,
+			City:          address.City,
+			District:      address.District,
+			DetailAddress: address.DetailAddress,
+			PostalCode:    address.PostalCode,
+		},
+	}
+	expireAt := sale.Expiration(s.orderConfig.FlashSale.Retention)
+	if err := s.flashSaleDS.Purchase(ctx, sale, ticket, time.Now(), expireAt); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// GetFlashSaleTicket 获取用户的抢购记录
+func (s *Service) GetFlashSaleTicket(ctx context.Context, userID, ticketID string) (*flashsale.Ticket, error) {
+	ticket, err := s.flashSaleQueue.Get(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.UserID != userID {
+		return nil, flashsale.ErrTicketNotFound
+	}
+	return ticket, nil
+}
+
+// ProcessFlashSaleTickets 为排队的抢购记录创建订单，返回处理的记录数
+// 订单通过下单Saga创建，库存服务中的库存在此时预占；创建失败时退回活动库存。
+func (s *Service) ProcessFlashSaleTickets(ctx context.Context, limit int) (int, error) {
+	tickets, err := s.flashSaleQueue.Dequeue(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	sales := make(map[string]*flashsale.Sale)
+	processed := 0
+	for _, ticket := range tickets {
+		// 重新排队的记录可能已被处理
+		if ticket.Status != flashsale.TicketStatusQueued {
+			continue
+		}
+
+		sale, ok := sales[ticket.SaleID]
+		if !ok {
+			sale, err = s.flashSaleRepo.Get(ctx, ticket.SaleID)
+			if err != nil {
+				log.Printf("Failed to get flash sale %s for ticket %s: %v", ticket.SaleID, ticket.ID, err)
+				continue
+			}
+			sales[ticket.SaleID] = sale
+		}
+
+		if err := s.processFlashSaleTicket(ctx, sale, ticket); err != nil {
+			log.Printf("Failed to process flash sale ticket %s: %v", ticket.ID, err)
+			continue
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// processFlashSaleTicket 为单条抢购记录创建订单，以抢购记录ID为幂等键，重复处理时返回同一个订单
+func (s *Service) processFlashSaleTicket(ctx context.Context, sale *flashsale.Sale, ticket *flashsale.Ticket) error {
+	createdOrder, err := idempotent(ctx, s, idempotency.OperationFlashSaleOrder, ticket.UserID, ticket.ID, ticket.SaleID, func() (*order.Order, error) {
+		return s.createFlashSaleOrder(ctx, sale, ticket)
+	})
+	if err != nil {
+		// 另一个实例正在处理该记录，记录保持排队状态，超时后由对账任务重新排队
+		if errors.Is(err, idempotency.ErrRequestInProgress) {
+			return err
+		}
+		return s.flashSaleDS.Fail(ctx, ticket, err.Error())
+	}
+	return s.flashSaleDS.Complete(ctx, ticket, createdOrder.ID)
+}
+
+// createFlashSaleOrder 按秒杀价创建订单，不使用优惠券，也不检查商品的限购（活动自身的每人限购已在抢购时检查）
+func (s *Service) createFlashSaleOrder(ctx context.Context, sale *flashsale.Sale, ticket *flashsale.Ticket) (*order.Order, error) {
+	req := CreateOrderRequest{
+		UserID: ticket.UserID,
+		Items: []CreateOrderItemRequest{{
+			ProductID: sale.ProductID,
+			SkuID:     sale.SkuID,
+			Quantity:  ticket.Quantity,
+		}},
+		Address: CreateOrderAddressRequest{
+			ReceiverName:  ticket.Address.ReceiverName,
+			ReceiverPhone: ticket.Address.ReceiverPhone,
+			Province:      ticket.Address.Province,
+			City:          ticket.Address.City,
+			District:      ticket.Address.District,
+			DetailAddress: ticket.Address.DetailAddress,
+			PostalCode:    ticket.Address.PostalCode,
+		},
+		PaymentMethod: ticket.PaymentMethod,
+		Remark:        fmt.Sprintf("秒杀活动：%s", sale.Name),
+	}
+
+	pricing, err := s.priceOrder(ctx, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	applyActivityPrice(pricing, sale.Price)
+	if err := s.applyFreight(ctx, pricing, req.Address); err != nil {
+		return nil, err
+	}
+
+	return s.placeOrder(ctx, req, pricing, nil)
+}
+
+// applyActivityPrice 以秒杀价、预售价等活动价替换商品服务的售价并重新汇总金额
+func applyActivityPrice(pricing *orderPricing, price decimal.Decimal) {
+	pricing.TotalAmount = decimal.Zero
+	for i, item := range pricing.Items {
+		item.Price = price
+		item.TotalAmount = price.Mul(decimal.NewFromInt32(item.Quantity))
+		pricing.promotionItems[i].Amount = item.TotalAmount
+		pricing.freightItems[i].Amount = item.TotalAmount
+		pricing.TotalAmount = pricing.TotalAmount.Add(item.TotalAmount)
+	}
+	pricing.ActualAmount = pricing.TotalAmount.Add(pricing.ShippingFee).Sub(pricing.DiscountAmount)
+}
+
+// ReconcileFlashSales 秒杀对账，返回结算的活动数：
+//  1. 活动开始前预热库存，预热数量不超过库存服务中的可用库存；
+//  2. 排队超时的抢购记录重新排队；
+//  3. 活动进行中订单已取消的抢购记录退回活动库存，供其他用户抢购；
+//  4. 活动结束且排队的记录全部处理后结算销量。
+func (s *Service) ReconcileFlashSales(ctx context.Context, now time.Time) (int, error) {
+	sales, err := s.flashSaleRepo.ListUnsettled(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	settled := 0
+	for _, sale := range sales {
+		done, err := s.reconcileFlashSale(ctx, sale, now)
+		if err != nil {
+			log.Printf("Failed to reconcile flash sale %s: %v", sale.ID, err)
+			continue
+		}
+		if done {
+			settled++
+		}
+	}
+	return settled, nil
+}
+
+// reconcileFlashSale 对账单个秒杀活动，返回活动是否已结算
+func (s *Service) reconcileFlashSale(ctx context.Context, sale *flashsale.Sale, now time.Time) (bool, error) {
+	cfg := s.orderConfig.FlashSale
+	if sale.ShouldPreload(now, cfg.PreloadLead) {
+		available, err := s.inventoryClient.BatchGetAvailable(ctx, []string{sale.SkuID})
+		if err != nil {
+			return false, fmt.Errorf("获取可用库存失败: %w", err)
+		}
+		if err := s.flashSaleDS.Preload(ctx, sale, available[sale.SkuID], sale.Expiration(cfg.Retention)); err != nil {
+			return false, err
+		}
+	}
+	if !sale.Preloaded {
+		// 未预热就已结束的活动没有抢购记录
+		if sale.Ended(now) {
+			return true, s.flashSaleDS.Settle(ctx, sale, 0)
+		}
+		return false, nil
+	}
+
+	tickets, err := s.flashSaleQueue.ListBySale(ctx, sale.ID)
+	if err != nil {
+		return false, err
+	}
+
+	queued := 0
+	var sold int32
+	for _, ticket := range tickets {
+		switch ticket.Status {
+		case flashsale.TicketStatusQueued:
+			queued++
+			if ticket.Stale(now, cfg.StaleAfter) {
+				if err := s.flashSaleDS.Requeue(ctx, ticket); err != nil {
+					return false, err
+				}
+			}
+		case flashsale.TicketStatusCreated:
+			orderEntity, err := s.orderRepo.GetByID(ctx, ticket.OrderID)
+			if err != nil {
+				return false, fmt.Errorf("获取订单失败: %w", err)
+			}
+			if orderEntity.Status != int32(order.OrderStatusCancelled) {
+				sold += ticket.Quantity
+				continue
+			}
+			if sale.Active(now) {
+				ticket.Reason = "订单已取消"
+				if err := s.flashSaleDS.Release(ctx, ticket); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	if !sale.Ended(now) || queued > 0 {
+		return false, nil
+	}
+	return true, s.flashSaleDS.Settle(ctx, sale, sold)
+}
@@ -0,0 +1,76 @@
+package order
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
+)
+
+// FreightRuleRequest 运费计费规则
+type FreightRuleRequest struct {
+	FirstFee      decimal.Decimal `json:"first_fee"`
+	AdditionalFee decimal.Decimal `json:"additional_fee"`
+	FreeThreshold decimal.Decimal `json:"free_threshold"`
+	Surcharge     decimal.Decimal `json:"surcharge"`
+}
+
+// FreightRegionRequest 指定地区的运费计费规则
+type FreightRegionRequest struct {
+	Provinces []string           `json:"provinces"`
+	Cities    []string           `json:"cities"`
+	Rule      FreightRuleRequest `json:"rule"`
+}
+
+// SaveFreightTemplateRequest 创建或更新运费模板请求，ID 为空时创建
+type SaveFreightTemplateRequest struct {
+	ID               string                 `json:"id"`
+	Name             string                 `json:"name"`
+	FirstWeight      int32                  `json:"first_weight"`
+	AdditionalWeight int32                  `json:"additional_weight"`
+	DefaultRule      FreightRuleRequest     `json:"default_rule"`
+	Regions          []FreightRegionRequest `json:"regions"`
+	IsDefault        bool                   `json:"is_default"`
+}
+
+// SaveFreightTemplate 创建或更新运费模板
+func (s *Service) SaveFreightTemplate(ctx context.Context, req SaveFreightTemplateRequest) (*freight.Template, error) {
+	template := &freight.Template{
+		ID:               req.ID,
+		Name:             req.Name,
+		FirstWeight:      req.FirstWeight,
+		AdditionalWeight: req.AdditionalWeight,
+		DefaultRule:      freight.Rule(req.DefaultRule),
+		Regions:          make([]*freight.RegionRule, 0, len(req.Regions)),
+		IsDefault:        req.IsDefault,
+	}
+	for _, region := range req.Regions {
+		template.Regions = append(template.Regions, &freight.RegionRule{
+			Provinces: region.Provinces,
+			Cities:    region.Cities,
+			Rule:      freight.Rule(region.Rule),
+		})
+	}
+
+	var err error
+	if req.ID == "" {
+		err = s.freightDS.CreateTemplate(ctx, template)
+	} else {
+		err = s.freightDS.UpdateTemplate(ctx, template)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ListFreightTemplates 获取全部运费模板
+func (s *Service) ListFreightTemplates(ctx context.Context) ([]*freight.Template, error) {
+	return s.freightRepo.ListTemplates(ctx)
+}
+
+// SetProductFreightTemplate 为商品绑定运费模板，templateID 为空时改用默认模板
+func (s *Service) SetProductFreightTemplate(ctx context.Context, productID, templateID string) error {
+	return s.freightDS.BindProduct(ctx, productID, templateID)
+}
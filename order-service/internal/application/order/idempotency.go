@@ -0,0 +1,133 @@
+package order
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
+)
+
+// 处理中的幂等键的占用时长，请求执行期间按续期间隔延长；进程中途崩溃时超过该时长后允许重试
+const (
+	idempotencyLockTTL           = 2 * time.Minute
+	idempotencyLockRenewInterval = idempotencyLockTTL / 4
+)
+
+// idempotent 按客户端幂等键执行 fn，key 为空时直接执行
+// 有效期内相同请求的重放返回首次请求的响应，不同请求复用同一个键时返回 ErrKeyReused；
+// fn 执行期间幂等键持续续期，不会因为下单 saga 等耗时操作超过占用时长而被重复执行；
+// fn 失败时释放幂等键，客户端可以用同一个键重试。
+func idempotent[T any](ctx context.Context, s *Service, operation, userID, key string, request any, fn func() (T, error)) (T, error) {
+	var zero T
+	if key == "" {
+		return fn()
+	}
+	if len(key) > idempotency.MaxKeyLength {
+		return zero, fmt.Errorf("%w: 长度不能超过 %d", idempotency.ErrInvalidKey, idempotency.MaxKeyLength)
+	}
+
+	requestHash, err := hashRequest(request)
+	if err != nil {
+		return zero, err
+	}
+
+	record := idempotency.NewRecord(userID, operation, key, requestHash, time.Now().Add(idempotencyLockTTL))
+	existing, err := s.idempotencyRepo.Acquire(ctx, record)
+	if err != nil {
+		return zero, fmt.Errorf("占用幂等键失败: %w", err)
+	}
+	if existing != nil {
+		return replay[T](existing, requestHash)
+	}
+
+	stopRenew := s.renewIdempotencyKey(ctx, record, idempotencyLockRenewInterval)
+	resp, err := fn()
+	stopRenew()
+	if err != nil {
+		if releaseErr := s.idempotencyRepo.Release(ctx, record); releaseErr != nil {
+			log.Printf("Failed to release idempotency key %s for user %s: %v", key, userID, releaseErr)
+		}
+		return zero, err
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Printf("Failed to encode idempotent response for key %s of user %s: %v", key, userID, err)
+		return resp, nil
+	}
+	record.Complete(string(data), time.Now().Add(s.orderConfig.IdempotencyTTL))
+	if err := s.idempotencyRepo.Complete(ctx, record); err != nil {
+		// 请求已成功，响应保存失败只影响之后的重放
+		log.Printf("Failed to save idempotent response for key %s of user %s: %v", key, userID, err)
+	}
+	return resp, nil
+}
+
+// renewIdempotencyKey 每隔 interval 延长处理中的幂等键的占用时长，返回的函数停止续期并等待续期协程退出
+// 续期不受请求上下文取消的影响，客户端断开后 fn 仍在执行时键保持占用。
+func (s *Service) renewIdempotencyKey(ctx context.Context, record *idempotency.Record, interval time.Duration) func() {
+	ctx = context.WithoutCancel(ctx)
+	renewal := *record
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				renewal.Renew(time.Now().Add(idempotencyLockTTL))
+				if err := s.idempotencyRepo.Renew(ctx, &renewal); err != nil {
+					log.Printf("Failed to renew idempotency key %s for user %s: %v", renewal.Key, renewal.UserID, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// replay 返回已有幂等记录中保存的响应
+func replay[T any](record *idempotency.Record, requestHash string) (T, error) {
+	var resp T
+	if record.RequestHash != requestHash {
+		return resp, idempotency.ErrKeyReused
+	}
+	if !record.IsCompleted() {
+		return resp, idempotency.ErrRequestInProgress
+	}
+
+	if err := json.Unmarshal([]byte(record.Response), &resp); err != nil {
+		return resp, fmt.Errorf("解析幂等响应失败: %w", err)
+	}
+	return resp, nil
+}
+
+// hashRequest 计算请求内容的摘要，用于识别同一个幂等键下的不同请求
+func hashRequest(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("序列化请求失败: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// PurgeExpiredIdempotencyKeys 清理过期的幂等记录，返回删除的数量
+func (s *Service) PurgeExpiredIdempotencyKeys(ctx context.Context, limit int) (int64, error) {
+	return s.idempotencyRepo.DeleteExpired(ctx, time.Now(), limit)
+}
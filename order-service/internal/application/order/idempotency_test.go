@@ -0,0 +1,141 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
+)
+
+// memoryIdempotencyRepo 内存幂等记录仓储
+type memoryIdempotencyRepo struct {
+	records  map[string]*idempotency.Record
+	renewals int
+}
+
+func (r *memoryIdempotencyRepo) Acquire(_ context.Context, record *idempotency.Record) (*idempotency.Record, error) {
+	id := record.UserID + "/" + record.Operation + "/" + record.Key
+	if existing, ok := r.records[id]; ok {
+		copied := *existing
+		return &copied, nil
+	}
+	record.ID = id
+	copied := *record
+	r.records[id] = &copied
+	return nil, nil
+}
+
+func (r *memoryIdempotencyRepo) Renew(_ context.Context, record *idempotency.Record) error {
+	r.renewals++
+	if existing, ok := r.records[record.ID]; ok && !existing.IsCompleted() {
+		existing.ExpiresAt = record.ExpiresAt
+	}
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) Complete(_ context.Context, record *idempotency.Record) error {
+	copied := *record
+	r.records[record.ID] = &copied
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) Release(_ context.Context, record *idempotency.Record) error {
+	delete(r.records, record.ID)
+	return nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteExpired(context.Context, time.Time, int) (int64, error) {
+	return 0, nil
+}
+
+func TestIdempotent(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		idempotencyRepo: &memoryIdempotencyRepo{records: make(map[string]*idempotency.Record)},
+		orderConfig:     &config.OrderConfig{IdempotencyTTL: time.Hour},
+	}
+
+	calls := 0
+	pay := func(request PayOrderRequest) (string, error) {
+		return idempotent(ctx, s, idempotency.OperationPayOrder, "user-1", request.IdempotencyKey, request, func() (string, error) {
+			calls++
+			return request.OrderID + "-paid", nil
+		})
+	}
+
+	first, err := pay(PayOrderRequest{OrderID: "order-1", IdempotencyKey: "key-1"})
+	require.NoError(t, err)
+
+	t.Run("replay returns original response", func(t *testing.T) {
+		replayed, err := pay(PayOrderRequest{OrderID: "order-1", IdempotencyKey: "key-1"})
+		require.NoError(t, err)
+		assert.Equal(t, first, replayed)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("different payload is rejected", func(t *testing.T) {
+		_, err := pay(PayOrderRequest{OrderID: "order-2", IdempotencyKey: "key-1"})
+		assert.ErrorIs(t, err, idempotency.ErrKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("without key always runs", func(t *testing.T) {
+		_, err := pay(PayOrderRequest{OrderID: "order-1"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("failure releases key", func(t *testing.T) {
+		failed := errors.New("boom")
+		_, err := idempotent(ctx, s, idempotency.OperationPayOrder, "user-1", "key-2", "request", func() (string, error) {
+			return "", failed
+		})
+		require.ErrorIs(t, err, failed)
+
+		resp, err := idempotent(ctx, s, idempotency.OperationPayOrder, "user-1", "key-2", "request", func() (string, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		_, err := idempotent(ctx, s, idempotency.OperationPayOrder, "user-1", "key-3", "request", func() (string, error) {
+			return idempotent(ctx, s, idempotency.OperationPayOrder, "user-1", "key-3", "request", func() (string, error) {
+				return "nested", nil
+			})
+		})
+		assert.ErrorIs(t, err, idempotency.ErrRequestInProgress)
+	})
+}
+
+func TestRenewIdempotencyKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &memoryIdempotencyRepo{records: make(map[string]*idempotency.Record)}
+	s := &Service{idempotencyRepo: repo}
+
+	record := idempotency.NewRecord("user-1", idempotency.OperationCreateOrder, "key-1", "hash", time.Now().Add(time.Second))
+	existing, err := repo.Acquire(ctx, record)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+	acquiredExpiresAt := repo.records[record.ID].ExpiresAt
+
+	// 请求上下文取消后仍继续续期，直到 fn 返回
+	stop := s.renewIdempotencyKey(ctx, record, 10*time.Millisecond)
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	stop()
+
+	renewals := repo.renewals
+	assert.Positive(t, renewals)
+	assert.Greater(t, repo.records[record.ID].ExpiresAt, acquiredExpiresAt)
+
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, renewals, repo.renewals)
+}
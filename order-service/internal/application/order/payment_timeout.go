@@ -0,0 +1,70 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// reservationGracePeriod 库存预占在支付截止后额外保留的时间，保证超时取消先于预占过期执行
+const reservationGracePeriod = 5 * time.Minute
+
+// paymentDeadline 计算订单的支付截止时间
+func (s *Service) paymentDeadline(createdAt time.Time) string {
+	return createdAt.Add(s.orderConfig.PaymentTimeout).Format("2006-01-02 15:04:05")
+}
+
+// CancelOverdueOrders 取消超过支付截止时间仍未支付的订单（包括超时未付尾款的预售订单）并释放库存，返回取消的数量
+func (s *Service) CancelOverdueOrders(ctx context.Context, limit int) (int, error) {
+	orders, err := s.orderRepo.ListPaymentOverdue(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	cancelled := 0
+	for _, orderEntity := range orders {
+		if err := s.cancelOverdueOrder(ctx, orderEntity); err != nil {
+			log.Printf("Failed to cancel overdue order %s: %v", orderEntity.ID, err)
+			continue
+		}
+		cancelled++
+	}
+
+	return cancelled, nil
+}
+
+// cancelOverdueOrder 取消单个超时订单
+// 先取消订单再释放库存：订单更新带乐观锁，与支付并发时只有一方成功，避免已支付订单的库存被释放。
+func (s *Service) cancelOverdueOrder(ctx context.Context, orderEntity *order.Order) error {
+	if err := s.orderDS.ExpireOrder(ctx, orderEntity); err != nil {
+		if errors.Is(err, order.ErrOrderConflict) {
+			return nil
+		}
+		return err
+	}
+
+	// 超时未付尾款的预售订单按定金规则处理定金
+	if orderEntity.IsPresale() {
+		s.refundCancelledDeposit(ctx, orderEntity)
+	}
+
+	// 释放失败时预占会在宽限期结束后由库存服务过期清理
+	return s.releaseInventory(ctx, orderEntity.ID)
+}
+
+// releaseInventory 释放订单预占的库存，释放按订单幂等
+func (s *Service) releaseInventory(ctx context.Context, orderID string) error {
+	resp, err := s.inventoryClient.ReleaseInventory(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("释放库存失败: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("释放库存失败: %s", resp.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/presale"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+)
+
+// CreatePresaleRequest 创建预售活动请求
+type CreatePresaleRequest struct {
+	Name           string                `json:"name"`
+	ProductID      string                `json:"product_id"`
+	SkuID          string                `json:"sku_id"`
+	Price          decimal.Decimal       `json:"price"`
+	Deposit        decimal.Decimal       `json:"deposit"`
+	DepositPolicy  presale.DepositPolicy `json:"deposit_policy"`
+	DepositStartAt string                `json:"deposit_start_at"`
+	DepositEndAt   string                `json:"deposit_end_at"`
+	BalanceStartAt string                `json:"balance_start_at"`
+	BalanceEndAt   string                `json:"balance_end_at"`
+}
+
+// CreatePresale 创建预售活动
+func (s *Service) CreatePresale(ctx context.Context, req CreatePresaleRequest) (*presale.Presale, error) {
+	p := &presale.Presale{
+		Name:           req.Name,
+		ProductID:      req.ProductID,
+		SkuID:          req.SkuID,
+		Price:          req.Price,
+		Deposit:        req.Deposit,
+		DepositPolicy:  req.DepositPolicy,
+		DepositStartAt: req.DepositStartAt,
+		DepositEndAt:   req.DepositEndAt,
+		BalanceStartAt: req.BalanceStartAt,
+		BalanceEndAt:   req.BalanceEndAt,
+	}
+	if err := s.presaleDS.CreatePresale(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListPresales 获取预售活动列表
+func (s *Service) ListPresales(ctx context.Context) ([]*presale.Presale, error) {
+	return s.presaleRepo.List(ctx)
+}
+
+// CreatePresaleOrderRequest 预售下单请求
+type CreatePresaleOrderRequest struct {
+	UserID        string `json:"user_id"`
+	PresaleID     string `json:"presale_id"`
+	Quantity      int32  `json:"quantity"`
+	AddressID     string `json:"address_id"`
+	PaymentMethod string `json:"payment_method"`
+	Remark        string `json:"remark"`
+	// IdempotencyKey 客户端幂等键，不参与请求摘要
+	IdempotencyKey string `json:"-"`
+}
+
+// CreatePresaleOrder 预售下单：按预售价计价，订单拆分为定金、尾款两个支付阶段，下单后支付定金
+// 不使用优惠券；运费计入尾款。库存在下单时预占，保留到尾款截止后。
+func (s *Service) CreatePresaleOrder(ctx context.Context, req CreatePresaleOrderRequest) (*order.Order, error) {
+	return idempotent(ctx, s, idempotency.OperationCreatePresaleOrder, req.UserID, req.IdempotencyKey, req, func() (*order.Order, error) {
+		return s.createPresaleOrder(ctx, req)
+	})
+}
+
+// createPresaleOrder 预售下单
+func (s *Service) createPresaleOrder(ctx context.Context, req CreatePresaleOrderRequest) (*order.Order, error) {
+	if req.Quantity <= 0 {
+		return nil, order.ErrInvalidQuantity
+	}
+
+	p, err := s.presaleRepo.Get(ctx, req.PresaleID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if !p.DepositOpen(now) {
+		return nil, presale.ErrPresaleNotActive
+	}
+
+	address, err := s.resolveAddress(ctx, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	orderReq := CreateOrderRequest{
+		UserID: req.UserID,
+		Items: []CreateOrderItemRequest{{
+			ProductID: p.ProductID,
+			SkuID:     p.SkuID,
+			Quantity:  req.Quantity,
+		}},
+		Address:       address,
+		PaymentMethod: req.PaymentMethod,
+		Remark:        req.Remark,
+	}
+
+	pricing, err := s.priceOrder(ctx, orderReq.Items)
+	if err != nil {
+		return nil, err
+	}
+	applyActivityPrice(pricing, p.Price)
+	if err := s.applyFreight(ctx, pricing, orderReq.Address); err != nil {
+		return nil, err
+	}
+	if err := s.checkPurchaseLimit(ctx, req.UserID, pricing); err != nil {
+		return nil, err
+	}
+
+	orderEntity, orderAddress := s.newOrder(orderReq, pricing)
+	if err := orderEntity.ApplyPresale(p.Terms(req.Quantity, now, s.orderConfig.PaymentTimeout)); err != nil {
+		return nil, err
+	}
+	return s.submitOrder(ctx, orderEntity, orderAddress, pricing.Items, nil)
+}
+
+// RequestBalancePayments 为进入尾款期的预售订单创建尾款支付单，返回创建的数量
+func (s *Service) RequestBalancePayments(ctx context.Context, now time.Time, limit int) (int, error) {
+	payments, err := s.orderRepo.ListBalanceDue(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	requested := 0
+	for _, payment := range payments {
+		orderEntity, err := s.orderRepo.GetByID(ctx, payment.OrderID)
+		if err != nil {
+			log.Printf("Failed to get presale order %s: %v", payment.OrderID, err)
+			continue
+		}
+		if err := requestStagePayment(ctx, s.paymentClient, s.orderRepo, s.orderConfig.Payment, orderEntity, payment, "订单尾款"); err != nil {
+			log.Printf("Failed to request balance payment for order %s: %v", payment.OrderID, err)
+			continue
+		}
+		requested++
+	}
+	return requested, nil
+}
+
+// requestStagePayment 为预售订单的支付阶段创建支付单并记录创建时间
+// 支付阶段ID作为支付服务中的业务订单号，同一订单的定金和尾款在支付服务中是两个支付单。
+func requestStagePayment(ctx context.Context, paymentClient *client.PaymentServiceClient, orderRepo order.Repository, paymentConfig config.PaymentConfig, orderEntity *order.Order, payment *order.OrderPayment, subject string) error {
+	resp, err := paymentClient.CreatePayment(ctx, &client.PaymentRequest{
+		OrderID:       payment.ID,
+		Amount:        payment.Amount.String(),
+		PaymentMethod: convertToPaymentMethod(orderEntity.PaymentMethod),
+		Subject:       fmt.Sprintf("%s-%s", subject, orderEntity.OrderNo),
+		Description:   "商城预售订单支付",
+		NotifyURL:     paymentConfig.NotifyURL,
+		ReturnURL:     paymentConfig.ReturnURL,
+	})
+	if err != nil {
+		return fmt.Errorf("创建支付订单失败: %w", err)
+	}
+	if !resp.Success {
+		return errors.New("创建支付订单失败")
+	}
+
+	payment.RequestedAt = time.Now().Format("2006-01-02 15:04:05")
+	return orderRepo.UpdatePayment(ctx, payment)
+}
+
+// RetryDepositRefunds 重新退还退款中的定金，返回退款成功的数量
+func (s *Service) RetryDepositRefunds(ctx context.Context, limit int) (int, error) {
+	payments, err := s.orderRepo.ListRefundingPayments(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	refunded := 0
+	for _, payment := range payments {
+		if err := s.refundDeposit(ctx, payment); err != nil {
+			log.Printf("Failed to refund deposit of order %s: %v", payment.OrderID, err)
+			continue
+		}
+		refunded++
+	}
+	return refunded, nil
+}
+
+// settleCancelledPresale 预售订单取消后退还需要退还的定金，并释放保留到尾款截止的库存
+// 失败时只记录日志：定金保持退款中由定时任务重试，库存在预占过期后由库存服务清理。
+func (s *Service) settleCancelledPresale(ctx context.Context, orderEntity *order.Order) {
+	s.refundCancelledDeposit(ctx, orderEntity)
+	if err := s.releaseInventory(ctx, orderEntity.ID); err != nil {
+		log.Printf("Failed to release inventory of cancelled presale order %s: %v", orderEntity.ID, err)
+	}
+}
+
+// refundCancelledDeposit 退还已取消预售订单中标记为退款中的定金，不退的定金无需处理
+func (s *Service) refundCancelledDeposit(ctx context.Context, orderEntity *order.Order) {
+	deposit := orderEntity.Payment(order.PaymentStageDeposit)
+	if deposit == nil || deposit.Status != int32(order.PaymentStatusRefunding) {
+		return
+	}
+	if err := s.refundDeposit(ctx, deposit); err != nil {
+		log.Printf("Failed to refund deposit of order %s: %v", orderEntity.ID, err)
+	}
+}
+
+// refundDeposit 调用支付服务退还定金
+func (s *Service) refundDeposit(ctx context.Context, deposit *order.OrderPayment) error {
+	amount := deposit.Amount.Sub(deposit.RefundedAmount)
+	if _, err := s.paymentClient.CreateRefund(ctx, &client.RefundRequest{
+		OrderID: deposit.ID,
+		Amount:  amount.StringFixed(2),
+		Reason:  "预售订单取消，退还定金",
+	}); err != nil {
+		return fmt.Errorf("发起退款失败: %w", err)
+	}
+
+	deposit.Refund(amount, time.Now())
+	return s.orderRepo.UpdatePayment(ctx, deposit)
+}
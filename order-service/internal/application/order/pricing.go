@@ -0,0 +1,228 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+)
+
+// orderPricing 服务端计价结果
+type orderPricing struct {
+	Items          []*order.OrderItem
+	TotalAmount    decimal.Decimal
+	DiscountAmount decimal.Decimal
+	ShippingFee    decimal.Decimal
+	ActualAmount   decimal.Decimal
+	// Coupons 使用的优惠券，优惠金额合计即 DiscountAmount
+	Coupons []*order.AppliedCoupon
+	// promotionItems 与 Items 一一对应，用于选择优惠券
+	promotionItems []promotion.Item
+	// freightItems 与 Items 一一对应，用于计算运费
+	freightItems []freight.Item
+}
+
+// pricedItem 计价后的商品项，附带匹配优惠券适用范围需要的商品分类和品牌，以及计算运费需要的重量
+type pricedItem struct {
+	item       *order.OrderItem
+	categoryID string
+	brandID    string
+	weight     int32
+	virtual    bool
+}
+
+// UnavailableItem 无法购买的商品项
+type UnavailableItem struct {
+	ProductID string `json:"product_id"`
+	SkuID     string `json:"sku_id"`
+	Reason    string `json:"reason"`
+}
+
+// priceOrder 以商品服务的当前售价和名称为订单计价，不信任客户端传入的金额
+func (s *Service) priceOrder(ctx context.Context, items []CreateOrderItemRequest) (*orderPricing, error) {
+	if len(items) == 0 {
+		return nil, order.ErrEmptyOrderItems
+	}
+
+	pricedItems := make([]*pricedItem, 0, len(items))
+	for _, item := range items {
+		priced, err := s.priceItem(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		pricedItems = append(pricedItems, priced)
+	}
+
+	return newOrderPricing(pricedItems), nil
+}
+
+// priceQuote 为报价计价，不可购买的商品项单独返回而不是中断计价
+func (s *Service) priceQuote(ctx context.Context, items []CreateOrderItemRequest) (*orderPricing, []UnavailableItem, error) {
+	if len(items) == 0 {
+		return nil, nil, order.ErrEmptyOrderItems
+	}
+
+	pricedItems := make([]*pricedItem, 0, len(items))
+	var unavailable []UnavailableItem
+	for _, item := range items {
+		priced, err := s.priceItem(ctx, item)
+		if err != nil {
+			if errors.Is(err, order.ErrProductUnavailable) {
+				unavailable = append(unavailable, UnavailableItem{
+					ProductID: item.ProductID,
+					SkuID:     item.SkuID,
+					Reason:    err.Error(),
+				})
+				continue
+			}
+			return nil, nil, err
+		}
+		pricedItems = append(pricedItems, priced)
+	}
+
+	return newOrderPricing(pricedItems), unavailable, nil
+}
+
+// priceItem 为单个商品项计价，商品或SKU不存在、已下架时返回 ErrProductUnavailable
+func (s *Service) priceItem(ctx context.Context, item CreateOrderItemRequest) (*pricedItem, error) {
+	if item.Quantity <= 0 {
+		return nil, fmt.Errorf("%w: sku %s", order.ErrInvalidQuantity, item.SkuID)
+	}
+
+	// 获取商品信息
+	product, err := s.productClient.GetProduct(ctx, item.ProductID)
+	if err != nil {
+		if errors.Is(err, client.ErrProductNotFound) || status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: 商品 %s 不存在", order.ErrProductUnavailable, item.ProductID)
+		}
+		return nil, fmt.Errorf("获取商品信息失败: %w", err)
+	}
+
+	// 检查商品状态
+	if product.Status != client.ProductStatusActive {
+		return nil, fmt.Errorf("%w: 商品 %s 不可购买", order.ErrProductUnavailable, product.Name)
+	}
+
+	// 商品详情已包含全部SKU，无需再查询SKU列表
+	var sku *client.ProductSKU
+	for _, productSKU := range product.SKUs {
+		if productSKU.ID == item.SkuID {
+			sku = productSKU
+			break
+		}
+	}
+	if sku == nil {
+		return nil, fmt.Errorf("%w: 商品SKU %s 不存在", order.ErrProductUnavailable, item.SkuID)
+	}
+
+	// 检查SKU状态
+	if !sku.IsActive {
+		return nil, fmt.Errorf("%w: 商品SKU %s 不可购买", order.ErrProductUnavailable, sku.Name)
+	}
+
+	price, err := decimal.NewFromString(sku.SalePrice)
+	if err != nil {
+		return nil, fmt.Errorf("商品SKU %s 售价格式错误: %w", sku.ID, err)
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	return &pricedItem{
+		item: &order.OrderItem{
+			ProductID:    product.ID,
+			SkuID:        sku.ID,
+			ProductName:  product.Name,
+			SkuName:      sku.Name,
+			ProductImage: product.MainImageURL,
+			Price:        price,
+			Quantity:     item.Quantity,
+			TotalAmount:  price.Mul(decimal.NewFromInt32(item.Quantity)),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+		categoryID: product.CategoryID,
+		brandID:    product.BrandID,
+		weight:     sku.Weight,
+		virtual:    product.IsVirtual,
+	}, nil
+}
+
+// newOrderPricing 汇总商品项金额，运费由 applyFreight 计算，优惠由 applyCoupons 计算
+func newOrderPricing(items []*pricedItem) *orderPricing {
+	pricing := &orderPricing{
+		Items:          make([]*order.OrderItem, 0, len(items)),
+		TotalAmount:    decimal.Zero,
+		DiscountAmount: decimal.Zero,
+		ShippingFee:    decimal.Zero,
+		promotionItems: make([]promotion.Item, 0, len(items)),
+		freightItems:   make([]freight.Item, 0, len(items)),
+	}
+	for _, priced := range items {
+		pricing.Items = append(pricing.Items, priced.item)
+		pricing.promotionItems = append(pricing.promotionItems, promotion.Item{
+			SkuID:      priced.item.SkuID,
+			CategoryID: priced.categoryID,
+			BrandID:    priced.brandID,
+			Amount:     priced.item.TotalAmount,
+		})
+		pricing.freightItems = append(pricing.freightItems, freight.Item{
+			ProductID: priced.item.ProductID,
+			Weight:    priced.weight,
+			Quantity:  priced.item.Quantity,
+			Amount:    priced.item.TotalAmount,
+			Virtual:   priced.virtual,
+		})
+		pricing.TotalAmount = pricing.TotalAmount.Add(priced.item.TotalAmount)
+	}
+
+	pricing.ActualAmount = pricing.TotalAmount.Add(pricing.ShippingFee).Sub(pricing.DiscountAmount)
+	return pricing
+}
+
+// applyFreight 按收货地区和商品绑定的运费模板计算运费，未填写地址时按模板的默认规则计算
+func (s *Service) applyFreight(ctx context.Context, pricing *orderPricing, address CreateOrderAddressRequest) error {
+	fee, err := s.freightDS.Calculate(ctx, pricing.freightItems, freight.Destination{
+		Province: address.Province,
+		City:     address.City,
+	})
+	if err != nil {
+		return err
+	}
+
+	pricing.ShippingFee = fee
+	pricing.ActualAmount = pricing.TotalAmount.Add(pricing.ShippingFee).Sub(pricing.DiscountAmount)
+	return nil
+}
+
+// applyCoupons 为订单选择优惠券并计入优惠金额
+// couponIDs 为空时自动选择用户优惠最大的优惠券组合，否则使用指定的优惠券。
+func (s *Service) applyCoupons(ctx context.Context, pricing *orderPricing, userID string, couponIDs []string) error {
+	if len(pricing.Items) == 0 {
+		return nil
+	}
+
+	plan, err := s.promotionDS.Plan(ctx, userID, pricing.promotionItems, couponIDs)
+	if err != nil {
+		return err
+	}
+
+	pricing.Coupons = make([]*order.AppliedCoupon, 0, len(plan.Coupons))
+	for _, applied := range plan.Coupons {
+		pricing.Coupons = append(pricing.Coupons, &order.AppliedCoupon{
+			CouponID:       applied.Coupon.ID,
+			Name:           applied.Coupon.Template.Name,
+			DiscountAmount: applied.Amount,
+		})
+	}
+	pricing.DiscountAmount = plan.DiscountAmount
+	pricing.ActualAmount = pricing.TotalAmount.Add(pricing.ShippingFee).Sub(pricing.DiscountAmount)
+	return nil
+}
@@ -0,0 +1,45 @@
+package order
+
+import (
+	"context"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
+)
+
+// SetPurchaseLimitRequest 设置限购规则请求
+type SetPurchaseLimitRequest struct {
+	SkuID       string `json:"sku_id"`
+	ProductID   string `json:"product_id"`
+	MaxPerOrder int32  `json:"max_per_order"`
+	MaxPerUser  int32  `json:"max_per_user"`
+	WindowDays  int32  `json:"window_days"`
+}
+
+// SetPurchaseLimit 设置商品SKU的限购规则，已存在时覆盖
+func (s *Service) SetPurchaseLimit(ctx context.Context, req SetPurchaseLimitRequest) (*purchaselimit.Limit, error) {
+	limit := &purchaselimit.Limit{
+		SkuID:       req.SkuID,
+		ProductID:   req.ProductID,
+		MaxPerOrder: req.MaxPerOrder,
+		MaxPerUser:  req.MaxPerUser,
+		WindowDays:  req.WindowDays,
+	}
+	if err := limit.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.limitRepo.Save(ctx, limit); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// GetPurchaseLimit 获取商品SKU的限购规则
+func (s *Service) GetPurchaseLimit(ctx context.Context, skuID string) (*purchaselimit.Limit, error) {
+	return s.limitRepo.GetBySkuID(ctx, skuID)
+}
+
+// DeletePurchaseLimit 删除商品SKU的限购规则
+func (s *Service) DeletePurchaseLimit(ctx context.Context, skuID string) error {
+	return s.limitRepo.Delete(ctx, skuID)
+}
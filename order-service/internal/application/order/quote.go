@@ -0,0 +1,246 @@
+package order
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// QuoteOrderRequest 下单报价请求
+type QuoteOrderRequest struct {
+	UserID string                   `json:"user_id"`
+	Items  []CreateOrderItemRequest `json:"items"`
+	// AddressID 用户服务中保存的收货地址，非空时忽略 Address
+	AddressID string                    `json:"address_id"`
+	Address   CreateOrderAddressRequest `json:"address"`
+	// CouponIDs 使用的优惠券，为空时自动选择优惠最大的组合
+	CouponIDs []string `json:"coupon_ids"`
+	// SkipCoupons 不使用优惠券
+	SkipCoupons bool `json:"skip_coupons"`
+}
+
+// QuoteOrderResponse 下单报价结果
+type QuoteOrderResponse struct {
+	Items            []*order.OrderItem `json:"items"`
+	UnavailableItems []UnavailableItem  `json:"unavailable_items"`
+	TotalAmount      decimal.Decimal    `json:"total_amount"`
+	DiscountAmount   decimal.Decimal    `json:"discount_amount"`
+	ShippingFee      decimal.Decimal    `json:"shipping_fee"`
+	PayableAmount    decimal.Decimal    `json:"payable_amount"`
+	// Coupons 使用的优惠券，下单时在订单落库的事务中锁定
+	Coupons []*order.AppliedCoupon `json:"coupons"`
+	// QuoteToken 报价令牌，有效期内 CreateOrder 携带该令牌时按报价金额下单；存在不可购买商品时为空
+	QuoteToken string    `json:"quote_token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// QuoteOrder 计算订单金额明细，不落库也不预占库存
+func (s *Service) QuoteOrder(ctx context.Context, req QuoteOrderRequest) (*QuoteOrderResponse, error) {
+	if req.AddressID != "" {
+		address, err := s.resolveAddress(ctx, req.AddressID)
+		if err != nil {
+			return nil, err
+		}
+		req.Address = address
+	}
+
+	pricing, unavailable, err := s.priceQuote(ctx, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyFreight(ctx, pricing, req.Address); err != nil {
+		return nil, err
+	}
+	if !req.SkipCoupons {
+		if err := s.applyCoupons(ctx, pricing, req.UserID, req.CouponIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &QuoteOrderResponse{
+		Items:            pricing.Items,
+		UnavailableItems: unavailable,
+		TotalAmount:      pricing.TotalAmount,
+		DiscountAmount:   pricing.DiscountAmount,
+		ShippingFee:      pricing.ShippingFee,
+		PayableAmount:    pricing.ActualAmount,
+		Coupons:          pricing.Coupons,
+	}
+
+	// 部分商品不可购买时报价不可直接用于下单
+	if len(unavailable) > 0 || len(pricing.Items) == 0 {
+		return resp, nil
+	}
+
+	resp.ExpiresAt = time.Now().Add(s.orderConfig.QuoteTTL)
+	claims := newQuoteClaims(req.UserID, req.Address, pricing, resp.ExpiresAt)
+	resp.QuoteToken, err = signQuote([]byte(s.orderConfig.QuoteSecret), claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// applyQuote 校验报价令牌与下单请求一致，并按报价金额重写计价结果
+func (s *Service) applyQuote(pricing *orderPricing, req CreateOrderRequest) error {
+	claims, err := parseQuote([]byte(s.orderConfig.QuoteSecret), req.QuoteToken, time.Now())
+	if err != nil {
+		return err
+	}
+	if claims.UserID != req.UserID || claims.Address != addressDigest(req.Address) {
+		return order.ErrQuoteMismatch
+	}
+	if len(claims.Items) != len(pricing.Items) {
+		return order.ErrQuoteMismatch
+	}
+
+	quoted := make(map[string]quoteClaimItem, len(claims.Items))
+	for _, item := range claims.Items {
+		quoted[item.SkuID] = item
+	}
+
+	totalAmount := decimal.Zero
+	for _, item := range pricing.Items {
+		quotedItem, ok := quoted[item.SkuID]
+		if !ok || quotedItem.ProductID != item.ProductID || quotedItem.Quantity != item.Quantity {
+			return order.ErrQuoteMismatch
+		}
+		delete(quoted, item.SkuID)
+
+		item.Price = quotedItem.Price
+		item.TotalAmount = quotedItem.Price.Mul(decimal.NewFromInt32(item.Quantity))
+		totalAmount = totalAmount.Add(item.TotalAmount)
+	}
+
+	pricing.Coupons = make([]*order.AppliedCoupon, 0, len(claims.Coupons))
+	for _, coupon := range claims.Coupons {
+		pricing.Coupons = append(pricing.Coupons, &order.AppliedCoupon{
+			CouponID:       coupon.ID,
+			Name:           coupon.Name,
+			DiscountAmount: coupon.Amount,
+		})
+	}
+
+	pricing.TotalAmount = totalAmount
+	pricing.DiscountAmount = claims.DiscountAmount
+	pricing.ShippingFee = claims.ShippingFee
+	pricing.ActualAmount = totalAmount.Add(claims.ShippingFee).Sub(claims.DiscountAmount)
+	return nil
+}
+
+// quoteClaims 报价令牌中携带的报价内容
+type quoteClaims struct {
+	UserID         string             `json:"uid"`
+	Address        string             `json:"addr"`
+	Items          []quoteClaimItem   `json:"items"`
+	Coupons        []quoteClaimCoupon `json:"coupons,omitempty"`
+	DiscountAmount decimal.Decimal    `json:"discount"`
+	ShippingFee    decimal.Decimal    `json:"shipping"`
+	ExpiresAt      int64              `json:"exp"`
+}
+
+// quoteClaimItem 报价中的商品项
+type quoteClaimItem struct {
+	ProductID string          `json:"pid"`
+	SkuID     string          `json:"sku"`
+	Quantity  int32           `json:"qty"`
+	Price     decimal.Decimal `json:"price"`
+}
+
+// quoteClaimCoupon 报价中使用的优惠券
+type quoteClaimCoupon struct {
+	ID     string          `json:"id"`
+	Name   string          `json:"name"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// newQuoteClaims 根据计价结果生成报价内容
+func newQuoteClaims(userID string, address CreateOrderAddressRequest, pricing *orderPricing, expiresAt time.Time) *quoteClaims {
+	claims := &quoteClaims{
+		UserID:         userID,
+		Address:        addressDigest(address),
+		DiscountAmount: pricing.DiscountAmount,
+		ShippingFee:    pricing.ShippingFee,
+		ExpiresAt:      expiresAt.Unix(),
+	}
+	for _, item := range pricing.Items {
+		claims.Items = append(claims.Items, quoteClaimItem{
+			ProductID: item.ProductID,
+			SkuID:     item.SkuID,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+		})
+	}
+	for _, coupon := range pricing.Coupons {
+		claims.Coupons = append(claims.Coupons, quoteClaimCoupon{
+			ID:     coupon.CouponID,
+			Name:   coupon.Name,
+			Amount: coupon.DiscountAmount,
+		})
+	}
+
+	return claims
+}
+
+// addressDigest 计算影响运费的地址字段摘要，报价只对同一收货区域有效
+func addressDigest(address CreateOrderAddressRequest) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{address.Province, address.City, address.District}, "|")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// signQuote 签发报价令牌，格式为 base64(内容).base64(HMAC-SHA256签名)
+func signQuote(secret []byte, claims *quoteClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("序列化报价失败: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(quoteSignature(secret, encoded)), nil
+}
+
+// parseQuote 校验报价令牌的签名和有效期
+func parseQuote(secret []byte, token string, now time.Time) (*quoteClaims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, order.ErrQuoteInvalid
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, quoteSignature(secret, encoded)) {
+		return nil, order.ErrQuoteInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, order.ErrQuoteInvalid
+	}
+
+	var claims quoteClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, order.ErrQuoteInvalid
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, order.ErrQuoteExpired
+	}
+
+	return &claims, nil
+}
+
+// quoteSignature 计算报价内容的签名
+func quoteSignature(secret []byte, encoded string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
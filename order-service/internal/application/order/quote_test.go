@@ -0,0 +1,98 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+func TestQuoteToken(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Now()
+	claims := &quoteClaims{
+		UserID:    "user-1",
+		Items:     []quoteClaimItem{{ProductID: "p1", SkuID: "s1", Quantity: 2, Price: decimal.RequireFromString("9.90")}},
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	}
+
+	token, err := signQuote(secret, claims)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		parsed, err := parseQuote(secret, token, now)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", parsed.UserID)
+		assert.True(t, parsed.Items[0].Price.Equal(decimal.RequireFromString("9.90")))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, err := parseQuote(secret, token, now.Add(2*time.Minute))
+		assert.ErrorIs(t, err, order.ErrQuoteExpired)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		_, err := parseQuote([]byte("other-secret"), token, now)
+		assert.ErrorIs(t, err, order.ErrQuoteInvalid)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := parseQuote(secret, "not-a-token", now)
+		assert.ErrorIs(t, err, order.ErrQuoteInvalid)
+	})
+}
+
+func TestService_applyQuote(t *testing.T) {
+	s := &Service{orderConfig: &config.OrderConfig{QuoteSecret: "test-secret"}}
+	address := CreateOrderAddressRequest{Province: "浙江省", City: "杭州市", District: "西湖区"}
+
+	quoted := newOrderPricing([]*pricedItem{
+		{item: &order.OrderItem{ProductID: "p1", SkuID: "s1", Quantity: 2, Price: decimal.RequireFromString("10"), TotalAmount: decimal.RequireFromString("20")}},
+	})
+	quoted.Coupons = []*order.AppliedCoupon{{CouponID: "coupon-1", Name: "立减5元", DiscountAmount: decimal.RequireFromString("5")}}
+	quoted.DiscountAmount = decimal.RequireFromString("5")
+	token, err := signQuote([]byte("test-secret"), newQuoteClaims("user-1", address, quoted, time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+
+	// 报价后涨价，下单时仍按报价金额
+	current := func() *orderPricing {
+		return newOrderPricing([]*pricedItem{
+			{item: &order.OrderItem{ProductID: "p1", SkuID: "s1", Quantity: 2, Price: decimal.RequireFromString("12"), TotalAmount: decimal.RequireFromString("24")}},
+		})
+	}
+
+	t.Run("honours quoted price and coupons", func(t *testing.T) {
+		pricing := current()
+		err := s.applyQuote(pricing, CreateOrderRequest{UserID: "user-1", Address: address, QuoteToken: token})
+		require.NoError(t, err)
+		assert.True(t, pricing.ActualAmount.Equal(decimal.RequireFromString("15")))
+		assert.True(t, pricing.Items[0].Price.Equal(decimal.RequireFromString("10")))
+		require.Len(t, pricing.Coupons, 1)
+		assert.Equal(t, "coupon-1", pricing.Coupons[0].CouponID)
+		assert.True(t, pricing.Coupons[0].DiscountAmount.Equal(decimal.RequireFromString("5")))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		err := s.applyQuote(current(), CreateOrderRequest{UserID: "user-2", Address: address, QuoteToken: token})
+		assert.ErrorIs(t, err, order.ErrQuoteMismatch)
+	})
+
+	t.Run("different quantity", func(t *testing.T) {
+		pricing := current()
+		pricing.Items[0].Quantity = 3
+		err := s.applyQuote(pricing, CreateOrderRequest{UserID: "user-1", Address: address, QuoteToken: token})
+		assert.ErrorIs(t, err, order.ErrQuoteMismatch)
+	})
+
+	t.Run("different region", func(t *testing.T) {
+		other := address
+		other.City = "宁波市"
+		err := s.applyQuote(current(), CreateOrderRequest{UserID: "user-1", Address: other, QuoteToken: token})
+		assert.ErrorIs(t, err, order.ErrQuoteMismatch)
+	})
+}
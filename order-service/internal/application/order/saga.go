@@ -0,0 +1,383 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/saga"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+)
+
+// CreateOrderSaga 下单Saga编排器
+// 步骤：订单落库 -> 预占库存 -> 创建支付单，任一步失败时逆序补偿（释放库存、取消订单、恢复结算的购物车项）。
+// 每一步的结果都记录在Saga日志中，进程崩溃后由 ResumeStale 接管未结束的Saga。
+type CreateOrderSaga struct {
+	sagaRepo        saga.Repository
+	orderRepo       order.Repository
+	orderDS         order.DomainService
+	paymentClient   *client.PaymentServiceClient
+	inventoryClient *client.InventoryServiceClient
+	orderConfig     *config.OrderConfig
+}
+
+// NewCreateOrderSaga 创建下单Saga编排器
+func NewCreateOrderSaga(
+	sagaRepo saga.Repository,
+	orderRepo order.Repository,
+	orderDS order.DomainService,
+	paymentClient *client.PaymentServiceClient,
+	inventoryClient *client.InventoryServiceClient,
+	orderConfig *config.OrderConfig,
+) *CreateOrderSaga {
+	return &CreateOrderSaga{
+		sagaRepo:        sagaRepo,
+		orderRepo:       orderRepo,
+		orderDS:         orderDS,
+		paymentClient:   paymentClient,
+		inventoryClient: inventoryClient,
+		orderConfig:     orderConfig,
+	}
+}
+
+// sagaState 执行过程中缓存的订单数据，恢复时按需从仓储加载
+type sagaState struct {
+	order *order.Order
+	items []*order.OrderItem
+}
+
+// Execute 执行下单Saga，cartItemIDs 非空时订单落库的同时移除这些购物车项
+func (s *CreateOrderSaga) Execute(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, address *order.OrderAddress, cartItemIDs []string) (*order.Order, error) {
+	// 预先分配订单ID，保证Saga记录先于订单落库，崩溃后可以定位到订单
+	orderEntity.ID = uuid.NewString()
+
+	sg := saga.NewCreateOrderSaga(orderEntity.ID)
+	sg.CartItemIDs = cartItemIDs
+	if err := s.sagaRepo.Create(ctx, sg); err != nil {
+		return nil, fmt.Errorf("创建Saga失败: %w", err)
+	}
+
+	// 1. 订单落库
+	var createdOrder *order.Order
+	var err error
+	if len(cartItemIDs) > 0 {
+		createdOrder, err = s.orderDS.CreateOrderFromCart(ctx, orderEntity, items, address, cartItemIDs)
+	} else {
+		createdOrder, err = s.orderDS.CreateOrder(ctx, orderEntity, items, address)
+	}
+	if err != nil {
+		s.appendLog(ctx, sg, saga.StepOrderPersisted, saga.ActionExecute, err)
+		return nil, s.compensate(ctx, sg, &sagaState{}, fmt.Errorf("创建订单失败: %w", err))
+	}
+	s.appendLog(ctx, sg, saga.StepOrderPersisted, saga.ActionExecute, nil)
+
+	state := &sagaState{order: createdOrder, items: items}
+	if err := s.advance(ctx, sg, saga.StepOrderPersisted); err != nil {
+		return nil, s.compensate(ctx, sg, state, err)
+	}
+
+	// 2. 后续步骤
+	if err := s.run(ctx, sg, state); err != nil {
+		return nil, err
+	}
+
+	return createdOrder, nil
+}
+
+// ResumeStale 恢复在 staleAfter 时间内没有推进的Saga，返回恢复成功的数量
+func (s *CreateOrderSaga) ResumeStale(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	sagas, err := s.sagaRepo.ListStale(ctx, time.Now().Add(-staleAfter), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	resumed := 0
+	for _, sg := range sagas {
+		if err := s.Resume(ctx, sg); err != nil {
+			if errors.Is(err, saga.ErrSagaConflict) {
+				continue
+			}
+			log.Printf("Failed to resume saga %s for order %s: %v", sg.ID, sg.OrderID, err)
+			continue
+		}
+		resumed++
+	}
+
+	return resumed, nil
+}
+
+// Resume 恢复单个Saga：执行中的继续向前推进，补偿中的继续补偿
+func (s *CreateOrderSaga) Resume(ctx context.Context, sg *saga.Saga) error {
+	if sg.IsFinished() {
+		return nil
+	}
+
+	// 先抢占Saga，版本冲突说明其他实例正在处理
+	sg.RetryCount++
+	if err := s.sagaRepo.Update(ctx, sg); err != nil {
+		return err
+	}
+
+	state := &sagaState{}
+	if sg.Status == int32(saga.SagaStatusCompensating) {
+		return s.compensate(ctx, sg, state, nil)
+	}
+
+	// 订单是否落库只能通过查询确认
+	if sg.CurrentStep == saga.StepStarted {
+		orderEntity, err := s.orderRepo.GetByID(ctx, sg.OrderID)
+		if err != nil {
+			if errors.Is(err, order.ErrOrderNotFound) {
+				return s.compensate(ctx, sg, state, errors.New("订单未落库"))
+			}
+			return err
+		}
+		state.order = orderEntity
+		if err := s.advance(ctx, sg, saga.StepOrderPersisted); err != nil {
+			return err
+		}
+	}
+
+	return s.run(ctx, sg, state)
+}
+
+// run 从当前步骤继续执行剩余步骤，失败时进入补偿
+func (s *CreateOrderSaga) run(ctx context.Context, sg *saga.Saga, state *sagaState) error {
+	for step := sg.NextStep(); step != ""; step = sg.NextStep() {
+		err := s.execute(ctx, sg, step, state)
+		s.appendLog(ctx, sg, step, saga.ActionExecute, err)
+		if err != nil {
+			return s.compensate(ctx, sg, state, err)
+		}
+		if err := s.advance(ctx, sg, step); err != nil {
+			return s.compensate(ctx, sg, state, err)
+		}
+	}
+
+	return nil
+}
+
+// execute 执行单个步骤
+func (s *CreateOrderSaga) execute(ctx context.Context, sg *saga.Saga, step saga.Step, state *sagaState) error {
+	switch step {
+	case saga.StepInventoryReserved:
+		return s.reserveInventory(ctx, sg.OrderID, state)
+	case saga.StepPaymentCreated:
+		return s.createPayment(ctx, sg.OrderID, state)
+	default:
+		return fmt.Errorf("未知的Saga步骤: %s", step)
+	}
+}
+
+// compensate 逆序补偿已完成的步骤，补偿成功时返回原始错误 cause
+// 补偿使用独立于请求的上下文，避免客户端断开导致补偿中断；补偿失败时Saga保持补偿中，由恢复任务重试。
+func (s *CreateOrderSaga) compensate(ctx context.Context, sg *saga.Saga, state *sagaState, cause error) error {
+	ctx = context.WithoutCancel(ctx)
+
+	if sg.Status != int32(saga.SagaStatusCompensating) {
+		sg.StartCompensation(cause)
+		if err := s.sagaRepo.Update(ctx, sg); err != nil {
+			return errors.Join(cause, err)
+		}
+	}
+
+	if sg.Reached(saga.StepOrderPersisted) {
+		// 预占可能部分成功或超时后实际成功，只要订单已落库就释放，释放按订单幂等
+		err := s.releaseInventory(ctx, sg.OrderID)
+		s.appendLog(ctx, sg, saga.StepInventoryReserved, saga.ActionCompensate, err)
+		if err != nil {
+			return errors.Join(cause, err)
+		}
+
+		err = s.cancelOrder(ctx, sg.OrderID, state)
+		if err == nil && len(sg.CartItemIDs) > 0 {
+			// 订单落库时已移除结算的购物车项，下单失败后放回购物车
+			err = s.orderRepo.RestoreCartItems(ctx, sg.CartItemIDs)
+		}
+		s.appendLog(ctx, sg, saga.StepOrderPersisted, saga.ActionCompensate, err)
+		if err != nil {
+			return errors.Join(cause, err)
+		}
+	}
+
+	sg.FinishCompensation()
+	if err := s.sagaRepo.Update(ctx, sg); err != nil {
+		return errors.Join(cause, err)
+	}
+
+	return cause
+}
+
+// advance 标记步骤完成并持久化
+func (s *CreateOrderSaga) advance(ctx context.Context, sg *saga.Saga, step saga.Step) error {
+	sg.Advance(step)
+	return s.sagaRepo.Update(ctx, sg)
+}
+
+// appendLog 记录步骤日志，日志写入失败不影响Saga推进
+func (s *CreateOrderSaga) appendLog(ctx context.Context, sg *saga.Saga, step saga.Step, action saga.Action, stepErr error) {
+	stepLog := &saga.StepLog{
+		SagaID:  sg.ID,
+		Step:    step,
+		Action:  action,
+		Success: stepErr == nil,
+	}
+	if stepErr != nil {
+		stepLog.Error = stepErr.Error()
+	}
+
+	if err := s.sagaRepo.AppendLog(ctx, stepLog); err != nil {
+		log.Printf("Failed to append saga log for saga %s: %v", sg.ID, err)
+	}
+}
+
+// reserveInventory 预占库存，预占在订单支付截止后保留一段宽限期，由订单超时取消负责释放
+// 预售订单的库存在整个预售期内保留，预占到尾款截止后。
+func (s *CreateOrderSaga) reserveInventory(ctx context.Context, orderID string, state *sagaState) error {
+	if err := s.loadOrder(ctx, orderID, state); err != nil {
+		return err
+	}
+	if state.items == nil {
+		items, err := s.orderRepo.GetOrderItems(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		state.items = items
+	}
+
+	inventoryItems := make([]client.InventoryItem, 0, len(state.items))
+	for _, item := range state.items {
+		inventoryItems = append(inventoryItems, client.InventoryItem{
+			SkuID:    item.SkuID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	var expiresAt time.Time
+	if deadline, err := time.ParseInLocation("2006-01-02 15:04:05", state.order.ReservationDeadline(), time.Local); err == nil {
+		expiresAt = deadline.Add(reservationGracePeriod)
+	}
+
+	resp, err := s.inventoryClient.ReserveInventory(ctx, orderID, inventoryItems, expiresAt)
+	if err != nil {
+		return fmt.Errorf("库存预占失败: %w", err)
+	}
+	if len(resp.Shortfalls) > 0 {
+		details := make([]string, len(resp.Shortfalls))
+		for i, shortfall := range resp.Shortfalls {
+			details[i] = fmt.Sprintf("SKU %s 需要 %d，可用 %d", shortfall.SkuID, shortfall.Requested, shortfall.Available)
+		}
+		return fmt.Errorf("%w: %s", order.ErrInsufficientStock, strings.Join(details, "；"))
+	}
+	if !resp.Success {
+		return fmt.Errorf("库存预占失败: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// releaseInventory 释放预占库存
+func (s *CreateOrderSaga) releaseInventory(ctx context.Context, orderID string) error {
+	resp, err := s.inventoryClient.ReleaseInventory(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("释放库存失败: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("释放库存失败: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// createPayment 为订单创建支付单，预售订单创建定金支付单，尾款支付单在尾款期开始后创建
+// 支付服务没有关闭支付单的接口，补偿时订单被取消后支付单不会再被确认，因此该步骤无需补偿。
+func (s *CreateOrderSaga) createPayment(ctx context.Context, orderID string, state *sagaState) error {
+	if err := s.loadOrder(ctx, orderID, state); err != nil {
+		return err
+	}
+
+	if deposit := state.order.Payment(order.PaymentStageDeposit); deposit != nil {
+		return requestStagePayment(ctx, s.paymentClient, s.orderRepo, s.orderConfig.Payment, state.order, deposit, "订单定金")
+	}
+
+	req := &client.PaymentRequest{
+		OrderID:       orderID,
+		Amount:        state.order.ActualAmount.String(),
+		PaymentMethod: convertToPaymentMethod(state.order.PaymentMethod),
+		Subject:       fmt.Sprintf("订单支付-%s", state.order.OrderNo),
+		Description:   "商城订单支付",
+		NotifyURL:     s.orderConfig.Payment.NotifyURL,
+		ReturnURL:     s.orderConfig.Payment.ReturnURL,
+	}
+
+	resp, err := s.paymentClient.CreatePayment(ctx, req)
+	if err != nil {
+		return fmt.Errorf("创建支付订单失败: %w", err)
+	}
+	if !resp.Success {
+		return errors.New("创建支付订单失败")
+	}
+
+	return nil
+}
+
+// loadOrder 按需加载订单和预售订单的支付阶段
+func (s *CreateOrderSaga) loadOrder(ctx context.Context, orderID string, state *sagaState) error {
+	if state.order == nil {
+		orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		state.order = orderEntity
+	}
+	if state.order.IsPresale() && state.order.PaymentStages == nil {
+		payments, err := s.orderRepo.GetPayments(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		state.order.PaymentStages = payments
+	}
+	return nil
+}
+
+// cancelOrder 取消订单，订单不存在或已取消时视为成功
+func (s *CreateOrderSaga) cancelOrder(ctx context.Context, orderID string, state *sagaState) error {
+	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, order.ErrOrderNotFound) {
+			return nil
+		}
+		return err
+	}
+	state.order = orderEntity
+
+	if orderEntity.Status == int32(order.OrderStatusCancelled) {
+		return nil
+	}
+	if orderEntity.Status != int32(order.OrderStatusPendingPayment) {
+		return fmt.Errorf("订单状态已变更为 %d，无法自动取消", orderEntity.Status)
+	}
+
+	return s.orderDS.UpdateOrderStatus(ctx, orderEntity, int32(order.OrderStatusCancelled), "下单失败，系统自动取消", order.SystemOperator)
+}
+
+// convertToPaymentMethod 转换支付方式
+func convertToPaymentMethod(method string) string {
+	switch method {
+	case "alipay":
+		return "alipay"
+	case "wechat":
+		return "wechat"
+	case "balance":
+		return "balance"
+	default:
+		return "alipay" // 默认支付宝
+	}
+}
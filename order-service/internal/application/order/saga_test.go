@@ -0,0 +1,48 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/saga"
+)
+
+// stubSagaRepo 按Saga ID返回预设错误的Saga仓储
+type stubSagaRepo struct {
+	saga.Repository
+	stale     []*saga.Saga
+	updateErr map[string]error
+}
+
+func (r *stubSagaRepo) ListStale(context.Context, time.Time, int) ([]*saga.Saga, error) {
+	return r.stale, nil
+}
+
+func (r *stubSagaRepo) Update(_ context.Context, sg *saga.Saga) error {
+	return r.updateErr[sg.ID]
+}
+
+func TestCreateOrderSaga_ResumeStale(t *testing.T) {
+	repo := &stubSagaRepo{
+		stale: []*saga.Saga{
+			{ID: "finished", OrderID: "o1", Status: int32(saga.SagaStatusCompleted)},
+			{ID: "conflict", OrderID: "o2", Status: int32(saga.SagaStatusRunning)},
+			{ID: "failed", OrderID: "o3", Status: int32(saga.SagaStatusRunning)},
+		},
+		updateErr: map[string]error{
+			"conflict": saga.ErrSagaConflict,
+			"failed":   errors.New("connection refused"),
+		},
+	}
+	s := &CreateOrderSaga{sagaRepo: repo}
+
+	// 只统计恢复成功的Saga，版本冲突和恢复失败的都不计入
+	resumed, err := s.ResumeStale(context.Background(), time.Minute, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resumed)
+}
@@ -0,0 +1,459 @@
+package order
+
+import (
+	"context"
+	"log"
+	"time"
+
+	cartapp "github.com/people257/poor-guy-shop/order-service/internal/application/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+const (
+	sagaRecoveryInterval  = 1 * time.Minute
+	sagaStaleAfter        = 2 * time.Minute
+	sagaRecoveryBatchSize = 100
+
+	paymentTimeoutInterval  = 30 * time.Second
+	paymentTimeoutBatchSize = 100
+
+	autoConfirmInterval  = 10 * time.Minute
+	autoConfirmBatchSize = 100
+
+	idempotencyPurgeInterval  = 1 * time.Hour
+	idempotencyPurgeBatchSize = 1000
+
+	eventRelayInterval  = 2 * time.Second
+	eventRelayBatchSize = 200
+
+	eventPurgeInterval  = 1 * time.Hour
+	eventPurgeBatchSize = 1000
+
+	cartFlushInterval  = 1 * time.Second
+	cartFlushBatchSize = 200
+	cartFlushTimeout   = 10 * time.Second
+
+	cartMergeInterval  = 1 * time.Second
+	cartMergeBatchSize = 100
+
+	flashSaleOrderInterval  = 1 * time.Second
+	flashSaleOrderBatchSize = 100
+
+	flashSaleReconcileInterval = 30 * time.Second
+
+	presalePaymentInterval  = 1 * time.Minute
+	presalePaymentBatchSize = 100
+)
+
+// Scheduler 订单定时任务调度器
+type Scheduler struct {
+	createOrderSaga *CreateOrderSaga
+	orderService    *Service
+	eventRelay      *EventRelay
+	cartFlusher     cart.Flusher
+	cartService     *cartapp.Service
+
+	stopCh chan struct{}
+}
+
+// NewScheduler 创建订单定时任务调度器
+func NewScheduler(createOrderSaga *CreateOrderSaga, orderService *Service, eventRelay *EventRelay, cartFlusher cart.Flusher, cartService *cartapp.Service) *Scheduler {
+	return &Scheduler{
+		createOrderSaga: createOrderSaga,
+		orderService:    orderService,
+		eventRelay:      eventRelay,
+		cartFlusher:     cartFlusher,
+		cartService:     cartService,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start 启动定时任务
+func (s *Scheduler) Start(ctx context.Context) {
+	// 恢复未结束的下单Saga - 启动时执行一次，之后每1分钟执行一次
+	go s.runSagaRecovery(ctx)
+
+	// 取消超时未支付的订单 - 每30秒执行一次
+	go s.runPaymentTimeout(ctx)
+
+	// 自动确认超过收货期限的订单 - 每10分钟执行一次
+	go s.runAutoConfirm(ctx)
+
+	// 清理过期的幂等记录 - 每1小时执行一次
+	go s.runIdempotencyPurge(ctx)
+
+	// 投递发件箱中的订单事件 - 每2秒执行一次
+	go s.runEventRelay(ctx)
+
+	// 清理已投递的订单事件 - 每1小时执行一次
+	go s.runEventPurge(ctx)
+
+	// 将缓存中有变更的购物车写回数据库 - 每1秒执行一次
+	go s.runCartFlush(ctx)
+
+	// 合并用户登录时提交的游客购物车 - 每1秒执行一次
+	go s.runCartMerge(ctx)
+
+	// 为排队的抢购记录创建订单 - 每1秒执行一次
+	go s.runFlashSaleOrders(ctx)
+
+	// 预热秒杀库存、对账并结算已结束的活动 - 启动时执行一次，之后每30秒执行一次
+	go s.runFlashSaleReconcile(ctx)
+
+	// 为进入尾款期的预售订单创建尾款支付单、重试退还定金 - 每1分钟执行一次
+	go s.runPresalePayments(ctx)
+}
+
+// Stop 停止定时任务
+func (s *Scheduler) Stop() {
+	close(s.stopCh)
+}
+
+// runSagaRecovery 运行Saga恢复任务
+func (s *Scheduler) runSagaRecovery(ctx context.Context) {
+	ticker := time.NewTicker(sagaRecoveryInterval)
+	defer ticker.Stop()
+
+	s.recoverSagas(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.recoverSagas(ctx)
+		}
+	}
+}
+
+// recoverSagas 恢复停止推进的Saga
+func (s *Scheduler) recoverSagas(ctx context.Context) {
+	resumed, err := s.createOrderSaga.ResumeStale(ctx, sagaStaleAfter, sagaRecoveryBatchSize)
+	if err != nil {
+		log.Printf("Failed to recover order sagas: %v", err)
+		return
+	}
+
+	if resumed > 0 {
+		log.Printf("Resumed %d order sagas", resumed)
+	}
+}
+
+// runPaymentTimeout 运行支付超时取消任务
+func (s *Scheduler) runPaymentTimeout(ctx context.Context) {
+	ticker := time.NewTicker(paymentTimeoutInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.cancelOverdueOrders(ctx)
+		}
+	}
+}
+
+// cancelOverdueOrders 取消超时未支付的订单
+func (s *Scheduler) cancelOverdueOrders(ctx context.Context) {
+	cancelled, err := s.orderService.CancelOverdueOrders(ctx, paymentTimeoutBatchSize)
+	if err != nil {
+		log.Printf("Failed to cancel overdue orders: %v", err)
+		return
+	}
+
+	if cancelled > 0 {
+		log.Printf("Cancelled %d overdue orders", cancelled)
+	}
+}
+
+// runAutoConfirm 运行自动确认收货任务
+func (s *Scheduler) runAutoConfirm(ctx context.Context) {
+	ticker := time.NewTicker(autoConfirmInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.confirmOverdueReceipts(ctx)
+		}
+	}
+}
+
+// confirmOverdueReceipts 自动确认超过收货期限的订单
+func (s *Scheduler) confirmOverdueReceipts(ctx context.Context) {
+	confirmed, err := s.orderService.ConfirmOverdueReceipts(ctx, autoConfirmBatchSize)
+	if err != nil {
+		log.Printf("Failed to auto confirm orders: %v", err)
+		return
+	}
+
+	if confirmed > 0 {
+		log.Printf("Auto confirmed %d orders", confirmed)
+	}
+}
+
+// runIdempotencyPurge 运行过期幂等记录清理任务
+func (s *Scheduler) runIdempotencyPurge(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.purgeIdempotencyKeys(ctx)
+		}
+	}
+}
+
+// purgeIdempotencyKeys 清理过期的幂等记录
+func (s *Scheduler) purgeIdempotencyKeys(ctx context.Context) {
+	purged, err := s.orderService.PurgeExpiredIdempotencyKeys(ctx, idempotencyPurgeBatchSize)
+	if err != nil {
+		log.Printf("Failed to purge expired idempotency keys: %v", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("Purged %d expired idempotency keys", purged)
+	}
+}
+
+// runEventRelay 运行订单事件投递任务
+func (s *Scheduler) runEventRelay(ctx context.Context) {
+	ticker := time.NewTicker(eventRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.publishEvents(ctx)
+		}
+	}
+}
+
+// publishEvents 投递待发布的订单事件
+func (s *Scheduler) publishEvents(ctx context.Context) {
+	published, err := s.eventRelay.PublishPending(ctx, eventRelayBatchSize)
+	if err != nil {
+		log.Printf("Failed to publish order events: %v", err)
+		return
+	}
+
+	if published > 0 {
+		log.Printf("Published %d order events", published)
+	}
+}
+
+// runEventPurge 运行已投递事件清理任务
+func (s *Scheduler) runEventPurge(ctx context.Context) {
+	ticker := time.NewTicker(eventPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.purgeEvents(ctx)
+		}
+	}
+}
+
+// purgeEvents 清理超过保留时长的已投递事件
+func (s *Scheduler) purgeEvents(ctx context.Context) {
+	purged, err := s.eventRelay.PurgePublished(ctx, eventPurgeBatchSize)
+	if err != nil {
+		log.Printf("Failed to purge published order events: %v", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("Purged %d published order events", purged)
+	}
+}
+
+// runCartFlush 运行购物车写回任务，停止时再写回一次，减少未写回的变更
+func (s *Scheduler) runCartFlush(ctx context.Context) {
+	ticker := time.NewTicker(cartFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			flushCtx, cancel := context.WithTimeout(context.Background(), cartFlushTimeout)
+			s.flushCarts(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			s.flushCarts(ctx)
+		}
+	}
+}
+
+// flushCarts 将有变更的购物车写回数据库
+func (s *Scheduler) flushCarts(ctx context.Context) {
+	flushed, err := s.cartFlusher.Flush(ctx, cartFlushBatchSize)
+	if err != nil {
+		log.Printf("Failed to flush carts: %v", err)
+		return
+	}
+
+	if flushed > 0 {
+		log.Printf("Flushed %d carts", flushed)
+	}
+}
+
+// runCartMerge 运行游客购物车合并任务
+func (s *Scheduler) runCartMerge(ctx context.Context) {
+	ticker := time.NewTicker(cartMergeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.mergeGuestCarts(ctx)
+		}
+	}
+}
+
+// mergeGuestCarts 合并用户登录时提交的游客购物车
+func (s *Scheduler) mergeGuestCarts(ctx context.Context) {
+	merged, err := s.cartService.ProcessLoginMerges(ctx, cartMergeBatchSize)
+	if err != nil {
+		log.Printf("Failed to merge guest carts: %v", err)
+		return
+	}
+
+	if merged > 0 {
+		log.Printf("Merged %d guest carts", merged)
+	}
+}
+
+// runFlashSaleOrders 运行秒杀异步下单任务
+func (s *Scheduler) runFlashSaleOrders(ctx context.Context) {
+	ticker := time.NewTicker(flashSaleOrderInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.processFlashSaleTickets(ctx)
+		}
+	}
+}
+
+// processFlashSaleTickets 为排队的抢购记录创建订单
+func (s *Scheduler) processFlashSaleTickets(ctx context.Context) {
+	processed, err := s.orderService.ProcessFlashSaleTickets(ctx, flashSaleOrderBatchSize)
+	if err != nil {
+		log.Printf("Failed to process flash sale tickets: %v", err)
+		return
+	}
+
+	if processed > 0 {
+		log.Printf("Processed %d flash sale tickets", processed)
+	}
+}
+
+// runFlashSaleReconcile 运行秒杀对账任务
+func (s *Scheduler) runFlashSaleReconcile(ctx context.Context) {
+	ticker := time.NewTicker(flashSaleReconcileInterval)
+	defer ticker.Stop()
+
+	s.reconcileFlashSales(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.reconcileFlashSales(ctx)
+		}
+	}
+}
+
+// reconcileFlashSales 预热秒杀库存、对账并结算已结束的活动
+func (s *Scheduler) reconcileFlashSales(ctx context.Context) {
+	settled, err := s.orderService.ReconcileFlashSales(ctx, time.Now())
+	if err != nil {
+		log.Printf("Failed to reconcile flash sales: %v", err)
+		return
+	}
+
+	if settled > 0 {
+		log.Printf("Settled %d flash sales", settled)
+	}
+}
+
+// runPresalePayments 运行预售支付任务
+func (s *Scheduler) runPresalePayments(ctx context.Context) {
+	ticker := time.NewTicker(presalePaymentInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.requestBalancePayments(ctx)
+			s.retryDepositRefunds(ctx)
+		}
+	}
+}
+
+// requestBalancePayments 为进入尾款期的预售订单创建尾款支付单
+func (s *Scheduler) requestBalancePayments(ctx context.Context) {
+	requested, err := s.orderService.RequestBalancePayments(ctx, time.Now(), presalePaymentBatchSize)
+	if err != nil {
+		log.Printf("Failed to request presale balance payments: %v", err)
+		return
+	}
+
+	if requested > 0 {
+		log.Printf("Requested %d presale balance payments", requested)
+	}
+}
+
+// retryDepositRefunds 重试退还已取消预售订单的定金
+func (s *Scheduler) retryDepositRefunds(ctx context.Context) {
+	refunded, err := s.orderService.RetryDepositRefunds(ctx, presalePaymentBatchSize)
+	if err != nil {
+		log.Printf("Failed to retry presale deposit refunds: %v", err)
+		return
+	}
+
+	if refunded > 0 {
+		log.Printf("Refunded %d presale deposits", refunded)
+	}
+}
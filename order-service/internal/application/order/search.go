@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+	// exportBatchSize 导出时每批查询的订单数
+	exportBatchSize = 500
+)
+
+// SearchOrdersRequest 运营搜索订单请求
+type SearchOrdersRequest struct {
+	Filter   order.SearchFilter `json:"filter"`
+	Cursor   string             `json:"cursor"`
+	PageSize int32              `json:"page_size"`
+}
+
+// SearchOrdersResponse 运营搜索订单响应
+type SearchOrdersResponse struct {
+	Orders     []*order.Order `json:"orders"`
+	NextCursor string         `json:"next_cursor"` // 为空表示没有下一页
+}
+
+// SearchOrders 按条件搜索所有用户的订单，按下单时间倒序 keyset 翻页
+func (s *Service) SearchOrders(ctx context.Context, req SearchOrdersRequest) (*SearchOrdersResponse, error) {
+	if err := req.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	var after *order.SearchCursor
+	if req.Cursor != "" {
+		cursor, err := order.DecodeSearchCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	orders, next, err := s.orderRepo.Search(ctx, &req.Filter, after, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SearchOrdersResponse{Orders: orders}
+	if next != nil {
+		resp.NextCursor = next.Encode()
+	}
+	return resp, nil
+}
+
+// ExportOrders 按搜索条件分批读取全部匹配的订单，每批调用一次 fn，fn 返回错误时停止导出
+func (s *Service) ExportOrders(ctx context.Context, filter order.SearchFilter, fn func(orders []*order.Order) error) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	var after *order.SearchCursor
+	for {
+		orders, next, err := s.orderRepo.Search(ctx, &filter, after, exportBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(orders) > 0 {
+			if err := fn(orders); err != nil {
+				return err
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		after = next
+	}
+}
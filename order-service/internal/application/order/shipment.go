@@ -0,0 +1,59 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// ShipOrderRequest 发货请求
+type ShipOrderRequest struct {
+	OrderID        string `json:"order_id"`
+	OperatorID     string `json:"operator_id"`
+	CarrierCode    string `json:"carrier_code"`
+	TrackingNumber string `json:"tracking_number"`
+	// Items 本次发货的商品项，为空时发出所有未发货的商品
+	Items []ShipOrderItemRequest `json:"items"`
+}
+
+// ShipOrderItemRequest 发货商品项
+type ShipOrderItemRequest struct {
+	OrderItemID string `json:"order_item_id"`
+	Quantity    int32  `json:"quantity"`
+}
+
+// ShipOrderResponse 发货结果
+type ShipOrderResponse struct {
+	Shipment *order.Shipment `json:"shipment"`
+	Order    *order.Order    `json:"order"`
+}
+
+// ShipOrder 运营发货，支持分批发货
+func (s *Service) ShipOrder(ctx context.Context, req ShipOrderRequest) (*ShipOrderResponse, error) {
+	orderEntity, err := s.orderRepo.GetByID(ctx, req.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+
+	shipment := &order.Shipment{
+		CarrierCode:    req.CarrierCode,
+		TrackingNumber: req.TrackingNumber,
+		OperatorID:     req.OperatorID,
+	}
+	for _, item := range req.Items {
+		shipment.Items = append(shipment.Items, &order.ShipmentItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	if err := s.orderDS.ShipOrder(ctx, orderEntity, shipment); err != nil {
+		return nil, err
+	}
+
+	return &ShipOrderResponse{
+		Shipment: shipment,
+		Order:    orderEntity,
+	}, nil
+}
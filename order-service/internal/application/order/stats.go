@@ -0,0 +1,74 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// defaultStatsDays 未指定时间范围时统计最近的天数
+const defaultStatsDays = 30
+
+// GetOrderStatsRequest 订单统计请求
+type GetOrderStatsRequest struct {
+	UserID      string                 `json:"user_id"` // 为空时统计全部用户
+	Granularity order.StatsGranularity `json:"granularity"`
+	From        time.Time              `json:"from"`
+	To          time.Time              `json:"to"`
+}
+
+// GetOrderStatsResponse 订单统计响应
+type GetOrderStatsResponse struct {
+	Counts      *order.OrderStats      `json:"counts"`
+	Buckets     []*order.AmountBucket  `json:"buckets"`
+	TotalGMV    decimal.Decimal        `json:"total_gmv"`
+	TotalPaid   decimal.Decimal        `json:"total_paid"`
+	TotalRefund decimal.Decimal        `json:"total_refund"`
+	From        time.Time              `json:"from"`
+	To          time.Time              `json:"to"`
+	Granularity order.StatsGranularity `json:"granularity"`
+}
+
+// GetOrderStats 统计时间范围内的订单数和按周期汇总的金额
+// 起始时间向前对齐到所在周期的开始，保证第一个周期的数据完整。
+func (s *Service) GetOrderStats(ctx context.Context, req GetOrderStatsRequest) (*GetOrderStatsResponse, error) {
+	if req.Granularity == "" {
+		req.Granularity = order.StatsGranularityDay
+	}
+	if req.To.IsZero() {
+		req.To = time.Now()
+	}
+	if req.From.IsZero() {
+		req.From = req.To.AddDate(0, 0, -defaultStatsDays)
+	}
+	if err := order.ValidateStatsRange(req.Granularity, req.From, req.To); err != nil {
+		return nil, err
+	}
+	from := req.Granularity.Truncate(req.From)
+
+	counts, err := s.orderRepo.CountByStatus(ctx, req.UserID, from, req.To)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := s.orderRepo.SumAmountsByPeriod(ctx, req.UserID, req.Granularity, from, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetOrderStatsResponse{
+		Counts:      counts,
+		Buckets:     order.AmountSeries(req.Granularity, from, req.To, buckets),
+		From:        from,
+		To:          req.To,
+		Granularity: req.Granularity,
+	}
+	for _, bucket := range resp.Buckets {
+		resp.TotalGMV = resp.TotalGMV.Add(bucket.GMV)
+		resp.TotalPaid = resp.TotalPaid.Add(bucket.PaidAmount)
+		resp.TotalRefund = resp.TotalRefund.Add(bucket.RefundAmount)
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package application
 import (
 	"github.com/google/wire"
 
+	"github.com/people257/poor-guy-shop/order-service/internal/application/aftersale"
 	"github.com/people257/poor-guy-shop/order-service/internal/application/cart"
 	"github.com/people257/poor-guy-shop/order-service/internal/application/order"
 )
@@ -10,5 +11,9 @@ import (
 // ProviderSet 应用服务提供者集合
 var ProviderSet = wire.NewSet(
 	order.NewService,
+	order.NewCreateOrderSaga,
+	order.NewScheduler,
+	order.NewEventRelay,
 	cart.NewService,
+	aftersale.NewService,
 )
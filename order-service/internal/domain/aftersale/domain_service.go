@@ -0,0 +1,153 @@
+package aftersale
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// DomainService 售后领域服务
+type DomainService interface {
+	// 校验并创建售后申请
+	Apply(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, afterSale *AfterSale) error
+}
+
+// domainService 售后领域服务实现
+type domainService struct {
+	afterSaleRepo Repository
+}
+
+// NewDomainService 创建售后领域服务
+func NewDomainService(afterSaleRepo Repository) DomainService {
+	return &domainService{
+		afterSaleRepo: afterSaleRepo,
+	}
+}
+
+// Apply 校验并创建售后申请
+func (ds *domainService) Apply(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, afterSale *AfterSale) error {
+	var item *order.OrderItem
+	for _, orderItem := range items {
+		if orderItem.ID == afterSale.OrderItemID {
+			item = orderItem
+			break
+		}
+	}
+	if item == nil {
+		return fmt.Errorf("%w: %s", order.ErrOrderItemNotFound, afterSale.OrderItemID)
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	afterSale.OrderID = orderEntity.ID
+	afterSale.Status = int32(AfterSaleStatusPending)
+	afterSale.CreatedAt = now
+	afterSale.UpdatedAt = now
+	afterSale.Version = 1
+
+	// 已有售后申请在锁定订单后读取，保证数量和金额校验与创建之间不会插入其他申请
+	return ds.afterSaleRepo.Create(ctx, afterSale, func(existing []*AfterSale) error {
+		return PlanAfterSale(orderEntity, item, existing, afterSale)
+	})
+}
+
+// PlanAfterSale 校验售后申请，未填写退款金额时按可退金额补全
+// existing 为该订单已有的售后申请，已拒绝和已撤销的申请不占用数量和金额。
+func PlanAfterSale(orderEntity *order.Order, item *order.OrderItem, existing []*AfterSale, afterSale *AfterSale) error {
+	switch AfterSaleType(afterSale.Type) {
+	case AfterSaleTypeRefundOnly, AfterSaleTypeReturnAndRefund:
+	default:
+		return ErrInvalidAfterSaleType
+	}
+	if afterSale.Reason <= int32(AfterSaleReasonUnknown) || afterSale.Reason > int32(maxAfterSaleReason) {
+		return ErrInvalidAfterSaleReason
+	}
+
+	if err := ValidateEvidenceFileIDs(afterSale.EvidenceFileIDs); err != nil {
+		return err
+	}
+
+	// 已付款未发货只能仅退款，发货后才能退货退款
+	switch order.OrderStatus(orderEntity.Status) {
+	case order.OrderStatusPaid:
+		if afterSale.Type == int32(AfterSaleTypeReturnAndRefund) {
+			return fmt.Errorf("%w: 订单未发货，不能申请退货", ErrOrderNotEligible)
+		}
+	case order.OrderStatusShipped, order.OrderStatusDelivered:
+	default:
+		return fmt.Errorf("%w: 订单状态 %d", ErrOrderNotEligible, orderEntity.Status)
+	}
+
+	if afterSale.Quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	var usedQuantity int32
+	usedItemAmount := decimal.Zero
+	usedOrderAmount := decimal.Zero
+	for _, other := range existing {
+		if !other.IsOpen() {
+			continue
+		}
+		usedOrderAmount = usedOrderAmount.Add(other.RefundAmount())
+		if other.OrderItemID == item.ID {
+			usedQuantity += other.Quantity
+			usedItemAmount = usedItemAmount.Add(other.RefundAmount())
+		}
+	}
+	if usedQuantity+afterSale.Quantity > item.Quantity {
+		return fmt.Errorf("%w: 订单商品项共 %d 件，已申请 %d 件", ErrQuantityExceedsOrder, item.Quantity, usedQuantity)
+	}
+
+	// 可退金额取数量对应金额、商品项剩余金额、订单剩余实付金额中的最小值
+	refundable := item.Price.Mul(decimal.NewFromInt(int64(afterSale.Quantity)))
+	refundable = decimal.Min(refundable, item.TotalAmount.Sub(usedItemAmount), orderEntity.ActualAmount.Sub(usedOrderAmount))
+	if refundable.LessThanOrEqual(decimal.Zero) {
+		return fmt.Errorf("%w: 订单已无可退金额", ErrRefundAmountExceeded)
+	}
+
+	if afterSale.RequestedAmount.IsZero() {
+		afterSale.RequestedAmount = refundable
+	}
+	if afterSale.RequestedAmount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidRefundAmount
+	}
+	if afterSale.RequestedAmount.GreaterThan(refundable) {
+		return fmt.Errorf("%w: 最多可退 %s", ErrRefundAmountExceeded, refundable.StringFixed(2))
+	}
+	return nil
+}
+
+// ValidateEvidenceFileIDs 校验凭证文件数量和ID格式，文件是否存在及归属由应用层向 oss-infra 确认
+func ValidateEvidenceFileIDs(fileIDs []string) error {
+	if len(fileIDs) > MaxEvidenceFiles {
+		return fmt.Errorf("%w: 最多 %d 张", ErrTooManyEvidenceFiles, MaxEvidenceFiles)
+	}
+	for _, fileID := range fileIDs {
+		if _, err := uuid.Parse(fileID); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidEvidenceFile, fileID)
+		}
+	}
+	return nil
+}
+
+// RefundedInFull 订单所有商品是否均已退款完成
+func RefundedInFull(items []*order.OrderItem, afterSales []*AfterSale) bool {
+	refunded := make(map[string]int32, len(items))
+	for _, afterSale := range afterSales {
+		if afterSale.Status == int32(AfterSaleStatusRefunded) {
+			refunded[afterSale.OrderItemID] += afterSale.Quantity
+		}
+	}
+
+	for _, item := range items {
+		if refunded[item.ID] < item.Quantity {
+			return false
+		}
+	}
+	return len(items) > 0
+}
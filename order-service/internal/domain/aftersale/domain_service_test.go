@@ -0,0 +1,115 @@
+package aftersale
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+func TestPlanAfterSale(t *testing.T) {
+	item := &order.OrderItem{
+		ID:          "item-1",
+		Price:       decimal.RequireFromString("50"),
+		Quantity:    2,
+		TotalAmount: decimal.RequireFromString("100"),
+	}
+	evidence := "0f8fad5b-d9cb-469f-a165-70867728950e"
+
+	tests := []struct {
+		name        string
+		orderStatus order.OrderStatus
+		existing    []*AfterSale
+		afterSale   AfterSale
+		wantAmount  string
+		wantErr     error
+	}{
+		{
+			name:        "defaults amount to quantity price",
+			orderStatus: order.OrderStatusDelivered,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonDamaged), Quantity: 1, EvidenceFileIDs: []string{evidence}},
+			wantAmount:  "50",
+		},
+		{
+			name:        "refund only before shipment",
+			orderStatus: order.OrderStatusPaid,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeRefundOnly), Reason: int32(AfterSaleReasonNoLongerNeeded), Quantity: 2},
+			wantAmount:  "100",
+		},
+		{
+			name:        "return before shipment",
+			orderStatus: order.OrderStatusPaid,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonNoLongerNeeded), Quantity: 1},
+			wantErr:     ErrOrderNotEligible,
+		},
+		{
+			name:        "pending payment order",
+			orderStatus: order.OrderStatusPendingPayment,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeRefundOnly), Reason: int32(AfterSaleReasonOther), Quantity: 1},
+			wantErr:     ErrOrderNotEligible,
+		},
+		{
+			name:        "quantity already requested",
+			orderStatus: order.OrderStatusDelivered,
+			existing:    []*AfterSale{{OrderItemID: "item-1", Status: int32(AfterSaleStatusPending), Quantity: 2, RequestedAmount: decimal.RequireFromString("100")}},
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonDamaged), Quantity: 1},
+			wantErr:     ErrQuantityExceedsOrder,
+		},
+		{
+			name:        "rejected request frees quantity",
+			orderStatus: order.OrderStatusDelivered,
+			existing:    []*AfterSale{{OrderItemID: "item-1", Status: int32(AfterSaleStatusRejected), Quantity: 2, RequestedAmount: decimal.RequireFromString("100")}},
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonDamaged), Quantity: 2},
+			wantAmount:  "100",
+		},
+		{
+			name:        "amount above refundable",
+			orderStatus: order.OrderStatusShipped,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeRefundOnly), Reason: int32(AfterSaleReasonNotReceived), Quantity: 1, RequestedAmount: decimal.RequireFromString("60")},
+			wantErr:     ErrRefundAmountExceeded,
+		},
+		{
+			name:        "capped by order actual amount",
+			orderStatus: order.OrderStatusDelivered,
+			existing:    []*AfterSale{{OrderItemID: "item-2", Status: int32(AfterSaleStatusRefunded), Quantity: 1, ApprovedAmount: decimal.RequireFromString("90")}},
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonDamaged), Quantity: 1},
+			wantAmount:  "30",
+		},
+		{
+			name:        "invalid evidence file",
+			orderStatus: order.OrderStatusDelivered,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Reason: int32(AfterSaleReasonDamaged), Quantity: 1, EvidenceFileIDs: []string{"not-a-file-id"}},
+			wantErr:     ErrInvalidEvidenceFile,
+		},
+		{
+			name:        "missing reason",
+			orderStatus: order.OrderStatusDelivered,
+			afterSale:   AfterSale{Type: int32(AfterSaleTypeReturnAndRefund), Quantity: 1},
+			wantErr:     ErrInvalidAfterSaleReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderEntity := &order.Order{
+				Status:       int32(tt.orderStatus),
+				ActualAmount: decimal.RequireFromString("120"),
+			}
+			afterSale := tt.afterSale
+			afterSale.OrderItemID = item.ID
+
+			err := PlanAfterSale(orderEntity, item, tt.existing, &afterSale)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, afterSale.RequestedAmount.Equal(decimal.RequireFromString(tt.wantAmount)),
+				"requested amount %s", afterSale.RequestedAmount)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package aftersale
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AfterSaleType 售后类型
+type AfterSaleType int
+
+const (
+	AfterSaleTypeUnknown         AfterSaleType = 0
+	AfterSaleTypeRefundOnly      AfterSaleType = 1 // 仅退款
+	AfterSaleTypeReturnAndRefund AfterSaleType = 2 // 退货退款
+)
+
+// AfterSaleStatus 售后状态
+type AfterSaleStatus int
+
+const (
+	AfterSaleStatusUnknown   AfterSaleStatus = 0
+	AfterSaleStatusPending   AfterSaleStatus = 1 // 待审核
+	AfterSaleStatusApproved  AfterSaleStatus = 2 // 已同意，待买家退货
+	AfterSaleStatusRejected  AfterSaleStatus = 3 // 已拒绝
+	AfterSaleStatusReturned  AfterSaleStatus = 4 // 买家已寄回
+	AfterSaleStatusRefunding AfterSaleStatus = 5 // 退款中
+	AfterSaleStatusRefunded  AfterSaleStatus = 6 // 已退款
+	AfterSaleStatusCancelled AfterSaleStatus = 7 // 买家已撤销
+)
+
+// AfterSaleReason 售后原因
+type AfterSaleReason int
+
+const (
+	AfterSaleReasonUnknown        AfterSaleReason = 0
+	AfterSaleReasonQualityIssue   AfterSaleReason = 1 // 质量问题
+	AfterSaleReasonDamaged        AfterSaleReason = 2 // 商品破损
+	AfterSaleReasonWrongItem      AfterSaleReason = 3 // 发错货
+	AfterSaleReasonNotAsDescribed AfterSaleReason = 4 // 与描述不符
+	AfterSaleReasonNoLongerNeeded AfterSaleReason = 5 // 不想要了
+	AfterSaleReasonNotReceived    AfterSaleReason = 6 // 未收到货
+	AfterSaleReasonOther          AfterSaleReason = 7 // 其他
+	maxAfterSaleReason                            = AfterSaleReasonOther
+)
+
+// MaxEvidenceFiles 单个售后申请最多上传的凭证图片数
+const MaxEvidenceFiles = 9
+
+// AfterSale 售后申请实体，每个申请针对一个订单商品项
+type AfterSale struct {
+	ID                   string          `json:"id"`
+	OrderID              string          `json:"order_id"`
+	OrderItemID          string          `json:"order_item_id"`
+	UserID               string          `json:"user_id"`
+	Type                 int32           `json:"type"`
+	Status               int32           `json:"status"`
+	Reason               int32           `json:"reason"`
+	Description          string          `json:"description"`
+	Quantity             int32           `json:"quantity"`
+	RequestedAmount      decimal.Decimal `json:"requested_amount"`
+	ApprovedAmount       decimal.Decimal `json:"approved_amount"`
+	EvidenceFileIDs      []string        `json:"evidence_file_ids"` // oss-infra 文件ID
+	RejectReason         string          `json:"reject_reason"`
+	OperatorID           string          `json:"operator_id"`
+	ReturnCarrierCode    string          `json:"return_carrier_code"`
+	ReturnTrackingNumber string          `json:"return_tracking_number"`
+	RefundID             string          `json:"refund_id"`
+	RefundRequestedAt    string          `json:"refund_requested_at"` // 已向支付服务发起退款、结果未确认的时间
+	ReviewedAt           string          `json:"reviewed_at"`
+	ReceivedAt           string          `json:"received_at"`
+	RefundedAt           string          `json:"refunded_at"`
+	CreatedAt            string          `json:"created_at"`
+	UpdatedAt            string          `json:"updated_at"`
+	Version              int32           `json:"version"`
+}
+
+// IsOpen 售后申请是否仍在处理中（未拒绝、未撤销）
+func (a *AfterSale) IsOpen() bool {
+	return a.Status != int32(AfterSaleStatusRejected) && a.Status != int32(AfterSaleStatusCancelled)
+}
+
+// RefundAmount 售后申请占用的退款金额，审核通过后以审核金额为准
+func (a *AfterSale) RefundAmount() decimal.Decimal {
+	if a.ApprovedAmount.GreaterThan(decimal.Zero) {
+		return a.ApprovedAmount
+	}
+	return a.RequestedAmount
+}
+
+// Approve 审核通过，仅退款直接进入退款中，退货退款等待买家寄回
+func (a *AfterSale) Approve(operatorID string, amount decimal.Decimal, now time.Time) error {
+	if a.Status != int32(AfterSaleStatusPending) {
+		return ErrInvalidAfterSaleStatus
+	}
+	if amount.IsZero() {
+		amount = a.RequestedAmount
+	}
+	if amount.LessThanOrEqual(decimal.Zero) || amount.GreaterThan(a.RequestedAmount) {
+		return ErrInvalidRefundAmount
+	}
+
+	a.ApprovedAmount = amount
+	a.OperatorID = operatorID
+	a.ReviewedAt = now.Format("2006-01-02 15:04:05")
+	if a.Type == int32(AfterSaleTypeRefundOnly) {
+		a.Status = int32(AfterSaleStatusRefunding)
+	} else {
+		a.Status = int32(AfterSaleStatusApproved)
+	}
+	return nil
+}
+
+// Reject 审核拒绝
+func (a *AfterSale) Reject(operatorID, reason string, now time.Time) error {
+	if a.Status != int32(AfterSaleStatusPending) {
+		return ErrInvalidAfterSaleStatus
+	}
+
+	a.Status = int32(AfterSaleStatusRejected)
+	a.RejectReason = reason
+	a.OperatorID = operatorID
+	a.ReviewedAt = now.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// SubmitReturn 买家填写退货物流
+func (a *AfterSale) SubmitReturn(carrierCode, trackingNumber string) error {
+	if a.Type != int32(AfterSaleTypeReturnAndRefund) || a.Status != int32(AfterSaleStatusApproved) {
+		return ErrInvalidAfterSaleStatus
+	}
+
+	a.ReturnCarrierCode = carrierCode
+	a.ReturnTrackingNumber = trackingNumber
+	a.Status = int32(AfterSaleStatusReturned)
+	return nil
+}
+
+// Receive 运营确认收到退货，进入退款中
+// 买家未填写物流（如线下退回）时也允许从待退货状态直接收货。
+func (a *AfterSale) Receive(operatorID string, now time.Time) error {
+	if a.Type != int32(AfterSaleTypeReturnAndRefund) {
+		return ErrInvalidAfterSaleStatus
+	}
+	if a.Status != int32(AfterSaleStatusApproved) && a.Status != int32(AfterSaleStatusReturned) {
+		return ErrInvalidAfterSaleStatus
+	}
+
+	a.Status = int32(AfterSaleStatusRefunding)
+	a.OperatorID = operatorID
+	a.ReceivedAt = now.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// StartRefund 记录已向支付服务发起退款，结果确认前不允许再次发起
+func (a *AfterSale) StartRefund(now time.Time) error {
+	if a.Status != int32(AfterSaleStatusRefunding) {
+		return ErrInvalidAfterSaleStatus
+	}
+	if a.RefundRequestedAt != "" {
+		return ErrRefundInFlight
+	}
+
+	a.RefundRequestedAt = now.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// AbortRefund 支付服务确认未受理退款，清除发起记录以便重试
+func (a *AfterSale) AbortRefund() {
+	a.RefundRequestedAt = ""
+}
+
+// MarkRefunded 退款成功
+func (a *AfterSale) MarkRefunded(refundID string, now time.Time) error {
+	if a.Status != int32(AfterSaleStatusRefunding) {
+		return ErrInvalidAfterSaleStatus
+	}
+
+	a.Status = int32(AfterSaleStatusRefunded)
+	a.RefundID = refundID
+	a.RefundedAt = now.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// Cancel 买家撤销申请，仅待审核或待退货状态可撤销
+func (a *AfterSale) Cancel() error {
+	if a.Status != int32(AfterSaleStatusPending) && a.Status != int32(AfterSaleStatusApproved) {
+		return ErrInvalidAfterSaleStatus
+	}
+
+	a.Status = int32(AfterSaleStatusCancelled)
+	return nil
+}
@@ -0,0 +1,26 @@
+package aftersale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAfterSale_StartRefund(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.Local)
+	afterSale := &AfterSale{Status: int32(AfterSaleStatusRefunding)}
+
+	require.NoError(t, afterSale.StartRefund(now))
+	assert.Equal(t, "2025-03-01 10:00:00", afterSale.RefundRequestedAt)
+
+	// 结果未确认前再次发起会被拒绝，避免重复退款
+	assert.ErrorIs(t, afterSale.StartRefund(now), ErrRefundInFlight)
+
+	afterSale.AbortRefund()
+	assert.NoError(t, afterSale.StartRefund(now))
+
+	pending := &AfterSale{Status: int32(AfterSaleStatusPending)}
+	assert.ErrorIs(t, pending.StartRefund(now), ErrInvalidAfterSaleStatus)
+}
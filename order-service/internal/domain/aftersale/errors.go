@@ -0,0 +1,20 @@
+package aftersale
+
+import "errors"
+
+// 售后领域错误定义
+var (
+	ErrAfterSaleNotFound      = errors.New("after-sale request not found")
+	ErrAfterSaleConflict      = errors.New("after-sale request modified concurrently")
+	ErrInvalidAfterSaleType   = errors.New("invalid after-sale type")
+	ErrInvalidAfterSaleReason = errors.New("invalid after-sale reason")
+	ErrInvalidAfterSaleStatus = errors.New("invalid after-sale status")
+	ErrInvalidQuantity        = errors.New("invalid quantity")
+	ErrInvalidRefundAmount    = errors.New("invalid refund amount")
+	ErrTooManyEvidenceFiles   = errors.New("too many evidence files")
+	ErrInvalidEvidenceFile    = errors.New("invalid evidence file")
+	ErrOrderNotEligible       = errors.New("order not eligible for after-sale")
+	ErrQuantityExceedsOrder   = errors.New("after-sale quantity exceeds order quantity")
+	ErrRefundAmountExceeded   = errors.New("refund amount exceeds refundable amount")
+	ErrRefundInFlight         = errors.New("refund already requested, reconcile with payment service before retrying")
+)
@@ -0,0 +1,24 @@
+package aftersale
+
+import (
+	"context"
+)
+
+// Repository 售后仓储接口
+type Repository interface {
+	// 创建售后申请及凭证
+	// 在同一事务内锁定订单行并加载该订单已有的售后申请交给 check 校验，并发申请按订单串行，避免超额退款
+	Create(ctx context.Context, afterSale *AfterSale, check func(existing []*AfterSale) error) error
+
+	// 根据ID获取售后申请
+	GetByID(ctx context.Context, id string) (*AfterSale, error)
+
+	// 获取订单的售后申请列表
+	ListByOrderID(ctx context.Context, orderID string) ([]*AfterSale, error)
+
+	// 分页获取售后申请，userID 为空时查询全部，status 为0时不过滤状态
+	List(ctx context.Context, userID string, status int32, page, pageSize int32) ([]*AfterSale, int64, error)
+
+	// 更新售后申请（乐观锁）
+	Update(ctx context.Context, afterSale *AfterSale) error
+}
@@ -27,6 +27,9 @@ type DomainService interface {
 
 	// 批量更新选中状态
 	BatchUpdateSelection(ctx context.Context, carts []*ShoppingCart, selected bool) error
+
+	// 将游客购物车项合并到用户购物车
+	MergeCart(ctx context.Context, userID string, guestItems []*ShoppingCart) (*MergeResult, error)
 }
 
 // domainService 购物车领域服务实现
@@ -47,6 +50,9 @@ func (ds *domainService) AddToCart(ctx context.Context, cart *ShoppingCart) (*Sh
 	if cart.Quantity <= 0 {
 		return nil, fmt.Errorf("商品数量必须大于0")
 	}
+	if cart.Quantity > MaxItemQuantity {
+		return nil, fmt.Errorf("%w: 单个商品最多购买 %d 件", ErrQuantityExceeded, MaxItemQuantity)
+	}
 
 	// 验证商品价格
 	if cart.Price.LessThanOrEqual(decimal.Zero) {
@@ -73,6 +79,9 @@ func (ds *domainService) UpdateQuantity(ctx context.Context, cart *ShoppingCart,
 	if quantity <= 0 {
 		return nil, fmt.Errorf("商品数量必须大于0")
 	}
+	if quantity > MaxItemQuantity {
+		return nil, fmt.Errorf("%w: 单个商品最多购买 %d 件", ErrQuantityExceeded, MaxItemQuantity)
+	}
 
 	// 更新数量和时间
 	cart.Quantity = quantity
@@ -138,3 +147,22 @@ func (ds *domainService) BatchUpdateSelection(ctx context.Context, carts []*Shop
 
 	return nil
 }
+
+// MergeCart 将游客购物车项合并到用户购物车，合并结果在同一事务中保存
+func (ds *domainService) MergeCart(ctx context.Context, userID string, guestItems []*ShoppingCart) (*MergeResult, error) {
+	userItems, err := ds.cartRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := Merge(userID, userItems, guestItems, time.Now())
+	if len(result.Changed) == 0 {
+		return result, nil
+	}
+
+	if err := ds.cartRepo.SaveAll(ctx, result.Changed); err != nil {
+		return nil, fmt.Errorf("合并购物车失败: %w", err)
+	}
+
+	return result, nil
+}
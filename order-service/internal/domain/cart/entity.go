@@ -22,22 +22,24 @@ type ShoppingCart struct {
 
 // CartItem 购物车商品项实体
 type CartItem struct {
-	ID           string          `json:"id"`
-	UserID       string          `json:"user_id"`
-	ProductID    string          `json:"product_id"`
-	SkuID        string          `json:"sku_id"`
-	ProductName  string          `json:"product_name"`
-	ProductImage string          `json:"product_image"`
-	SkuName      string          `json:"sku_name"`
-	Price        decimal.Decimal `json:"price"`
-	Quantity     int             `json:"quantity"`
-	TotalAmount  decimal.Decimal `json:"total_amount"`
-	Selected     bool            `json:"selected"`
-	Available    bool            `json:"available"` // 商品是否可用（库存充足等）
-	CreatedAt    string          `json:"created_at"`
-	UpdatedAt    string          `json:"updated_at"`
-	DeletedAt    *time.Time      `json:"deleted_at"`
-	Version      int             `json:"version"`
+	ID                string          `json:"id"`
+	UserID            string          `json:"user_id"`
+	ProductID         string          `json:"product_id"`
+	SkuID             string          `json:"sku_id"`
+	ProductName       string          `json:"product_name"`
+	ProductImage      string          `json:"product_image"`
+	SkuName           string          `json:"sku_name"`
+	Price             decimal.Decimal `json:"price"`       // 当前售价
+	AddedPrice        decimal.Decimal `json:"added_price"` // 加入购物车时的价格
+	Quantity          int             `json:"quantity"`
+	TotalAmount       decimal.Decimal `json:"total_amount"`
+	Selected          bool            `json:"selected"`
+	Available         bool            `json:"available"`          // 商品是否可用（库存充足等）
+	UnavailableReason string          `json:"unavailable_reason"` // 商品不可用的原因
+	CreatedAt         string          `json:"created_at"`
+	UpdatedAt         string          `json:"updated_at"`
+	DeletedAt         *time.Time      `json:"deleted_at"`
+	Version           int             `json:"version"`
 }
 
 // CartSummary 购物车汇总信息
@@ -76,6 +78,16 @@ func (c *CartItem) SetAvailable(available bool) {
 	}
 }
 
+// PriceDelta 当前售价与加入购物车时价格的差额，正数表示涨价
+func (c *CartItem) PriceDelta() decimal.Decimal {
+	return c.Price.Sub(c.AddedPrice)
+}
+
+// PriceChanged 加入购物车后价格是否发生变化
+func (c *CartItem) PriceChanged() bool {
+	return !c.Price.Equal(c.AddedPrice)
+}
+
 // CalculateTotal 计算小计
 func (c *CartItem) CalculateTotal() {
 	c.TotalAmount = c.Price.Mul(decimal.NewFromInt(int64(c.Quantity)))
@@ -14,4 +14,6 @@ var (
 	ErrNoSelectedItems    = errors.New("no selected items")
 	ErrInvalidPrice       = errors.New("invalid price")
 	ErrDuplicateCartItem  = errors.New("duplicate cart item")
+	ErrQuantityExceeded   = errors.New("quantity exceeds purchase limit")
+	ErrInvalidCartToken   = errors.New("invalid cart token")
 )
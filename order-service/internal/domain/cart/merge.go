@@ -0,0 +1,69 @@
+package cart
+
+import "time"
+
+// MaxItemQuantity 单个购物车项的最大数量
+const MaxItemQuantity int32 = 99
+
+// MergeResult 合并游客购物车的结果
+type MergeResult struct {
+	// Changed 需要保存的购物车项，ID 为空的是新增项
+	Changed []*ShoppingCart
+	// Merged 合并的游客购物车项数量
+	Merged int
+	// Capped 因超过限购数量被截断的商品项数量
+	Capped int
+}
+
+// Merge 将游客购物车项合并到用户购物车
+// 相同商品 SKU 的数量相加，超过 MaxItemQuantity 时按 MaxItemQuantity 保留；用户购物车中已有的商品保持原有选中状态。
+func Merge(userID string, userItems, guestItems []*ShoppingCart, now time.Time) *MergeResult {
+	result := &MergeResult{}
+	updatedAt := now.Format("2006-01-02 15:04:05")
+
+	existing := make(map[string]*ShoppingCart, len(userItems))
+	for _, item := range userItems {
+		existing[item.ProductID+"/"+item.SkuID] = item
+	}
+	changed := make(map[*ShoppingCart]bool)
+
+	for _, guestItem := range guestItems {
+		if guestItem.Quantity <= 0 {
+			continue
+		}
+		result.Merged++
+
+		key := guestItem.ProductID + "/" + guestItem.SkuID
+		target, ok := existing[key]
+		if !ok {
+			target = &ShoppingCart{
+				UserID:    userID,
+				ProductID: guestItem.ProductID,
+				SkuID:     guestItem.SkuID,
+				Price:     guestItem.Price,
+				Selected:  guestItem.Selected,
+				CreatedAt: updatedAt,
+			}
+			existing[key] = target
+		}
+
+		quantity := target.Quantity + guestItem.Quantity
+		if quantity > MaxItemQuantity {
+			quantity = MaxItemQuantity
+			result.Capped++
+		}
+		if quantity == target.Quantity {
+			continue
+		}
+
+		target.Quantity = quantity
+		target.UpdatedAt = updatedAt
+		if !changed[target] {
+			changed[target] = true
+			target.Version++
+			result.Changed = append(result.Changed, target)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package cart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.Local)
+	price := decimal.RequireFromString("19.90")
+
+	userItems := []*ShoppingCart{
+		{ID: "c1", UserID: "user-1", ProductID: "p1", SkuID: "s1", Quantity: 2, Price: price, Selected: false, Version: 3},
+		{ID: "c2", UserID: "user-1", ProductID: "p2", SkuID: "s2", Quantity: 90, Price: price, Selected: true, Version: 1},
+		{ID: "c3", UserID: "user-1", ProductID: "p3", SkuID: "s3", Quantity: MaxItemQuantity, Price: price, Selected: true, Version: 1},
+	}
+	guestItems := []*ShoppingCart{
+		{ID: "g1", ProductID: "p1", SkuID: "s1", Quantity: 3, Price: price, Selected: true},
+		{ID: "g2", ProductID: "p2", SkuID: "s2", Quantity: 20, Price: price, Selected: true},
+		{ID: "g3", ProductID: "p3", SkuID: "s3", Quantity: 1, Price: price, Selected: true},
+		{ID: "g4", ProductID: "p4", SkuID: "s4", Quantity: 1, Price: price, Selected: false},
+	}
+
+	result := Merge("user-1", userItems, guestItems, now)
+	assert.Equal(t, 4, result.Merged)
+	assert.Equal(t, 2, result.Capped)
+	require.Len(t, result.Changed, 3)
+
+	// 已有商品数量相加，保持用户购物车中的选中状态
+	assert.Equal(t, "c1", result.Changed[0].ID)
+	assert.Equal(t, int32(5), result.Changed[0].Quantity)
+	assert.False(t, result.Changed[0].Selected)
+	assert.Equal(t, int32(4), result.Changed[0].Version)
+
+	// 超过上限按上限保留
+	assert.Equal(t, "c2", result.Changed[1].ID)
+	assert.Equal(t, MaxItemQuantity, result.Changed[1].Quantity)
+
+	// 用户购物车中没有的商品新增
+	added := result.Changed[2]
+	assert.Empty(t, added.ID)
+	assert.Equal(t, "user-1", added.UserID)
+	assert.Equal(t, "p4", added.ProductID)
+	assert.Equal(t, int32(1), added.Quantity)
+	assert.False(t, added.Selected)
+	assert.Equal(t, int32(1), added.Version)
+	assert.Equal(t, "2025-09-01 12:00:00", added.CreatedAt)
+}
+
+func TestMerge_EmptyGuestCart(t *testing.T) {
+	result := Merge("user-1", []*ShoppingCart{{ID: "c1", ProductID: "p1", Quantity: 1}}, nil, time.Now())
+	assert.Zero(t, result.Merged)
+	assert.Empty(t, result.Changed)
+}
@@ -0,0 +1,120 @@
+package cart
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// 商品不可用的原因
+const (
+	ReasonOffShelf          = "商品已下架"
+	ReasonOutOfStock        = "商品已售罄"
+	ReasonInsufficientStock = "库存不足"
+)
+
+// SkuQuote 商品SKU的实时售价和库存
+type SkuQuote struct {
+	ProductID    string
+	SkuID        string
+	ProductName  string
+	SkuName      string
+	ProductImage string
+	Price        decimal.Decimal
+	Stock        int32 // 可用库存
+	OnSale       bool  // 商品和SKU均处于可售状态
+}
+
+// QuoteKey 报价的索引键
+func QuoteKey(productID, skuID string) string {
+	return productID + "/" + skuID
+}
+
+// RefreshResult 以实时报价刷新购物车的结果
+type RefreshResult struct {
+	// Items 带实时价格和可用性的购物车商品项，顺序与输入一致
+	Items []*CartItem
+	// Unselected 因不可购买被自动取消选中的购物车项，需要保存
+	Unselected []*ShoppingCart
+	// Summary 购物车汇总，金额按当前售价计算，只统计可用商品
+	Summary CartSummary
+}
+
+// Refresh 以实时报价刷新购物车
+// 没有报价、已下架或库存不足的商品标记为不可用并自动取消选中；加购价格保留在 AddedPrice 中，用于提示价格变化。
+// quotes 为 nil 表示没有获取到实时报价，此时按加购价格展示，不判断可用性。
+func Refresh(items []*ShoppingCart, quotes map[string]*SkuQuote) *RefreshResult {
+	result := &RefreshResult{Items: make([]*CartItem, 0, len(items))}
+
+	for _, item := range items {
+		cartItem := &CartItem{
+			ID:         item.ID,
+			UserID:     item.UserID,
+			ProductID:  item.ProductID,
+			SkuID:      item.SkuID,
+			Price:      item.Price,
+			AddedPrice: item.Price,
+			Quantity:   int(item.Quantity),
+			Selected:   item.Selected,
+			Available:  true,
+			CreatedAt:  item.CreatedAt,
+			UpdatedAt:  item.UpdatedAt,
+			Version:    int(item.Version),
+		}
+
+		quote, ok := quotes[QuoteKey(item.ProductID, item.SkuID)]
+		if ok {
+			cartItem.ProductName = quote.ProductName
+			cartItem.SkuName = quote.SkuName
+			cartItem.ProductImage = quote.ProductImage
+			cartItem.Price = quote.Price
+		}
+		if reason := unavailableReason(quote, item.Quantity); quotes != nil && reason != "" {
+			cartItem.SetAvailable(false)
+			cartItem.UnavailableReason = reason
+			if item.Selected {
+				item.Selected = false
+				result.Unselected = append(result.Unselected, item)
+			}
+		}
+		cartItem.CalculateTotal()
+
+		result.Items = append(result.Items, cartItem)
+		if cartItem.Available {
+			result.Summary.TotalItems += cartItem.Quantity
+			result.Summary.TotalAmount = result.Summary.TotalAmount.Add(cartItem.TotalAmount)
+			if cartItem.Selected {
+				result.Summary.SelectedItems += cartItem.Quantity
+				result.Summary.SelectedAmount = result.Summary.SelectedAmount.Add(cartItem.TotalAmount)
+			}
+		}
+	}
+
+	return result
+}
+
+// CheckAvailable 检查商品能否按指定数量购买
+func CheckAvailable(quote *SkuQuote, quantity int32) error {
+	switch reason := unavailableReason(quote, quantity); reason {
+	case "":
+		return nil
+	case ReasonOffShelf:
+		return fmt.Errorf("%w: %s", ErrProductUnavailable, reason)
+	default:
+		return fmt.Errorf("%w: %s", ErrInsufficientStock, reason)
+	}
+}
+
+// unavailableReason 返回商品不可购买的原因，可以购买时返回空字符串
+func unavailableReason(quote *SkuQuote, quantity int32) string {
+	switch {
+	case quote == nil || !quote.OnSale:
+		return ReasonOffShelf
+	case quote.Stock <= 0:
+		return ReasonOutOfStock
+	case quote.Stock < quantity:
+		return ReasonInsufficientStock
+	default:
+		return ""
+	}
+}
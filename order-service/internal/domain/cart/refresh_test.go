@@ -0,0 +1,73 @@
+package cart
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefresh(t *testing.T) {
+	items := []*ShoppingCart{
+		{ID: "c1", ProductID: "p1", SkuID: "s1", Quantity: 2, Price: decimal.RequireFromString("19.90"), Selected: true},
+		{ID: "c2", ProductID: "p2", SkuID: "s2", Quantity: 1, Price: decimal.RequireFromString("50.00"), Selected: true},
+		{ID: "c3", ProductID: "p3", SkuID: "s3", Quantity: 5, Price: decimal.RequireFromString("10.00"), Selected: true},
+		{ID: "c4", ProductID: "p4", SkuID: "s4", Quantity: 1, Price: decimal.RequireFromString("8.00"), Selected: false},
+		{ID: "c5", ProductID: "p5", SkuID: "s5", Quantity: 1, Price: decimal.RequireFromString("8.00"), Selected: true},
+	}
+	quotes := map[string]*SkuQuote{
+		QuoteKey("p1", "s1"): {ProductName: "T恤", SkuName: "白色 L", Price: decimal.RequireFromString("17.90"), Stock: 10, OnSale: true},
+		QuoteKey("p2", "s2"): {ProductName: "卫衣", Price: decimal.RequireFromString("50.00"), Stock: 0, OnSale: true},
+		QuoteKey("p3", "s3"): {ProductName: "袜子", Price: decimal.RequireFromString("12.00"), Stock: 3, OnSale: true},
+		QuoteKey("p4", "s4"): {ProductName: "帽子", Price: decimal.RequireFromString("8.00"), Stock: 10, OnSale: false},
+	}
+
+	result := Refresh(items, quotes)
+	require.Len(t, result.Items, 5)
+
+	// 可用商品按当前售价计算，并给出相对加购价的差额
+	first := result.Items[0]
+	assert.True(t, first.Available)
+	assert.Equal(t, "T恤", first.ProductName)
+	assert.True(t, first.PriceChanged())
+	assert.Equal(t, "-2", first.PriceDelta().String())
+	assert.Equal(t, "35.8", first.TotalAmount.String())
+
+	// 售罄、库存不足、下架和无报价的商品不可用
+	assert.Equal(t, ReasonOutOfStock, result.Items[1].UnavailableReason)
+	assert.Equal(t, ReasonInsufficientStock, result.Items[2].UnavailableReason)
+	assert.True(t, result.Items[2].PriceChanged())
+	assert.Equal(t, ReasonOffShelf, result.Items[3].UnavailableReason)
+	assert.Equal(t, ReasonOffShelf, result.Items[4].UnavailableReason)
+	for _, item := range result.Items[1:] {
+		assert.False(t, item.Available)
+		assert.False(t, item.Selected)
+	}
+
+	// 原本选中的不可用商品需要保存取消选中状态
+	require.Len(t, result.Unselected, 3)
+	assert.Equal(t, "c2", result.Unselected[0].ID)
+	assert.Equal(t, "c3", result.Unselected[1].ID)
+	assert.Equal(t, "c5", result.Unselected[2].ID)
+	assert.False(t, items[1].Selected)
+
+	// 汇总只统计可用商品
+	assert.Equal(t, 2, result.Summary.TotalItems)
+	assert.Equal(t, 2, result.Summary.SelectedItems)
+	assert.Equal(t, "35.8", result.Summary.SelectedAmount.String())
+}
+
+func TestRefresh_WithoutQuotes(t *testing.T) {
+	items := []*ShoppingCart{
+		{ID: "c1", ProductID: "p1", SkuID: "s1", Quantity: 2, Price: decimal.RequireFromString("19.90"), Selected: true},
+	}
+
+	result := Refresh(items, nil)
+	require.Len(t, result.Items, 1)
+	assert.True(t, result.Items[0].Available)
+	assert.True(t, result.Items[0].Selected)
+	assert.False(t, result.Items[0].PriceChanged())
+	assert.Empty(t, result.Unselected)
+	assert.Equal(t, "39.8", result.Summary.SelectedAmount.String())
+}
@@ -30,9 +30,49 @@ type Repository interface {
 	// 批量更新购物车项
 	BatchUpdate(ctx context.Context, carts []*ShoppingCart) error
 
+	// 在同一事务中创建没有ID的购物车项、更新已有的购物车项
+	SaveAll(ctx context.Context, carts []*ShoppingCart) error
+
 	// 获取用户购物车中选中的商品
 	GetSelectedItems(ctx context.Context, userID string) ([]*ShoppingCart, error)
 
 	// 统计用户购物车商品数量
 	CountByUserID(ctx context.Context, userID string) (int64, error)
 }
+
+// GuestRepository 游客购物车仓储，购物车按网关签发的令牌存储
+// 每次访问都会刷新有效期，超过有效期未访问的游客购物车自动过期。
+type GuestRepository interface {
+	// 返回令牌对应的购物车仓储，仓储方法中的 userID 参数被忽略
+	ForToken(token string) Repository
+}
+
+// Flusher 异步写回数据库的购物车仓储
+// 购物车变更先写入缓存，由定时任务批量写回数据库；直接读写数据库的仓储无需写回。
+type Flusher interface {
+	// 将有变更的购物车写回数据库，返回写回的购物车数
+	Flush(ctx context.Context, limit int) (int, error)
+
+	// 立即将用户的购物车写回数据库，用于在数据库事务中结算购物车项之前
+	FlushUser(ctx context.Context, userID string) error
+
+	// 从缓存中移除已在数据库中删除的购物车项
+	Discard(ctx context.Context, userID string, ids []string) error
+}
+
+// MergeRequest 登录时提交的游客购物车合并请求
+type MergeRequest struct {
+	UserID    string
+	CartToken string
+	// Attempts 已失败的合并次数
+	Attempts int
+}
+
+// MergeQueue 游客购物车合并队列，用户服务在登录时写入，由定时任务合并
+type MergeQueue interface {
+	// 按提交顺序取出最多 limit 个合并请求，取出后不再排队
+	Dequeue(ctx context.Context, limit int) ([]*MergeRequest, error)
+
+	// 重新排队，用于合并失败后重试
+	Requeue(ctx context.Context, req *MergeRequest) error
+}
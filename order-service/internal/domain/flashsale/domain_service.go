@@ -0,0 +1,148 @@
+package flashsale
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DomainService 秒杀领域服务
+type DomainService interface {
+	// 创建秒杀活动
+	CreateSale(ctx context.Context, sale *Sale) error
+
+	// 预热活动库存，预热数量不超过库存服务中的可用库存 available
+	Preload(ctx context.Context, sale *Sale, available int32, expireAt time.Time) error
+
+	// 抢购：扣减活动库存并排队创建订单，排队失败时退回库存
+	Purchase(ctx context.Context, sale *Sale, ticket *Ticket, now, expireAt time.Time) error
+
+	// 订单创建成功
+	Complete(ctx context.Context, ticket *Ticket, orderID string) error
+
+	// 订单创建失败，退回活动库存
+	Fail(ctx context.Context, ticket *Ticket, reason string) error
+
+	// 订单已取消，退回活动库存供其他用户抢购
+	Release(ctx context.Context, ticket *Ticket) error
+
+	// 重新排队排队超时的记录
+	Requeue(ctx context.Context, ticket *Ticket) error
+
+	// 结算已结束的活动，sold 为已创建且未取消的订单中的商品数量
+	Settle(ctx context.Context, sale *Sale, sold int32) error
+}
+
+// domainService 秒杀领域服务实现
+type domainService struct {
+	repo  Repository
+	stock Stock
+	queue TicketQueue
+}
+
+// NewDomainService 创建秒杀领域服务
+func NewDomainService(repo Repository, stock Stock, queue TicketQueue) DomainService {
+	return &domainService{
+		repo:  repo,
+		stock: stock,
+		queue: queue,
+	}
+}
+
+// CreateSale 创建秒杀活动
+func (ds *domainService) CreateSale(ctx context.Context, sale *Sale) error {
+	if err := sale.Validate(); err != nil {
+		return err
+	}
+	return ds.repo.Create(ctx, sale)
+}
+
+// Preload 预热活动库存
+func (ds *domainService) Preload(ctx context.Context, sale *Sale, available int32, expireAt time.Time) error {
+	sale.Stock = min(sale.Quota, max(available, 0))
+	if err := ds.stock.Preload(ctx, sale, expireAt); err != nil {
+		return err
+	}
+
+	sale.Preloaded = true
+	return ds.repo.UpdateProgress(ctx, sale)
+}
+
+// Purchase 抢购
+func (ds *domainService) Purchase(ctx context.Context, sale *Sale, ticket *Ticket, now, expireAt time.Time) error {
+	if !sale.Active(now) || !sale.Preloaded {
+		return ErrSaleNotActive
+	}
+	if ticket.Quantity <= 0 || (sale.PerUserLimit > 0 && ticket.Quantity > sale.PerUserLimit) {
+		return ErrInvalidQuantity
+	}
+
+	if err := ds.stock.Deduct(ctx, sale, ticket.UserID, ticket.Quantity); err != nil {
+		return err
+	}
+
+	ticket.SaleID = sale.ID
+	ticket.Status = TicketStatusQueued
+	ticket.CreatedAt = now.Format(timeLayout)
+	ticket.UpdatedAt = ticket.CreatedAt
+	if err := ds.queue.Enqueue(ctx, ticket, expireAt); err != nil {
+		if restoreErr := ds.stock.Restore(ctx, sale.ID, ticket.UserID, ticket.Quantity); restoreErr != nil {
+			return fmt.Errorf("抢购排队失败: %w，退回库存失败: %v", err, restoreErr)
+		}
+		return fmt.Errorf("抢购排队失败: %w", err)
+	}
+	return nil
+}
+
+// Complete 订单创建成功
+func (ds *domainService) Complete(ctx context.Context, ticket *Ticket, orderID string) error {
+	ticket.Status = TicketStatusCreated
+	ticket.OrderID = orderID
+	ticket.UpdatedAt = time.Now().Format(timeLayout)
+	return ds.queue.Save(ctx, ticket)
+}
+
+// Fail 订单创建失败
+func (ds *domainService) Fail(ctx context.Context, ticket *Ticket, reason string) error {
+	return ds.giveBack(ctx, ticket, TicketStatusFailed, reason)
+}
+
+// Release 订单已取消
+func (ds *domainService) Release(ctx context.Context, ticket *Ticket) error {
+	return ds.giveBack(ctx, ticket, TicketStatusReleased, ticket.Reason)
+}
+
+// giveBack 退回记录占用的活动库存，先更新记录状态，避免重复退回
+func (ds *domainService) giveBack(ctx context.Context, ticket *Ticket, status TicketStatus, reason string) error {
+	if !ticket.Holding() {
+		return nil
+	}
+
+	ticket.Status = status
+	ticket.Reason = reason
+	ticket.UpdatedAt = time.Now().Format(timeLayout)
+	if err := ds.queue.Save(ctx, ticket); err != nil {
+		return err
+	}
+	return ds.stock.Restore(ctx, ticket.SaleID, ticket.UserID, ticket.Quantity)
+}
+
+// Requeue 重新排队，先刷新记录的更新时间，避免下一轮对账再次排队
+func (ds *domainService) Requeue(ctx context.Context, ticket *Ticket) error {
+	if ticket.Status != TicketStatusQueued {
+		return nil
+	}
+
+	ticket.UpdatedAt = time.Now().Format(timeLayout)
+	if err := ds.queue.Save(ctx, ticket); err != nil {
+		return err
+	}
+	return ds.queue.Requeue(ctx, ticket)
+}
+
+// Settle 结算已结束的活动
+func (ds *domainService) Settle(ctx context.Context, sale *Sale, sold int32) error {
+	sale.Settled = true
+	sale.Sold = sold
+	return ds.repo.UpdateProgress(ctx, sale)
+}
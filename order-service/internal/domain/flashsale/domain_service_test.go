@@ -0,0 +1,251 @@
+package flashsale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore 内存中的活动、库存和抢购记录
+type memoryStore struct {
+	sales      map[string]*Sale
+	stock      map[string]int32
+	bought     map[string]int32
+	tickets    map[string]*Ticket
+	queue      []string
+	enqueueErr error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		sales:   make(map[string]*Sale),
+		stock:   make(map[string]int32),
+		bought:  make(map[string]int32),
+		tickets: make(map[string]*Ticket),
+	}
+}
+
+func (m *memoryStore) Create(_ context.Context, sale *Sale) error {
+	sale.ID = "sale-1"
+	m.sales[sale.ID] = sale
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, id string) (*Sale, error) {
+	sale, ok := m.sales[id]
+	if !ok {
+		return nil, ErrSaleNotFound
+	}
+	return sale, nil
+}
+
+func (m *memoryStore) List(context.Context) ([]*Sale, error)          { return nil, nil }
+func (m *memoryStore) ListUnsettled(context.Context) ([]*Sale, error) { return nil, nil }
+
+func (m *memoryStore) UpdateProgress(_ context.Context, sale *Sale) error {
+	m.sales[sale.ID] = sale
+	return nil
+}
+
+func (m *memoryStore) Preload(_ context.Context, sale *Sale, _ time.Time) error {
+	if _, ok := m.stock[sale.ID]; !ok {
+		m.stock[sale.ID] = sale.Stock
+	}
+	return nil
+}
+
+func (m *memoryStore) Deduct(_ context.Context, sale *Sale, userID string, quantity int32) error {
+	stock, ok := m.stock[sale.ID]
+	switch {
+	case !ok:
+		return ErrSaleNotActive
+	case stock < quantity:
+		return ErrSoldOut
+	case sale.PerUserLimit > 0 && m.bought[userID]+quantity > sale.PerUserLimit:
+		return ErrUserLimitExceeded
+	}
+	m.stock[sale.ID] -= quantity
+	m.bought[userID] += quantity
+	return nil
+}
+
+func (m *memoryStore) Restore(_ context.Context, saleID, userID string, quantity int32) error {
+	m.stock[saleID] += quantity
+	m.bought[userID] -= quantity
+	return nil
+}
+
+func (m *memoryStore) Remaining(_ context.Context, saleID string) (int32, error) {
+	return m.stock[saleID], nil
+}
+
+func (m *memoryStore) Enqueue(_ context.Context, ticket *Ticket, _ time.Time) error {
+	if m.enqueueErr != nil {
+		return m.enqueueErr
+	}
+	ticket.ID = "ticket-1"
+	copied := *ticket
+	m.tickets[ticket.ID] = &copied
+	m.queue = append(m.queue, ticket.ID)
+	return nil
+}
+
+func (m *memoryStore) Requeue(_ context.Context, ticket *Ticket) error {
+	m.queue = append(m.queue, ticket.ID)
+	return nil
+}
+
+func (m *memoryStore) Dequeue(context.Context, int) ([]*Ticket, error) { return nil, nil }
+
+func (m *memoryStore) Save(_ context.Context, ticket *Ticket) error {
+	copied := *ticket
+	m.tickets[ticket.ID] = &copied
+	return nil
+}
+
+func (m *memoryStore) ListBySale(context.Context, string) ([]*Ticket, error) { return nil, nil }
+
+// ticketStore 以 TicketQueue 的方法集使用 memoryStore，Get 与 Repository.Get 同名
+type ticketStore struct{ *memoryStore }
+
+func (t ticketStore) Get(_ context.Context, id string) (*Ticket, error) {
+	ticket, ok := t.tickets[id]
+	if !ok {
+		return nil, ErrTicketNotFound
+	}
+	return ticket, nil
+}
+
+func newTestSale() *Sale {
+	return &Sale{
+		ID:           "sale-1",
+		Name:         "限时秒杀",
+		ProductID:    "product-1",
+		SkuID:        "sku-1",
+		Price:        decimal.RequireFromString("9.9"),
+		Quota:        10,
+		PerUserLimit: 2,
+		StartAt:      "2025-06-18 10:00:00",
+		EndAt:        "2025-06-18 11:00:00",
+	}
+}
+
+func TestSaleValidate(t *testing.T) {
+	require.NoError(t, newTestSale().Validate())
+
+	sale := newTestSale()
+	sale.EndAt = sale.StartAt
+	assert.ErrorIs(t, sale.Validate(), ErrInvalidSale)
+
+	sale = newTestSale()
+	sale.Price = decimal.Zero
+	assert.ErrorIs(t, sale.Validate(), ErrInvalidSale)
+}
+
+func TestSaleShouldPreload(t *testing.T) {
+	sale := newTestSale()
+	at := func(value string) time.Time {
+		parsed, _ := time.ParseInLocation(timeLayout, value, time.Local)
+		return parsed
+	}
+
+	assert.False(t, sale.ShouldPreload(at("2025-06-18 09:49:59"), 10*time.Minute))
+	assert.True(t, sale.ShouldPreload(at("2025-06-18 09:50:00"), 10*time.Minute))
+	assert.True(t, sale.ShouldPreload(at("2025-06-18 10:30:00"), 10*time.Minute))
+	assert.False(t, sale.ShouldPreload(at("2025-06-18 11:00:00"), 10*time.Minute))
+
+	sale.Preloaded = true
+	assert.False(t, sale.ShouldPreload(at("2025-06-18 09:55:00"), 10*time.Minute))
+}
+
+func TestPreloadCapsStockAtAvailable(t *testing.T) {
+	store := newMemoryStore()
+	ds := NewDomainService(store, store, ticketStore{store})
+	sale := newTestSale()
+
+	require.NoError(t, ds.Preload(context.Background(), sale, 6, time.Now()))
+	assert.True(t, sale.Preloaded)
+	assert.Equal(t, int32(6), sale.Stock)
+	assert.Equal(t, int32(6), store.stock[sale.ID])
+}
+
+func TestPurchase(t *testing.T) {
+	now, _ := time.ParseInLocation(timeLayout, "2025-06-18 10:30:00", time.Local)
+
+	tests := []struct {
+		name       string
+		preloaded  bool
+		stock      int32
+		bought     int32
+		quantity   int32
+		enqueueErr error
+		now        time.Time
+		wantErr    error
+		wantStock  int32
+	}{
+		{name: "queued", preloaded: true, stock: 5, quantity: 2, now: now, wantStock: 3},
+		{name: "not preloaded", stock: 5, quantity: 1, now: now, wantErr: ErrSaleNotActive, wantStock: 5},
+		{name: "before start", preloaded: true, stock: 5, quantity: 1, now: now.Add(-time.Hour), wantErr: ErrSaleNotActive, wantStock: 5},
+		{name: "over per user limit", preloaded: true, stock: 5, quantity: 3, now: now, wantErr: ErrInvalidQuantity, wantStock: 5},
+		{name: "limit reached by earlier purchases", preloaded: true, stock: 5, bought: 1, quantity: 2, now: now, wantErr: ErrUserLimitExceeded, wantStock: 5},
+		{name: "sold out", preloaded: true, stock: 1, quantity: 2, now: now, wantErr: ErrSoldOut, wantStock: 1},
+		{name: "enqueue failure restores stock", preloaded: true, stock: 5, quantity: 1, enqueueErr: errors.New("redis down"), now: now, wantStock: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryStore()
+			store.enqueueErr = tt.enqueueErr
+			ds := NewDomainService(store, store, ticketStore{store})
+			sale := newTestSale()
+			sale.Preloaded = tt.preloaded
+			store.stock[sale.ID] = tt.stock
+			store.bought["user-1"] = tt.bought
+
+			ticket := &Ticket{UserID: "user-1", Quantity: tt.quantity}
+			err := ds.Purchase(context.Background(), sale, ticket, tt.now, tt.now.Add(time.Hour))
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.enqueueErr != nil:
+				assert.ErrorIs(t, err, tt.enqueueErr)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, TicketStatusQueued, ticket.Status)
+				assert.Equal(t, []string{ticket.ID}, store.queue)
+			}
+			assert.Equal(t, tt.wantStock, store.stock[sale.ID])
+		})
+	}
+}
+
+func TestGiveBackRestoresStockOnce(t *testing.T) {
+	store := newMemoryStore()
+	ds := NewDomainService(store, store, ticketStore{store})
+	store.stock["sale-1"] = 3
+	ticket := &Ticket{ID: "ticket-1", SaleID: "sale-1", UserID: "user-1", Quantity: 2, Status: TicketStatusCreated}
+
+	require.NoError(t, ds.Release(context.Background(), ticket))
+	assert.Equal(t, TicketStatusReleased, ticket.Status)
+	assert.Equal(t, int32(5), store.stock["sale-1"])
+
+	require.NoError(t, ds.Fail(context.Background(), ticket, "重复退回"))
+	assert.Equal(t, TicketStatusReleased, ticket.Status)
+	assert.Equal(t, int32(5), store.stock["sale-1"])
+}
+
+func TestTicketStale(t *testing.T) {
+	now, _ := time.ParseInLocation(timeLayout, "2025-06-18 10:30:00", time.Local)
+	ticket := &Ticket{Status: TicketStatusQueued, UpdatedAt: "2025-06-18 10:29:30"}
+
+	assert.False(t, ticket.Stale(now, time.Minute))
+	assert.True(t, ticket.Stale(now.Add(30*time.Second), time.Minute))
+
+	ticket.Status = TicketStatusCreated
+	assert.False(t, ticket.Stale(now.Add(time.Hour), time.Minute))
+}
@@ -0,0 +1,119 @@
+package flashsale
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// timeLayout 实体中时间字段的格式，该格式的字符串按字典序比较即按时间先后比较
+const timeLayout = "2006-01-02 15:04:05"
+
+// Sale 秒杀活动，每个活动对应一个SKU
+// 活动开始前库存预热到 Redis，抢购时在 Redis 中原子扣减，抢到的请求排队异步创建订单。
+type Sale struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	ProductID string          `json:"product_id"`
+	SkuID     string          `json:"sku_id"`
+	Price     decimal.Decimal `json:"price"` // 秒杀价
+	// Quota 活动库存；预热时不超过库存服务中的可用库存，Stock 为实际预热的数量
+	Quota        int32  `json:"quota"`
+	Stock        int32  `json:"stock"`
+	PerUserLimit int32  `json:"per_user_limit"` // 每人限购数量，0 表示不限
+	StartAt      string `json:"start_at"`
+	EndAt        string `json:"end_at"`
+	// Preloaded 库存已预热到 Redis；Settled 活动已结束且排队的请求已全部处理，Sold 为对账后的销量
+	Preloaded bool   `json:"preloaded"`
+	Settled   bool   `json:"settled"`
+	Sold      int32  `json:"sold"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Validate 校验秒杀活动
+func (s *Sale) Validate() error {
+	switch {
+	case s.Name == "" || s.ProductID == "" || s.SkuID == "":
+		return ErrInvalidSale
+	case !s.Price.IsPositive() || s.Quota <= 0 || s.PerUserLimit < 0:
+		return ErrInvalidSale
+	case !validTime(s.StartAt) || !validTime(s.EndAt) || s.StartAt >= s.EndAt:
+		return ErrInvalidSale
+	}
+	return nil
+}
+
+// validTime 时间字段是否符合 timeLayout 格式
+func validTime(value string) bool {
+	_, err := time.Parse(timeLayout, value)
+	return err == nil
+}
+
+// Active 活动是否正在进行
+func (s *Sale) Active(now time.Time) bool {
+	current := now.Format(timeLayout)
+	return s.StartAt <= current && current < s.EndAt
+}
+
+// Ended 活动是否已结束
+func (s *Sale) Ended(now time.Time) bool {
+	return now.Format(timeLayout) >= s.EndAt
+}
+
+// ShouldPreload 是否到了预热库存的时间：活动开始前 lead 时间内且尚未预热
+func (s *Sale) ShouldPreload(now time.Time, lead time.Duration) bool {
+	return !s.Preloaded && !s.Ended(now) && now.Add(lead).Format(timeLayout) >= s.StartAt
+}
+
+// Expiration 活动在 Redis 中的库存和抢购记录的过期时间，活动结束后保留 retention 供对账和查询
+func (s *Sale) Expiration(retention time.Duration) time.Time {
+	endAt, _ := time.ParseInLocation(timeLayout, s.EndAt, time.Local)
+	return endAt.Add(retention)
+}
+
+// TicketStatus 抢购记录状态
+type TicketStatus int32
+
+const (
+	TicketStatusQueued   TicketStatus = 1 // 排队中：已扣减活动库存，等待创建订单
+	TicketStatusCreated  TicketStatus = 2 // 已创建订单
+	TicketStatusFailed   TicketStatus = 3 // 创建订单失败，活动库存已退回
+	TicketStatusReleased TicketStatus = 4 // 订单已取消，活动库存已退回
+)
+
+// Address 抢购时确定的收货地址，异步下单时没有用户的登录凭证，因此在抢购时获取
+type Address struct {
+	ReceiverName  string `json:"receiver_name"`
+	ReceiverPhone string `json:"receiver_phone"`
+	Province      string `json:"province"`
+	City          string `json:"city"`
+	District      string `json:"district"`
+	DetailAddress string `json:"detail_address"`
+	PostalCode    string `json:"postal_code"`
+}
+
+// Ticket 抢购记录，抢到活动库存的请求生成一条记录并排队创建订单
+type Ticket struct {
+	ID            string       `json:"id"`
+	SaleID        string       `json:"sale_id"`
+	UserID        string       `json:"user_id"`
+	Quantity      int32        `json:"quantity"`
+	Address       Address      `json:"address"`
+	PaymentMethod string       `json:"payment_method"`
+	Status        TicketStatus `json:"status"`
+	OrderID       string       `json:"order_id"`
+	Reason        string       `json:"reason"` // 创建订单失败的原因
+	CreatedAt     string       `json:"created_at"`
+	UpdatedAt     string       `json:"updated_at"`
+}
+
+// Holding 记录是否仍占用活动库存
+func (t *Ticket) Holding() bool {
+	return t.Status == TicketStatusQueued || t.Status == TicketStatusCreated
+}
+
+// Stale 排队时间超过 staleAfter 的记录视为丢失，由对账任务重新排队
+func (t *Ticket) Stale(now time.Time, staleAfter time.Duration) bool {
+	return t.Status == TicketStatusQueued && now.Add(-staleAfter).Format(timeLayout) >= t.UpdatedAt
+}
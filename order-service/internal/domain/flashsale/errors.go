@@ -0,0 +1,15 @@
+package flashsale
+
+import "errors"
+
+// 秒杀领域错误定义
+var (
+	ErrSaleNotFound      = errors.New("flash sale not found")
+	ErrInvalidSale       = errors.New("invalid flash sale")
+	ErrSaleNotActive     = errors.New("flash sale not in progress")
+	ErrSoldOut           = errors.New("flash sale sold out")
+	ErrUserLimitExceeded = errors.New("flash sale purchase limit exceeded")
+	ErrInvalidQuantity   = errors.New("invalid flash sale quantity")
+	ErrTooManyRequests   = errors.New("too many flash sale requests")
+	ErrTicketNotFound    = errors.New("flash sale ticket not found")
+)
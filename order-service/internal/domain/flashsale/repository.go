@@ -0,0 +1,60 @@
+package flashsale
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 秒杀活动仓储接口
+type Repository interface {
+	// 创建秒杀活动
+	Create(ctx context.Context, sale *Sale) error
+
+	// 获取秒杀活动
+	Get(ctx context.Context, id string) (*Sale, error)
+
+	// 获取秒杀活动列表，按开始时间倒序
+	List(ctx context.Context) ([]*Sale, error)
+
+	// 获取未结算的秒杀活动，按开始时间正序
+	ListUnsettled(ctx context.Context) ([]*Sale, error)
+
+	// 保存预热、对账进度：Stock、Preloaded、Settled 和 Sold
+	UpdateProgress(ctx context.Context, sale *Sale) error
+}
+
+// Stock 秒杀活动库存，扣减在 Redis 中原子完成
+type Stock interface {
+	// 预热活动库存，已预热时不覆盖；库存在 expireAt 过期
+	Preload(ctx context.Context, sale *Sale, expireAt time.Time) error
+
+	// 扣减活动库存并累计用户的购买数量，库存不足时返回 ErrSoldOut，超出每人限购时返回 ErrUserLimitExceeded
+	Deduct(ctx context.Context, sale *Sale, userID string, quantity int32) error
+
+	// 退回活动库存和用户的购买数量
+	Restore(ctx context.Context, saleID, userID string, quantity int32) error
+
+	// 剩余活动库存
+	Remaining(ctx context.Context, saleID string) (int32, error)
+}
+
+// TicketQueue 抢购记录队列，抢购记录保存在 Redis 中，由下单任务异步消费
+type TicketQueue interface {
+	// 保存抢购记录并排队，记录在 expireAt 过期
+	Enqueue(ctx context.Context, ticket *Ticket, expireAt time.Time) error
+
+	// 重新排队，用于处理丢失的排队记录
+	Requeue(ctx context.Context, ticket *Ticket) error
+
+	// 按排队顺序取出最多 limit 条记录，取出后不再排队
+	Dequeue(ctx context.Context, limit int) ([]*Ticket, error)
+
+	// 更新抢购记录
+	Save(ctx context.Context, ticket *Ticket) error
+
+	// 获取抢购记录
+	Get(ctx context.Context, id string) (*Ticket, error)
+
+	// 获取活动的全部抢购记录
+	ListBySale(ctx context.Context, saleID string) ([]*Ticket, error)
+}
@@ -0,0 +1,143 @@
+package freight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Item 需要计算运费的商品项
+type Item struct {
+	ProductID string
+	Weight    int32 // 单件重量（克）
+	Quantity  int32
+	Amount    decimal.Decimal // 商品项原价小计
+	Virtual   bool            // 虚拟商品不计运费
+}
+
+// Destination 收货地区，为空时按默认规则计费
+type Destination struct {
+	Province string
+	City     string
+}
+
+// DomainService 运费领域服务
+type DomainService interface {
+	// 校验并创建运费模板
+	CreateTemplate(ctx context.Context, template *Template) error
+
+	// 校验并更新运费模板
+	UpdateTemplate(ctx context.Context, template *Template) error
+
+	// 为商品绑定运费模板，templateID 为空时解除绑定，改用默认模板
+	BindProduct(ctx context.Context, productID, templateID string) error
+
+	// 计算订单运费：商品按绑定的运费模板分组，每组按总重量和原价合计分别计费后相加
+	Calculate(ctx context.Context, items []Item, dest Destination) (decimal.Decimal, error)
+}
+
+// domainService 运费领域服务实现
+type domainService struct {
+	freightRepo Repository
+}
+
+// NewDomainService 创建运费领域服务
+func NewDomainService(freightRepo Repository) DomainService {
+	return &domainService{
+		freightRepo: freightRepo,
+	}
+}
+
+// CreateTemplate 校验并创建运费模板
+func (ds *domainService) CreateTemplate(ctx context.Context, template *Template) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	return ds.freightRepo.CreateTemplate(ctx, template)
+}
+
+// UpdateTemplate 校验并更新运费模板
+func (ds *domainService) UpdateTemplate(ctx context.Context, template *Template) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	return ds.freightRepo.UpdateTemplate(ctx, template)
+}
+
+// BindProduct 为商品绑定运费模板
+func (ds *domainService) BindProduct(ctx context.Context, productID, templateID string) error {
+	if productID == "" {
+		return ErrInvalidTemplate
+	}
+	if templateID != "" {
+		if _, err := ds.freightRepo.GetTemplate(ctx, templateID); err != nil {
+			return err
+		}
+	}
+	return ds.freightRepo.BindProduct(ctx, productID, templateID)
+}
+
+// Calculate 计算订单运费
+// 虚拟商品不计运费；既未绑定运费模板也没有默认模板的商品包邮。
+func (ds *domainService) Calculate(ctx context.Context, items []Item, dest Destination) (decimal.Decimal, error) {
+	productIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if !item.Virtual {
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+	if len(productIDs) == 0 {
+		return decimal.Zero, nil
+	}
+
+	bound, err := ds.freightRepo.GetByProductIDs(ctx, productIDs)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("获取商品运费模板失败: %w", err)
+	}
+
+	var defaultTemplate *Template
+	if len(bound) < len(productIDs) {
+		defaultTemplate, err = ds.freightRepo.GetDefaultTemplate(ctx)
+		if err != nil && !errors.Is(err, ErrTemplateNotFound) {
+			return decimal.Zero, fmt.Errorf("获取默认运费模板失败: %w", err)
+		}
+	}
+
+	// 按运费模板分组汇总重量和金额，保持模板首次出现的顺序
+	type group struct {
+		template *Template
+		weight   int64
+		amount   decimal.Decimal
+	}
+	var groups []*group
+	byTemplate := make(map[string]*group)
+	for _, item := range items {
+		if item.Virtual {
+			continue
+		}
+		template, ok := bound[item.ProductID]
+		if !ok {
+			template = defaultTemplate
+		}
+		if template == nil {
+			continue
+		}
+
+		g, ok := byTemplate[template.ID]
+		if !ok {
+			g = &group{template: template, amount: decimal.Zero}
+			byTemplate[template.ID] = g
+			groups = append(groups, g)
+		}
+		g.weight += int64(item.Weight) * int64(item.Quantity)
+		g.amount = g.amount.Add(item.Amount)
+	}
+
+	fee := decimal.Zero
+	for _, g := range groups {
+		fee = fee.Add(g.template.Fee(g.weight, g.amount, dest))
+	}
+	return fee, nil
+}
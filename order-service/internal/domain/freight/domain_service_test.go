@@ -0,0 +1,191 @@
+package freight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository 内存运费模板仓储
+type memoryRepository struct {
+	templates map[string]*Template
+	bindings  map[string]string
+}
+
+func (r *memoryRepository) CreateTemplate(_ context.Context, template *Template) error {
+	r.templates[template.ID] = template
+	return nil
+}
+
+func (r *memoryRepository) UpdateTemplate(_ context.Context, template *Template) error {
+	if _, ok := r.templates[template.ID]; !ok {
+		return ErrTemplateNotFound
+	}
+	r.templates[template.ID] = template
+	return nil
+}
+
+func (r *memoryRepository) GetTemplate(_ context.Context, id string) (*Template, error) {
+	if template, ok := r.templates[id]; ok {
+		return template, nil
+	}
+	return nil, ErrTemplateNotFound
+}
+
+func (r *memoryRepository) ListTemplates(_ context.Context) ([]*Template, error) {
+	templates := make([]*Template, 0, len(r.templates))
+	for _, template := range r.templates {
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+func (r *memoryRepository) GetDefaultTemplate(_ context.Context) (*Template, error) {
+	for _, template := range r.templates {
+		if template.IsDefault {
+			return template, nil
+		}
+	}
+	return nil, ErrTemplateNotFound
+}
+
+func (r *memoryRepository) BindProduct(_ context.Context, productID, templateID string) error {
+	if templateID == "" {
+		delete(r.bindings, productID)
+		return nil
+	}
+	r.bindings[productID] = templateID
+	return nil
+}
+
+func (r *memoryRepository) GetByProductIDs(_ context.Context, productIDs []string) (map[string]*Template, error) {
+	result := make(map[string]*Template)
+	for _, productID := range productIDs {
+		if templateID, ok := r.bindings[productID]; ok {
+			result[productID] = r.templates[templateID]
+		}
+	}
+	return result, nil
+}
+
+func dec(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+// testTemplate 首重1kg 10元，续重每500g 2元，满99包邮；新疆、西藏不包邮并加收附加费，杭州首重8元
+func testTemplate() *Template {
+	return &Template{
+		ID:               "tpl-1",
+		Name:             "普通快递",
+		FirstWeight:      1000,
+		AdditionalWeight: 500,
+		DefaultRule:      Rule{FirstFee: dec("10"), AdditionalFee: dec("2"), FreeThreshold: dec("99")},
+		Regions: []*RegionRule{
+			{Provinces: []string{"新疆维吾尔自治区", "西藏自治区"}, Rule: Rule{FirstFee: dec("20"), AdditionalFee: dec("8"), Surcharge: dec("15")}},
+			{Cities: []string{"杭州市"}, Rule: Rule{FirstFee: dec("8"), AdditionalFee: dec("1"), FreeThreshold: dec("59")}},
+		},
+	}
+}
+
+func TestTemplateFee(t *testing.T) {
+	tests := []struct {
+		name   string
+		weight int64
+		amount string
+		dest   Destination
+		want   string
+	}{
+		{name: "within first weight", weight: 800, amount: "50", want: "10"},
+		{name: "exactly first weight", weight: 1000, amount: "50", want: "10"},
+		{name: "partial additional unit rounds up", weight: 1001, amount: "50", want: "12"},
+		{name: "multiple additional units", weight: 2200, amount: "50", want: "16"},
+		{name: "free shipping threshold", weight: 5000, amount: "99", want: "0"},
+		{name: "unmatched province uses default rule", weight: 1500, amount: "50", dest: Destination{Province: "江苏省", City: "南京市"}, want: "12"},
+		{name: "city rule", weight: 1500, amount: "50", dest: Destination{Province: "浙江省", City: "杭州市"}, want: "9"},
+		{name: "city free shipping threshold", weight: 1500, amount: "60", dest: Destination{Province: "浙江省", City: "杭州市"}, want: "0"},
+		{name: "remote area surcharge", weight: 1500, amount: "50", dest: Destination{Province: "西藏自治区", City: "拉萨市"}, want: "43"},
+		{name: "remote area not free", weight: 500, amount: "500", dest: Destination{Province: "新疆维吾尔自治区"}, want: "35"},
+	}
+
+	template := testTemplate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := template.Fee(tt.weight, dec(tt.amount), tt.dest)
+			assert.True(t, dec(tt.want).Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestTemplateFeeSurchargeWhenFree(t *testing.T) {
+	template := testTemplate()
+	template.Regions[0].FreeThreshold = dec("200")
+
+	// 偏远地区包邮时仍收取附加费
+	got := template.Fee(3000, dec("300"), Destination{Province: "新疆维吾尔自治区"})
+	assert.True(t, dec("15").Equal(got), "got %s", got)
+}
+
+func TestTemplateValidate(t *testing.T) {
+	require.NoError(t, testTemplate().Validate())
+
+	invalid := []func(t *Template){
+		func(t *Template) { t.Name = "" },
+		func(t *Template) { t.FirstWeight = 0 },
+		func(t *Template) { t.AdditionalWeight = 0 },
+		func(t *Template) { t.DefaultRule.FirstFee = dec("-1") },
+		func(t *Template) { t.Regions[0].Surcharge = dec("-1") },
+		func(t *Template) { t.Regions = append(t.Regions, &RegionRule{}) },
+	}
+	for _, mutate := range invalid {
+		template := testTemplate()
+		mutate(template)
+		assert.ErrorIs(t, template.Validate(), ErrInvalidTemplate)
+	}
+}
+
+func TestDomainServiceCalculate(t *testing.T) {
+	defaultTemplate := testTemplate()
+	defaultTemplate.IsDefault = true
+	bulky := &Template{
+		ID:               "tpl-bulky",
+		Name:             "大件物流",
+		FirstWeight:      5000,
+		AdditionalWeight: 1000,
+		DefaultRule:      Rule{FirstFee: dec("30"), AdditionalFee: dec("5")},
+	}
+	repo := &memoryRepository{
+		templates: map[string]*Template{defaultTemplate.ID: defaultTemplate, bulky.ID: bulky},
+		bindings:  map[string]string{"sofa": bulky.ID},
+	}
+	ds := NewDomainService(repo)
+	ctx := context.Background()
+
+	// 未绑定的商品使用默认模板，绑定的商品按各自模板分别计费
+	fee, err := ds.Calculate(ctx, []Item{
+		{ProductID: "book", Weight: 400, Quantity: 2, Amount: dec("40")},
+		{ProductID: "pen", Weight: 100, Quantity: 3, Amount: dec("15")},
+		{ProductID: "sofa", Weight: 7000, Quantity: 1, Amount: dec("2000")},
+	}, Destination{})
+	require.NoError(t, err)
+	// 默认模板 1100g 共 12 元；大件物流 7000g 共 40 元
+	assert.True(t, dec("52").Equal(fee), "got %s", fee)
+
+	// 虚拟商品不计运费
+	fee, err = ds.Calculate(ctx, []Item{{ProductID: "gift-card", Weight: 0, Quantity: 1, Amount: dec("100"), Virtual: true}}, Destination{})
+	require.NoError(t, err)
+	assert.True(t, fee.IsZero())
+
+	// 没有默认模板时未绑定的商品包邮
+	defaultTemplate.IsDefault = false
+	fee, err = ds.Calculate(ctx, []Item{{ProductID: "book", Weight: 400, Quantity: 1, Amount: dec("20")}}, Destination{})
+	require.NoError(t, err)
+	assert.True(t, fee.IsZero())
+
+	// 绑定不存在的模板失败，解除绑定后改用默认模板
+	assert.ErrorIs(t, ds.BindProduct(ctx, "book", "missing"), ErrTemplateNotFound)
+	require.NoError(t, ds.BindProduct(ctx, "sofa", ""))
+	assert.NotContains(t, repo.bindings, "sofa")
+}
@@ -0,0 +1,93 @@
+package freight
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// Rule 运费计费规则
+type Rule struct {
+	FirstFee      decimal.Decimal `json:"first_fee"`      // 首重运费
+	AdditionalFee decimal.Decimal `json:"additional_fee"` // 每个续重单位的运费
+	FreeThreshold decimal.Decimal `json:"free_threshold"` // 包邮门槛：商品原价合计满该金额免运费，0 表示不包邮
+	Surcharge     decimal.Decimal `json:"surcharge"`      // 偏远地区附加费，包邮时也收取
+}
+
+// validate 校验计费规则的金额
+func (r *Rule) validate() bool {
+	return !r.FirstFee.IsNegative() && !r.AdditionalFee.IsNegative() &&
+		!r.FreeThreshold.IsNegative() && !r.Surcharge.IsNegative()
+}
+
+// RegionRule 指定地区的计费规则，收货地址的市或省匹配时使用
+type RegionRule struct {
+	Provinces []string `json:"provinces"`
+	Cities    []string `json:"cities"`
+	Rule
+}
+
+// Template 运费模板：首重加续重计费，可按地区设置不同的价格、包邮门槛和偏远地区附加费
+type Template struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	FirstWeight      int32  `json:"first_weight"`      // 首重（克）
+	AdditionalWeight int32  `json:"additional_weight"` // 续重单位（克），不足一个单位按一个单位计费
+	// DefaultRule 未匹配指定地区时使用的计费规则
+	DefaultRule Rule          `json:"default_rule"`
+	Regions     []*RegionRule `json:"regions"`
+	// IsDefault 未绑定运费模板的商品使用默认模板，同时只有一个默认模板
+	IsDefault bool   `json:"is_default"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Validate 校验运费模板
+func (t *Template) Validate() error {
+	switch {
+	case t.Name == "" || t.FirstWeight <= 0 || t.AdditionalWeight <= 0:
+		return ErrInvalidTemplate
+	case !t.DefaultRule.validate():
+		return ErrInvalidTemplate
+	}
+	for _, region := range t.Regions {
+		if region == nil || len(region.Provinces)+len(region.Cities) == 0 || !region.validate() {
+			return ErrInvalidTemplate
+		}
+	}
+	return nil
+}
+
+// RuleFor 获取收货地区适用的计费规则：先按市匹配，再按省匹配，都未匹配时使用默认规则
+func (t *Template) RuleFor(dest Destination) Rule {
+	if dest.City != "" {
+		for _, region := range t.Regions {
+			if slices.Contains(region.Cities, dest.City) {
+				return region.Rule
+			}
+		}
+	}
+	if dest.Province != "" {
+		for _, region := range t.Regions {
+			if slices.Contains(region.Provinces, dest.Province) {
+				return region.Rule
+			}
+		}
+	}
+	return t.DefaultRule
+}
+
+// Fee 计算按该模板计费的商品运费，weight 为商品总重量（克），amount 为商品原价合计
+func (t *Template) Fee(weight int64, amount decimal.Decimal, dest Destination) decimal.Decimal {
+	rule := t.RuleFor(dest)
+	if rule.FreeThreshold.IsPositive() && amount.GreaterThanOrEqual(rule.FreeThreshold) {
+		return rule.Surcharge
+	}
+
+	fee := rule.FirstFee
+	if extra := weight - int64(t.FirstWeight); extra > 0 {
+		units := (extra + int64(t.AdditionalWeight) - 1) / int64(t.AdditionalWeight)
+		fee = fee.Add(rule.AdditionalFee.Mul(decimal.NewFromInt(units)))
+	}
+	return fee.Add(rule.Surcharge)
+}
@@ -0,0 +1,9 @@
+package freight
+
+import "errors"
+
+// 运费领域错误定义
+var (
+	ErrTemplateNotFound = errors.New("freight template not found")
+	ErrInvalidTemplate  = errors.New("invalid freight template")
+)
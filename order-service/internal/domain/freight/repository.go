@@ -0,0 +1,27 @@
+package freight
+
+import "context"
+
+// Repository 运费模板仓储接口
+type Repository interface {
+	// 创建运费模板，新模板为默认模板时取消原默认模板
+	CreateTemplate(ctx context.Context, template *Template) error
+
+	// 更新运费模板，不存在时返回 ErrTemplateNotFound；设为默认模板时取消原默认模板
+	UpdateTemplate(ctx context.Context, template *Template) error
+
+	// 获取运费模板，不存在时返回 ErrTemplateNotFound
+	GetTemplate(ctx context.Context, id string) (*Template, error)
+
+	// 获取全部运费模板
+	ListTemplates(ctx context.Context) ([]*Template, error)
+
+	// 获取默认运费模板，未设置时返回 ErrTemplateNotFound
+	GetDefaultTemplate(ctx context.Context) (*Template, error)
+
+	// 为商品绑定运费模板，已绑定时覆盖；templateID 为空时解除绑定
+	BindProduct(ctx context.Context, productID, templateID string) error
+
+	// 批量获取商品绑定的运费模板，未绑定的商品不出现在结果中
+	GetByProductIDs(ctx context.Context, productIDs []string) (map[string]*Template, error)
+}
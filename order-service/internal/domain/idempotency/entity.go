@@ -0,0 +1,71 @@
+package idempotency
+
+import "time"
+
+// Status 幂等记录状态
+type Status int32
+
+const (
+	StatusUnknown    Status = 0
+	StatusProcessing Status = 1 // 处理中
+	StatusCompleted  Status = 2 // 已完成
+)
+
+// 幂等操作
+const (
+	OperationCreateOrder        = "create_order"         // 下单
+	OperationPayOrder           = "pay_order"            // 支付
+	OperationFlashSaleOrder     = "flash_sale_order"     // 秒杀异步下单，幂等键为抢购记录ID
+	OperationCreatePresaleOrder = "create_presale_order" // 预售下单
+)
+
+// MaxKeyLength 客户端幂等键的最大长度
+const MaxKeyLength = 128
+
+// Record 幂等记录，同一用户同一操作下的幂等键唯一
+type Record struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	Operation   string `json:"operation"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+	Status      int32  `json:"status"`
+	Response    string `json:"response"` // 首次请求的响应（JSON）
+	ExpiresAt   string `json:"expires_at"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// NewRecord 创建处理中的幂等记录
+func NewRecord(userID, operation, key, requestHash string, expiresAt time.Time) *Record {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	return &Record{
+		UserID:      userID,
+		Operation:   operation,
+		Key:         key,
+		RequestHash: requestHash,
+		Status:      int32(StatusProcessing),
+		ExpiresAt:   expiresAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsCompleted 首次请求是否已处理完成
+func (r *Record) IsCompleted() bool {
+	return r.Status == int32(StatusCompleted)
+}
+
+// Renew 延长处理中的幂等键的占用时长至 expiresAt
+func (r *Record) Renew(expiresAt time.Time) {
+	r.ExpiresAt = expiresAt.Format("2006-01-02 15:04:05")
+	r.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+}
+
+// Complete 保存首次请求的响应，并将有效期延长至 expiresAt
+func (r *Record) Complete(response string, expiresAt time.Time) {
+	r.Status = int32(StatusCompleted)
+	r.Response = response
+	r.ExpiresAt = expiresAt.Format("2006-01-02 15:04:05")
+	r.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+}
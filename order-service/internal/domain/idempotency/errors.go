@@ -0,0 +1,10 @@
+package idempotency
+
+import "errors"
+
+// 幂等领域错误定义
+var (
+	ErrInvalidKey        = errors.New("invalid idempotency key")
+	ErrKeyReused         = errors.New("idempotency key reused with a different request")
+	ErrRequestInProgress = errors.New("request with the same idempotency key is in progress")
+)
@@ -0,0 +1,24 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 幂等记录仓储接口
+type Repository interface {
+	// 占用幂等键，成功时返回nil；键已被占用且未过期时返回已有记录
+	Acquire(ctx context.Context, record *Record) (*Record, error)
+
+	// 延长处理中的幂等键的占用时长，键已完成或已释放时不做处理
+	Renew(ctx context.Context, record *Record) error
+
+	// 保存首次请求的响应
+	Complete(ctx context.Context, record *Record) error
+
+	// 释放处理中的幂等键，允许客户端使用同一个键重试
+	Release(ctx context.Context, record *Record) error
+
+	// 删除在指定时间之前过期的记录
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
+}
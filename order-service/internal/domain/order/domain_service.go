@@ -11,143 +11,256 @@ type DomainService interface {
 	// 创建订单
 	CreateOrder(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress) (*Order, error)
 
-	// 更新订单状态
-	UpdateOrderStatus(ctx context.Context, order *Order, status int32, reason string) error
+	// 从购物车创建订单
+	CreateOrderFromCart(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress, cartItemIDs []string) (*Order, error)
 
-	// 支付订单
+	// 更新订单状态，状态日志与订单在同一事务中写入；买家只能取消订单或确认收货
+	UpdateOrderStatus(ctx context.Context, order *Order, status int32, reason string, operator Operator) error
+
+	// 取消超时未支付的订单，发布订单过期事件
+	ExpireOrder(ctx context.Context, order *Order) error
+
+	// 支付订单，预售订单按当前阶段支付定金或尾款
 	PayOrder(ctx context.Context, order *Order, paymentMethod string) error
 
+	// 发货，支持分批发货，全部商品发出后订单变为已发货
+	ShipOrder(ctx context.Context, order *Order, shipment *Shipment) error
+
+	// 修改收货地址，只允许在发货前修改，修改记录写入状态日志
+	ChangeAddress(ctx context.Context, order *Order, address *OrderAddress, operator Operator) error
+
+	// 延长自动确认收货时间，每个订单只能延长一次
+	ExtendReceiveDeadline(ctx context.Context, order *Order, window, extension time.Duration) error
+
 	// 生成订单号
-	GenerateOrderNo() string
+	GenerateOrderNo(ctx context.Context) (string, error)
 }
 
 // domainService 订单领域服务实现
 type domainService struct {
-	orderRepo Repository
+	orderRepo        Repository
+	orderNoGenerator OrderNoGenerator
 }
 
 // NewDomainService 创建订单领域服务
-func NewDomainService(orderRepo Repository) DomainService {
+func NewDomainService(orderRepo Repository, orderNoGenerator OrderNoGenerator) DomainService {
 	return &domainService{
-		orderRepo: orderRepo,
+		orderRepo:        orderRepo,
+		orderNoGenerator: orderNoGenerator,
 	}
 }
 
 // CreateOrder 创建订单
 func (ds *domainService) CreateOrder(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress) (*Order, error) {
-	// 生成订单号
-	order.OrderNo = ds.GenerateOrderNo()
+	if err := ds.initOrder(ctx, order); err != nil {
+		return nil, err
+	}
 
-	// 设置初始状态
-	order.Status = int32(OrderStatusPendingPayment)
-	order.PaymentStatus = int32(PaymentStatusUnpaid)
+	// 调用仓储创建订单，订单创建事件在同一事务中写入发件箱
+	if err := ds.orderRepo.Create(ctx, order, items, address, NewEvent(EventOrderCreated, order, items, time.Now())); err != nil {
+		return nil, fmt.Errorf("创建订单失败: %w", err)
+	}
+
+	return order, nil
+}
 
-	// 调用仓储创建订单
-	if err := ds.orderRepo.Create(ctx, order, items, address); err != nil {
+// CreateOrderFromCart 从购物车创建订单
+func (ds *domainService) CreateOrderFromCart(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress, cartItemIDs []string) (*Order, error) {
+	if err := ds.initOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	// 订单落库与移除购物车项在同一事务中完成
+	if err := ds.orderRepo.CreateFromCart(ctx, order, items, address, cartItemIDs, NewEvent(EventOrderCreated, order, items, time.Now())); err != nil {
 		return nil, fmt.Errorf("创建订单失败: %w", err)
 	}
 
 	return order, nil
 }
 
-// UpdateOrderStatus 更新订单状态
-func (ds *domainService) UpdateOrderStatus(ctx context.Context, order *Order, status int32, reason string) error {
-	// 验证状态转换是否合法
-	if !ds.isValidStatusTransition(order.Status, status) {
-		return fmt.Errorf("无效的状态转换: %d -> %d", order.Status, status)
+// initOrder 生成订单号并设置初始状态
+func (ds *domainService) initOrder(ctx context.Context, order *Order) error {
+	orderNo, err := ds.GenerateOrderNo(ctx)
+	if err != nil {
+		return err
 	}
 
-	// 更新订单状态
-	oldStatus := order.Status
-	order.Status = status
-	order.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+	order.OrderNo = orderNo
+	order.Status = int32(OrderStatusPendingPayment)
+	order.PaymentStatus = int32(PaymentStatusUnpaid)
+	for _, payment := range order.PaymentStages {
+		payment.OrderID = order.ID
+		payment.PaymentNo = fmt.Sprintf("%s-%d", orderNo, payment.Stage)
+	}
+	return nil
+}
 
-	// 根据状态设置相应的时间字段
-	switch status {
-	case int32(OrderStatusCancelled):
-		order.CancelTime = time.Now().Format("2006-01-02 15:04:05")
-		order.CancelReason = reason
-	case int32(OrderStatusShipped):
-		order.DeliveryTime = time.Now().Format("2006-01-02 15:04:05")
-	case int32(OrderStatusDelivered):
-		order.ReceiveTime = time.Now().Format("2006-01-02 15:04:05")
+// UpdateOrderStatus 更新订单状态，买家只能触发取消和确认收货事件
+func (ds *domainService) UpdateOrderStatus(ctx context.Context, order *Order, status int32, reason string, operator Operator) error {
+	event, ok := StateMachine.EventInto(OrderStatus(status))
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrInvalidOrderStatus, status)
+	}
+	if operator.Type == OperatorTypeUser && !buyerEvents[event] {
+		return fmt.Errorf("%w: %s", ErrStatusNotAllowed, OrderStatus(status))
 	}
 
-	// 更新数据库
-	if err := ds.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("更新订单状态失败: %w", err)
+	return ds.fire(ctx, NewStatusChange(order, reason, operator), event)
+}
+
+// ExpireOrder 取消超时未支付的订单
+func (ds *domainService) ExpireOrder(ctx context.Context, order *Order) error {
+	change := NewStatusChange(order, "超时未支付，系统自动取消", SystemOperator)
+	change.EventType = EventOrderExpired
+	return ds.fire(ctx, change, OrderEventCancel)
+}
+
+// PayOrder 支付订单，待付款的预售订单支付定金，待付尾款的预售订单支付尾款
+func (ds *domainService) PayOrder(ctx context.Context, order *Order, paymentMethod string) error {
+	if order.IsPresale() && order.Status == int32(OrderStatusPendingPayment) {
+		change := NewStatusChange(order, "定金支付成功", UserOperator(order.UserID))
+		change.PaymentMethod = paymentMethod
+		return ds.fire(ctx, change, OrderEventPayDeposit)
+	}
+
+	reason := "订单支付成功"
+	if order.IsPresale() {
+		reason = "尾款支付成功"
 	}
+	change := NewStatusChange(order, reason, UserOperator(order.UserID))
+	change.PaymentMethod = paymentMethod
+	return ds.fire(ctx, change, OrderEventPay)
+}
 
-	// 记录状态日志
-	logReason := reason
+// fire 通过状态机变更订单状态，状态日志和订单事件与订单在同一事务中写入
+// 预售订单的状态变更会修改支付阶段，未加载时先加载，与订单在同一事务中保存。
+func (ds *domainService) fire(ctx context.Context, change *StatusChange, event OrderEvent) error {
+	if change.Order.IsPresale() && change.Order.PaymentStages == nil {
+		payments, err := ds.orderRepo.GetPayments(ctx, change.Order.ID)
+		if err != nil {
+			return err
+		}
+		change.Order.PaymentStages = payments
+	}
+
+	from, err := StateMachine.Fire(ctx, change, event)
+	if err != nil {
+		return err
+	}
+
+	items, err := ds.orderRepo.GetOrderItems(ctx, change.Order.ID)
+	if err != nil {
+		return err
+	}
+
+	order := change.Order
+	logReason := change.Reason
 	if logReason == "" {
-		logReason = fmt.Sprintf("状态从 %d 更新为 %d", oldStatus, status)
+		logReason = fmt.Sprintf("状态从 %d 更新为 %d", from, order.Status)
 	}
 
-	if err := ds.orderRepo.CreateStatusLog(ctx, order.ID, status, logReason); err != nil {
-		// 日志记录失败不影响主流程
-		// 这里可以记录错误日志
+	// 更新数据库并记录状态日志
+	statusLog := NewStatusLog(order.ID, int32(from), order.Status, change.Operator, logReason)
+	if err := ds.orderRepo.UpdateStatus(ctx, order, statusLog, NewEvent(change.EventType, order, items, change.At)); err != nil {
+		return fmt.Errorf("更新订单状态失败: %w", err)
 	}
 
 	return nil
 }
 
-// PayOrder 支付订单
-func (ds *domainService) PayOrder(ctx context.Context, order *Order, paymentMethod string) error {
-	// 检查订单状态
-	if order.Status != int32(OrderStatusPendingPayment) {
-		return fmt.Errorf("订单状态不是待付款，无法支付")
+// ShipOrder 发货
+func (ds *domainService) ShipOrder(ctx context.Context, order *Order, shipment *Shipment) error {
+	change := NewStatusChange(order, "", AdminOperator(shipment.OperatorID))
+	if err := StateMachine.Check(change, OrderEventShip); err != nil {
+		return err
 	}
 
-	// 更新支付信息
-	order.PaymentMethod = paymentMethod
-	order.PaymentStatus = int32(PaymentStatusPaid)
-	order.PaymentTime = time.Now().Format("2006-01-02 15:04:05")
-	order.Status = int32(OrderStatusPaid)
-	order.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+	items, err := ds.orderRepo.GetOrderItems(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	shipments, err := ds.orderRepo.GetShipments(ctx, order.ID)
+	if err != nil {
+		return err
+	}
 
-	// 更新数据库
-	if err := ds.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("更新订单支付信息失败: %w", err)
+	fullyShipped, err := PlanShipment(items, ShippedQuantities(shipments), shipment)
+	if err != nil {
+		return err
+	}
+
+	shipment.OrderID = order.ID
+	shipment.ShippedAt = change.At.Format("2006-01-02 15:04:05")
+	order.UpdatedAt = shipment.ShippedAt
+	var event *Event
+	if fullyShipped {
+		if _, err := StateMachine.Fire(ctx, change, OrderEventShip); err != nil {
+			return err
+		}
+		event = NewEvent(change.EventType, order, items, change.At)
 	}
 
-	// 记录状态日志
-	if err := ds.orderRepo.CreateStatusLog(ctx, order.ID, int32(OrderStatusPaid), "订单支付成功"); err != nil {
-		// 日志记录失败不影响主流程
+	// 每次发货都会递增订单版本，并发发货时只有一方成功，避免超发
+	if err := ds.orderRepo.CreateShipment(ctx, order, shipment, event); err != nil {
+		return fmt.Errorf("创建发货记录失败: %w", err)
 	}
 
 	return nil
 }
 
-// GenerateOrderNo 生成订单号
-func (ds *domainService) GenerateOrderNo() string {
-	// 简单的订单号生成规则：ORD + 时间戳 + 随机数
-	now := time.Now()
-	return fmt.Sprintf("ORD%s%03d", now.Format("20060102150405"), now.Nanosecond()%1000)
-}
+// ChangeAddress 修改收货地址
+func (ds *domainService) ChangeAddress(ctx context.Context, order *Order, address *OrderAddress, operator Operator) error {
+	if !order.CanChangeAddress() {
+		return ErrAddressNotChangeable
+	}
 
-// isValidStatusTransition 检查状态转换是否合法
-func (ds *domainService) isValidStatusTransition(from, to int32) bool {
-	// 定义合法的状态转换
-	validTransitions := map[int32][]int32{
-		int32(OrderStatusPendingPayment): {int32(OrderStatusPaid), int32(OrderStatusCancelled)},
-		int32(OrderStatusPaid):           {int32(OrderStatusShipped), int32(OrderStatusCancelled), int32(OrderStatusRefunded)},
-		int32(OrderStatusShipped):        {int32(OrderStatusDelivered), int32(OrderStatusCancelled)},
-		int32(OrderStatusDelivered):      {int32(OrderStatusCancelled)}, // 已收货还可以申请退货
-		int32(OrderStatusCancelled):      {},                            // 已取消不能再转换
-		int32(OrderStatusRefunded):       {},                            // 已退款不能再转换
+	// 已有包裹发出的订单不能再修改地址
+	shipments, err := ds.orderRepo.GetShipments(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	if len(shipments) > 0 {
+		return ErrAddressNotChangeable
 	}
 
-	allowedStates, exists := validTransitions[from]
-	if !exists {
-		return false
+	previous, err := ds.orderRepo.GetOrderAddress(ctx, order.ID)
+	if err != nil {
+		return err
 	}
 
-	for _, allowedState := range allowedStates {
-		if allowedState == to {
-			return true
-		}
+	address.ID = previous.ID
+	address.OrderID = order.ID
+	remark := fmt.Sprintf("修改收货地址：%s → %s", previous, address)
+	statusLog := NewStatusLog(order.ID, order.Status, order.Status, operator, remark)
+	order.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+
+	// 地址快照整体替换，与发货并发时只有一方成功
+	if err := ds.orderRepo.UpdateAddress(ctx, order, address, statusLog); err != nil {
+		return fmt.Errorf("修改收货地址失败: %w", err)
+	}
+
+	return nil
+}
+
+// ExtendReceiveDeadline 延长自动确认收货时间
+func (ds *domainService) ExtendReceiveDeadline(ctx context.Context, order *Order, window, extension time.Duration) error {
+	if err := order.ExtendReceiveDeadline(window, extension); err != nil {
+		return err
 	}
 
-	return false
+	// 与自动确认收货并发时只有一方成功
+	if err := ds.orderRepo.Update(ctx, order); err != nil {
+		return fmt.Errorf("延长收货失败: %w", err)
+	}
+
+	return nil
+}
+
+// GenerateOrderNo 生成订单号
+func (ds *domainService) GenerateOrderNo(ctx context.Context) (string, error) {
+	orderNo, err := ds.orderNoGenerator.Generate(ctx)
+	if err != nil {
+		return "", fmt.Errorf("生成订单号失败: %w", err)
+	}
+	return orderNo, nil
 }
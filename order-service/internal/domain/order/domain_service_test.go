@@ -0,0 +1,22 @@
+package order
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainService_UpdateOrderStatusByBuyer(t *testing.T) {
+	ds := NewDomainService(nil, nil)
+
+	// 支付、发货、退款只能经支付、运营发货和售后流程触发
+	for _, status := range []OrderStatus{OrderStatusPaid, OrderStatusShipped, OrderStatusRefunded} {
+		t.Run(status.String(), func(t *testing.T) {
+			o := &Order{ID: "order-1", UserID: "user-1", Status: int32(OrderStatusPendingPayment)}
+			err := ds.UpdateOrderStatus(context.Background(), o, int32(status), "", UserOperator("user-1"))
+			assert.ErrorIs(t, err, ErrStatusNotAllowed)
+			assert.Equal(t, int32(OrderStatusPendingPayment), o.Status)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package order
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -15,6 +18,7 @@ const (
 	OrderStatusDelivered      OrderStatus = 4 // 已收货
 	OrderStatusCancelled      OrderStatus = 5 // 已取消
 	OrderStatusRefunded       OrderStatus = 6 // 已退款
+	OrderStatusDepositPaid    OrderStatus = 7 // 预售订单定金已付，待付尾款
 )
 
 // PaymentMethod 支付方式
@@ -35,6 +39,7 @@ const (
 	PaymentStatusPaid      PaymentStatus = 1 // 已支付
 	PaymentStatusRefunding PaymentStatus = 2 // 退款中
 	PaymentStatusRefunded  PaymentStatus = 3 // 已退款
+	PaymentStatusForfeited PaymentStatus = 4 // 定金不退，仅用于预售订单的定金支付阶段
 )
 
 // Order 订单实体（匹配数据库模型）
@@ -58,20 +63,39 @@ type Order struct {
 	CreatedAt      string          `json:"created_at"`
 	UpdatedAt      string          `json:"updated_at"`
 	Version        int32           `json:"version"`
+	// PaymentDeadline 支付截止时间，超时未支付的订单由系统自动取消
+	PaymentDeadline string `json:"payment_deadline"`
+	// ReceiveDeadline 买家延长收货后的自动确认收货时间，未延长时为空
+	ReceiveDeadline string `json:"receive_deadline"`
+	// Coupons 下单使用的优惠券，订单落库的同一事务中锁定；查询订单时不加载
+	Coupons []*AppliedCoupon `json:"coupons,omitempty"`
+	// PresaleID 预售活动，非空时订单分定金、尾款两个阶段支付
+	PresaleID string `json:"presale_id"`
+	// PaymentStages 预售订单的支付阶段，与订单在同一事务中写入；查询订单时不加载，由领域服务在状态变更前按需加载
+	PaymentStages []*OrderPayment `json:"payment_stages,omitempty"`
+}
+
+// AppliedCoupon 订单使用的优惠券
+type AppliedCoupon struct {
+	CouponID       string          `json:"coupon_id"`
+	Name           string          `json:"name"`
+	DiscountAmount decimal.Decimal `json:"discount_amount"`
 }
 
 // OrderItem 订单商品项实体（匹配数据库模型）
 type OrderItem struct {
-	ID          string          `json:"id"`
-	OrderID     string          `json:"order_id"`
-	ProductID   string          `json:"product_id"`
-	SkuID       string          `json:"sku_id"`
-	ProductName string          `json:"product_name"`
-	SkuName     string          `json:"sku_name"`
-	Price       decimal.Decimal `json:"price"`
-	Quantity    int32           `json:"quantity"`
-	CreatedAt   string          `json:"created_at"`
-	UpdatedAt   string          `json:"updated_at"`
+	ID           string          `json:"id"`
+	OrderID      string          `json:"order_id"`
+	ProductID    string          `json:"product_id"`
+	SkuID        string          `json:"sku_id"`
+	ProductName  string          `json:"product_name"`
+	SkuName      string          `json:"sku_name"`
+	ProductImage string          `json:"product_image"`
+	Price        decimal.Decimal `json:"price"`
+	Quantity     int32           `json:"quantity"`
+	TotalAmount  decimal.Decimal `json:"total_amount"`
+	CreatedAt    string          `json:"created_at"`
+	UpdatedAt    string          `json:"updated_at"`
 }
 
 // OrderAddress 订单收货地址实体（匹配数据库模型）
@@ -89,16 +113,63 @@ type OrderAddress struct {
 	UpdatedAt     string `json:"updated_at"`
 }
 
+// String 地址的展示文本，用于状态日志
+func (a *OrderAddress) String() string {
+	return fmt.Sprintf("%s %s %s%s%s%s", a.ReceiverName, a.ReceiverPhone, a.Province, a.City, a.District, a.DetailAddress)
+}
+
 // OrderStatusLog 订单状态变更日志（匹配数据库模型）
 type OrderStatusLog struct {
-	ID        string `json:"id"`
-	OrderID   string `json:"order_id"`
-	Status    int32  `json:"status"`
-	Remark    string `json:"remark"`
-	CreatedAt string `json:"created_at"`
+	ID           string `json:"id"`
+	OrderID      string `json:"order_id"`
+	FromStatus   int32  `json:"from_status"` // 订单创建时为 0
+	Status       int32  `json:"status"`
+	OperatorID   string `json:"operator_id"`
+	OperatorType string `json:"operator_type"`
+	Remark       string `json:"remark"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// 状态变更操作人类型
+const (
+	OperatorTypeUser   = "user"
+	OperatorTypeAdmin  = "admin"
+	OperatorTypeSystem = "system"
+)
+
+// Operator 状态变更的操作人
+type Operator struct {
+	ID   string
+	Type string
+}
+
+// SystemOperator 定时任务、Saga 补偿等系统自动操作
+var SystemOperator = Operator{Type: OperatorTypeSystem}
+
+// UserOperator 下单用户本人操作
+func UserOperator(userID string) Operator {
+	return Operator{ID: userID, Type: OperatorTypeUser}
+}
+
+// AdminOperator 运营人员操作
+func AdminOperator(operatorID string) Operator {
+	return Operator{ID: operatorID, Type: OperatorTypeAdmin}
+}
+
+// NewStatusLog 创建订单状态变更日志
+func NewStatusLog(orderID string, from, to int32, operator Operator, remark string) *OrderStatusLog {
+	return &OrderStatusLog{
+		OrderID:      orderID,
+		FromStatus:   from,
+		Status:       to,
+		OperatorID:   operator.ID,
+		OperatorType: operator.Type,
+		Remark:       remark,
+	}
 }
 
 // OrderPayment 订单支付记录（匹配数据库模型）
+// 预售订单的定金和尾款各对应一条记录，记录ID作为支付服务中的业务订单号，一个订单在支付服务中对应两个支付单。
 type OrderPayment struct {
 	ID            string          `json:"id"`
 	OrderID       string          `json:"order_id"`
@@ -109,22 +180,38 @@ type OrderPayment struct {
 	PaidAt        string          `json:"paid_at"`
 	CreatedAt     string          `json:"created_at"`
 	UpdatedAt     string          `json:"updated_at"`
+	Stage         int32           `json:"stage"`
+	// PayableFrom 开始支付的时间，Deadline 支付截止时间
+	PayableFrom string `json:"payable_from"`
+	Deadline    string `json:"deadline"`
+	// RefundedAmount 已退款金额，售后退款按阶段分配
+	RefundedAmount decimal.Decimal `json:"refunded_amount"`
+	// Forfeitable 定金不退：买家取消或超时未付尾款时定金不退还，下单时从预售活动复制
+	Forfeitable bool `json:"forfeitable"`
+	// RequestedAt 支付服务中的支付单创建时间，尾款在尾款支付开始后创建支付单
+	RequestedAt string `json:"requested_at"`
 }
 
 // 简单的业务方法
 // CanCancel 检查订单是否可以取消
 func (o *Order) CanCancel() bool {
-	return o.Status == int32(OrderStatusPendingPayment) || o.Status == int32(OrderStatusPaid)
+	return StateMachine.Can(&StatusChange{Order: o}, OrderEventCancel)
 }
 
 // CanPay 检查订单是否可以支付
 func (o *Order) CanPay() bool {
-	return o.Status == int32(OrderStatusPendingPayment)
+	return StateMachine.Can(&StatusChange{Order: o}, OrderEventPay)
 }
 
 // CanConfirm 检查订单是否可以确认收货
 func (o *Order) CanConfirm() bool {
-	return o.Status == int32(OrderStatusShipped)
+	return StateMachine.Can(&StatusChange{Order: o}, OrderEventDeliver)
+}
+
+// CanChangeAddress 检查订单是否可以修改收货地址：未发货的待付款、待付尾款、已付款订单可以修改
+// 部分商品已发出的订单由领域服务按发货记录拒绝。
+func (o *Order) CanChangeAddress() bool {
+	return o.Status == int32(OrderStatusPendingPayment) || o.Status == int32(OrderStatusDepositPaid) || o.Status == int32(OrderStatusPaid)
 }
 
 // IsCompleted 检查订单是否完成
@@ -137,6 +224,59 @@ func (o *Order) IsCancelled() bool {
 	return o.Status == int32(OrderStatusCancelled)
 }
 
+// IsPaymentOverdue 检查待付款、待付尾款订单是否已超过支付截止时间
+func (o *Order) IsPaymentOverdue(now time.Time) bool {
+	if (o.Status != int32(OrderStatusPendingPayment) && o.Status != int32(OrderStatusDepositPaid)) || o.PaymentDeadline == "" {
+		return false
+	}
+
+	deadline, err := time.ParseInLocation("2006-01-02 15:04:05", o.PaymentDeadline, time.Local)
+	if err != nil {
+		return false
+	}
+	return !now.Before(deadline)
+}
+
+// AutoConfirmDeadline 返回已发货订单的自动确认收货时间
+// 买家延长过收货时以延长后的时间为准，否则为发货时间加上 window。
+func (o *Order) AutoConfirmDeadline(window time.Duration) (time.Time, bool) {
+	if o.Status != int32(OrderStatusShipped) {
+		return time.Time{}, false
+	}
+
+	if o.ReceiveDeadline != "" {
+		deadline, err := time.ParseInLocation("2006-01-02 15:04:05", o.ReceiveDeadline, time.Local)
+		return deadline, err == nil
+	}
+
+	deliveryTime, err := time.ParseInLocation("2006-01-02 15:04:05", o.DeliveryTime, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return deliveryTime.Add(window), true
+}
+
+// ExtendReceiveDeadline 延长自动确认收货时间，每个订单只能延长一次
+func (o *Order) ExtendReceiveDeadline(window, extension time.Duration) error {
+	if o.ReceiveDeadline != "" {
+		return ErrReceiveExtended
+	}
+
+	deadline, ok := o.AutoConfirmDeadline(window)
+	if !ok {
+		return ErrReceiveNotExtendable
+	}
+
+	o.ReceiveDeadline = deadline.Add(extension).Format("2006-01-02 15:04:05")
+	o.UpdatedAt = time.Now().Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// IsPresale 检查是否为预售订单
+func (o *Order) IsPresale() bool {
+	return o.PresaleID != ""
+}
+
 // IsPaid 检查订单是否已支付
 func (o *Order) IsPaid() bool {
 	return o.PaymentStatus == int32(PaymentStatusPaid)
@@ -0,0 +1,64 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrder_IsPaymentOverdue(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		status   OrderStatus
+		deadline string
+		want     bool
+	}{
+		{"before deadline", OrderStatusPendingPayment, "2024-05-01 12:30:00", false},
+		{"at deadline", OrderStatusPendingPayment, "2024-05-01 12:00:00", true},
+		{"after deadline", OrderStatusPendingPayment, "2024-05-01 11:30:00", true},
+		{"paid order", OrderStatusPaid, "2024-05-01 11:30:00", false},
+		{"no deadline", OrderStatusPendingPayment, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{Status: int32(tt.status), PaymentDeadline: tt.deadline}
+			assert.Equal(t, tt.want, o.IsPaymentOverdue(now))
+		})
+	}
+}
+
+func TestOrder_AutoConfirmDeadline(t *testing.T) {
+	window := 10 * 24 * time.Hour
+
+	shipped := &Order{Status: int32(OrderStatusShipped), DeliveryTime: "2024-05-01 12:00:00"}
+	deadline, ok := shipped.AutoConfirmDeadline(window)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-05-11 12:00:00", deadline.Format("2006-01-02 15:04:05"))
+
+	extended := &Order{Status: int32(OrderStatusShipped), DeliveryTime: "2024-05-01 12:00:00", ReceiveDeadline: "2024-05-14 12:00:00"}
+	deadline, ok = extended.AutoConfirmDeadline(window)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-05-14 12:00:00", deadline.Format("2006-01-02 15:04:05"))
+
+	_, ok = (&Order{Status: int32(OrderStatusDelivered), DeliveryTime: "2024-05-01 12:00:00"}).AutoConfirmDeadline(window)
+	assert.False(t, ok)
+}
+
+func TestOrder_ExtendReceiveDeadline(t *testing.T) {
+	window := 10 * 24 * time.Hour
+	extension := 3 * 24 * time.Hour
+
+	o := &Order{Status: int32(OrderStatusShipped), DeliveryTime: "2024-05-01 12:00:00"}
+	assert.NoError(t, o.ExtendReceiveDeadline(window, extension))
+	assert.Equal(t, "2024-05-14 12:00:00", o.ReceiveDeadline)
+
+	// 每个订单只能延长一次
+	assert.ErrorIs(t, o.ExtendReceiveDeadline(window, extension), ErrReceiveExtended)
+
+	paid := &Order{Status: int32(OrderStatusPaid)}
+	assert.ErrorIs(t, paid.ExtendReceiveDeadline(window, extension), ErrReceiveNotExtendable)
+}
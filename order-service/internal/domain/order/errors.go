@@ -10,6 +10,7 @@ var (
 	ErrOrderCannotShip      = errors.New("order cannot be shipped")
 	ErrOrderCannotConfirm   = errors.New("order cannot be confirmed")
 	ErrInvalidOrderStatus   = errors.New("invalid order status")
+	ErrStatusNotAllowed     = errors.New("order status cannot be set by buyer")
 	ErrInvalidPaymentMethod = errors.New("invalid payment method")
 	ErrOrderItemNotFound    = errors.New("order item not found")
 	ErrOrderAddressNotFound = errors.New("order address not found")
@@ -17,4 +18,26 @@ var (
 	ErrInvalidQuantity      = errors.New("invalid quantity")
 	ErrOrderAlreadyPaid     = errors.New("order already paid")
 	ErrOrderExpired         = errors.New("order expired")
+	ErrEmptyOrderItems      = errors.New("order items empty")
+	ErrProductUnavailable   = errors.New("product unavailable")
+	ErrPriceChanged         = errors.New("order amount changed")
+	ErrOrderConflict        = errors.New("order modified concurrently")
+	ErrCartChanged          = errors.New("cart items changed")
+	ErrAddressNotFound      = errors.New("shipping address not found")
+	ErrQuoteInvalid         = errors.New("invalid quote token")
+	ErrQuoteExpired         = errors.New("quote expired")
+	ErrQuoteMismatch        = errors.New("order does not match quote")
+	ErrNothingToShip        = errors.New("all order items already shipped")
+	ErrShipmentExceedsOrder = errors.New("shipment quantity exceeds order quantity")
+	ErrInvalidSearchFilter  = errors.New("invalid order search filter")
+	ErrInvalidSearchCursor  = errors.New("invalid order search cursor")
+	ErrInvalidStatsRange    = errors.New("invalid order stats range")
+	ErrReceiveNotExtendable = errors.New("order receive deadline cannot be extended")
+	ErrReceiveExtended      = errors.New("order receive deadline already extended")
+	ErrAddressNotChangeable = errors.New("order address cannot be changed after shipment")
+	ErrInvalidPresaleTerms  = errors.New("invalid presale payment terms")
+	ErrNotPresaleOrder      = errors.New("order is not a presale order")
+	ErrDepositUnpaid        = errors.New("presale deposit unpaid")
+	ErrBalanceNotPayable    = errors.New("presale balance payment not started")
+	ErrRefundExceedsPaid    = errors.New("refund amount exceeds paid amount")
 )
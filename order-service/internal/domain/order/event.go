@@ -0,0 +1,100 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType 订单领域事件类型
+type EventType string
+
+const (
+	EventOrderCreated     EventType = "order.created"      // 订单创建
+	EventOrderDepositPaid EventType = "order.deposit_paid" // 预售订单支付定金
+	EventOrderPaid        EventType = "order.paid"         // 订单支付
+	EventOrderShipped     EventType = "order.shipped"      // 全部商品发出
+	EventOrderDelivered   EventType = "order.delivered"    // 确认收货
+	EventOrderCancelled   EventType = "order.cancelled"    // 订单取消
+	EventOrderExpired     EventType = "order.expired"      // 超时未支付，系统取消
+	EventOrderRefunded    EventType = "order.refunded"     // 全额退款
+)
+
+// Event 订单领域事件，发布给其他服务消费
+// 事件与订单变更在同一事务中写入发件箱，再由后台任务投递；投递保证至少一次，消费方按 ID 去重。
+type Event struct {
+	ID        string      `json:"event_id"`
+	Type      EventType   `json:"type"`
+	OrderID   string      `json:"order_id"`
+	OrderNo   string      `json:"order_no"`
+	UserID    string      `json:"user_id"`
+	Items     []EventItem `json:"items"`
+	Status    string      `json:"status"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+// EventItem 事件中的订单商品
+type EventItem struct {
+	SkuID    string  `json:"sku_id"`
+	Quantity int32   `json:"quantity"`
+	Price    float64 `json:"price"`
+}
+
+// NewEvent 创建订单事件，事件ID在创建时生成，重复投递时保持不变
+func NewEvent(eventType EventType, order *Order, items []*OrderItem, at time.Time) *Event {
+	eventItems := make([]EventItem, 0, len(items))
+	for _, item := range items {
+		eventItems = append(eventItems, EventItem{
+			SkuID:    item.SkuID,
+			Quantity: item.Quantity,
+			Price:    item.Price.InexactFloat64(),
+		})
+	}
+
+	return &Event{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		OrderID:   order.ID,
+		OrderNo:   order.OrderNo,
+		UserID:    order.UserID,
+		Items:     eventItems,
+		Status:    OrderStatus(order.Status).String(),
+		Timestamp: at,
+	}
+}
+
+// OutboxMessage 发件箱中待投递的事件
+type OutboxMessage struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	OrderID   string    `json:"order_id"`
+	Payload   []byte    `json:"payload"` // 事件JSON
+	Attempts  int32     `json:"attempts"`
+	CreatedAt string    `json:"created_at"`
+}
+
+// OutboxRepository 事件发件箱仓储接口，事件由订单仓储在订单变更的事务中写入
+type OutboxRepository interface {
+	// 获取已到投递时间的待投递事件，按写入顺序排列
+	// 同一订单存在更早的、尚未到重试时间的事件时，该订单后续的事件不返回。
+	ListPending(ctx context.Context, limit int) ([]*OutboxMessage, error)
+
+	// 标记事件已投递
+	MarkPublished(ctx context.Context, ids []string) error
+
+	// 记录投递失败，事件保持待投递状态，到 retryAt 后再重试
+	MarkFailed(ctx context.Context, id string, reason string, retryAt time.Time) error
+
+	// 记录投递失败并将事件转为死信，不再自动重试
+	MarkDead(ctx context.Context, id string, reason string) error
+
+	// 删除在指定时间之前投递的事件，返回删除的数量
+	DeletePublished(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// EventPublisher 事件发布接口，由消息中间件实现
+// Publish 返回 nil 表示中间件已持久化该消息。
+type EventPublisher interface {
+	Publish(ctx context.Context, message *OutboxMessage) error
+}
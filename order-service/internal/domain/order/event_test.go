@@ -0,0 +1,75 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	at := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	o := &Order{
+		ID:      "5f0c6a2e-8d0b-4d61-9a3c-6f3f0f6b8a10",
+		OrderNo: "ORD20250901000001",
+		UserID:  "0b7e7c1e-2f55-4c1a-a6a4-0d3c8f1e9b21",
+		Status:  int32(OrderStatusPaid),
+	}
+	items := []*OrderItem{
+		{SkuID: "3c1d2b4a-6e7f-4a8b-9c0d-1e2f3a4b5c6d", Quantity: 2, Price: decimal.RequireFromString("19.90")},
+	}
+
+	event := NewEvent(EventOrderPaid, o, items, at)
+	assert.NotEmpty(t, event.ID)
+	assert.NotEqual(t, event.ID, NewEvent(EventOrderPaid, o, items, at).ID)
+
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	// 字段与库存服务的 OrderEvent 保持一致
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, event.ID, decoded["event_id"])
+	assert.Equal(t, "order.paid", decoded["type"])
+	assert.Equal(t, o.ID, decoded["order_id"])
+	assert.Equal(t, o.UserID, decoded["user_id"])
+	assert.Equal(t, "PAID", decoded["status"])
+	assert.Equal(t, "2025-09-01T12:00:00Z", decoded["timestamp"])
+	assert.Equal(t, []any{map[string]any{"sku_id": items[0].SkuID, "quantity": 2.0, "price": 19.9}}, decoded["items"])
+}
+
+func TestStateMachineEventTypes(t *testing.T) {
+	tests := []struct {
+		from  OrderStatus
+		event OrderEvent
+		want  EventType
+	}{
+		{OrderStatusPendingPayment, OrderEventPay, EventOrderPaid},
+		{OrderStatusDepositPaid, OrderEventCancel, EventOrderCancelled},
+		{OrderStatusPaid, OrderEventShip, EventOrderShipped},
+		{OrderStatusShipped, OrderEventDeliver, EventOrderDelivered},
+		{OrderStatusPendingPayment, OrderEventCancel, EventOrderCancelled},
+		{OrderStatusDelivered, OrderEventRefund, EventOrderRefunded},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			change := NewStatusChange(&Order{Status: int32(tt.from)}, "", SystemOperator)
+			_, err := StateMachine.Fire(context.Background(), change, tt.event)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, change.EventType)
+		})
+	}
+
+	t.Run("preset event type is kept", func(t *testing.T) {
+		change := NewStatusChange(&Order{Status: int32(OrderStatusPendingPayment)}, "", SystemOperator)
+		change.EventType = EventOrderExpired
+		_, err := StateMachine.Fire(context.Background(), change, OrderEventCancel)
+		require.NoError(t, err)
+		assert.Equal(t, EventOrderExpired, change.EventType)
+	})
+}
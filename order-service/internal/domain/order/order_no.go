@@ -0,0 +1,55 @@
+package order
+
+import (
+	"context"
+	"strings"
+)
+
+// OrderNoPrefix 订单号前缀
+const OrderNoPrefix = "ORD"
+
+// OrderNoGenerator 订单号生成器
+// 实现需要保证多实例部署时订单号全局唯一，并且大致按生成时间递增。
+type OrderNoGenerator interface {
+	Generate(ctx context.Context) (string, error)
+}
+
+// AppendCheckDigit 在数字串末尾追加 Luhn 校验位
+func AppendCheckDigit(digits string) string {
+	return digits + string(rune('0'+luhnCheckDigit(digits)))
+}
+
+// ValidCheckDigit 校验订单号末尾的 Luhn 校验位
+// 客服录入订单号时可以在查库前发现输错一位或大部分相邻两位颠倒的情况。
+func ValidCheckDigit(orderNo string) bool {
+	orderNo = strings.TrimPrefix(orderNo, OrderNoPrefix)
+	if len(orderNo) < 2 {
+		return false
+	}
+	for _, c := range orderNo {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	body, check := orderNo[:len(orderNo)-1], orderNo[len(orderNo)-1]
+	return luhnCheckDigit(body) == int(check-'0')
+}
+
+// luhnCheckDigit 计算数字串的 Luhn 校验位
+func luhnCheckDigit(digits string) int {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
@@ -0,0 +1,144 @@
+package order
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentStage 支付阶段
+type PaymentStage int32
+
+const (
+	PaymentStageFull    PaymentStage = 0 // 一次付清
+	PaymentStageDeposit PaymentStage = 1 // 预售定金
+	PaymentStageBalance PaymentStage = 2 // 预售尾款
+)
+
+// PresaleTerms 预售订单的支付约定，下单时由预售活动生成
+type PresaleTerms struct {
+	PresaleID string
+	// Deposit 订单的定金总额，尾款为订单实付金额减去定金
+	Deposit         decimal.Decimal
+	DepositDeadline string
+	BalanceFrom     string
+	BalanceDeadline string
+	Forfeitable     bool
+}
+
+// ApplyPresale 将订单拆分为定金、尾款两个支付阶段
+// 订单的支付截止时间先为定金截止时间，定金支付后改为尾款截止时间。
+func (o *Order) ApplyPresale(terms PresaleTerms) error {
+	balance := o.ActualAmount.Sub(terms.Deposit)
+	if !terms.Deposit.IsPositive() || !balance.IsPositive() {
+		return fmt.Errorf("%w: 定金 %s，实付金额 %s", ErrInvalidPresaleTerms, terms.Deposit.StringFixed(2), o.ActualAmount.StringFixed(2))
+	}
+
+	o.PresaleID = terms.PresaleID
+	o.PaymentDeadline = terms.DepositDeadline
+	o.PaymentStages = []*OrderPayment{
+		{
+			ID:          uuid.NewString(),
+			Stage:       int32(PaymentStageDeposit),
+			Amount:      terms.Deposit,
+			Status:      int32(PaymentStatusUnpaid),
+			PayableFrom: o.CreatedAt,
+			Deadline:    terms.DepositDeadline,
+			Forfeitable: terms.Forfeitable,
+		},
+		{
+			ID:          uuid.NewString(),
+			Stage:       int32(PaymentStageBalance),
+			Amount:      balance,
+			Status:      int32(PaymentStatusUnpaid),
+			PayableFrom: terms.BalanceFrom,
+			Deadline:    terms.BalanceDeadline,
+		},
+	}
+	return nil
+}
+
+// Payment 返回订单已加载的支付阶段，不存在时返回 nil
+func (o *Order) Payment(stage PaymentStage) *OrderPayment {
+	for _, payment := range o.PaymentStages {
+		if payment.Stage == int32(stage) {
+			return payment
+		}
+	}
+	return nil
+}
+
+// ReservationDeadline 库存预占需要保留到的时间：普通订单为支付截止时间，预售订单为尾款截止时间
+func (o *Order) ReservationDeadline() string {
+	if balance := o.Payment(PaymentStageBalance); balance != nil {
+		return balance.Deadline
+	}
+	return o.PaymentDeadline
+}
+
+// Payable 是否已到开始支付的时间
+func (p *OrderPayment) Payable(now time.Time) bool {
+	return now.Format("2006-01-02 15:04:05") >= p.PayableFrom
+}
+
+// Refundable 可退款金额，只有已支付的阶段可以退款
+func (p *OrderPayment) Refundable() decimal.Decimal {
+	if p.Status != int32(PaymentStatusPaid) {
+		return decimal.Zero
+	}
+	return p.Amount.Sub(p.RefundedAmount)
+}
+
+// Refund 记录退款，全部退还后支付阶段变为已退款
+func (p *OrderPayment) Refund(amount decimal.Decimal, at time.Time) {
+	p.RefundedAmount = p.RefundedAmount.Add(amount)
+	if p.RefundedAmount.GreaterThanOrEqual(p.Amount) {
+		p.Status = int32(PaymentStatusRefunded)
+	}
+	p.UpdatedAt = at.Format("2006-01-02 15:04:05")
+}
+
+// RefundAllocation 退款在支付阶段上的分配
+type RefundAllocation struct {
+	Payment *OrderPayment
+	Amount  decimal.Decimal
+}
+
+// AllocateRefund 将退款金额分配到已支付的阶段，先退尾款再退定金；可退金额不足时返回 ErrRefundExceedsPaid
+func AllocateRefund(payments []*OrderPayment, amount decimal.Decimal) ([]RefundAllocation, error) {
+	sorted := make([]*OrderPayment, len(payments))
+	copy(sorted, payments)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Stage > sorted[j].Stage })
+
+	remaining := amount
+	var allocations []RefundAllocation
+	for _, payment := range sorted {
+		if !remaining.IsPositive() {
+			break
+		}
+		refundable := payment.Refundable()
+		if !refundable.IsPositive() {
+			continue
+		}
+		allocated := decimal.Min(refundable, remaining)
+		allocations = append(allocations, RefundAllocation{Payment: payment, Amount: allocated})
+		remaining = remaining.Sub(allocated)
+	}
+
+	if remaining.IsPositive() {
+		return nil, fmt.Errorf("%w: 还需退款 %s", ErrRefundExceedsPaid, remaining.StringFixed(2))
+	}
+	return allocations, nil
+}
+
+// RefundedTotal 各支付阶段已退款金额合计
+func RefundedTotal(payments []*OrderPayment) decimal.Decimal {
+	total := decimal.Zero
+	for _, payment := range payments {
+		total = total.Add(payment.RefundedAmount)
+	}
+	return total
+}
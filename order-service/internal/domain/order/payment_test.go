@@ -0,0 +1,77 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPresale(t *testing.T) {
+	o := &Order{ActualAmount: decimal.RequireFromString("310")}
+	terms := PresaleTerms{
+		PresaleID:       "presale-1",
+		Deposit:         decimal.RequireFromString("50"),
+		DepositDeadline: "2025-10-21 10:30:00",
+		BalanceFrom:     "2025-11-11 00:00:00",
+		BalanceDeadline: "2025-11-11 23:59:59",
+		Forfeitable:     true,
+	}
+	require.NoError(t, o.ApplyPresale(terms))
+
+	assert.True(t, o.IsPresale())
+	assert.Equal(t, terms.DepositDeadline, o.PaymentDeadline)
+	assert.Equal(t, terms.BalanceDeadline, o.ReservationDeadline())
+
+	deposit, balance := o.Payment(PaymentStageDeposit), o.Payment(PaymentStageBalance)
+	require.NotNil(t, deposit)
+	require.NotNil(t, balance)
+	assert.NotEqual(t, deposit.ID, balance.ID)
+	assert.True(t, decimal.RequireFromString("50").Equal(deposit.Amount))
+	assert.True(t, decimal.RequireFromString("260").Equal(balance.Amount))
+	assert.True(t, deposit.Forfeitable)
+	assert.False(t, balance.Payable(time.Date(2025, 11, 10, 23, 59, 59, 0, time.Local)))
+	assert.True(t, balance.Payable(time.Date(2025, 11, 11, 0, 0, 0, 0, time.Local)))
+
+	terms.Deposit = o.ActualAmount
+	assert.ErrorIs(t, (&Order{ActualAmount: o.ActualAmount}).ApplyPresale(terms), ErrInvalidPresaleTerms)
+}
+
+func TestAllocateRefund(t *testing.T) {
+	newPayments := func() []*OrderPayment {
+		return []*OrderPayment{
+			{ID: "deposit", Stage: int32(PaymentStageDeposit), Amount: decimal.RequireFromString("50"), Status: int32(PaymentStatusPaid)},
+			{ID: "balance", Stage: int32(PaymentStageBalance), Amount: decimal.RequireFromString("260"), Status: int32(PaymentStatusPaid)},
+		}
+	}
+
+	// 先退尾款
+	allocations, err := AllocateRefund(newPayments(), decimal.RequireFromString("100"))
+	require.NoError(t, err)
+	require.Len(t, allocations, 1)
+	assert.Equal(t, "balance", allocations[0].Payment.ID)
+	assert.True(t, decimal.RequireFromString("100").Equal(allocations[0].Amount))
+
+	// 尾款不足时再退定金
+	allocations, err = AllocateRefund(newPayments(), decimal.RequireFromString("300"))
+	require.NoError(t, err)
+	require.Len(t, allocations, 2)
+	assert.True(t, decimal.RequireFromString("260").Equal(allocations[0].Amount))
+	assert.Equal(t, "deposit", allocations[1].Payment.ID)
+	assert.True(t, decimal.RequireFromString("40").Equal(allocations[1].Amount))
+
+	// 已退部分不再分配
+	payments := newPayments()
+	payments[1].Refund(decimal.RequireFromString("260"), time.Now())
+	assert.Equal(t, int32(PaymentStatusRefunded), payments[1].Status)
+	assert.True(t, decimal.RequireFromString("260").Equal(RefundedTotal(payments)))
+	allocations, err = AllocateRefund(payments, decimal.RequireFromString("30"))
+	require.NoError(t, err)
+	require.Len(t, allocations, 1)
+	assert.Equal(t, "deposit", allocations[0].Payment.ID)
+
+	_, err = AllocateRefund(payments, decimal.RequireFromString("60"))
+	assert.ErrorIs(t, err, ErrRefundExceedsPaid)
+}
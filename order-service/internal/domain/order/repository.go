@@ -2,12 +2,20 @@ package order
 
 import (
 	"context"
+	"time"
 )
 
 // Repository 订单仓储接口
 type Repository interface {
-	// 创建订单（包括订单项和地址）
-	Create(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress) error
+	// 创建订单（包括订单项、地址和预售订单的支付阶段），同一事务中锁定订单使用的优惠券并写入订单创建事件
+	// 优惠券已被使用或已过期时返回 promotion.ErrCouponUnavailable
+	Create(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress, event *Event) error
+
+	// 从购物车创建订单，同一事务中移除已结算的购物车项；购物车项已不存在时返回 ErrCartChanged
+	CreateFromCart(ctx context.Context, order *Order, items []*OrderItem, address *OrderAddress, cartItemIDs []string, event *Event) error
+
+	// 恢复 CreateFromCart 移除的购物车项，用于下单失败的补偿；用户已重新加购同一商品时不再恢复，重复调用无副作用
+	RestoreCartItems(ctx context.Context, cartItemIDs []string) error
 
 	// 根据ID获取订单
 	GetByID(ctx context.Context, id string) (*Order, error)
@@ -18,9 +26,36 @@ type Repository interface {
 	// 根据用户ID获取订单列表
 	ListByUserID(ctx context.Context, userID string, status int32, page, pageSize int32) ([]*Order, int64, error)
 
-	// 更新订单
+	// 按状态统计 [from, to) 内创建的订单数，userID 为空时统计全部用户
+	CountByStatus(ctx context.Context, userID string, from, to time.Time) (*OrderStats, error)
+
+	// 按周期汇总 [from, to) 内的下单、支付和售后退款金额，只返回有数据的周期；userID 为空时统计全部用户
+	SumAmountsByPeriod(ctx context.Context, userID string, granularity StatsGranularity, from, to time.Time) ([]*AmountBucket, error)
+
+	// 按条件搜索订单，按下单时间倒序；after 为空时从第一页开始，还有下一页时返回下一页的游标
+	Search(ctx context.Context, filter *SearchFilter, after *SearchCursor, limit int) ([]*Order, *SearchCursor, error)
+
+	// 更新订单（乐观锁，版本冲突时返回 ErrOrderConflict）
 	Update(ctx context.Context, order *Order) error
 
+	// 获取支付截止时间早于 before 的待付款、待付尾款订单
+	ListPaymentOverdue(ctx context.Context, before time.Time, limit int) ([]*Order, error)
+
+	// 获取订单的支付阶段，按阶段正序；普通订单返回空列表
+	GetPayments(ctx context.Context, orderID string) ([]*OrderPayment, error)
+
+	// 更新支付阶段的支付状态、退款金额和支付单创建时间
+	UpdatePayment(ctx context.Context, payment *OrderPayment) error
+
+	// 获取已开始支付但尚未创建支付单的尾款，订单须为待付尾款
+	ListBalanceDue(ctx context.Context, now time.Time, limit int) ([]*OrderPayment, error)
+
+	// 获取退款中的支付阶段，即已取消订单中需要退还的定金
+	ListRefundingPayments(ctx context.Context, limit int) ([]*OrderPayment, error)
+
+	// 获取超过自动确认收货时间的已发货订单：未延长的按发货时间早于 shippedBefore，已延长的按延长后的时间早于 now
+	ListReceiveOverdue(ctx context.Context, shippedBefore, now time.Time, limit int) ([]*Order, error)
+
 	// 删除订单（软删除）
 	Delete(ctx context.Context, id string) error
 
@@ -30,11 +65,21 @@ type Repository interface {
 	// 获取订单地址
 	GetOrderAddress(ctx context.Context, orderID string) (*OrderAddress, error)
 
-	// 创建状态日志
-	CreateStatusLog(ctx context.Context, orderID string, status int32, remark string) error
+	// 替换订单地址快照，同一事务中按乐观锁更新订单并记录状态日志
+	UpdateAddress(ctx context.Context, order *Order, address *OrderAddress, statusLog *OrderStatusLog) error
 
-	// 获取状态日志
+	// 更新订单状态，同一事务中按乐观锁更新订单、记录状态日志并写入订单事件
+	// 订单支付时核销锁定的优惠券，订单取消时退回订单使用的优惠券；订单已加载支付阶段时同时保存
+	UpdateStatus(ctx context.Context, order *Order, statusLog *OrderStatusLog, event *Event) error
+
+	// 获取状态日志，按时间正序
 	GetStatusLogs(ctx context.Context, orderID string) ([]*OrderStatusLog, error)
+
+	// 创建发货包裹，同一事务中按乐观锁更新订单；订单状态变为已发货时记录状态日志并写入 event
+	CreateShipment(ctx context.Context, order *Order, shipment *Shipment, event *Event) error
+
+	// 获取订单的发货包裹
+	GetShipments(ctx context.Context, orderID string) ([]*Shipment, error)
 }
 
 // OrderItemRepository 订单商品项仓储接口
@@ -102,4 +147,5 @@ type OrderStats struct {
 	ShippedOrders   int64 `json:"shipped_orders"`
 	DeliveredOrders int64 `json:"delivered_orders"`
 	CancelledOrders int64 `json:"cancelled_orders"`
+	RefundedOrders  int64 `json:"refunded_orders"`
 }
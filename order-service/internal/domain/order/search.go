@@ -0,0 +1,81 @@
+package order
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SearchFilter 运营订单搜索条件，零值字段不参与过滤
+// 时间范围均为左闭右开区间。
+type SearchFilter struct {
+	OrderNo       string
+	UserID        string
+	Statuses      []int32
+	PaymentMethod string
+	MinAmount     *decimal.Decimal // 实付金额下限
+	MaxAmount     *decimal.Decimal // 实付金额上限
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	PaidFrom      time.Time
+	PaidTo        time.Time
+}
+
+// Validate 检查搜索条件
+func (f *SearchFilter) Validate() error {
+	for _, s := range f.Statuses {
+		if s < int32(OrderStatusPendingPayment) || s > int32(OrderStatusDepositPaid) {
+			return fmt.Errorf("%w: 订单状态 %d", ErrInvalidSearchFilter, s)
+		}
+	}
+	if f.MinAmount != nil && f.MinAmount.IsNegative() {
+		return fmt.Errorf("%w: 金额下限不能为负数", ErrInvalidSearchFilter)
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && f.MinAmount.GreaterThan(*f.MaxAmount) {
+		return fmt.Errorf("%w: 金额下限大于上限", ErrInvalidSearchFilter)
+	}
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && !f.CreatedFrom.Before(f.CreatedTo) {
+		return fmt.Errorf("%w: 下单时间范围为空", ErrInvalidSearchFilter)
+	}
+	if !f.PaidFrom.IsZero() && !f.PaidTo.IsZero() && !f.PaidFrom.Before(f.PaidTo) {
+		return fmt.Errorf("%w: 支付时间范围为空", ErrInvalidSearchFilter)
+	}
+	return nil
+}
+
+// searchCursorTimeLayout 游标中下单时间的格式，保留数据库中的微秒精度
+const searchCursorTimeLayout = "20060102150405.000000"
+
+// SearchCursor 按下单时间倒序翻页的游标，指向上一页的最后一个订单
+type SearchCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode 编码为对客户端不透明的字符串
+func (c *SearchCursor) Encode() string {
+	raw := c.CreatedAt.Format(searchCursorTimeLayout) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeSearchCursor 解析翻页游标
+func DecodeSearchCursor(cursor string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidSearchCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return nil, ErrInvalidSearchCursor
+	}
+	t, err := time.ParseInLocation(searchCursorTimeLayout, createdAt, time.Local)
+	if err != nil {
+		return nil, ErrInvalidSearchCursor
+	}
+
+	return &SearchCursor{CreatedAt: t, ID: id}, nil
+}
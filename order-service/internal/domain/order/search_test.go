@@ -0,0 +1,66 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFilterValidate(t *testing.T) {
+	amount := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		filter  SearchFilter
+		wantErr bool
+	}{
+		{name: "empty filter", filter: SearchFilter{}},
+		{name: "full filter", filter: SearchFilter{
+			Statuses:    []int32{int32(OrderStatusPaid), int32(OrderStatusShipped)},
+			MinAmount:   amount("10"),
+			MaxAmount:   amount("10"),
+			CreatedFrom: now.Add(-time.Hour),
+			CreatedTo:   now,
+		}},
+		{name: "unknown status", filter: SearchFilter{Statuses: []int32{0}}, wantErr: true},
+		{name: "negative amount", filter: SearchFilter{MinAmount: amount("-1")}, wantErr: true},
+		{name: "inverted amount range", filter: SearchFilter{MinAmount: amount("20"), MaxAmount: amount("10")}, wantErr: true},
+		{name: "empty created range", filter: SearchFilter{CreatedFrom: now, CreatedTo: now}, wantErr: true},
+		{name: "inverted paid range", filter: SearchFilter{PaidFrom: now, PaidTo: now.Add(-time.Minute)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSearchFilter)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	cursor := &SearchCursor{
+		CreatedAt: time.Date(2026, 10, 1, 12, 30, 45, 123456000, time.Local),
+		ID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+	}
+
+	decoded, err := DecodeSearchCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt), "keeps microseconds: %s", decoded.CreatedAt)
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	for _, invalid := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "MjAyNjoxMjM"} {
+		_, err := DecodeSearchCursor(invalid)
+		assert.ErrorIs(t, err, ErrInvalidSearchCursor, invalid)
+	}
+}
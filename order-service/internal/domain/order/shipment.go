@@ -0,0 +1,81 @@
+package order
+
+import "fmt"
+
+// Shipment 发货包裹，一个订单可以分多个包裹发货
+type Shipment struct {
+	ID             string          `json:"id"`
+	OrderID        string          `json:"order_id"`
+	CarrierCode    string          `json:"carrier_code"`
+	TrackingNumber string          `json:"tracking_number"`
+	OperatorID     string          `json:"operator_id"`
+	Items          []*ShipmentItem `json:"items"`
+	ShippedAt      string          `json:"shipped_at"`
+	CreatedAt      string          `json:"created_at"`
+	UpdatedAt      string          `json:"updated_at"`
+}
+
+// ShipmentItem 包裹中的订单商品项及数量
+type ShipmentItem struct {
+	ID          string `json:"id"`
+	ShipmentID  string `json:"shipment_id"`
+	OrderItemID string `json:"order_item_id"`
+	Quantity    int32  `json:"quantity"`
+}
+
+// ShippedQuantities 统计各订单商品项已发货的数量
+func ShippedQuantities(shipments []*Shipment) map[string]int32 {
+	shipped := make(map[string]int32)
+	for _, shipment := range shipments {
+		for _, item := range shipment.Items {
+			shipped[item.OrderItemID] += item.Quantity
+		}
+	}
+	return shipped
+}
+
+// PlanShipment 校验本次发货的商品项，返回发货后订单是否已全部发货
+// shipment.Items 为空时发出所有未发货的商品。
+func PlanShipment(items []*OrderItem, shipped map[string]int32, shipment *Shipment) (bool, error) {
+	ordered := make(map[string]int32, len(items))
+	for _, item := range items {
+		ordered[item.ID] = item.Quantity
+	}
+
+	if len(shipment.Items) == 0 {
+		for _, item := range items {
+			if remaining := item.Quantity - shipped[item.ID]; remaining > 0 {
+				shipment.Items = append(shipment.Items, &ShipmentItem{OrderItemID: item.ID, Quantity: remaining})
+			}
+		}
+		if len(shipment.Items) == 0 {
+			return false, ErrNothingToShip
+		}
+	}
+
+	after := make(map[string]int32, len(shipped))
+	for id, quantity := range shipped {
+		after[id] = quantity
+	}
+	for _, shipmentItem := range shipment.Items {
+		quantity, ok := ordered[shipmentItem.OrderItemID]
+		if !ok {
+			return false, fmt.Errorf("%w: %s", ErrOrderItemNotFound, shipmentItem.OrderItemID)
+		}
+		if shipmentItem.Quantity <= 0 {
+			return false, fmt.Errorf("%w: 订单商品项 %s", ErrInvalidQuantity, shipmentItem.OrderItemID)
+		}
+		after[shipmentItem.OrderItemID] += shipmentItem.Quantity
+		if after[shipmentItem.OrderItemID] > quantity {
+			return false, fmt.Errorf("%w: 订单商品项 %s 共 %d 件，已发货 %d 件",
+				ErrShipmentExceedsOrder, shipmentItem.OrderItemID, quantity, shipped[shipmentItem.OrderItemID])
+		}
+	}
+
+	for id, quantity := range ordered {
+		if after[id] < quantity {
+			return false, nil
+		}
+	}
+	return true, nil
+}
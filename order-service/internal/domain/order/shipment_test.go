@@ -0,0 +1,74 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanShipment(t *testing.T) {
+	items := []*OrderItem{
+		{ID: "item-1", Quantity: 2},
+		{ID: "item-2", Quantity: 1},
+	}
+
+	tests := []struct {
+		name         string
+		shipped      map[string]int32
+		shipItems    []*ShipmentItem
+		wantComplete bool
+		wantErr      error
+		wantItems    int
+	}{
+		{
+			name:      "partial shipment",
+			shipItems: []*ShipmentItem{{OrderItemID: "item-1", Quantity: 1}},
+			wantItems: 1,
+		},
+		{
+			name:         "completes order",
+			shipped:      map[string]int32{"item-1": 1},
+			shipItems:    []*ShipmentItem{{OrderItemID: "item-1", Quantity: 1}, {OrderItemID: "item-2", Quantity: 1}},
+			wantComplete: true,
+			wantItems:    2,
+		},
+		{
+			name:         "ships remaining when no items given",
+			shipped:      map[string]int32{"item-1": 2},
+			wantComplete: true,
+			wantItems:    1,
+		},
+		{
+			name:      "exceeds ordered quantity",
+			shipped:   map[string]int32{"item-1": 2},
+			shipItems: []*ShipmentItem{{OrderItemID: "item-1", Quantity: 1}},
+			wantErr:   ErrShipmentExceedsOrder,
+		},
+		{
+			name:      "unknown order item",
+			shipItems: []*ShipmentItem{{OrderItemID: "item-3", Quantity: 1}},
+			wantErr:   ErrOrderItemNotFound,
+		},
+		{
+			name:    "nothing left to ship",
+			shipped: map[string]int32{"item-1": 2, "item-2": 1},
+			wantErr: ErrNothingToShip,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shipment := &Shipment{Items: tt.shipItems}
+			complete, err := PlanShipment(items, tt.shipped, shipment)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantComplete, complete)
+			assert.Len(t, shipment.Items, tt.wantItems)
+		})
+	}
+}
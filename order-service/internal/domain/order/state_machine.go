@@ -0,0 +1,222 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/statemachine"
+)
+
+// OrderEvent 订单状态机事件
+type OrderEvent string
+
+const (
+	OrderEventPayDeposit OrderEvent = "pay_deposit" // 预售订单支付定金
+	OrderEventPay        OrderEvent = "pay"         // 支付，预售订单为支付尾款
+	OrderEventShip       OrderEvent = "ship"        // 全部商品发出
+	OrderEventDeliver    OrderEvent = "deliver"     // 确认收货
+	OrderEventCancel     OrderEvent = "cancel"      // 取消
+	OrderEventRefund     OrderEvent = "refund"      // 全额退款
+)
+
+// buyerEvents 买家可以通过更新订单状态直接触发的事件
+// 支付只能经 PayOrder，发货只能经运营人员的 ShipOrder，退款只能经售后流程。
+var buyerEvents = map[OrderEvent]bool{
+	OrderEventCancel:  true,
+	OrderEventDeliver: true,
+}
+
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusUnknown:        "UNKNOWN",
+	OrderStatusPendingPayment: "PENDING_PAYMENT",
+	OrderStatusPaid:           "PAID",
+	OrderStatusShipped:        "SHIPPED",
+	OrderStatusDelivered:      "DELIVERED",
+	OrderStatusCancelled:      "CANCELLED",
+	OrderStatusRefunded:       "REFUNDED",
+	OrderStatusDepositPaid:    "DEPOSIT_PAID",
+}
+
+// String 返回与 proto 枚举一致的状态名
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// StatusChange 一次订单状态变更，是订单状态机的转换对象
+type StatusChange struct {
+	Order    *Order
+	Reason   string
+	Operator Operator
+	// PaymentMethod 支付事件使用的支付方式
+	PaymentMethod string
+	// EventType 状态变更后发布的事件，为空时使用转换声明的事件
+	EventType EventType
+	At        time.Time
+}
+
+// NewStatusChange 创建订单状态变更
+func NewStatusChange(order *Order, reason string, operator Operator) *StatusChange {
+	return &StatusChange{Order: order, Reason: reason, Operator: operator, At: time.Now()}
+}
+
+// TransitionError 非法订单状态转换，From 为订单当前状态
+type TransitionError = statemachine.TransitionError[OrderStatus, OrderEvent]
+
+// StateMachine 订单状态机，声明订单状态、事件、转换条件和状态变更后需要写入的字段
+var StateMachine = newStateMachine()
+
+func newStateMachine() *statemachine.Machine[OrderStatus, OrderEvent, *StatusChange] {
+	m := statemachine.New[OrderStatus, OrderEvent](
+		"order",
+		OrderStatusPendingPayment,
+		func(c *StatusChange) OrderStatus { return OrderStatus(c.Order.Status) },
+		func(c *StatusChange, status OrderStatus) { c.Order.Status = int32(status) },
+	)
+	m.States(
+		OrderStatusPendingPayment,
+		OrderStatusDepositPaid,
+		OrderStatusPaid,
+		OrderStatusShipped,
+		OrderStatusDelivered,
+		OrderStatusCancelled,
+		OrderStatusRefunded,
+	)
+
+	// 预售订单先支付定金，尾款支付开始后再支付尾款
+	m.Event(OrderEventPayDeposit, OrderStatusDepositPaid, OrderStatusPendingPayment).
+		Guard(presaleOnly, paymentNotOverdue).
+		After(touch, markDepositPaid, emit(EventOrderDepositPaid))
+	m.Event(OrderEventPay, OrderStatusPaid, OrderStatusPendingPayment, OrderStatusDepositPaid).
+		Guard(paymentNotOverdue, balancePayable).
+		After(touch, markPaid, emit(EventOrderPaid))
+	m.Event(OrderEventShip, OrderStatusShipped, OrderStatusPaid).
+		After(touch, markShipped, emit(EventOrderShipped))
+	m.Event(OrderEventDeliver, OrderStatusDelivered, OrderStatusShipped).
+		After(touch, markDelivered, emit(EventOrderDelivered))
+	m.Event(OrderEventCancel, OrderStatusCancelled, OrderStatusPendingPayment, OrderStatusDepositPaid, OrderStatusPaid, OrderStatusShipped).
+		After(touch, markCancelled, settleDeposit, emit(EventOrderCancelled))
+	// 已收货的订单可通过售后全额退款
+	m.Event(OrderEventRefund, OrderStatusRefunded, OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered).
+		After(touch, markRefunded, emit(EventOrderRefunded))
+
+	return m
+}
+
+// paymentNotOverdue 超过支付截止时间的订单不能支付
+func paymentNotOverdue(_ context.Context, c *StatusChange) error {
+	if c.Order.IsPaymentOverdue(c.At) {
+		return ErrOrderExpired
+	}
+	return nil
+}
+
+// presaleOnly 只有预售订单需要支付定金
+func presaleOnly(_ context.Context, c *StatusChange) error {
+	if !c.Order.IsPresale() {
+		return ErrNotPresaleOrder
+	}
+	return nil
+}
+
+// balancePayable 预售订单须先支付定金，尾款支付开始后才能支付尾款
+func balancePayable(_ context.Context, c *StatusChange) error {
+	if !c.Order.IsPresale() {
+		return nil
+	}
+	if c.Order.Status == int32(OrderStatusPendingPayment) {
+		return ErrDepositUnpaid
+	}
+	if balance := c.Order.Payment(PaymentStageBalance); balance == nil || !balance.Payable(c.At) {
+		return ErrBalanceNotPayable
+	}
+	return nil
+}
+
+func touch(_ context.Context, c *StatusChange) error {
+	c.Order.UpdatedAt = c.At.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// markDepositPaid 定金已付，订单的支付截止时间改为尾款截止时间
+func markDepositPaid(_ context.Context, c *StatusChange) error {
+	deposit := c.Order.Payment(PaymentStageDeposit)
+	balance := c.Order.Payment(PaymentStageBalance)
+	if deposit == nil || balance == nil {
+		return ErrNotPresaleOrder
+	}
+	markStagePaid(deposit, c)
+	c.Order.PaymentMethod = c.PaymentMethod
+	c.Order.PaymentDeadline = balance.Deadline
+	return nil
+}
+
+func markPaid(_ context.Context, c *StatusChange) error {
+	if balance := c.Order.Payment(PaymentStageBalance); balance != nil {
+		markStagePaid(balance, c)
+	}
+	c.Order.PaymentMethod = c.PaymentMethod
+	c.Order.PaymentStatus = int32(PaymentStatusPaid)
+	c.Order.PaymentTime = c.At.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// markStagePaid 标记支付阶段已支付
+func markStagePaid(payment *OrderPayment, c *StatusChange) {
+	payment.PaymentMethod = c.PaymentMethod
+	payment.Status = int32(PaymentStatusPaid)
+	payment.PaidAt = c.At.Format("2006-01-02 15:04:05")
+	payment.UpdatedAt = payment.PaidAt
+}
+
+func markShipped(_ context.Context, c *StatusChange) error {
+	c.Order.DeliveryTime = c.At.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+func markDelivered(_ context.Context, c *StatusChange) error {
+	c.Order.ReceiveTime = c.At.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+func markCancelled(_ context.Context, c *StatusChange) error {
+	c.Order.CancelTime = c.At.Format("2006-01-02 15:04:05")
+	c.Order.CancelReason = c.Reason
+	return nil
+}
+
+// settleDeposit 取消待付尾款的预售订单时处理已付的定金：买家取消或超时未付尾款时按约定不退定金，
+// 运营取消时退还定金；需要退还的定金标记为退款中，由应用服务调用支付服务退款。
+// 尾款已付的订单与普通已付款订单一样，取消时不在这里处理退款。
+func settleDeposit(_ context.Context, c *StatusChange) error {
+	deposit := c.Order.Payment(PaymentStageDeposit)
+	balance := c.Order.Payment(PaymentStageBalance)
+	if deposit == nil || balance == nil || deposit.Status != int32(PaymentStatusPaid) || balance.Status != int32(PaymentStatusUnpaid) {
+		return nil
+	}
+
+	if deposit.Forfeitable && c.Operator.Type != OperatorTypeAdmin {
+		deposit.Status = int32(PaymentStatusForfeited)
+	} else {
+		deposit.Status = int32(PaymentStatusRefunding)
+	}
+	deposit.UpdatedAt = c.At.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+func markRefunded(_ context.Context, c *StatusChange) error {
+	c.Order.PaymentStatus = int32(PaymentStatusRefunded)
+	return nil
+}
+
+// emit 设置状态变更后发布的事件，调用方已指定时保持不变
+func emit(eventType EventType) func(context.Context, *StatusChange) error {
+	return func(_ context.Context, c *StatusChange) error {
+		if c.EventType == "" {
+			c.EventType = eventType
+		}
+		return nil
+	}
+}
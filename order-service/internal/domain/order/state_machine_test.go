@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateMachineTransitions(t *testing.T) {
+	tests := []struct {
+		from  OrderStatus
+		event OrderEvent
+		to    OrderStatus
+		ok    bool
+	}{
+		{OrderStatusPendingPayment, OrderEventPay, OrderStatusPaid, true},
+		{OrderStatusPendingPayment, OrderEventShip, OrderStatusPendingPayment, false},
+		{OrderStatusDepositPaid, OrderEventCancel, OrderStatusCancelled, true},
+		{OrderStatusDepositPaid, OrderEventShip, OrderStatusDepositPaid, false},
+		{OrderStatusPaid, OrderEventShip, OrderStatusShipped, true},
+		{OrderStatusPaid, OrderEventPay, OrderStatusPaid, false},
+		{OrderStatusShipped, OrderEventDeliver, OrderStatusDelivered, true},
+		{OrderStatusShipped, OrderEventCancel, OrderStatusCancelled, true},
+		{OrderStatusDelivered, OrderEventCancel, OrderStatusDelivered, false},
+		{OrderStatusDelivered, OrderEventRefund, OrderStatusRefunded, true},
+		{OrderStatusCancelled, OrderEventRefund, OrderStatusCancelled, false},
+		{OrderStatusRefunded, OrderEventCancel, OrderStatusRefunded, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"/"+string(tt.event), func(t *testing.T) {
+			change := NewStatusChange(&Order{Status: int32(tt.from)}, "", SystemOperator)
+			_, err := StateMachine.Fire(context.Background(), change, tt.event)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				var transitionErr *TransitionError
+				require.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, tt.from, transitionErr.From)
+			}
+			assert.Equal(t, int32(tt.to), change.Order.Status)
+		})
+	}
+}
+
+func TestStateMachineHooks(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+
+	t.Run("pay", func(t *testing.T) {
+		change := NewStatusChange(&Order{Status: int32(OrderStatusPendingPayment)}, "", SystemOperator)
+		change.PaymentMethod = "alipay"
+		change.At = at
+		_, err := StateMachine.Fire(context.Background(), change, OrderEventPay)
+		require.NoError(t, err)
+		assert.Equal(t, "alipay", change.Order.PaymentMethod)
+		assert.Equal(t, int32(PaymentStatusPaid), change.Order.PaymentStatus)
+		assert.Equal(t, "2024-05-01 12:00:00", change.Order.PaymentTime)
+	})
+
+	t.Run("pay after deadline", func(t *testing.T) {
+		change := NewStatusChange(&Order{Status: int32(OrderStatusPendingPayment), PaymentDeadline: "2024-05-01 11:30:00"}, "", SystemOperator)
+		change.At = at
+		_, err := StateMachine.Fire(context.Background(), change, OrderEventPay)
+		assert.ErrorIs(t, err, ErrOrderExpired)
+		assert.Equal(t, int32(OrderStatusPendingPayment), change.Order.Status)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		change := NewStatusChange(&Order{Status: int32(OrderStatusPaid)}, "不想要了", SystemOperator)
+		change.At = at
+		_, err := StateMachine.Fire(context.Background(), change, OrderEventCancel)
+		require.NoError(t, err)
+		assert.Equal(t, "不想要了", change.Order.CancelReason)
+		assert.Equal(t, "2024-05-01 12:00:00", change.Order.CancelTime)
+	})
+}
+
+func TestStateMachinePresale(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+	newPresaleOrder := func(forfeitable bool) *Order {
+		o := &Order{Status: int32(OrderStatusPendingPayment), ActualAmount: decimal.RequireFromString("300")}
+		require.NoError(t, o.ApplyPresale(PresaleTerms{
+			PresaleID:       "presale-1",
+			Deposit:         decimal.RequireFromString("50"),
+			DepositDeadline: "2024-05-01 12:30:00",
+			BalanceFrom:     "2024-05-10 00:00:00",
+			BalanceDeadline: "2024-05-10 23:59:59",
+			Forfeitable:     forfeitable,
+		}))
+		return o
+	}
+	fire := func(o *Order, event OrderEvent, operator Operator, at time.Time) error {
+		change := NewStatusChange(o, "", operator)
+		change.At = at
+		_, err := StateMachine.Fire(context.Background(), change, event)
+		return err
+	}
+
+	t.Run("pay deposit then balance", func(t *testing.T) {
+		o := newPresaleOrder(true)
+		assert.ErrorIs(t, fire(o, OrderEventPay, SystemOperator, at), ErrDepositUnpaid)
+
+		require.NoError(t, fire(o, OrderEventPayDeposit, SystemOperator, at))
+		assert.Equal(t, int32(OrderStatusDepositPaid), o.Status)
+		assert.Equal(t, int32(PaymentStatusPaid), o.Payment(PaymentStageDeposit).Status)
+		assert.Equal(t, "2024-05-10 23:59:59", o.PaymentDeadline)
+
+		assert.ErrorIs(t, fire(o, OrderEventPay, SystemOperator, at), ErrBalanceNotPayable)
+
+		balanceAt := time.Date(2024, 5, 10, 9, 0, 0, 0, time.Local)
+		require.NoError(t, fire(o, OrderEventPay, SystemOperator, balanceAt))
+		assert.Equal(t, int32(OrderStatusPaid), o.Status)
+		assert.Equal(t, int32(PaymentStatusPaid), o.Payment(PaymentStageBalance).Status)
+	})
+
+	t.Run("pay deposit requires presale order", func(t *testing.T) {
+		o := &Order{Status: int32(OrderStatusPendingPayment)}
+		assert.ErrorIs(t, fire(o, OrderEventPayDeposit, SystemOperator, at), ErrNotPresaleOrder)
+	})
+
+	t.Run("cancel forfeits deposit", func(t *testing.T) {
+		o := newPresaleOrder(true)
+		require.NoError(t, fire(o, OrderEventPayDeposit, SystemOperator, at))
+		require.NoError(t, fire(o, OrderEventCancel, UserOperator("user-1"), at))
+		assert.Equal(t, int32(PaymentStatusForfeited), o.Payment(PaymentStageDeposit).Status)
+	})
+
+	t.Run("admin cancel refunds forfeitable deposit", func(t *testing.T) {
+		o := newPresaleOrder(true)
+		require.NoError(t, fire(o, OrderEventPayDeposit, SystemOperator, at))
+		require.NoError(t, fire(o, OrderEventCancel, AdminOperator("admin-1"), at))
+		assert.Equal(t, int32(PaymentStatusRefunding), o.Payment(PaymentStageDeposit).Status)
+	})
+
+	t.Run("cancel refunds deposit", func(t *testing.T) {
+		o := newPresaleOrder(false)
+		require.NoError(t, fire(o, OrderEventPayDeposit, SystemOperator, at))
+		require.NoError(t, fire(o, OrderEventCancel, SystemOperator, at))
+		assert.Equal(t, int32(PaymentStatusRefunding), o.Payment(PaymentStageDeposit).Status)
+	})
+
+	t.Run("cancel before deposit paid", func(t *testing.T) {
+		o := newPresaleOrder(true)
+		require.NoError(t, fire(o, OrderEventCancel, UserOperator("user-1"), at))
+		assert.Equal(t, int32(PaymentStatusUnpaid), o.Payment(PaymentStageDeposit).Status)
+	})
+}
+
+func TestStateMachineDiagram(t *testing.T) {
+	want := `stateDiagram-v2
+    [*] --> PENDING_PAYMENT
+    PENDING_PAYMENT --> DEPOSIT_PAID: pay_deposit
+    PENDING_PAYMENT --> PAID: pay
+    DEPOSIT_PAID --> PAID: pay
+    PAID --> SHIPPED: ship
+    SHIPPED --> DELIVERED: deliver
+    PENDING_PAYMENT --> CANCELLED: cancel
+    DEPOSIT_PAID --> CANCELLED: cancel
+    PAID --> CANCELLED: cancel
+    SHIPPED --> CANCELLED: cancel
+    PAID --> REFUNDED: refund
+    SHIPPED --> REFUNDED: refund
+    DELIVERED --> REFUNDED: refund
+    CANCELLED --> [*]
+    REFUNDED --> [*]
+`
+	assert.Equal(t, want, StateMachine.Diagram())
+}
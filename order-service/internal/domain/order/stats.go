@@ -0,0 +1,97 @@
+package order
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatsGranularity 金额统计的汇总周期
+type StatsGranularity string
+
+const (
+	StatsGranularityDay   StatsGranularity = "day"
+	StatsGranularityWeek  StatsGranularity = "week" // 自然周，从周一开始
+	StatsGranularityMonth StatsGranularity = "month"
+)
+
+// MaxStatsBuckets 单次统计最多返回的周期数
+const MaxStatsBuckets = 400
+
+// AmountBucket 一个统计周期内的金额汇总
+type AmountBucket struct {
+	Period       string          `json:"period"`        // 周期起始日期，格式 2006-01-02
+	GMV          decimal.Decimal `json:"gmv"`           // 下单金额，含未支付和已取消的订单
+	PaidAmount   decimal.Decimal `json:"paid_amount"`   // 支付金额，按支付时间统计
+	RefundAmount decimal.Decimal `json:"refund_amount"` // 售后退款金额，按退款完成时间统计
+}
+
+// Truncate 返回 t 所在周期的起始时间
+func (g StatsGranularity) Truncate(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch g {
+	case StatsGranularityWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case StatsGranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+// Next 返回下一个周期的起始时间
+func (g StatsGranularity) Next(start time.Time) time.Time {
+	switch g {
+	case StatsGranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case StatsGranularityMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// IsValid 检查汇总周期是否支持
+func (g StatsGranularity) IsValid() bool {
+	return g == StatsGranularityDay || g == StatsGranularityWeek || g == StatsGranularityMonth
+}
+
+// ValidateStatsRange 检查统计周期和时间范围
+func ValidateStatsRange(g StatsGranularity, from, to time.Time) error {
+	if !g.IsValid() {
+		return fmt.Errorf("%w: 不支持的汇总周期 %s", ErrInvalidStatsRange, g)
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("%w: 统计时间范围为空", ErrInvalidStatsRange)
+	}
+
+	buckets := 0
+	for start := g.Truncate(from); start.Before(to); start = g.Next(start) {
+		buckets++
+		if buckets > MaxStatsBuckets {
+			return fmt.Errorf("%w: 统计周期数超过 %d", ErrInvalidStatsRange, MaxStatsBuckets)
+		}
+	}
+	return nil
+}
+
+// AmountSeries 按周期补齐 [from, to) 范围内的金额汇总，没有数据的周期金额为 0
+func AmountSeries(g StatsGranularity, from, to time.Time, buckets []*AmountBucket) []*AmountBucket {
+	byPeriod := make(map[string]*AmountBucket, len(buckets))
+	for _, bucket := range buckets {
+		byPeriod[bucket.Period] = bucket
+	}
+
+	var series []*AmountBucket
+	for start := g.Truncate(from); start.Before(to); start = g.Next(start) {
+		period := start.Format("2006-01-02")
+		if bucket, ok := byPeriod[period]; ok {
+			series = append(series, bucket)
+			continue
+		}
+		series = append(series, &AmountBucket{Period: period})
+	}
+	return series
+}
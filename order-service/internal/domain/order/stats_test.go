@@ -0,0 +1,64 @@
+package order
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsGranularityTruncate(t *testing.T) {
+	// 2026-10-15 是周四
+	ts := time.Date(2026, 10, 15, 18, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		granularity StatsGranularity
+		want        string
+		next        string
+	}{
+		{granularity: StatsGranularityDay, want: "2026-10-15", next: "2026-10-16"},
+		{granularity: StatsGranularityWeek, want: "2026-10-12", next: "2026-10-19"},
+		{granularity: StatsGranularityMonth, want: "2026-10-01", next: "2026-11-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.granularity), func(t *testing.T) {
+			start := tt.granularity.Truncate(ts)
+			assert.Equal(t, tt.want, start.Format("2006-01-02"))
+			assert.Equal(t, tt.next, tt.granularity.Next(start).Format("2006-01-02"))
+		})
+	}
+
+	t.Run("sunday belongs to previous week", func(t *testing.T) {
+		sunday := time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local)
+		assert.Equal(t, "2026-10-12", StatsGranularityWeek.Truncate(sunday).Format("2006-01-02"))
+	})
+}
+
+func TestValidateStatsRange(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)
+
+	assert.NoError(t, ValidateStatsRange(StatsGranularityDay, from, from.AddDate(0, 0, MaxStatsBuckets)))
+	assert.ErrorIs(t, ValidateStatsRange(StatsGranularityDay, from, from.AddDate(0, 0, MaxStatsBuckets+1)), ErrInvalidStatsRange)
+	assert.NoError(t, ValidateStatsRange(StatsGranularityMonth, from, from.AddDate(5, 0, 0)))
+	assert.ErrorIs(t, ValidateStatsRange(StatsGranularityDay, from, from), ErrInvalidStatsRange)
+	assert.ErrorIs(t, ValidateStatsRange("hour", from, from.AddDate(0, 0, 1)), ErrInvalidStatsRange)
+}
+
+func TestAmountSeries(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 3)
+
+	series := AmountSeries(StatsGranularityDay, from, to, []*AmountBucket{
+		{Period: "2026-10-02", GMV: decimal.RequireFromString("100"), PaidAmount: decimal.RequireFromString("80")},
+	})
+
+	require.Len(t, series, 3)
+	assert.Equal(t, []string{"2026-10-01", "2026-10-02", "2026-10-03"},
+		[]string{series[0].Period, series[1].Period, series[2].Period})
+	assert.True(t, series[0].GMV.IsZero())
+	assert.True(t, series[1].GMV.Equal(decimal.RequireFromString("100")))
+	assert.True(t, series[1].PaidAmount.Equal(decimal.RequireFromString("80")))
+}
@@ -0,0 +1,91 @@
+package order
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// TimelineEventType 订单时间线事件类型
+type TimelineEventType string
+
+const (
+	TimelineEventStatusChanged  TimelineEventType = "status_changed"  // 状态变更
+	TimelineEventPaid           TimelineEventType = "paid"            // 支付成功
+	TimelineEventShipped        TimelineEventType = "shipped"         // 包裹发出
+	TimelineEventAddressChanged TimelineEventType = "address_changed" // 修改收货地址
+)
+
+// TimelineEvent 订单时间线事件
+type TimelineEvent struct {
+	Type         TimelineEventType `json:"type"`
+	FromStatus   int32             `json:"from_status"`
+	ToStatus     int32             `json:"to_status"`
+	OperatorID   string            `json:"operator_id"`
+	OperatorType string            `json:"operator_type"`
+	Remark       string            `json:"remark"`
+
+	// 支付事件
+	PaymentMethod string          `json:"payment_method"`
+	Amount        decimal.Decimal `json:"amount"`
+
+	// 发货事件
+	ShipmentID     string          `json:"shipment_id"`
+	CarrierCode    string          `json:"carrier_code"`
+	TrackingNumber string          `json:"tracking_number"`
+	Items          []*ShipmentItem `json:"items"`
+
+	OccurredAt string `json:"occurred_at"`
+}
+
+// BuildTimeline 合并状态日志、支付和发货记录，按发生时间正序排列
+// 同一时刻的事件保持状态日志、支付、发货的先后顺序。
+func BuildTimeline(order *Order, logs []*OrderStatusLog, shipments []*Shipment) []*TimelineEvent {
+	events := make([]*TimelineEvent, 0, len(logs)+len(shipments)+1)
+	for _, log := range logs {
+		// 状态不变的日志记录的是收货地址修改
+		eventType := TimelineEventStatusChanged
+		if log.FromStatus == log.Status {
+			eventType = TimelineEventAddressChanged
+		}
+		events = append(events, &TimelineEvent{
+			Type:         eventType,
+			FromStatus:   log.FromStatus,
+			ToStatus:     log.Status,
+			OperatorID:   log.OperatorID,
+			OperatorType: log.OperatorType,
+			Remark:       log.Remark,
+			OccurredAt:   log.CreatedAt,
+		})
+	}
+
+	if order.PaymentTime != "" {
+		events = append(events, &TimelineEvent{
+			Type:          TimelineEventPaid,
+			OperatorID:    order.UserID,
+			OperatorType:  OperatorTypeUser,
+			PaymentMethod: order.PaymentMethod,
+			Amount:        order.ActualAmount,
+			OccurredAt:    order.PaymentTime,
+		})
+	}
+
+	for _, shipment := range shipments {
+		events = append(events, &TimelineEvent{
+			Type:           TimelineEventShipped,
+			OperatorID:     shipment.OperatorID,
+			OperatorType:   OperatorTypeAdmin,
+			ShipmentID:     shipment.ID,
+			CarrierCode:    shipment.CarrierCode,
+			TrackingNumber: shipment.TrackingNumber,
+			Items:          shipment.Items,
+			OccurredAt:     shipment.ShippedAt,
+		})
+	}
+
+	// 时间格式为 2006-01-02 15:04:05，按字符串比较即按时间比较
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].OccurredAt < events[j].OccurredAt
+	})
+	return events
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	orderEntity := &Order{
+		UserID:        "user-1",
+		PaymentMethod: "PAYMENT_METHOD_ALIPAY",
+		PaymentTime:   "2026-10-01 10:05:00",
+		ActualAmount:  decimal.RequireFromString("99.00"),
+	}
+	logs := []*OrderStatusLog{
+		{Status: int32(OrderStatusPendingPayment), OperatorID: "user-1", OperatorType: OperatorTypeUser, Remark: "订单创建", CreatedAt: "2026-10-01 10:00:00"},
+		{FromStatus: int32(OrderStatusPendingPayment), Status: int32(OrderStatusPaid), OperatorID: "user-1", OperatorType: OperatorTypeUser, Remark: "订单支付成功", CreatedAt: "2026-10-01 10:05:00"},
+		{FromStatus: int32(OrderStatusPaid), Status: int32(OrderStatusShipped), OperatorID: "admin-1", OperatorType: OperatorTypeAdmin, Remark: "订单已全部发货", CreatedAt: "2026-10-03 09:00:00"},
+	}
+	shipments := []*Shipment{
+		{ID: "shipment-2", CarrierCode: "SF", TrackingNumber: "SF002", OperatorID: "admin-1", ShippedAt: "2026-10-03 09:00:00"},
+		{ID: "shipment-1", CarrierCode: "SF", TrackingNumber: "SF001", OperatorID: "admin-1", ShippedAt: "2026-10-02 15:30:00"},
+	}
+
+	events := BuildTimeline(orderEntity, logs, shipments)
+	require.Len(t, events, 6)
+
+	var got []TimelineEventType
+	for _, event := range events {
+		got = append(got, event.Type)
+	}
+	assert.Equal(t, []TimelineEventType{
+		TimelineEventStatusChanged,
+		TimelineEventStatusChanged,
+		TimelineEventPaid,
+		TimelineEventShipped,
+		TimelineEventStatusChanged,
+		TimelineEventShipped,
+	}, got)
+
+	assert.Equal(t, "PAYMENT_METHOD_ALIPAY", events[2].PaymentMethod)
+	assert.True(t, events[2].Amount.Equal(decimal.RequireFromString("99")))
+	assert.Equal(t, "shipment-1", events[3].ShipmentID)
+	assert.Equal(t, OperatorTypeAdmin, events[4].OperatorType)
+
+	t.Run("unpaid order has no payment event", func(t *testing.T) {
+		events := BuildTimeline(&Order{UserID: "user-1"}, logs[:1], nil)
+		require.Len(t, events, 1)
+		assert.Equal(t, TimelineEventStatusChanged, events[0].Type)
+	})
+
+	t.Run("log without status change is an address change", func(t *testing.T) {
+		changed := &OrderStatusLog{FromStatus: int32(OrderStatusPaid), Status: int32(OrderStatusPaid), OperatorID: "admin-1", OperatorType: OperatorTypeAdmin, Remark: "修改收货地址", CreatedAt: "2026-10-01 11:00:00"}
+		events := BuildTimeline(&Order{UserID: "user-1"}, append(logs[:2:2], changed), nil)
+		require.Len(t, events, 3)
+		assert.Equal(t, TimelineEventAddressChanged, events[2].Type)
+		assert.Equal(t, "admin-1", events[2].OperatorID)
+	})
+}
@@ -0,0 +1,27 @@
+package presale
+
+import "context"
+
+// DomainService 预售领域服务
+type DomainService interface {
+	// 创建预售活动
+	CreatePresale(ctx context.Context, presale *Presale) error
+}
+
+// domainService 预售领域服务实现
+type domainService struct {
+	repo Repository
+}
+
+// NewDomainService 创建预售领域服务
+func NewDomainService(repo Repository) DomainService {
+	return &domainService{repo: repo}
+}
+
+// CreatePresale 创建预售活动
+func (ds *domainService) CreatePresale(ctx context.Context, presale *Presale) error {
+	if err := presale.Validate(); err != nil {
+		return err
+	}
+	return ds.repo.Create(ctx, presale)
+}
@@ -0,0 +1,87 @@
+package presale
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// timeLayout 实体中时间字段的格式，该格式的字符串按字典序比较即按时间先后比较
+const timeLayout = "2006-01-02 15:04:05"
+
+// DepositPolicy 定金规则，决定待付尾款的订单被取消时定金是否退还
+type DepositPolicy int32
+
+const (
+	DepositPolicyForfeit DepositPolicy = 1 // 买家取消或超时未付尾款时定金不退，运营取消时退还
+	DepositPolicyRefund  DepositPolicy = 2 // 订单取消时退还定金
+)
+
+// Presale 预售活动，每个活动对应一个SKU
+// 定金期内下单并支付定金，尾款期内支付尾款；库存在下单时预占，保留到尾款截止后。
+type Presale struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	ProductID string          `json:"product_id"`
+	SkuID     string          `json:"sku_id"`
+	Price     decimal.Decimal `json:"price"`   // 预售价
+	Deposit   decimal.Decimal `json:"deposit"` // 每件定金
+	// DepositPolicy 定金规则
+	DepositPolicy DepositPolicy `json:"deposit_policy"`
+	// DepositStartAt、DepositEndAt 定金期，即可以下单的时间
+	DepositStartAt string `json:"deposit_start_at"`
+	DepositEndAt   string `json:"deposit_end_at"`
+	// BalanceStartAt、BalanceEndAt 尾款期，尾款截止前未支付的订单由系统取消
+	BalanceStartAt string `json:"balance_start_at"`
+	BalanceEndAt   string `json:"balance_end_at"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+// Validate 校验预售活动：定金小于预售价，定金期在尾款期之前
+func (p *Presale) Validate() error {
+	switch {
+	case p.Name == "" || p.ProductID == "" || p.SkuID == "":
+		return ErrInvalidPresale
+	case !p.Price.IsPositive() || !p.Deposit.IsPositive() || p.Deposit.GreaterThanOrEqual(p.Price):
+		return ErrInvalidPresale
+	case p.DepositPolicy != DepositPolicyForfeit && p.DepositPolicy != DepositPolicyRefund:
+		return ErrInvalidPresale
+	case !validTime(p.DepositStartAt) || !validTime(p.DepositEndAt) || !validTime(p.BalanceStartAt) || !validTime(p.BalanceEndAt):
+		return ErrInvalidPresale
+	case p.DepositStartAt >= p.DepositEndAt || p.DepositEndAt > p.BalanceStartAt || p.BalanceStartAt >= p.BalanceEndAt:
+		return ErrInvalidPresale
+	}
+	return nil
+}
+
+// validTime 时间字段是否符合 timeLayout 格式
+func validTime(value string) bool {
+	_, err := time.Parse(timeLayout, value)
+	return err == nil
+}
+
+// DepositOpen 是否在定金期内
+func (p *Presale) DepositOpen(now time.Time) bool {
+	current := now.Format(timeLayout)
+	return p.DepositStartAt <= current && current < p.DepositEndAt
+}
+
+// Terms 生成订单的支付约定：定金须在 paymentTimeout 内且不晚于定金期结束时支付
+func (p *Presale) Terms(quantity int32, now time.Time, paymentTimeout time.Duration) order.PresaleTerms {
+	depositDeadline := now.Add(paymentTimeout).Format(timeLayout)
+	if depositDeadline > p.DepositEndAt {
+		depositDeadline = p.DepositEndAt
+	}
+
+	return order.PresaleTerms{
+		PresaleID:       p.ID,
+		Deposit:         p.Deposit.Mul(decimal.NewFromInt32(quantity)),
+		DepositDeadline: depositDeadline,
+		BalanceFrom:     p.BalanceStartAt,
+		BalanceDeadline: p.BalanceEndAt,
+		Forfeitable:     p.DepositPolicy == DepositPolicyForfeit,
+	}
+}
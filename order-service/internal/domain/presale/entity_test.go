@@ -0,0 +1,75 @@
+package presale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPresale() *Presale {
+	return &Presale{
+		ID:             "presale-1",
+		Name:           "新品预售",
+		ProductID:      "product-1",
+		SkuID:          "sku-1",
+		Price:          decimal.RequireFromString("299"),
+		Deposit:        decimal.RequireFromString("50"),
+		DepositPolicy:  DepositPolicyForfeit,
+		DepositStartAt: "2025-10-20 20:00:00",
+		DepositEndAt:   "2025-11-10 20:00:00",
+		BalanceStartAt: "2025-11-11 00:00:00",
+		BalanceEndAt:   "2025-11-11 23:59:59",
+	}
+}
+
+func TestPresaleValidate(t *testing.T) {
+	require.NoError(t, newTestPresale().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(p *Presale)
+	}{
+		{name: "deposit not less than price", modify: func(p *Presale) { p.Deposit = p.Price }},
+		{name: "unknown deposit policy", modify: func(p *Presale) { p.DepositPolicy = 0 }},
+		{name: "balance starts before deposit ends", modify: func(p *Presale) { p.BalanceStartAt = "2025-11-01 00:00:00" }},
+		{name: "empty balance period", modify: func(p *Presale) { p.BalanceEndAt = p.BalanceStartAt }},
+		{name: "invalid time", modify: func(p *Presale) { p.DepositEndAt = "2025-11-10" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPresale()
+			tt.modify(p)
+			assert.ErrorIs(t, p.Validate(), ErrInvalidPresale)
+		})
+	}
+}
+
+func TestPresaleTerms(t *testing.T) {
+	p := newTestPresale()
+	at := func(value string) time.Time {
+		parsed, _ := time.ParseInLocation(timeLayout, value, time.Local)
+		return parsed
+	}
+
+	terms := p.Terms(2, at("2025-10-21 10:00:00"), 30*time.Minute)
+	assert.Equal(t, "presale-1", terms.PresaleID)
+	assert.True(t, decimal.RequireFromString("100").Equal(terms.Deposit))
+	assert.Equal(t, "2025-10-21 10:30:00", terms.DepositDeadline)
+	assert.Equal(t, p.BalanceStartAt, terms.BalanceFrom)
+	assert.Equal(t, p.BalanceEndAt, terms.BalanceDeadline)
+	assert.True(t, terms.Forfeitable)
+
+	// 定金截止时间不晚于定金期结束
+	terms = p.Terms(1, at("2025-11-10 19:50:00"), 30*time.Minute)
+	assert.Equal(t, p.DepositEndAt, terms.DepositDeadline)
+
+	p.DepositPolicy = DepositPolicyRefund
+	assert.False(t, p.Terms(1, at("2025-10-21 10:00:00"), 30*time.Minute).Forfeitable)
+
+	assert.True(t, p.DepositOpen(at("2025-10-20 20:00:00")))
+	assert.False(t, p.DepositOpen(at("2025-11-10 20:00:00")))
+}
@@ -0,0 +1,10 @@
+package presale
+
+import "errors"
+
+// 预售领域错误定义
+var (
+	ErrPresaleNotFound  = errors.New("presale not found")
+	ErrInvalidPresale   = errors.New("invalid presale")
+	ErrPresaleNotActive = errors.New("presale deposit period not in progress")
+)
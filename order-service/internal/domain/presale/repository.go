@@ -0,0 +1,15 @@
+package presale
+
+import "context"
+
+// Repository 预售活动仓储接口
+type Repository interface {
+	// 创建预售活动
+	Create(ctx context.Context, presale *Presale) error
+
+	// 获取预售活动
+	Get(ctx context.Context, id string) (*Presale, error)
+
+	// 获取预售活动列表，按定金开始时间倒序
+	List(ctx context.Context) ([]*Presale, error)
+}
@@ -0,0 +1,299 @@
+package promotion
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MaxStackedCoupons 一笔订单最多同时使用的优惠券数量
+	MaxStackedCoupons = 3
+	// maxStackCandidates 参与叠加组合枚举的优惠券数量上限，超出时只保留单张优惠最大的部分
+	maxStackCandidates = 20
+)
+
+// Item 参与优惠计算的订单商品项
+type Item struct {
+	SkuID      string
+	CategoryID string
+	BrandID    string
+	Amount     decimal.Decimal // 商品项原价小计
+}
+
+// AppliedCoupon 优惠方案中的一张优惠券及其优惠金额
+type AppliedCoupon struct {
+	Coupon *Coupon
+	Amount decimal.Decimal
+}
+
+// Plan 订单的优惠方案
+type Plan struct {
+	Coupons        []AppliedCoupon
+	DiscountAmount decimal.Decimal
+}
+
+// emptyPlan 不使用优惠券
+func emptyPlan() *Plan {
+	return &Plan{DiscountAmount: decimal.Zero}
+}
+
+// Applies 模板的适用范围是否包含该商品项
+func (t *Template) Applies(item Item) bool {
+	switch t.ScopeType {
+	case ScopeTypeAll:
+		return true
+	case ScopeTypeCategory:
+		return item.CategoryID != "" && slices.Contains(t.ScopeIDs, item.CategoryID)
+	case ScopeTypeBrand:
+		return item.BrandID != "" && slices.Contains(t.ScopeIDs, item.BrandID)
+	case ScopeTypeSku:
+		return slices.Contains(t.ScopeIDs, item.SkuID)
+	default:
+		return false
+	}
+}
+
+// discount 计算优惠金额，remaining 为各商品项扣除前序优惠券后的剩余金额
+// 使用门槛按适用商品的原价判断，优惠金额不超过适用商品的剩余金额；不满足门槛时返回零。
+func (t *Template) discount(items []Item, remaining []decimal.Decimal) decimal.Decimal {
+	base, left := decimal.Zero, decimal.Zero
+	for i, item := range items {
+		if t.Applies(item) {
+			base = base.Add(item.Amount)
+			left = left.Add(remaining[i])
+		}
+	}
+	if !base.IsPositive() || base.LessThan(t.Threshold) {
+		return decimal.Zero
+	}
+
+	var amount decimal.Decimal
+	switch t.Type {
+	case CouponTypeFixed:
+		amount = t.Amount
+	case CouponTypePercent:
+		amount = left.Mul(decimal.NewFromInt32(t.PercentOff)).Div(decimal.NewFromInt(100)).Truncate(2)
+	case CouponTypeSpendAndGet:
+		amount = t.Amount
+		if t.Repeatable {
+			amount = t.Amount.Mul(base.Div(t.Threshold).Floor())
+		}
+	default:
+		return decimal.Zero
+	}
+
+	if t.MaxDiscount.IsPositive() {
+		amount = decimal.Min(amount, t.MaxDiscount)
+	}
+	return decimal.Min(amount, left)
+}
+
+// NewPlan 按用户指定的优惠券生成优惠方案
+// 优惠券不可用时返回 ErrCouponUnavailable，不能同时使用时返回 ErrCouponNotStackable，不满足使用条件时返回 ErrCouponNotApplicable。
+func NewPlan(coupons []*Coupon, items []Item, now time.Time) (*Plan, error) {
+	if len(coupons) == 0 {
+		return emptyPlan(), nil
+	}
+	for _, coupon := range coupons {
+		if !coupon.Usable(now) {
+			return nil, ErrCouponUnavailable
+		}
+	}
+	if !stackable(coupons) {
+		return nil, ErrCouponNotStackable
+	}
+
+	plan, ok := apply(coupons, items)
+	if !ok {
+		return nil, ErrCouponNotApplicable
+	}
+	return plan, nil
+}
+
+// BestPlan 从用户的优惠券中选择优惠金额最大的组合
+// 不可叠加的优惠券只能单独使用，可叠加的优惠券每个模板最多使用一张、最多 MaxStackedCoupons 张；
+// 优惠金额相同时选择张数更少、过期更早的组合。没有可用的优惠券时返回不使用优惠券的方案。
+func BestPlan(coupons []*Coupon, items []Item, now time.Time) *Plan {
+	candidates := candidateCoupons(coupons, items, now)
+
+	best := emptyPlan()
+	consider := func(plan *Plan) {
+		if plan.DiscountAmount.GreaterThan(best.DiscountAmount) ||
+			(plan.DiscountAmount.Equal(best.DiscountAmount) && len(plan.Coupons) < len(best.Coupons)) {
+			best = plan
+		}
+	}
+
+	var stackables []*Coupon
+	for _, candidate := range candidates {
+		consider(candidate.plan)
+		if candidate.coupon.Template.Stackable {
+			stackables = append(stackables, candidate.coupon)
+		}
+	}
+
+	// 枚举可叠加优惠券的组合，stackables 中每个模板只有一张
+	var combination []*Coupon
+	var enumerate func(start int)
+	enumerate = func(start int) {
+		if len(combination) >= 2 {
+			if plan, ok := apply(combination, items); ok {
+				consider(plan)
+			}
+		}
+		if len(combination) == MaxStackedCoupons {
+			return
+		}
+		for i := start; i < len(stackables); i++ {
+			combination = append(combination, stackables[i])
+			enumerate(i + 1)
+			combination = combination[:len(combination)-1]
+		}
+	}
+	enumerate(0)
+
+	return best
+}
+
+// candidate 可以单独使用的优惠券及其单独使用时的方案
+type candidate struct {
+	coupon *Coupon
+	plan   *Plan
+}
+
+// candidateCoupons 筛选当前可用且能为订单带来优惠的优惠券
+// 同一模板的优惠券规则相同，只保留最早过期的一张；结果按单张优惠金额从大到小排列，可叠加的最多保留 maxStackCandidates 张。
+func candidateCoupons(coupons []*Coupon, items []Item, now time.Time) []candidate {
+	byTemplate := make(map[string]*Coupon)
+	for _, coupon := range coupons {
+		if coupon.Template == nil || !coupon.Usable(now) {
+			continue
+		}
+		if kept, ok := byTemplate[coupon.TemplateID]; ok && !expiresBefore(coupon, kept) {
+			continue
+		}
+		byTemplate[coupon.TemplateID] = coupon
+	}
+
+	candidates := make([]candidate, 0, len(byTemplate))
+	for _, coupon := range byTemplate {
+		if plan, ok := apply([]*Coupon{coupon}, items); ok {
+			candidates = append(candidates, candidate{coupon: coupon, plan: plan})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if cmp := candidates[i].plan.DiscountAmount.Cmp(candidates[j].plan.DiscountAmount); cmp != 0 {
+			return cmp > 0
+		}
+		return expiresBefore(candidates[i].coupon, candidates[j].coupon)
+	})
+
+	stacked := 0
+	kept := candidates[:0]
+	for _, candidate := range candidates {
+		if candidate.coupon.Template.Stackable {
+			if stacked == maxStackCandidates {
+				continue
+			}
+			stacked++
+		}
+		kept = append(kept, candidate)
+	}
+	return kept
+}
+
+// expiresBefore a 是否比 b 更早过期，过期时间相同时按ID排序保证结果稳定
+func expiresBefore(a, b *Coupon) bool {
+	if a.ValidTo != b.ValidTo {
+		return a.ValidTo < b.ValidTo
+	}
+	return a.ID < b.ID
+}
+
+// stackable 这些优惠券能否同时使用
+func stackable(coupons []*Coupon) bool {
+	if len(coupons) == 1 {
+		return true
+	}
+	if len(coupons) > MaxStackedCoupons {
+		return false
+	}
+
+	templates := make(map[string]bool, len(coupons))
+	for _, coupon := range coupons {
+		if !coupon.Template.Stackable || templates[coupon.TemplateID] {
+			return false
+		}
+		templates[coupon.TemplateID] = true
+	}
+	return true
+}
+
+// apply 依次使用优惠券并把优惠金额分摊到适用的商品项
+// 立减券和满减券先于折扣券使用，折扣券按扣除前序优惠后的金额打折；任一张优惠券没有带来优惠时返回 false。
+func apply(coupons []*Coupon, items []Item) (*Plan, bool) {
+	ordered := slices.Clone(coupons)
+	slices.SortStableFunc(ordered, func(a, b *Coupon) int {
+		return percentRank(a) - percentRank(b)
+	})
+
+	remaining := make([]decimal.Decimal, len(items))
+	for i, item := range items {
+		remaining[i] = item.Amount
+	}
+
+	plan := emptyPlan()
+	for _, coupon := range ordered {
+		amount := coupon.Template.discount(items, remaining)
+		if !amount.IsPositive() {
+			return nil, false
+		}
+		allocate(coupon.Template, items, remaining, amount)
+		plan.Coupons = append(plan.Coupons, AppliedCoupon{Coupon: coupon, Amount: amount})
+		plan.DiscountAmount = plan.DiscountAmount.Add(amount)
+	}
+	return plan, true
+}
+
+// percentRank 折扣券排在最后使用
+func percentRank(coupon *Coupon) int {
+	if coupon.Template.Type == CouponTypePercent {
+		return 1
+	}
+	return 0
+}
+
+// allocate 按剩余金额比例把优惠金额分摊到适用的商品项，amount 不超过适用商品项的剩余金额合计
+// 按比例向下取整到分，取整余下的零头依次分给还有剩余金额的商品项。
+func allocate(t *Template, items []Item, remaining []decimal.Decimal, amount decimal.Decimal) {
+	total := decimal.Zero
+	var eligible []int
+	for i, item := range items {
+		if t.Applies(item) && remaining[i].IsPositive() {
+			eligible = append(eligible, i)
+			total = total.Add(remaining[i])
+		}
+	}
+
+	shares := make([]decimal.Decimal, len(eligible))
+	left := amount
+	for k, i := range eligible {
+		shares[k] = amount.Mul(remaining[i]).Div(total).Truncate(2)
+		left = left.Sub(shares[k])
+	}
+	for k, i := range eligible {
+		if !left.IsPositive() {
+			break
+		}
+		extra := decimal.Min(left, remaining[i].Sub(shares[k]))
+		shares[k] = shares[k].Add(extra)
+		left = left.Sub(extra)
+	}
+
+	for k, i := range eligible {
+		remaining[i] = remaining[i].Sub(shares[k])
+	}
+}
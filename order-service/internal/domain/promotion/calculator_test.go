@@ -0,0 +1,215 @@
+package promotion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 6, 1, 12, 0, 0, 0, time.Local)
+
+func newTestCoupon(id string, template *Template) *Coupon {
+	if template.ID == "" {
+		template.ID = "tpl-" + id
+	}
+	return &Coupon{
+		ID:         id,
+		TemplateID: template.ID,
+		Status:     CouponStatusAvailable,
+		ValidFrom:  "2026-01-01 00:00:00",
+		ValidTo:    "2026-12-31 00:00:00",
+		Template:   template,
+	}
+}
+
+func testItems() []Item {
+	return []Item{
+		{SkuID: "sku-1", CategoryID: "phone", BrandID: "acme", Amount: decimal.RequireFromString("300")},
+		{SkuID: "sku-2", CategoryID: "case", BrandID: "acme", Amount: decimal.RequireFromString("50")},
+		{SkuID: "sku-3", CategoryID: "food", BrandID: "other", Amount: decimal.RequireFromString("20")},
+	}
+}
+
+func dec(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+func TestTemplateDiscount(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Template
+		want     string
+	}{
+		{name: "fixed", template: Template{Type: CouponTypeFixed, Amount: dec("10")}, want: "10"},
+		{name: "fixed below threshold", template: Template{Type: CouponTypeFixed, Amount: dec("10"), Threshold: dec("500")}, want: "0"},
+		{name: "fixed capped by scope amount", template: Template{Type: CouponTypeFixed, Amount: dec("30"), ScopeType: ScopeTypeSku, ScopeIDs: []string{"sku-3"}}, want: "20"},
+		{name: "percent", template: Template{Type: CouponTypePercent, PercentOff: 15}, want: "55.5"},
+		{name: "percent with cap", template: Template{Type: CouponTypePercent, PercentOff: 15, MaxDiscount: dec("40")}, want: "40"},
+		{name: "spend and get", template: Template{Type: CouponTypeSpendAndGet, Threshold: dec("100"), Amount: dec("20")}, want: "20"},
+		{name: "spend and get repeatable", template: Template{Type: CouponTypeSpendAndGet, Threshold: dec("100"), Amount: dec("20"), Repeatable: true}, want: "60"},
+		{name: "spend and get repeatable with cap", template: Template{Type: CouponTypeSpendAndGet, Threshold: dec("100"), Amount: dec("20"), Repeatable: true, MaxDiscount: dec("50")}, want: "50"},
+		{name: "category scope threshold", template: Template{Type: CouponTypeSpendAndGet, Threshold: dec("60"), Amount: dec("10"), ScopeType: ScopeTypeCategory, ScopeIDs: []string{"case", "food"}}, want: "10"},
+		{name: "brand scope below threshold", template: Template{Type: CouponTypeSpendAndGet, Threshold: dec("400"), Amount: dec("10"), ScopeType: ScopeTypeBrand, ScopeIDs: []string{"acme"}}, want: "0"},
+		{name: "scope not in order", template: Template{Type: CouponTypeFixed, Amount: dec("10"), ScopeType: ScopeTypeBrand, ScopeIDs: []string{"none"}}, want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := testItems()
+			remaining := make([]decimal.Decimal, len(items))
+			for i, item := range items {
+				remaining[i] = item.Amount
+			}
+			got := tt.template.discount(items, remaining)
+			assert.True(t, dec(tt.want).Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestBestPlan(t *testing.T) {
+	t.Run("no usable coupons", func(t *testing.T) {
+		expired := newTestCoupon("expired", &Template{Type: CouponTypeFixed, Amount: dec("10")})
+		expired.ValidTo = "2026-05-01 00:00:00"
+		locked := newTestCoupon("locked", &Template{Type: CouponTypeFixed, Amount: dec("10")})
+		locked.Status = CouponStatusLocked
+
+		plan := BestPlan([]*Coupon{expired, locked}, testItems(), testNow)
+		assert.Empty(t, plan.Coupons)
+		assert.True(t, plan.DiscountAmount.IsZero())
+	})
+
+	t.Run("exclusive coupon beats weaker stack", func(t *testing.T) {
+		exclusive := newTestCoupon("exclusive", &Template{Type: CouponTypeSpendAndGet, Threshold: dec("300"), Amount: dec("60")})
+		fixed := newTestCoupon("fixed", &Template{Type: CouponTypeFixed, Amount: dec("20"), Stackable: true})
+		percent := newTestCoupon("percent", &Template{Type: CouponTypePercent, PercentOff: 10, MaxDiscount: dec("30"), Stackable: true})
+
+		plan := BestPlan([]*Coupon{fixed, percent, exclusive}, testItems(), testNow)
+		require.Len(t, plan.Coupons, 1)
+		assert.Equal(t, "exclusive", plan.Coupons[0].Coupon.ID)
+		assert.True(t, dec("60").Equal(plan.DiscountAmount))
+	})
+
+	t.Run("stack applies fixed before percent", func(t *testing.T) {
+		fixed := newTestCoupon("fixed", &Template{Type: CouponTypeFixed, Amount: dec("70"), Stackable: true})
+		percent := newTestCoupon("percent", &Template{Type: CouponTypePercent, PercentOff: 10, Stackable: true})
+		exclusive := newTestCoupon("exclusive", &Template{Type: CouponTypeFixed, Amount: dec("90")})
+
+		plan := BestPlan([]*Coupon{percent, exclusive, fixed}, testItems(), testNow)
+		require.Len(t, plan.Coupons, 2)
+		assert.Equal(t, "fixed", plan.Coupons[0].Coupon.ID)
+		assert.Equal(t, "percent", plan.Coupons[1].Coupon.ID)
+		// (370 - 70) * 10% = 30
+		assert.True(t, dec("30").Equal(plan.Coupons[1].Amount))
+		assert.True(t, dec("100").Equal(plan.DiscountAmount))
+	})
+
+	t.Run("one coupon per template and earliest expiry", func(t *testing.T) {
+		template := &Template{Type: CouponTypeFixed, Amount: dec("10"), Stackable: true}
+		later := newTestCoupon("later", template)
+		sooner := newTestCoupon("sooner", template)
+		sooner.ValidTo = "2026-07-01 00:00:00"
+
+		plan := BestPlan([]*Coupon{later, sooner}, testItems(), testNow)
+		require.Len(t, plan.Coupons, 1)
+		assert.Equal(t, "sooner", plan.Coupons[0].Coupon.ID)
+	})
+
+	t.Run("stack limited to max coupons", func(t *testing.T) {
+		var coupons []*Coupon
+		for _, id := range []string{"a", "b", "c", "d", "e"} {
+			coupons = append(coupons, newTestCoupon(id, &Template{Type: CouponTypeFixed, Amount: dec("5"), Stackable: true}))
+		}
+
+		plan := BestPlan(coupons, testItems(), testNow)
+		assert.Len(t, plan.Coupons, MaxStackedCoupons)
+		assert.True(t, dec("15").Equal(plan.DiscountAmount))
+	})
+
+	t.Run("discount never exceeds order amount", func(t *testing.T) {
+		first := newTestCoupon("first", &Template{Type: CouponTypeFixed, Amount: dec("300"), Stackable: true})
+		second := newTestCoupon("second", &Template{Type: CouponTypeFixed, Amount: dec("300"), Stackable: true})
+
+		plan := BestPlan([]*Coupon{first, second}, testItems(), testNow)
+		assert.True(t, dec("370").Equal(plan.DiscountAmount))
+	})
+}
+
+func TestNewPlan(t *testing.T) {
+	fixed := newTestCoupon("fixed", &Template{Type: CouponTypeFixed, Amount: dec("10"), Stackable: true})
+	percent := newTestCoupon("percent", &Template{Type: CouponTypePercent, PercentOff: 10, Stackable: true})
+	exclusive := newTestCoupon("exclusive", &Template{Type: CouponTypeFixed, Amount: dec("5")})
+	unreachable := newTestCoupon("unreachable", &Template{Type: CouponTypeSpendAndGet, Threshold: dec("1000"), Amount: dec("100")})
+	used := newTestCoupon("used", &Template{Type: CouponTypeFixed, Amount: dec("10")})
+	used.Status = CouponStatusUsed
+
+	plan, err := NewPlan([]*Coupon{percent, fixed}, testItems(), testNow)
+	require.NoError(t, err)
+	assert.True(t, dec("46").Equal(plan.DiscountAmount))
+
+	// 用户指定的优惠券即使不是最优也按指定使用
+	plan, err = NewPlan([]*Coupon{exclusive}, testItems(), testNow)
+	require.NoError(t, err)
+	assert.True(t, dec("5").Equal(plan.DiscountAmount))
+
+	_, err = NewPlan([]*Coupon{fixed, exclusive}, testItems(), testNow)
+	assert.ErrorIs(t, err, ErrCouponNotStackable)
+	_, err = NewPlan([]*Coupon{unreachable}, testItems(), testNow)
+	assert.ErrorIs(t, err, ErrCouponNotApplicable)
+	_, err = NewPlan([]*Coupon{used}, testItems(), testNow)
+	assert.ErrorIs(t, err, ErrCouponUnavailable)
+}
+
+func TestAllocate(t *testing.T) {
+	items := []Item{
+		{SkuID: "sku-1", Amount: dec("1")},
+		{SkuID: "sku-2", Amount: dec("1")},
+		{SkuID: "sku-3", Amount: dec("1")},
+	}
+	remaining := []decimal.Decimal{dec("1"), dec("1"), dec("1")}
+	template := &Template{Type: CouponTypeFixed, Amount: dec("2")}
+
+	// 按比例取整后余下的零头分给第一个商品项，分摊合计等于优惠金额
+	allocate(template, items, remaining, dec("2"))
+	assert.Equal(t, []string{"0.32", "0.34", "0.34"}, []string{remaining[0].String(), remaining[1].String(), remaining[2].String()})
+}
+
+func TestTemplateValidate(t *testing.T) {
+	valid := Template{Name: "满100减20", Type: CouponTypeSpendAndGet, Threshold: dec("100"), Amount: dec("20"), ValidFrom: "2026-01-01 00:00:00", ValidTo: "2026-02-01 00:00:00"}
+	require.NoError(t, valid.Validate())
+
+	invalid := []func(t *Template){
+		func(t *Template) { t.Name = "" },
+		func(t *Template) { t.ValidTo = t.ValidFrom },
+		func(t *Template) { t.Amount = dec("100") },
+		func(t *Template) { t.ScopeType = ScopeTypeSku },
+		func(t *Template) { t.ScopeIDs = []string{"sku-1"} },
+		func(t *Template) { t.Type = CouponTypePercent },
+		func(t *Template) { t.Type = CouponTypeUnknown },
+		func(t *Template) { t.PerUserLimit = -1 },
+	}
+	for _, mutate := range invalid {
+		template := valid
+		mutate(&template)
+		assert.ErrorIs(t, template.Validate(), ErrInvalidTemplate)
+	}
+}
+
+func TestClaim(t *testing.T) {
+	template := &Template{ID: "tpl-1", TotalQuantity: 2, IssuedQuantity: 1, ValidFrom: "2026-05-01 00:00:00", ValidTo: "2026-07-01 00:00:00"}
+	require.NoError(t, template.Claimable(testNow))
+
+	coupon := template.NewCoupon("user-1", testNow)
+	assert.Equal(t, "2026-07-01 00:00:00", coupon.ValidTo)
+	assert.True(t, coupon.Usable(testNow))
+
+	template.ValidDays = 7
+	coupon = template.NewCoupon("user-1", testNow)
+	assert.Equal(t, "2026-06-08 12:00:00", coupon.ValidTo)
+
+	template.IssuedQuantity = 2
+	assert.ErrorIs(t, template.Claimable(testNow), ErrCouponSoldOut)
+	assert.ErrorIs(t, template.Claimable(testNow.AddDate(0, 2, 0)), ErrTemplateNotClaimable)
+}
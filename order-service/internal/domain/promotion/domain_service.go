@@ -0,0 +1,82 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DomainService 优惠券领域服务
+type DomainService interface {
+	// 校验并创建优惠券模板
+	CreateTemplate(ctx context.Context, template *Template) error
+
+	// 用户领取优惠券
+	Claim(ctx context.Context, userID, templateID string) (*Coupon, error)
+
+	// 为订单生成优惠方案：couponIDs 为空时从用户可用的优惠券中选择优惠最大的组合，否则使用指定的优惠券
+	Plan(ctx context.Context, userID string, items []Item, couponIDs []string) (*Plan, error)
+}
+
+// domainService 优惠券领域服务实现
+type domainService struct {
+	promotionRepo Repository
+}
+
+// NewDomainService 创建优惠券领域服务
+func NewDomainService(promotionRepo Repository) DomainService {
+	return &domainService{
+		promotionRepo: promotionRepo,
+	}
+}
+
+// CreateTemplate 校验并创建优惠券模板
+func (ds *domainService) CreateTemplate(ctx context.Context, template *Template) error {
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	template.IssuedQuantity = 0
+	return ds.promotionRepo.CreateTemplate(ctx, template)
+}
+
+// Claim 用户领取优惠券，发行总量和每人限领由仓储在事务中检查
+func (ds *domainService) Claim(ctx context.Context, userID, templateID string) (*Coupon, error) {
+	template, err := ds.promotionRepo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := template.Claimable(now); err != nil {
+		return nil, err
+	}
+
+	coupon := template.NewCoupon(userID, now)
+	if err := ds.promotionRepo.Claim(ctx, coupon); err != nil {
+		return nil, err
+	}
+	return coupon, nil
+}
+
+// Plan 为订单生成优惠方案
+func (ds *domainService) Plan(ctx context.Context, userID string, items []Item, couponIDs []string) (*Plan, error) {
+	now := time.Now()
+	if len(couponIDs) == 0 {
+		coupons, err := ds.promotionRepo.ListByUserID(ctx, userID, CouponStatusAvailable)
+		if err != nil {
+			return nil, fmt.Errorf("获取可用优惠券失败: %w", err)
+		}
+		return BestPlan(coupons, items, now), nil
+	}
+
+	couponIDs = slices.Compact(slices.Sorted(slices.Values(couponIDs)))
+	coupons, err := ds.promotionRepo.GetByIDs(ctx, userID, couponIDs)
+	if err != nil {
+		return nil, fmt.Errorf("获取优惠券失败: %w", err)
+	}
+	if len(coupons) != len(couponIDs) {
+		return nil, ErrCouponNotFound
+	}
+	return NewPlan(coupons, items, now)
+}
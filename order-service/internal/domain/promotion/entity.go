@@ -0,0 +1,153 @@
+package promotion
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// timeLayout 实体中时间字段的格式，该格式的字符串按字典序比较即按时间先后比较
+const timeLayout = "2006-01-02 15:04:05"
+
+// CouponType 优惠券类型
+type CouponType int32
+
+const (
+	CouponTypeUnknown     CouponType = 0
+	CouponTypeFixed       CouponType = 1 // 立减券：减免固定金额
+	CouponTypePercent     CouponType = 2 // 折扣券：按比例减免，可设置最高优惠金额
+	CouponTypeSpendAndGet CouponType = 3 // 满减券：满 X 减 Y，可设置为每满 X 减 Y
+)
+
+// ScopeType 优惠券适用范围
+type ScopeType int32
+
+const (
+	ScopeTypeAll      ScopeType = 0 // 全部商品
+	ScopeTypeCategory ScopeType = 1 // 指定分类
+	ScopeTypeBrand    ScopeType = 2 // 指定品牌
+	ScopeTypeSku      ScopeType = 3 // 指定SKU
+)
+
+// CouponStatus 用户优惠券状态
+type CouponStatus int32
+
+const (
+	CouponStatusAvailable CouponStatus = 1 // 可使用
+	CouponStatusLocked    CouponStatus = 2 // 已锁定：下单未支付，订单取消后退回
+	CouponStatusUsed      CouponStatus = 3 // 已使用：订单已支付
+)
+
+// Template 优惠券模板，运营创建后用户领取为 Coupon
+type Template struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Type        CouponType      `json:"type"`
+	Amount      decimal.Decimal `json:"amount"`       // 立减券、满减券的减免金额
+	PercentOff  int32           `json:"percent_off"`  // 折扣券的减免比例（1-99），15 表示 85 折
+	Threshold   decimal.Decimal `json:"threshold"`    // 使用门槛：适用商品原价合计满该金额才可使用，0 表示无门槛；满减券必填
+	MaxDiscount decimal.Decimal `json:"max_discount"` // 单张券的最高优惠金额，0 表示不限
+	Repeatable  bool            `json:"repeatable"`   // 满减券是否每满 Threshold 减 Amount
+	ScopeType   ScopeType       `json:"scope_type"`
+	ScopeIDs    []string        `json:"scope_ids"` // 适用的分类、品牌或SKU ID
+	Stackable   bool            `json:"stackable"` // 能否与其他可叠加的优惠券同时使用
+	// TotalQuantity 发行总量，0 表示不限；IssuedQuantity 已领取数量
+	TotalQuantity  int32  `json:"total_quantity"`
+	IssuedQuantity int32  `json:"issued_quantity"`
+	PerUserLimit   int32  `json:"per_user_limit"` // 每人限领张数，0 表示不限
+	ValidFrom      string `json:"valid_from"`     // 领取开始时间
+	ValidTo        string `json:"valid_to"`       // 领取截止时间，ValidDays 为 0 时也是优惠券的过期时间
+	ValidDays      int32  `json:"valid_days"`     // 领取后的有效天数，0 表示统一在 ValidTo 过期
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+// Validate 校验优惠券模板
+func (t *Template) Validate() error {
+	switch {
+	case t.Name == "" || !validTime(t.ValidFrom) || !validTime(t.ValidTo) || t.ValidFrom >= t.ValidTo:
+		return ErrInvalidTemplate
+	case t.Threshold.IsNegative() || t.MaxDiscount.IsNegative():
+		return ErrInvalidTemplate
+	case t.TotalQuantity < 0 || t.PerUserLimit < 0 || t.ValidDays < 0:
+		return ErrInvalidTemplate
+	case (t.ScopeType == ScopeTypeAll) != (len(t.ScopeIDs) == 0):
+		return ErrInvalidTemplate
+	case t.ScopeType < ScopeTypeAll || t.ScopeType > ScopeTypeSku:
+		return ErrInvalidTemplate
+	}
+
+	switch t.Type {
+	case CouponTypeFixed:
+		if !t.Amount.IsPositive() {
+			return ErrInvalidTemplate
+		}
+	case CouponTypePercent:
+		if t.PercentOff <= 0 || t.PercentOff >= 100 {
+			return ErrInvalidTemplate
+		}
+	case CouponTypeSpendAndGet:
+		if !t.Amount.IsPositive() || !t.Threshold.GreaterThan(t.Amount) {
+			return ErrInvalidTemplate
+		}
+	default:
+		return ErrInvalidTemplate
+	}
+	return nil
+}
+
+// validTime 时间字段是否符合 timeLayout 格式
+func validTime(value string) bool {
+	_, err := time.Parse(timeLayout, value)
+	return err == nil
+}
+
+// Claimable 检查当前是否可以领取该模板的优惠券，已领取数量由仓储在领取时加锁检查
+func (t *Template) Claimable(now time.Time) error {
+	current := now.Format(timeLayout)
+	if current < t.ValidFrom || current >= t.ValidTo {
+		return ErrTemplateNotClaimable
+	}
+	if t.TotalQuantity > 0 && t.IssuedQuantity >= t.TotalQuantity {
+		return ErrCouponSoldOut
+	}
+	return nil
+}
+
+// NewCoupon 为用户发放一张该模板的优惠券
+func (t *Template) NewCoupon(userID string, now time.Time) *Coupon {
+	validTo := t.ValidTo
+	if t.ValidDays > 0 {
+		validTo = now.AddDate(0, 0, int(t.ValidDays)).Format(timeLayout)
+	}
+	return &Coupon{
+		TemplateID: t.ID,
+		UserID:     userID,
+		Status:     CouponStatusAvailable,
+		ValidFrom:  now.Format(timeLayout),
+		ValidTo:    validTo,
+		Template:   t,
+	}
+}
+
+// Coupon 用户领取的优惠券
+type Coupon struct {
+	ID             string          `json:"id"`
+	TemplateID     string          `json:"template_id"`
+	UserID         string          `json:"user_id"`
+	Status         CouponStatus    `json:"status"`
+	OrderID        string          `json:"order_id"`        // 锁定或使用该券的订单
+	DiscountAmount decimal.Decimal `json:"discount_amount"` // 在订单中的优惠金额
+	ValidFrom      string          `json:"valid_from"`
+	ValidTo        string          `json:"valid_to"`
+	CreatedAt      string          `json:"created_at"`
+	UpdatedAt      string          `json:"updated_at"`
+	// Template 优惠券的使用规则，仓储查询优惠券时一并加载
+	Template *Template `json:"template"`
+}
+
+// Usable 优惠券当前是否可以使用
+func (c *Coupon) Usable(now time.Time) bool {
+	current := now.Format(timeLayout)
+	return c.Status == CouponStatusAvailable && c.ValidFrom <= current && current < c.ValidTo
+}
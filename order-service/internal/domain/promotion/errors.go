@@ -0,0 +1,16 @@
+package promotion
+
+import "errors"
+
+// 优惠券领域错误定义
+var (
+	ErrTemplateNotFound     = errors.New("coupon template not found")
+	ErrInvalidTemplate      = errors.New("invalid coupon template")
+	ErrTemplateNotClaimable = errors.New("coupon template not claimable")
+	ErrCouponSoldOut        = errors.New("coupons sold out")
+	ErrClaimLimitReached    = errors.New("coupon claim limit reached")
+	ErrCouponNotFound       = errors.New("coupon not found")
+	ErrCouponNotApplicable  = errors.New("coupon not applicable to order")
+	ErrCouponNotStackable   = errors.New("coupons cannot be used together")
+	ErrCouponUnavailable    = errors.New("coupon already used or expired")
+)
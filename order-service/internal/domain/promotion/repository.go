@@ -0,0 +1,23 @@
+package promotion
+
+import "context"
+
+// Repository 优惠券仓储接口
+// 下单锁定、取消退回和支付核销优惠券在订单仓储的事务中完成，见 order.Repository。
+type Repository interface {
+	// 创建优惠券模板
+	CreateTemplate(ctx context.Context, template *Template) error
+
+	// 获取优惠券模板，不存在时返回 ErrTemplateNotFound
+	GetTemplate(ctx context.Context, id string) (*Template, error)
+
+	// 领取优惠券，同一事务中锁定模板检查发行总量和每人限领、发放优惠券并增加已领取数量
+	// 已领完时返回 ErrCouponSoldOut，超过每人限领时返回 ErrClaimLimitReached
+	Claim(ctx context.Context, coupon *Coupon) error
+
+	// 获取用户的优惠券（含模板），按过期时间正序；status 为 0 时返回全部状态
+	ListByUserID(ctx context.Context, userID string, status CouponStatus) ([]*Coupon, error)
+
+	// 批量获取用户的优惠券（含模板），不存在或不属于该用户的优惠券不出现在结果中
+	GetByIDs(ctx context.Context, userID string, ids []string) ([]*Coupon, error)
+}
@@ -3,12 +3,24 @@ package domain
 import (
 	"github.com/google/wire"
 
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/presale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
 )
 
 // ProviderSet 领域服务依赖注入提供者集合
 var ProviderSet = wire.NewSet(
 	order.NewDomainService,
 	cart.NewDomainService,
+	aftersale.NewDomainService,
+	purchaselimit.NewDomainService,
+	promotion.NewDomainService,
+	freight.NewDomainService,
+	flashsale.NewDomainService,
+	presale.NewDomainService,
 )
@@ -0,0 +1,103 @@
+package purchaselimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Item 需要检查限购的商品
+type Item struct {
+	SkuID    string
+	Quantity int32
+}
+
+// DomainService 限购领域服务
+type DomainService interface {
+	// 检查用户本次购买是否超出限购，超出时返回 *ExceededError；userID 为空（游客）时只检查每单限购
+	Check(ctx context.Context, userID string, items []Item) error
+}
+
+// domainService 限购领域服务实现
+type domainService struct {
+	limitRepo Repository
+}
+
+// NewDomainService 创建限购领域服务
+func NewDomainService(limitRepo Repository) DomainService {
+	return &domainService{
+		limitRepo: limitRepo,
+	}
+}
+
+// Check 检查用户本次购买是否超出限购
+// 已购买数量按下单时统计，同一用户并发下单时可能短暂超出每人限购。
+func (ds *domainService) Check(ctx context.Context, userID string, items []Item) error {
+	// 同一SKU的数量合并计算
+	skuIDs := make([]string, 0, len(items))
+	quantities := make(map[string]int32, len(items))
+	for _, item := range items {
+		if _, ok := quantities[item.SkuID]; !ok {
+			skuIDs = append(skuIDs, item.SkuID)
+		}
+		quantities[item.SkuID] += item.Quantity
+	}
+	if len(skuIDs) == 0 {
+		return nil
+	}
+
+	limits, err := ds.limitRepo.GetBySkuIDs(ctx, skuIDs)
+	if err != nil {
+		return fmt.Errorf("获取限购规则失败: %w", err)
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	purchased, err := ds.countPurchased(ctx, userID, limits, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, skuID := range skuIDs {
+		limit, ok := limits[skuID]
+		if !ok {
+			continue
+		}
+		if userID == "" {
+			// 游客没有购买记录，只检查每单限购
+			limit = &Limit{SkuID: limit.SkuID, MaxPerOrder: limit.MaxPerOrder}
+		}
+		if err := limit.Check(quantities[skuID], purchased[skuID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countPurchased 统计用户在各SKU限购周期内已购买的数量，统计周期相同的SKU合并查询
+func (ds *domainService) countPurchased(ctx context.Context, userID string, limits map[string]*Limit, now time.Time) (map[string]int32, error) {
+	purchased := make(map[string]int32)
+	if userID == "" {
+		return purchased, nil
+	}
+
+	byWindow := make(map[int32][]string)
+	for skuID, limit := range limits {
+		if limit.LimitsUser() {
+			byWindow[limit.WindowDays] = append(byWindow[limit.WindowDays], skuID)
+		}
+	}
+
+	for _, skuIDs := range byWindow {
+		since := limits[skuIDs[0]].WindowStart(now)
+		counts, err := ds.limitRepo.CountPurchased(ctx, userID, skuIDs, since)
+		if err != nil {
+			return nil, fmt.Errorf("统计已购买数量失败: %w", err)
+		}
+		for skuID, count := range counts {
+			purchased[skuID] = count
+		}
+	}
+	return purchased, nil
+}
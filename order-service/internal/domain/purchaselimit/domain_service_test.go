@@ -0,0 +1,121 @@
+package purchaselimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepository 内存限购规则仓储，记录每次统计已购买数量的开始时间
+type memoryRepository struct {
+	limits    map[string]*Limit
+	purchased map[string]int32
+	sinces    []time.Time
+}
+
+func (r *memoryRepository) GetBySkuID(_ context.Context, skuID string) (*Limit, error) {
+	if limit, ok := r.limits[skuID]; ok {
+		return limit, nil
+	}
+	return nil, ErrLimitNotFound
+}
+
+func (r *memoryRepository) GetBySkuIDs(_ context.Context, skuIDs []string) (map[string]*Limit, error) {
+	result := make(map[string]*Limit)
+	for _, skuID := range skuIDs {
+		if limit, ok := r.limits[skuID]; ok {
+			result[skuID] = limit
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) Save(_ context.Context, limit *Limit) error {
+	r.limits[limit.SkuID] = limit
+	return nil
+}
+
+func (r *memoryRepository) Delete(_ context.Context, skuID string) error {
+	delete(r.limits, skuID)
+	return nil
+}
+
+func (r *memoryRepository) CountPurchased(_ context.Context, _ string, skuIDs []string, since time.Time) (map[string]int32, error) {
+	r.sinces = append(r.sinces, since)
+	result := make(map[string]int32)
+	for _, skuID := range skuIDs {
+		result[skuID] = r.purchased[skuID]
+	}
+	return result, nil
+}
+
+func TestLimitCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     Limit
+		quantity  int32
+		purchased int32
+		wantScope Scope
+		wantLeft  int32
+	}{
+		{name: "within order limit", limit: Limit{MaxPerOrder: 5}, quantity: 5},
+		{name: "exceeds order limit", limit: Limit{MaxPerOrder: 5}, quantity: 6, wantScope: ScopeOrder, wantLeft: 5},
+		{name: "within user limit", limit: Limit{MaxPerUser: 10}, quantity: 4, purchased: 6},
+		{name: "exceeds user limit", limit: Limit{MaxPerUser: 10, WindowDays: 30}, quantity: 5, purchased: 6, wantScope: ScopeUser, wantLeft: 4},
+		{name: "user limit used up", limit: Limit{MaxPerUser: 3}, quantity: 1, purchased: 5, wantScope: ScopeUser, wantLeft: 0},
+		{name: "user limit tighter than order limit", limit: Limit{MaxPerOrder: 5, MaxPerUser: 10}, quantity: 5, purchased: 8, wantScope: ScopeUser, wantLeft: 2},
+		{name: "order limit tighter than user limit", limit: Limit{MaxPerOrder: 2, MaxPerUser: 10}, quantity: 3, purchased: 1, wantScope: ScopeOrder, wantLeft: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.limit.SkuID = "sku-1"
+			err := tt.limit.Check(tt.quantity, tt.purchased)
+			if tt.wantScope == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var exceeded *ExceededError
+			require.True(t, errors.As(err, &exceeded))
+			assert.ErrorIs(t, err, ErrLimitExceeded)
+			assert.Equal(t, tt.wantScope, exceeded.Scope)
+			assert.Equal(t, tt.wantLeft, exceeded.Remaining)
+		})
+	}
+}
+
+func TestDomainServiceCheck(t *testing.T) {
+	repo := &memoryRepository{
+		limits: map[string]*Limit{
+			"sku-1": {SkuID: "sku-1", MaxPerOrder: 5, MaxPerUser: 6, WindowDays: 30},
+			"sku-2": {SkuID: "sku-2", MaxPerUser: 2},
+		},
+		purchased: map[string]int32{"sku-1": 3, "sku-2": 1},
+	}
+	ds := NewDomainService(repo)
+	ctx := context.Background()
+
+	// 没有限购规则的商品不受限制，同一SKU的数量合并计算
+	require.NoError(t, ds.Check(ctx, "user-1", []Item{{SkuID: "sku-1", Quantity: 2}, {SkuID: "sku-1", Quantity: 1}, {SkuID: "sku-2", Quantity: 1}, {SkuID: "sku-3", Quantity: 100}}))
+	// 统计周期不同的SKU分别统计已购买数量
+	require.Len(t, repo.sinces, 2)
+
+	err := ds.Check(ctx, "user-1", []Item{{SkuID: "sku-1", Quantity: 2}, {SkuID: "sku-1", Quantity: 2}})
+	var exceeded *ExceededError
+	require.True(t, errors.As(err, &exceeded))
+	assert.Equal(t, ScopeUser, exceeded.Scope)
+	assert.Equal(t, int32(3), exceeded.Remaining)
+	assert.Equal(t, "该商品每人每 30 天限购 6 件，您还可购买 3 件", exceeded.Message())
+
+	// 游客只检查每单限购
+	require.NoError(t, ds.Check(ctx, "", []Item{{SkuID: "sku-2", Quantity: 10}}))
+	err = ds.Check(ctx, "", []Item{{SkuID: "sku-1", Quantity: 6}})
+	require.True(t, errors.As(err, &exceeded))
+	assert.Equal(t, ScopeOrder, exceeded.Scope)
+	assert.Equal(t, "该商品每单限购 5 件", exceeded.Message())
+}
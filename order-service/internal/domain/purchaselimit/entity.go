@@ -0,0 +1,65 @@
+package purchaselimit
+
+import "time"
+
+// Limit 商品SKU的限购规则
+type Limit struct {
+	SkuID       string `json:"sku_id"`
+	ProductID   string `json:"product_id"`
+	MaxPerOrder int32  `json:"max_per_order"` // 每单限购数量，0 表示不限
+	MaxPerUser  int32  `json:"max_per_user"`  // 每人在统计周期内的限购数量，0 表示不限
+	WindowDays  int32  `json:"window_days"`   // 每人限购的统计周期（天），0 表示不限时间
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// Validate 校验限购规则
+func (l *Limit) Validate() error {
+	switch {
+	case l.SkuID == "" || l.ProductID == "":
+		return ErrInvalidLimit
+	case l.MaxPerOrder < 0 || l.MaxPerUser < 0 || l.WindowDays < 0:
+		return ErrInvalidLimit
+	case l.MaxPerOrder == 0 && l.MaxPerUser == 0:
+		return ErrInvalidLimit
+	}
+	return nil
+}
+
+// LimitsUser 是否限制每人购买数量
+func (l *Limit) LimitsUser() bool {
+	return l.MaxPerUser > 0
+}
+
+// WindowStart 每人限购统计周期的开始时间，不限时间时返回零值
+func (l *Limit) WindowStart(now time.Time) time.Time {
+	if l.WindowDays <= 0 {
+		return time.Time{}
+	}
+	return now.AddDate(0, 0, -int(l.WindowDays))
+}
+
+// Check 检查本次购买数量是否超出限购，purchased 为统计周期内已购买的数量
+// 同时受每单和每人限购时，以剩余数量更少的规则为准。
+func (l *Limit) Check(quantity, purchased int32) error {
+	exceeded := &ExceededError{SkuID: l.SkuID, Remaining: -1}
+	if l.MaxPerOrder > 0 {
+		exceeded.Scope = ScopeOrder
+		exceeded.Limit = l.MaxPerOrder
+		exceeded.Remaining = l.MaxPerOrder
+	}
+	if l.LimitsUser() {
+		remaining := max(l.MaxPerUser-purchased, 0)
+		if exceeded.Remaining < 0 || remaining < exceeded.Remaining {
+			exceeded.Scope = ScopeUser
+			exceeded.Limit = l.MaxPerUser
+			exceeded.WindowDays = l.WindowDays
+			exceeded.Remaining = remaining
+		}
+	}
+
+	if exceeded.Remaining < 0 || quantity <= exceeded.Remaining {
+		return nil
+	}
+	return exceeded
+}
@@ -0,0 +1,51 @@
+package purchaselimit
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 限购领域错误定义
+var (
+	ErrLimitNotFound = errors.New("purchase limit not found")
+	ErrInvalidLimit  = errors.New("invalid purchase limit")
+	ErrLimitExceeded = errors.New("purchase limit exceeded")
+)
+
+// Scope 限购维度
+type Scope string
+
+const (
+	ScopeOrder Scope = "order" // 每单限购
+	ScopeUser  Scope = "user"  // 每人限购
+)
+
+// ExceededError 超出限购错误，记录触发的限购规则和剩余可购买数量
+type ExceededError struct {
+	SkuID      string
+	Scope      Scope
+	Limit      int32 // 触发的限购数量
+	WindowDays int32 // 每人限购的统计周期（天），0 表示不限时间
+	Remaining  int32 // 本次最多还能购买的数量
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("sku %s exceeds %s purchase limit %d, remaining %d", e.SkuID, e.Scope, e.Limit, e.Remaining)
+}
+
+// Unwrap 支持 errors.Is(err, ErrLimitExceeded)
+func (e *ExceededError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// Message 面向用户的提示信息
+func (e *ExceededError) Message() string {
+	switch {
+	case e.Scope == ScopeOrder:
+		return fmt.Sprintf("该商品每单限购 %d 件", e.Limit)
+	case e.WindowDays > 0:
+		return fmt.Sprintf("该商品每人每 %d 天限购 %d 件，您还可购买 %d 件", e.WindowDays, e.Limit, e.Remaining)
+	default:
+		return fmt.Sprintf("该商品每人限购 %d 件，您还可购买 %d 件", e.Limit, e.Remaining)
+	}
+}
@@ -0,0 +1,24 @@
+package purchaselimit
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 限购规则仓储接口
+type Repository interface {
+	// 获取SKU的限购规则，不存在时返回 ErrLimitNotFound
+	GetBySkuID(ctx context.Context, skuID string) (*Limit, error)
+
+	// 批量获取限购规则，没有规则的SKU不出现在结果中
+	GetBySkuIDs(ctx context.Context, skuIDs []string) (map[string]*Limit, error)
+
+	// 保存限购规则，已存在时覆盖
+	Save(ctx context.Context, limit *Limit) error
+
+	// 删除限购规则
+	Delete(ctx context.Context, skuID string) error
+
+	// 统计用户自 since 起下单购买的各SKU数量，已取消和已退款的订单不计入；since 为零值时统计全部订单
+	CountPurchased(ctx context.Context, userID string, skuIDs []string, since time.Time) (map[string]int32, error)
+}
@@ -0,0 +1,128 @@
+package saga
+
+import "time"
+
+// SagaStatus Saga状态
+type SagaStatus int32
+
+const (
+	SagaStatusUnknown      SagaStatus = 0
+	SagaStatusRunning      SagaStatus = 1 // 执行中
+	SagaStatusCompensating SagaStatus = 2 // 补偿中
+	SagaStatusCompleted    SagaStatus = 3 // 已完成
+	SagaStatusCompensated  SagaStatus = 4 // 已补偿
+)
+
+// Saga类型
+const (
+	TypeCreateOrder = "create_order" // 下单
+)
+
+// Step Saga步骤，记录的是最近一个已完成的步骤
+type Step string
+
+const (
+	StepStarted           Step = "started"            // 已开始，订单尚未确认落库
+	StepOrderPersisted    Step = "order_persisted"    // 订单已落库
+	StepInventoryReserved Step = "inventory_reserved" // 库存已预占
+	StepPaymentCreated    Step = "payment_created"    // 支付单已创建
+)
+
+// createOrderSteps 下单Saga的步骤顺序
+var createOrderSteps = []Step{StepStarted, StepOrderPersisted, StepInventoryReserved, StepPaymentCreated}
+
+// Action 步骤动作
+type Action string
+
+const (
+	ActionExecute    Action = "execute"    // 正向执行
+	ActionCompensate Action = "compensate" // 补偿
+)
+
+// Saga 分布式事务实体
+type Saga struct {
+	ID          string   `json:"id"`
+	OrderID     string   `json:"order_id"`
+	Type        string   `json:"type"`
+	Status      int32    `json:"status"`
+	CurrentStep Step     `json:"current_step"`
+	LastError   string   `json:"last_error"`
+	CartItemIDs []string `json:"cart_item_ids"` // 购物车结算时随订单落库移除的购物车项，补偿时恢复
+	RetryCount  int32    `json:"retry_count"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	Version     int32    `json:"version"`
+}
+
+// StepLog Saga步骤日志
+type StepLog struct {
+	ID        string `json:"id"`
+	SagaID    string `json:"saga_id"`
+	Step      Step   `json:"step"`
+	Action    Action `json:"action"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error"`
+	CreatedAt string `json:"created_at"`
+}
+
+// NewCreateOrderSaga 创建下单Saga
+func NewCreateOrderSaga(orderID string) *Saga {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	return &Saga{
+		OrderID:     orderID,
+		Type:        TypeCreateOrder,
+		Status:      int32(SagaStatusRunning),
+		CurrentStep: StepStarted,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsFinished 检查Saga是否已结束
+func (s *Saga) IsFinished() bool {
+	return s.Status == int32(SagaStatusCompleted) || s.Status == int32(SagaStatusCompensated)
+}
+
+// Reached 检查Saga是否已完成指定步骤
+func (s *Saga) Reached(step Step) bool {
+	return stepIndex(s.CurrentStep) >= stepIndex(step)
+}
+
+// NextStep 获取下一个待执行的步骤，全部完成时返回空
+func (s *Saga) NextStep() Step {
+	i := stepIndex(s.CurrentStep)
+	if i < 0 || i+1 >= len(createOrderSteps) {
+		return ""
+	}
+	return createOrderSteps[i+1]
+}
+
+// Advance 标记步骤完成，最后一步完成时Saga结束
+func (s *Saga) Advance(step Step) {
+	s.CurrentStep = step
+	if s.NextStep() == "" {
+		s.Status = int32(SagaStatusCompleted)
+	}
+}
+
+// StartCompensation 进入补偿阶段
+func (s *Saga) StartCompensation(cause error) {
+	s.Status = int32(SagaStatusCompensating)
+	if cause != nil {
+		s.LastError = cause.Error()
+	}
+}
+
+// FinishCompensation 补偿完成
+func (s *Saga) FinishCompensation() {
+	s.Status = int32(SagaStatusCompensated)
+}
+
+func stepIndex(step Step) int {
+	for i, s := range createOrderSteps {
+		if s == step {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,61 @@
+package saga
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSagaAdvance(t *testing.T) {
+	sg := NewCreateOrderSaga("order-123")
+	assert.Equal(t, StepStarted, sg.CurrentStep)
+	assert.Equal(t, int32(SagaStatusRunning), sg.Status)
+
+	steps := []Step{StepOrderPersisted, StepInventoryReserved, StepPaymentCreated}
+	for _, step := range steps {
+		assert.Equal(t, step, sg.NextStep())
+		assert.False(t, sg.IsFinished())
+		sg.Advance(step)
+		assert.True(t, sg.Reached(step))
+	}
+
+	assert.Equal(t, Step(""), sg.NextStep())
+	assert.Equal(t, int32(SagaStatusCompleted), sg.Status)
+	assert.True(t, sg.IsFinished())
+}
+
+func TestSagaReached(t *testing.T) {
+	tests := []struct {
+		name    string
+		current Step
+		step    Step
+		want    bool
+	}{
+		{name: "same step", current: StepOrderPersisted, step: StepOrderPersisted, want: true},
+		{name: "later step reached", current: StepInventoryReserved, step: StepOrderPersisted, want: true},
+		{name: "order not persisted", current: StepStarted, step: StepOrderPersisted, want: false},
+		{name: "unknown step", current: Step("unknown"), step: StepStarted, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := &Saga{CurrentStep: tt.current}
+			assert.Equal(t, tt.want, sg.Reached(tt.step))
+		})
+	}
+}
+
+func TestSagaCompensation(t *testing.T) {
+	sg := NewCreateOrderSaga("order-123")
+	sg.Advance(StepOrderPersisted)
+
+	sg.StartCompensation(errors.New("insufficient stock"))
+	assert.Equal(t, int32(SagaStatusCompensating), sg.Status)
+	assert.Equal(t, "insufficient stock", sg.LastError)
+	assert.False(t, sg.IsFinished())
+
+	sg.FinishCompensation()
+	assert.Equal(t, int32(SagaStatusCompensated), sg.Status)
+	assert.True(t, sg.IsFinished())
+}
@@ -0,0 +1,9 @@
+package saga
+
+import "errors"
+
+// Saga领域错误定义
+var (
+	ErrSagaNotFound = errors.New("saga not found")
+	ErrSagaConflict = errors.New("saga modified concurrently")
+)
@@ -0,0 +1,27 @@
+package saga
+
+import (
+	"context"
+	"time"
+)
+
+// Repository Saga仓储接口
+type Repository interface {
+	// 创建Saga
+	Create(ctx context.Context, saga *Saga) error
+
+	// 更新Saga（乐观锁），版本不一致时返回 ErrSagaConflict
+	Update(ctx context.Context, saga *Saga) error
+
+	// 根据订单ID获取Saga
+	GetByOrderID(ctx context.Context, orderID string) (*Saga, error)
+
+	// 获取在指定时间之前停止推进的未结束Saga
+	ListStale(ctx context.Context, before time.Time, limit int) ([]*Saga, error)
+
+	// 追加步骤日志
+	AppendLog(ctx context.Context, log *StepLog) error
+
+	// 获取步骤日志
+	GetLogs(ctx context.Context, sagaID string) ([]*StepLog, error)
+}
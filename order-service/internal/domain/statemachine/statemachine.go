@@ -0,0 +1,203 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidTransition 当前状态不允许触发该事件
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// Guard 转换条件，返回错误时拒绝转换
+type Guard[T any] func(ctx context.Context, subject T) error
+
+// Hook 转换钩子
+// Before 钩子在状态变更前执行，返回错误时中止转换；After 钩子在状态变更后执行。
+type Hook[T any] func(ctx context.Context, subject T) error
+
+// TransitionError 非法状态转换错误，记录当前状态和触发的事件
+type TransitionError[S, E comparable] struct {
+	Machine string
+	From    S
+	Event   E
+}
+
+func (e *TransitionError[S, E]) Error() string {
+	return fmt.Sprintf("%s: cannot %v from state %v", e.Machine, e.Event, e.From)
+}
+
+// Unwrap 支持 errors.Is(err, ErrInvalidTransition)
+func (e *TransitionError[S, E]) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Transition 事件声明的状态转换
+type Transition[S, E comparable, T any] struct {
+	Event  E
+	From   []S
+	To     S
+	guards []Guard[T]
+	before []Hook[T]
+	after  []Hook[T]
+}
+
+// Guard 添加转换条件
+func (t *Transition[S, E, T]) Guard(guards ...Guard[T]) *Transition[S, E, T] {
+	t.guards = append(t.guards, guards...)
+	return t
+}
+
+// Before 添加状态变更前执行的钩子
+func (t *Transition[S, E, T]) Before(hooks ...Hook[T]) *Transition[S, E, T] {
+	t.before = append(t.before, hooks...)
+	return t
+}
+
+// After 添加状态变更后执行的钩子
+func (t *Transition[S, E, T]) After(hooks ...Hook[T]) *Transition[S, E, T] {
+	t.after = append(t.after, hooks...)
+	return t
+}
+
+// Machine 声明式状态机
+// S 为状态类型，E 为事件类型，T 为状态所属的对象，状态通过 stateOf/setState 读写。
+// 状态机在初始化时声明，之后只读，可被多个 goroutine 同时使用。
+type Machine[S, E comparable, T any] struct {
+	name        string
+	initial     S
+	states      []S
+	transitions []*Transition[S, E, T]
+	stateOf     func(T) S
+	setState    func(T, S)
+}
+
+// New 创建状态机
+func New[S, E comparable, T any](name string, initial S, stateOf func(T) S, setState func(T, S)) *Machine[S, E, T] {
+	return &Machine[S, E, T]{
+		name:     name,
+		initial:  initial,
+		states:   []S{initial},
+		stateOf:  stateOf,
+		setState: setState,
+	}
+}
+
+// States 声明状态，顺序决定状态图中的排列
+func (m *Machine[S, E, T]) States(states ...S) *Machine[S, E, T] {
+	for _, state := range states {
+		if !slices.Contains(m.states, state) {
+			m.states = append(m.states, state)
+		}
+	}
+	return m
+}
+
+// Event 声明事件：对象处于 from 中任一状态时可触发，触发后进入 to
+func (m *Machine[S, E, T]) Event(event E, to S, from ...S) *Transition[S, E, T] {
+	if m.transition(event) != nil {
+		panic(fmt.Sprintf("%s: event %v declared twice", m.name, event))
+	}
+	m.States(from...)
+	m.States(to)
+
+	t := &Transition[S, E, T]{Event: event, From: from, To: to}
+	m.transitions = append(m.transitions, t)
+	return t
+}
+
+// Can 检查对象当前状态是否可以触发事件，不执行转换条件
+func (m *Machine[S, E, T]) Can(subject T, event E) bool {
+	return m.Check(subject, event) == nil
+}
+
+// Check 检查对象当前状态是否可以触发事件，不可以时返回 *TransitionError
+func (m *Machine[S, E, T]) Check(subject T, event E) error {
+	from := m.stateOf(subject)
+	if t := m.transition(event); t == nil || !slices.Contains(t.From, from) {
+		return &TransitionError[S, E]{Machine: m.name, From: from, Event: event}
+	}
+	return nil
+}
+
+// EventInto 返回进入 to 状态的事件
+func (m *Machine[S, E, T]) EventInto(to S) (E, bool) {
+	for _, t := range m.transitions {
+		if t.To == to {
+			return t.Event, true
+		}
+	}
+
+	var zero E
+	return zero, false
+}
+
+// Fire 触发事件：依次检查当前状态、转换条件，执行 Before 钩子、变更状态、执行 After 钩子
+// 返回转换前的状态。After 钩子返回错误时状态保持变更后的值，由调用方决定是否放弃本次修改。
+func (m *Machine[S, E, T]) Fire(ctx context.Context, subject T, event E) (S, error) {
+	from := m.stateOf(subject)
+	if err := m.Check(subject, event); err != nil {
+		return from, err
+	}
+
+	t := m.transition(event)
+	for _, guard := range t.guards {
+		if err := guard(ctx, subject); err != nil {
+			return from, err
+		}
+	}
+	for _, hook := range t.before {
+		if err := hook(ctx, subject); err != nil {
+			return from, err
+		}
+	}
+
+	m.setState(subject, t.To)
+
+	for _, hook := range t.after {
+		if err := hook(ctx, subject); err != nil {
+			return from, err
+		}
+	}
+	return from, nil
+}
+
+// Diagram 以 Mermaid stateDiagram-v2 格式输出状态图，终态指向 [*]
+func (m *Machine[S, E, T]) Diagram() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	fmt.Fprintf(&b, "    [*] --> %v\n", m.initial)
+	for _, t := range m.transitions {
+		for _, from := range t.From {
+			fmt.Fprintf(&b, "    %v --> %v: %v\n", from, t.To, t.Event)
+		}
+	}
+	for _, state := range m.states {
+		if m.isFinal(state) {
+			fmt.Fprintf(&b, "    %v --> [*]\n", state)
+		}
+	}
+	return b.String()
+}
+
+// isFinal 检查状态是否没有任何出边
+func (m *Machine[S, E, T]) isFinal(state S) bool {
+	for _, t := range m.transitions {
+		if slices.Contains(t.From, state) {
+			return false
+		}
+	}
+	return true
+}
+
+// transition 查找事件声明的转换
+func (m *Machine[S, E, T]) transition(event E) *Transition[S, E, T] {
+	for _, t := range m.transitions {
+		if t.Event == event {
+			return t
+		}
+	}
+	return nil
+}
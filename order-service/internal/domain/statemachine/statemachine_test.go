@@ -0,0 +1,101 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type door struct {
+	state string
+	trace []string
+}
+
+func newDoorMachine() *Machine[string, string, *door] {
+	m := New[string, string](
+		"door",
+		"closed",
+		func(d *door) string { return d.state },
+		func(d *door, state string) { d.state = state },
+	)
+	m.Event("open", "opened", "closed").
+		Before(func(_ context.Context, d *door) error {
+			d.trace = append(d.trace, "before:"+d.state)
+			return nil
+		}).
+		After(func(_ context.Context, d *door) error {
+			d.trace = append(d.trace, "after:"+d.state)
+			return nil
+		})
+	m.Event("close", "closed", "opened")
+	m.Event("lock", "locked", "closed").
+		Guard(func(_ context.Context, d *door) error {
+			if len(d.trace) == 0 {
+				return errors.New("never opened")
+			}
+			return nil
+		})
+	return m
+}
+
+func TestMachineFire(t *testing.T) {
+	m := newDoorMachine()
+	d := &door{state: "closed"}
+
+	from, err := m.Fire(context.Background(), d, "open")
+	require.NoError(t, err)
+	assert.Equal(t, "closed", from)
+	assert.Equal(t, "opened", d.state)
+	assert.Equal(t, []string{"before:closed", "after:opened"}, d.trace)
+
+	_, err = m.Fire(context.Background(), d, "open")
+	var transitionErr *TransitionError[string, string]
+	require.ErrorAs(t, err, &transitionErr)
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Equal(t, "opened", transitionErr.From)
+	assert.Equal(t, "open", transitionErr.Event)
+	assert.Equal(t, "opened", d.state)
+
+	_, err = m.Fire(context.Background(), d, "unknown")
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
+
+func TestMachineGuard(t *testing.T) {
+	m := newDoorMachine()
+
+	d := &door{state: "closed"}
+	_, err := m.Fire(context.Background(), d, "lock")
+	assert.EqualError(t, err, "never opened")
+	assert.Equal(t, "closed", d.state)
+
+	d.trace = []string{"opened before"}
+	_, err = m.Fire(context.Background(), d, "lock")
+	require.NoError(t, err)
+	assert.Equal(t, "locked", d.state)
+	assert.False(t, m.Can(d, "open"))
+}
+
+func TestMachineEventInto(t *testing.T) {
+	m := newDoorMachine()
+
+	event, ok := m.EventInto("locked")
+	assert.True(t, ok)
+	assert.Equal(t, "lock", event)
+
+	_, ok = m.EventInto("broken")
+	assert.False(t, ok)
+}
+
+func TestMachineDiagram(t *testing.T) {
+	want := `stateDiagram-v2
+    [*] --> closed
+    closed --> opened: open
+    opened --> closed: close
+    closed --> locked: lock
+    locked --> [*]
+`
+	assert.Equal(t, want, newDoorMachine().Diagram())
+}
@@ -8,6 +8,7 @@ var (
 	ErrInvalidRequest         = errors.New("invalid request")
 	ErrInsufficientStock      = errors.New("insufficient stock")
 	ErrPaymentFailed          = errors.New("payment failed")
+	ErrRefundFailed           = errors.New("refund failed")
 	ErrInventoryReserveFailed = errors.New("inventory reserve failed")
 	ErrInventoryConfirmFailed = errors.New("inventory confirm failed")
 	ErrInventoryReleaseFailed = errors.New("inventory release failed")
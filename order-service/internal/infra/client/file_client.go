@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	filepb "github.com/people257/poor-guy-shop/oss-infra/gen/proto/oss/file"
+)
+
+var ErrFileNotFound = errors.New("file not found")
+
+// FileInfo 文件信息
+type FileInfo struct {
+	ID       string
+	OwnerID  string
+	MimeType string
+	Category string
+}
+
+// FileServiceClient OSS文件服务客户端
+type FileServiceClient struct {
+	conn        *grpc.ClientConn
+	fileService filepb.FileServiceClient
+}
+
+// NewFileServiceClient 创建OSS文件服务客户端
+func NewFileServiceClient(cfg *config.ServiceConfig) (*FileServiceClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to oss service: %w", err)
+	}
+
+	return &FileServiceClient{
+		conn:        conn,
+		fileService: filepb.NewFileServiceClient(conn),
+	}, nil
+}
+
+// Close 关闭连接
+func (c *FileServiceClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GetFileInfo 获取文件信息，文件不存在或已删除时返回 ErrFileNotFound
+func (c *FileServiceClient) GetFileInfo(ctx context.Context, fileID string) (*FileInfo, error) {
+	resp, err := c.fileService.GetFileInfo(ctx, &filepb.GetFileInfoReq{
+		FileId: fileID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, NewClientError("oss", "GetFileInfo", ErrFileNotFound)
+		}
+		return nil, NewClientError("oss", "GetFileInfo", err)
+	}
+
+	info := resp.GetFileInfo()
+	return &FileInfo{
+		ID:       info.GetFileId(),
+		OwnerID:  info.GetOwnerId(),
+		MimeType: info.GetMimeType(),
+		Category: info.GetCategory(),
+	}, nil
+}
@@ -1,6 +1,9 @@
 package client
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // 定义简化的接口，避免跨服务proto依赖
 
@@ -12,8 +15,16 @@ type InventoryItem struct {
 
 // InventoryResponse 库存响应
 type InventoryResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success    bool                 `json:"success"`
+	Message    string               `json:"message"`
+	Shortfalls []InventoryShortfall `json:"shortfalls"`
+}
+
+// InventoryShortfall 库存不足时单个SKU的缺口
+type InventoryShortfall struct {
+	SkuID     string `json:"sku_id"`
+	Requested int32  `json:"requested"`
+	Available int32  `json:"available"`
 }
 
 // PaymentRequest 支付请求
@@ -35,16 +46,31 @@ type PaymentResponse struct {
 	PaymentParams map[string]string `json:"payment_params"`
 }
 
+// RefundRequest 退款请求
+type RefundRequest struct {
+	OrderID string `json:"order_id"`
+	Amount  string `json:"amount"`
+	Reason  string `json:"reason"`
+}
+
+// RefundResponse 退款响应
+type RefundResponse struct {
+	RefundID string `json:"refund_id"`
+	Status   string `json:"status"`
+}
+
 // InventoryServiceInterface 库存服务接口
 type InventoryServiceInterface interface {
-	ReserveInventory(ctx context.Context, orderID string, items []InventoryItem) (*InventoryResponse, error)
+	ReserveInventory(ctx context.Context, orderID string, items []InventoryItem, expiresAt time.Time) (*InventoryResponse, error)
 	ReleaseInventory(ctx context.Context, orderID string) (*InventoryResponse, error)
 	ConfirmInventory(ctx context.Context, orderID string) (*InventoryResponse, error)
+	RestockInventory(ctx context.Context, skuID string, quantity int32, reason string) error
 }
 
 // PaymentServiceInterface 支付服务接口
 type PaymentServiceInterface interface {
 	CreatePayment(ctx context.Context, req *PaymentRequest) (*PaymentResponse, error)
 	VerifyPayment(ctx context.Context, orderID string) (bool, error)
+	CreateRefund(ctx context.Context, req *RefundRequest) (*RefundResponse, error)
 }
 
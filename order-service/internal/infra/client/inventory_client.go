@@ -3,36 +3,35 @@ package client
 import (
 	"context"
 	"fmt"
-	"log"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	inventorypb "github.com/people257/poor-guy-shop/inventory-service/gen/proto/proto/inventory"
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
 )
 
 // InventoryServiceClient 库存服务客户端
 type InventoryServiceClient struct {
-	config *config.ServiceConfig
-	conn   *grpc.ClientConn
+	config           *config.ServiceConfig
+	conn             *grpc.ClientConn
+	inventoryService inventorypb.InventoryServiceClient
 }
 
 // NewInventoryServiceClient 创建库存服务客户端
 func NewInventoryServiceClient(cfg *config.ServiceConfig) (*InventoryServiceClient, error) {
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Printf("Warning: Failed to connect to inventory service at %s: %v. Using mock implementation.", addr, err)
-		// 连接失败时使用mock模式
-		return &InventoryServiceClient{
-			config: cfg,
-			conn:   nil,
-		}, nil
+		return nil, fmt.Errorf("failed to connect to inventory service: %w", err)
 	}
 
 	return &InventoryServiceClient{
-		config: cfg,
-		conn:   conn,
+		config:           cfg,
+		conn:             conn,
+		inventoryService: inventorypb.NewInventoryServiceClient(conn),
 	}, nil
 }
 
@@ -44,58 +43,101 @@ func (c *InventoryServiceClient) Close() error {
 	return nil
 }
 
-// ReserveInventory 预占库存
-func (c *InventoryServiceClient) ReserveInventory(ctx context.Context, orderID string, items []InventoryItem) (*InventoryResponse, error) {
-	if c.conn == nil {
-		// Mock模式：直接返回成功
-		log.Printf("Inventory client in mock mode: reserving inventory for order %s with %d items", orderID, len(items))
-		return &InventoryResponse{
-			Success: true,
-			Message: "库存预占成功 (Mock)",
-		}, nil
+// BatchGetAvailable 批量查询SKU的可用库存，库存服务中没有记录的SKU不出现在结果中
+func (c *InventoryServiceClient) BatchGetAvailable(ctx context.Context, skuIDs []string) (map[string]int32, error) {
+	resp, err := c.inventoryService.BatchGetInventory(ctx, &inventorypb.BatchGetInventoryReq{
+		SkuIds: skuIDs,
+	})
+	if err != nil {
+		return nil, NewClientError("inventory", "BatchGetInventory", err)
+	}
+
+	available := make(map[string]int32, len(resp.GetInventories()))
+	for _, inventory := range resp.GetInventories() {
+		available[inventory.GetSkuId()] = inventory.GetAvailableQuantity()
+	}
+	return available, nil
+}
+
+// ReserveInventory 预占库存，expiresAt 为零值时使用库存服务的默认过期时间
+func (c *InventoryServiceClient) ReserveInventory(ctx context.Context, orderID string, items []InventoryItem, expiresAt time.Time) (*InventoryResponse, error) {
+	reserveItems := make([]*inventorypb.ReserveItem, 0, len(items))
+	for _, item := range items {
+		reserveItems = append(reserveItems, &inventorypb.ReserveItem{
+			SkuId:    item.SkuID,
+			Quantity: item.Quantity,
+		})
+	}
+
+	req := &inventorypb.ReserveInventoryReq{
+		OrderId: orderID,
+		Items:   reserveItems,
+	}
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	resp, err := c.inventoryService.ReserveInventory(ctx, req)
+	if err != nil {
+		return nil, NewClientError("inventory", "ReserveInventory", err)
+	}
+
+	shortfalls := make([]InventoryShortfall, 0, len(resp.GetShortfalls()))
+	for _, shortfall := range resp.GetShortfalls() {
+		shortfalls = append(shortfalls, InventoryShortfall{
+			SkuID:     shortfall.GetSkuId(),
+			Requested: shortfall.GetRequested(),
+			Available: shortfall.GetAvailable(),
+		})
 	}
 
-	// TODO: 实现真实的gRPC调用
-	// 由于proto定义依赖问题，暂时使用mock
-	log.Printf("Inventory service connected but using mock implementation: reserving for order %s with %d items", orderID, len(items))
 	return &InventoryResponse{
-		Success: true,
-		Message: "库存预占成功 (Connected Mock)",
+		Success:    resp.GetSuccess(),
+		Message:    resp.GetMessage(),
+		Shortfalls: shortfalls,
 	}, nil
 }
 
 // ReleaseInventory 释放预占库存
 func (c *InventoryServiceClient) ReleaseInventory(ctx context.Context, orderID string) (*InventoryResponse, error) {
-	if c.conn == nil {
-		log.Printf("Inventory client in mock mode: releasing inventory for order %s", orderID)
-		return &InventoryResponse{
-			Success: true,
-			Message: "库存释放成功 (Mock)",
-		}, nil
+	resp, err := c.inventoryService.ReleaseReservedInventory(ctx, &inventorypb.ReleaseReservedInventoryReq{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, NewClientError("inventory", "ReleaseReservedInventory", err)
 	}
 
-	// TODO: 实现真实的gRPC调用
-	log.Printf("Inventory service connected but using mock implementation: releasing for order %s", orderID)
 	return &InventoryResponse{
-		Success: true,
-		Message: "库存释放成功 (Connected Mock)",
+		Success: resp.GetSuccess(),
+		Message: resp.GetMessage(),
 	}, nil
 }
 
 // ConfirmInventory 确认库存扣减
 func (c *InventoryServiceClient) ConfirmInventory(ctx context.Context, orderID string) (*InventoryResponse, error) {
-	if c.conn == nil {
-		log.Printf("Inventory client in mock mode: confirming inventory for order %s", orderID)
-		return &InventoryResponse{
-			Success: true,
-			Message: "库存确认成功 (Mock)",
-		}, nil
+	resp, err := c.inventoryService.ConfirmInventoryDeduction(ctx, &inventorypb.ConfirmInventoryDeductionReq{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, NewClientError("inventory", "ConfirmInventoryDeduction", err)
 	}
 
-	// TODO: 实现真实的gRPC调用
-	log.Printf("Inventory service connected but using mock implementation: confirming for order %s", orderID)
 	return &InventoryResponse{
-		Success: true,
-		Message: "库存确认成功 (Connected Mock)",
+		Success: resp.GetSuccess(),
+		Message: resp.GetMessage(),
 	}, nil
 }
+
+// RestockInventory 退货商品重新入库
+func (c *InventoryServiceClient) RestockInventory(ctx context.Context, skuID string, quantity int32, reason string) error {
+	_, err := c.inventoryService.UpdateInventory(ctx, &inventorypb.UpdateInventoryReq{
+		SkuId:    skuID,
+		Quantity: quantity,
+		Type:     inventorypb.InventoryChangeType_INVENTORY_CHANGE_TYPE_IN,
+		Reason:   reason,
+	})
+	if err != nil {
+		return NewClientError("inventory", "UpdateInventory", err)
+	}
+	return nil
+}
@@ -9,12 +9,14 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	paymentpb "github.com/people257/poor-guy-shop/payment-service/gen/proto/proto/payment"
 )
 
 // PaymentServiceClient 支付服务客户端
 type PaymentServiceClient struct {
-	config *config.ServiceConfig
-	conn   *grpc.ClientConn
+	config         *config.ServiceConfig
+	conn           *grpc.ClientConn
+	paymentService paymentpb.PaymentServiceClient
 }
 
 // NewPaymentServiceClient 创建支付服务客户端
@@ -31,8 +33,9 @@ func NewPaymentServiceClient(cfg *config.ServiceConfig) (*PaymentServiceClient,
 	}
 
 	return &PaymentServiceClient{
-		config: cfg,
-		conn:   conn,
+		config:         cfg,
+		conn:           conn,
+		paymentService: paymentpb.NewPaymentServiceClient(conn),
 	}, nil
 }
 
@@ -85,3 +88,41 @@ func (c *PaymentServiceClient) VerifyPayment(ctx context.Context, orderID string
 	log.Printf("Payment service connected but using mock verification for order %s", orderID)
 	return true, nil
 }
+
+// CreateRefund 按业务订单发起退款
+func (c *PaymentServiceClient) CreateRefund(ctx context.Context, req *RefundRequest) (*RefundResponse, error) {
+	// 未连接支付服务时不能伪造退款结果，否则售后单会记录从未发生的退款
+	if c.conn == nil {
+		return nil, NewClientError("payment", "CreateRefund", ErrServiceUnavailable)
+	}
+
+	// 支付服务按支付订单退款，先查出业务订单对应的支付订单
+	payment, err := c.paymentService.VerifyPaymentStatus(ctx, &paymentpb.VerifyPaymentStatusReq{
+		OrderId: req.OrderID,
+	})
+	if err != nil {
+		return nil, NewClientError("payment", "VerifyPaymentStatus", err)
+	}
+	if payment.GetPaymentId() == "" {
+		return nil, NewClientError("payment", "VerifyPaymentStatus", fmt.Errorf("%w: 订单 %s 没有支付记录", ErrRefundFailed, req.OrderID))
+	}
+
+	resp, err := c.paymentService.CreateRefund(ctx, &paymentpb.CreateRefundReq{
+		PaymentId: payment.GetPaymentId(),
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, NewClientError("payment", "CreateRefund", err)
+	}
+
+	refund := resp.GetRefund()
+	if refund.GetStatus() == paymentpb.RefundStatus_REFUND_STATUS_FAILED {
+		return nil, NewClientError("payment", "CreateRefund", fmt.Errorf("%w: 退款单 %s", ErrRefundFailed, refund.GetId()))
+	}
+
+	return &RefundResponse{
+		RefundID: refund.GetId(),
+		Status:   refund.GetStatus().String(),
+	}, nil
+}
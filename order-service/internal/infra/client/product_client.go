@@ -2,44 +2,44 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	productpb "github.com/people257/poor-guy-shop/product-service/gen/proto/proto/product/product"
 )
 
-// 临时定义，后续需要引入product-service的proto
-type ProductService interface {
-	GetProduct(ctx context.Context, req *GetProductReq, opts ...grpc.CallOption) (*GetProductResp, error)
-	ListProductSKUs(ctx context.Context, req *ListProductSKUsReq, opts ...grpc.CallOption) (*ListProductSKUsResp, error)
-}
+// ProductStatusActive 商品上架状态
+const ProductStatusActive = int32(productpb.ProductStatus_PRODUCT_STATUS_ACTIVE)
 
-type GetProductReq struct {
-	ID string
-}
+// batchGetConcurrency 批量获取商品时的最大并发请求数
+const batchGetConcurrency = 8
 
-type GetProductResp struct {
-	Product *Product
-}
-
-type ListProductSKUsReq struct {
-	ProductID string
-	IsActive  bool
-}
-
-type ListProductSKUsResp struct {
-	SKUs []*ProductSKU
-}
+var (
+	ErrProductNotFound = errors.New("product not found")
+	ErrSKUNotFound     = errors.New("sku not found")
+)
 
+// Product 商品信息
 type Product struct {
-	ID        string
-	Name      string
-	Status    int32
-	SalePrice string
+	ID           string
+	Name         string
+	Status       int32
+	SalePrice    string
+	MainImageURL string
+	IsVirtual    bool
+	CategoryID   string
+	BrandID      string
+	SKUs         []*ProductSKU
 }
 
+// ProductSKU 商品SKU信息
 type ProductSKU struct {
 	ID            string
 	ProductID     string
@@ -47,13 +47,15 @@ type ProductSKU struct {
 	Name          string
 	SalePrice     string
 	StockQuantity int32
+	Weight        int32
+	ImageURL      string
 	IsActive      bool
 }
 
 // ProductServiceClient 产品服务客户端
 type ProductServiceClient struct {
 	conn           *grpc.ClientConn
-	productService ProductService
+	productService productpb.ProductServiceClient
 }
 
 // NewProductServiceClient 创建产品服务客户端
@@ -65,73 +67,104 @@ func NewProductServiceClient(cfg *config.ServiceConfig) (*ProductServiceClient,
 		return nil, fmt.Errorf("failed to connect to product service: %w", err)
 	}
 
-	// 这里需要使用真实的product-service proto client
-	// productService := productpb.NewProductServiceClient(conn)
-
 	return &ProductServiceClient{
-		conn: conn,
-		// productService: productService,
+		conn:           conn,
+		productService: productpb.NewProductServiceClient(conn),
 	}, nil
 }
 
 // GetProduct 获取产品信息
 func (c *ProductServiceClient) GetProduct(ctx context.Context, productID string) (*Product, error) {
-	// req := &GetProductReq{ID: productID}
-	// resp, err := c.productService.GetProduct(ctx, req)
-	// if err != nil {
-	//     return nil, fmt.Errorf("failed to get product: %w", err)
-	// }
-	// return resp.Product, nil
-
-	// 临时返回固定产品信息
+	resp, err := c.productService.GetProduct(ctx, &productpb.GetProductReq{Id: productID})
+	if err != nil {
+		return nil, NewClientError("product", "GetProduct", err)
+	}
+	if resp.GetProduct() == nil {
+		return nil, NewClientError("product", "GetProduct", ErrProductNotFound)
+	}
+
+	p := resp.GetProduct()
+	skus := make([]*ProductSKU, 0, len(p.GetSkus()))
+	for _, sku := range p.GetSkus() {
+		skus = append(skus, skuFromProto(sku))
+	}
 	return &Product{
-		ID:        productID,
-		Name:      "Test Product",
-		Status:    2, // ACTIVE
-		SalePrice: "99.99",
+		ID:           p.GetId(),
+		Name:         p.GetName(),
+		Status:       int32(p.GetStatus()),
+		SalePrice:    p.GetSalePrice(),
+		MainImageURL: p.GetMainImageUrl(),
+		IsVirtual:    p.GetIsVirtual(),
+		CategoryID:   p.GetCategoryId(),
+		BrandID:      p.GetBrandId(),
+		SKUs:         skus,
 	}, nil
 }
 
-// GetProductSKU 获取产品SKU信息
-func (c *ProductServiceClient) GetProductSKU(ctx context.Context, productID string, skuID string) (*ProductSKU, error) {
-	// req := &ListProductSKUsReq{
-	//     ProductID: productID,
-	//     IsActive:  true,
-	// }
-	// resp, err := c.productService.ListProductSKUs(ctx, req)
-	// if err != nil {
-	//     return nil, fmt.Errorf("failed to get product SKUs: %w", err)
-	// }
-
-	// // 查找指定的SKU
-	// for _, sku := range resp.SKUs {
-	//     if sku.ID == skuID {
-	//         return sku, nil
-	//     }
-	// }
-
-	// return nil, fmt.Errorf("SKU not found: %s", skuID)
-
-	// 临时返回固定SKU信息
-	return &ProductSKU{
-		ID:            skuID,
-		ProductID:     productID,
-		SKUCode:       "TEST-SKU-001",
-		Name:          "Test SKU",
-		SalePrice:     "99.99",
-		StockQuantity: 100,
-		IsActive:      true,
-	}, nil
+// BatchGetProducts 批量获取商品信息（包含SKU），不存在的商品不出现在结果中
+// 商品服务没有批量接口，这里按商品并发调用 GetProduct。
+func (c *ProductServiceClient) BatchGetProducts(ctx context.Context, productIDs []string) (map[string]*Product, error) {
+	products := make([]*Product, len(productIDs))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(batchGetConcurrency)
+	for i, productID := range productIDs {
+		g.Go(func() error {
+			product, err := c.GetProduct(gctx, productID)
+			if err != nil {
+				if errors.Is(err, ErrProductNotFound) || status.Code(err) == codes.NotFound {
+					return nil
+				}
+				return err
+			}
+			products[i] = product
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*Product, len(productIDs))
+	for _, product := range products {
+		if product != nil {
+			result[product.ID] = product
+		}
+	}
+	return result, nil
 }
 
-// CheckStock 检查库存
-func (c *ProductServiceClient) CheckStock(ctx context.Context, skuID string, quantity int32) (bool, error) {
-	sku, err := c.GetProductSKU(ctx, "", skuID)
+// ListProductSKUs 获取产品下的可售SKU列表
+func (c *ProductServiceClient) ListProductSKUs(ctx context.Context, productID string) ([]*ProductSKU, error) {
+	resp, err := c.productService.ListProductSKUs(ctx, &productpb.ListProductSKUsReq{
+		ProductId: productID,
+		IsActive:  true,
+	})
 	if err != nil {
-		return false, err
+		return nil, NewClientError("product", "ListProductSKUs", err)
 	}
 
-	return sku.StockQuantity >= quantity, nil
+	skus := make([]*ProductSKU, 0, len(resp.GetSkus()))
+	for _, sku := range resp.GetSkus() {
+		skus = append(skus, skuFromProto(sku))
+	}
+	return skus, nil
+}
+
+// GetProductSKU 获取产品SKU信息
+func (c *ProductServiceClient) GetProductSKU(ctx context.Context, productID string, skuID string) (*ProductSKU, error) {
+	skus, err := c.ListProductSKUs(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sku := range skus {
+		if sku.ID == skuID {
+			return sku, nil
+		}
+	}
+
+	return nil, NewClientError("product", "GetProductSKU", fmt.Errorf("%w: %s", ErrSKUNotFound, skuID))
 }
 
 // Close 关闭连接
@@ -139,3 +172,16 @@ func (c *ProductServiceClient) Close() error {
 	return c.conn.Close()
 }
 
+func skuFromProto(sku *productpb.ProductSKU) *ProductSKU {
+	return &ProductSKU{
+		ID:            sku.GetId(),
+		ProductID:     sku.GetProductId(),
+		SKUCode:       sku.GetSkuCode(),
+		Name:          sku.GetName(),
+		SalePrice:     sku.GetSalePrice(),
+		StockQuantity: sku.GetStockQuantity(),
+		Weight:        sku.GetWeight(),
+		ImageURL:      sku.GetImageUrl(),
+		IsActive:      sku.GetIsActive(),
+	}
+}
@@ -11,6 +11,7 @@ var ClientProviderSet = wire.NewSet(
 	NewProductServiceClientFromConfig,
 	NewPaymentServiceClientFromConfig,
 	NewInventoryServiceClientFromConfig,
+	NewFileServiceClientFromConfig,
 	NewManagerFromConfig,
 )
 
@@ -34,6 +35,11 @@ func NewInventoryServiceClientFromConfig(cfg *config.ServicesConfig) (*Inventory
 	return NewInventoryServiceClient(&cfg.InventoryService)
 }
 
+// NewFileServiceClientFromConfig 从配置创建OSS文件服务客户端
+func NewFileServiceClientFromConfig(cfg *config.ServicesConfig) (*FileServiceClient, error) {
+	return NewFileServiceClient(&cfg.OSSService)
+}
+
 // NewManagerFromConfig 从配置创建客户端管理器
 func NewManagerFromConfig(cfg *config.ServicesConfig) (*Manager, error) {
 	return NewManager(cfg)
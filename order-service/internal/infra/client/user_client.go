@@ -2,16 +2,36 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/people257/poor-guy-shop/common/auth"
 	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	addresspb "github.com/people257/poor-guy-shop/user-service/gen/proto/user/address"
 )
 
+// ErrAddressNotFound 收货地址不存在或不属于当前用户
+var ErrAddressNotFound = errors.New("address not found")
+
+// Address 用户收货地址
+type Address struct {
+	ID            string
+	ReceiverName  string
+	ReceiverPhone string
+	Province      string
+	City          string
+	District      string
+	Street        string
+	PostalCode    string
+}
+
 // 临时定义，后续需要引入user-service的proto
 type AuthService interface {
 	AuthenticateRPC(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthenticateRPCResp, error)
@@ -25,8 +45,9 @@ type AuthenticateRPCResp struct {
 
 // UserServiceClient 用户服务客户端
 type UserServiceClient struct {
-	conn        *grpc.ClientConn
-	authService AuthService
+	conn           *grpc.ClientConn
+	authService    AuthService
+	addressService addresspb.AddressServiceClient
 }
 
 // NewUserServiceClient 创建用户服务客户端
@@ -44,6 +65,7 @@ func NewUserServiceClient(cfg *config.ServiceConfig) (*UserServiceClient, error)
 	return &UserServiceClient{
 		conn: conn,
 		// authService: authService,
+		addressService: addresspb.NewAddressServiceClient(conn),
 	}, nil
 }
 
@@ -63,6 +85,39 @@ func (c *UserServiceClient) AuthenticateUser(ctx context.Context, token string)
 	return "temp-user-id", nil
 }
 
+// GetAddress 获取当前用户的收货地址
+// 用户服务根据调用方token识别用户，因此需要透传请求中的authorization元数据。
+func (c *UserServiceClient) GetAddress(ctx context.Context, addressID string) (*Address, error) {
+	if tokens := metadata.ValueFromIncomingContext(ctx, auth.GrpcTokenMetadataKey); len(tokens) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.GrpcTokenMetadataKey, tokens[0])
+	}
+
+	resp, err := c.addressService.GetAddress(ctx, &addresspb.GetAddressReq{AddressId: addressID})
+	if err != nil {
+		// 地址不属于当前用户时同样按不存在处理，避免探测他人的地址ID
+		switch status.Code(err) {
+		case codes.NotFound, codes.PermissionDenied:
+			return nil, ErrAddressNotFound
+		}
+		return nil, NewClientError("user", "GetAddress", err)
+	}
+	if resp.GetAddress() == nil {
+		return nil, ErrAddressNotFound
+	}
+
+	addr := resp.GetAddress()
+	return &Address{
+		ID:            addr.GetAddressId(),
+		ReceiverName:  addr.GetReceiverName(),
+		ReceiverPhone: addr.GetReceiverPhone(),
+		Province:      addr.GetProvince(),
+		City:          addr.GetCity(),
+		District:      addr.GetDistrict(),
+		Street:        addr.GetStreet(),
+		PostalCode:    addr.GetPostalCode(),
+	}, nil
+}
+
 // Close 关闭连接
 func (c *UserServiceClient) Close() error {
 	return c.conn.Close()
@@ -0,0 +1,50 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// MemoryPublisher 进程内事件发布器，按发布顺序保存事件，用于测试
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []*order.OutboxMessage
+	err      error
+}
+
+// NewMemoryPublisher 创建进程内事件发布器
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish 发布事件
+func (p *MemoryPublisher) Publish(_ context.Context, message *order.OutboxMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+// Messages 返回已发布的事件
+func (p *MemoryPublisher) Messages() []*order.OutboxMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	messages := make([]*order.OutboxMessage, len(p.messages))
+	copy(messages, p.messages)
+	return messages
+}
+
+// SetError 设置后续发布返回的错误，用于模拟中间件不可用，传入 nil 时恢复
+func (p *MemoryPublisher) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
@@ -0,0 +1,29 @@
+package eventbus
+
+import (
+	"fmt"
+
+	"github.com/people257/poor-guy-shop/common/db"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+const (
+	// BrokerRedis Redis Streams
+	BrokerRedis = "redis"
+	// BrokerMemory 进程内队列，事件不会离开本进程，只用于测试和本地调试
+	BrokerMemory = "memory"
+)
+
+// NewPublisher 根据配置创建订单事件发布器，只有使用 redis 方式时才会连接 Redis
+func NewPublisher(cfg *config.OrderConfig, redisCfg *db.RedisConfig) (order.EventPublisher, error) {
+	switch cfg.Events.Broker {
+	case BrokerRedis:
+		return NewRedisStreamPublisher(db.NewRedis(redisCfg), cfg.Events.Stream, cfg.Events.MaxLen), nil
+	case BrokerMemory:
+		return NewMemoryPublisher(), nil
+	default:
+		return nil, fmt.Errorf("不支持的事件中间件: %s", cfg.Events.Broker)
+	}
+}
@@ -0,0 +1,50 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// RedisStreamPublisher 基于 Redis Streams 的订单事件发布器
+// 每个事件对应一条 Stream 消息，字段为 event_id、type、order_id 和 payload（事件JSON）。
+// 消费方使用消费组读取，投递至少一次，按 event_id 去重。
+type RedisStreamPublisher struct {
+	client redis.UniversalClient
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamPublisher 创建 Redis Streams 事件发布器，maxLen 大于 0 时按近似长度裁剪 Stream
+func NewRedisStreamPublisher(client redis.UniversalClient, stream string, maxLen int64) *RedisStreamPublisher {
+	return &RedisStreamPublisher{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+// Publish 发布事件
+func (p *RedisStreamPublisher) Publish(ctx context.Context, message *order.OutboxMessage) error {
+	args := &redis.XAddArgs{
+		Stream: p.stream,
+		Values: map[string]interface{}{
+			"event_id": message.ID,
+			"type":     string(message.Type),
+			"order_id": message.OrderID,
+			"payload":  string(message.Payload),
+		},
+	}
+	if p.maxLen > 0 {
+		args.MaxLen = p.maxLen
+		args.Approx = true
+	}
+
+	if err := p.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("发布订单事件失败: %w", err)
+	}
+	return nil
+}
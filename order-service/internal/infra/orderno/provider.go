@@ -0,0 +1,29 @@
+package orderno
+
+import (
+	"fmt"
+
+	"github.com/people257/poor-guy-shop/common/db"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+const (
+	// GeneratorSnowflake 机器号 + 毫秒内序号，不依赖外部存储
+	GeneratorSnowflake = "snowflake"
+	// GeneratorRedis Redis 按天递增的全局序号
+	GeneratorRedis = "redis"
+)
+
+// NewGenerator 根据配置创建订单号生成器，只有使用 redis 方式时才会连接 Redis
+func NewGenerator(cfg *config.OrderConfig, redisCfg *db.RedisConfig) (order.OrderNoGenerator, error) {
+	switch cfg.OrderNo.Generator {
+	case GeneratorSnowflake:
+		return NewSnowflakeGenerator(cfg.OrderNo.WorkerID, cfg.OrderNo.CheckDigit)
+	case GeneratorRedis:
+		return NewRedisGenerator(db.NewRedis(redisCfg), cfg.OrderNo.CheckDigit), nil
+	default:
+		return nil, fmt.Errorf("不支持的订单号生成方式: %s", cfg.OrderNo.Generator)
+	}
+}
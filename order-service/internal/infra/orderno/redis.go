@@ -0,0 +1,58 @@
+package orderno
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisSequenceKeyPrefix = "order:no:seq:"
+	// redisSequenceDigits 每天的序号位数
+	redisSequenceDigits = 10
+	maxRedisSequence    = 9_999_999_999
+	// redisSequenceTTL 序号键的保留时长，跨天后旧键自动过期
+	redisSequenceTTL = 48 * time.Hour
+)
+
+// RedisGenerator 基于 Redis 按天递增序号的订单号生成器
+// 订单号为 ORD + 年月日 + 10 位当天序号，所有实例共用同一个 INCR 计数器。
+type RedisGenerator struct {
+	client     redis.UniversalClient
+	checkDigit bool
+	now        func() time.Time
+}
+
+// NewRedisGenerator 创建 Redis 订单号生成器
+func NewRedisGenerator(client redis.UniversalClient, checkDigit bool) *RedisGenerator {
+	return &RedisGenerator{
+		client:     client,
+		checkDigit: checkDigit,
+		now:        time.Now,
+	}
+}
+
+// Generate 生成订单号
+func (g *RedisGenerator) Generate(ctx context.Context) (string, error) {
+	day := g.now().Format("20060102")
+	key := redisSequenceKeyPrefix + day
+
+	var incr *redis.IntCmd
+	_, err := g.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, redisSequenceTTL)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("获取订单序号失败: %w", err)
+	}
+
+	sequence := incr.Val()
+	if sequence > maxRedisSequence {
+		return "", fmt.Errorf("当天订单序号已用完: %d", sequence)
+	}
+
+	return format(fmt.Sprintf("%s%0*d", day, redisSequenceDigits, sequence), g.checkDigit), nil
+}
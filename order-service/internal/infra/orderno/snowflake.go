@@ -0,0 +1,79 @@
+package orderno
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+const (
+	workerIDBits = 10
+	sequenceBits = 12
+
+	// MaxWorkerID 最大机器号
+	MaxWorkerID = 1<<workerIDBits - 1
+	maxSequence = 1<<sequenceBits - 1
+)
+
+// snowflakeEpoch 时间戳起点，41 位毫秒时间戳可以使用约 69 年
+var snowflakeEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+// SnowflakeGenerator 雪花算法订单号生成器
+// 订单号为 ORD + 19 位定宽数字（毫秒时间戳 + 机器号 + 毫秒内序号），按字符串排序即大致按时间排序。
+type SnowflakeGenerator struct {
+	mu         sync.Mutex
+	workerID   int64
+	checkDigit bool
+	lastMillis int64
+	sequence   int64
+	now        func() time.Time
+}
+
+// NewSnowflakeGenerator 创建雪花算法订单号生成器，workerID 在所有实例中必须唯一
+func NewSnowflakeGenerator(workerID int64, checkDigit bool) (*SnowflakeGenerator, error) {
+	if workerID < 0 || workerID > MaxWorkerID {
+		return nil, fmt.Errorf("机器号必须在 0-%d 之间: %d", MaxWorkerID, workerID)
+	}
+
+	return &SnowflakeGenerator{
+		workerID:   workerID,
+		checkDigit: checkDigit,
+		now:        time.Now,
+	}, nil
+}
+
+// Generate 生成订单号
+func (g *SnowflakeGenerator) Generate(_ context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	millis := g.now().UnixMilli() - snowflakeEpoch
+	if millis < g.lastMillis {
+		// 时钟回拨时沿用上次的时间戳，序号继续递增保证不重复
+		millis = g.lastMillis
+	}
+	if millis == g.lastMillis {
+		g.sequence = (g.sequence + 1) & maxSequence
+		if g.sequence == 0 {
+			// 当前毫秒的序号已用完，借用下一毫秒
+			millis++
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.lastMillis = millis
+
+	id := millis<<(workerIDBits+sequenceBits) | g.workerID<<sequenceBits | g.sequence
+	return format(fmt.Sprintf("%019d", id), g.checkDigit), nil
+}
+
+// format 拼接订单号前缀和可选的校验位
+func format(digits string, checkDigit bool) string {
+	if checkDigit {
+		digits = order.AppendCheckDigit(digits)
+	}
+	return order.OrderNoPrefix + digits
+}
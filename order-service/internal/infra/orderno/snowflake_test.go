@@ -0,0 +1,116 @@
+package orderno
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+func TestSnowflakeGenerator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unique across workers", func(t *testing.T) {
+		const perWorker = 5000
+		var (
+			mu   sync.Mutex
+			seen = make(map[string]struct{})
+			wg   sync.WaitGroup
+		)
+		for workerID := int64(1); workerID <= 4; workerID++ {
+			generator, err := NewSnowflakeGenerator(workerID, true)
+			require.NoError(t, err)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < perWorker; i++ {
+					orderNo, err := generator.Generate(ctx)
+					assert.NoError(t, err)
+					mu.Lock()
+					seen[orderNo] = struct{}{}
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, seen, 4*perWorker)
+	})
+
+	t.Run("sortable and survives clock rollback", func(t *testing.T) {
+		generator, err := NewSnowflakeGenerator(7, false)
+		require.NoError(t, err)
+
+		base := time.Now()
+		clock := []time.Time{base, base, base.Add(-time.Second), base.Add(time.Millisecond)}
+		var orderNos []string
+		for _, now := range clock {
+			generator.now = func() time.Time { return now }
+			orderNo, err := generator.Generate(ctx)
+			require.NoError(t, err)
+			assert.Len(t, orderNo, len(order.OrderNoPrefix)+19)
+			orderNos = append(orderNos, orderNo)
+		}
+
+		assert.True(t, sort.StringsAreSorted(orderNos), "%v", orderNos)
+		assert.Len(t, map[string]bool{orderNos[0]: true, orderNos[1]: true, orderNos[2]: true, orderNos[3]: true}, 4)
+	})
+
+	t.Run("sequence overflow borrows next millisecond", func(t *testing.T) {
+		generator, err := NewSnowflakeGenerator(1, false)
+		require.NoError(t, err)
+		now := time.Now()
+		generator.now = func() time.Time { return now }
+
+		var last string
+		for i := 0; i <= maxSequence+1; i++ {
+			orderNo, err := generator.Generate(ctx)
+			require.NoError(t, err)
+			require.Greater(t, orderNo, last)
+			last = orderNo
+		}
+	})
+
+	t.Run("invalid worker id", func(t *testing.T) {
+		_, err := NewSnowflakeGenerator(MaxWorkerID+1, false)
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckDigit(t *testing.T) {
+	generator, err := NewSnowflakeGenerator(3, true)
+	require.NoError(t, err)
+	orderNo, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+
+	// 末位之前的数字替换为另一个数字
+	digits := []byte(orderNo)
+	typo := len(digits) - 2
+	digits[typo] = '0' + (digits[typo]-'0'+1)%10
+
+	tests := []struct {
+		name    string
+		orderNo string
+		want    bool
+	}{
+		{name: "generated", orderNo: orderNo, want: true},
+		{name: "known value", orderNo: "ORD79927398713", want: true},
+		{name: "single digit typo", orderNo: string(digits), want: false},
+		{name: "transposed digits", orderNo: "ORD79927398731", want: false},
+		{name: "not numeric", orderNo: "ORD7992739871X", want: false},
+		{name: "too short", orderNo: "ORD7", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, order.ValidCheckDigit(tt.orderNo))
+		})
+	}
+}
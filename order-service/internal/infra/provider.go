@@ -3,7 +3,10 @@ package infra
 import (
 	"github.com/google/wire"
 
+	"github.com/people257/poor-guy-shop/common/rate"
 	"github.com/people257/poor-guy-shop/order-service/internal/infra/client"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/eventbus"
+	"github.com/people257/poor-guy-shop/order-service/internal/infra/orderno"
 	"github.com/people257/poor-guy-shop/order-service/internal/infra/repository"
 )
 
@@ -11,5 +14,23 @@ import (
 var ProviderSet = wire.NewSet(
 	repository.NewOrderRepository,
 	repository.NewCartRepository,
+	repository.NewCartFlusher,
+	repository.NewGuestCartRepository,
+	repository.NewCartMergeQueue,
+	repository.NewSagaRepository,
+	repository.NewAfterSaleRepository,
+	repository.NewIdempotencyRepository,
+	repository.NewOutboxRepository,
+	repository.NewPurchaseLimitRepository,
+	repository.NewPromotionRepository,
+	repository.NewFreightRepository,
+	repository.NewFlashSaleRepository,
+	repository.NewFlashSaleStock,
+	repository.NewFlashSaleTicketQueue,
+	repository.NewPresaleRepository,
+	rate.NewSlidingWindowLimiter,
+	wire.Bind(new(rate.Limiter), new(*rate.SlidingWindowLimiter)),
+	orderno.NewGenerator,
+	eventbus.NewPublisher,
 	client.ClientProviderSet,
 )
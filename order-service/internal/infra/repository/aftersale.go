@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// afterSaleRepository 售后仓储实现
+type afterSaleRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewAfterSaleRepository 创建售后仓储
+func NewAfterSaleRepository(db *gorm.DB, q *query.Query) aftersale.Repository {
+	return &afterSaleRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// Create 锁定订单行后校验并创建售后申请及凭证
+func (r *afterSaleRepository) Create(ctx context.Context, afterSale *aftersale.AfterSale, check func(existing []*aftersale.AfterSale) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx)
+
+		o := q.Order
+		if _, err := q.WithContext(ctx).Order.Clauses(clause.Locking{Strength: "UPDATE"}).Where(o.ID.Eq(afterSale.OrderID)).First(); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return order.ErrOrderNotFound
+			}
+			return fmt.Errorf("锁定订单失败: %w", err)
+		}
+
+		a := q.AfterSale
+		existingModels, err := q.WithContext(ctx).AfterSale.Where(a.OrderID.Eq(afterSale.OrderID)).Find()
+		if err != nil {
+			return fmt.Errorf("获取订单售后申请失败: %w", err)
+		}
+		existing := make([]*aftersale.AfterSale, 0, len(existingModels))
+		for _, existingModel := range existingModels {
+			existing = append(existing, r.modelToDomain(existingModel))
+		}
+		if err := check(existing); err != nil {
+			return err
+		}
+
+		afterSaleModel := r.domainToModel(afterSale)
+		afterSaleModel.Version = 1
+		if err := q.WithContext(ctx).AfterSale.Create(afterSaleModel); err != nil {
+			return fmt.Errorf("创建售后申请失败: %w", err)
+		}
+
+		for _, fileID := range afterSale.EvidenceFileIDs {
+			evidence := &model.AfterSaleEvidence{
+				AfterSaleID: afterSaleModel.ID,
+				FileID:      fileID,
+			}
+			if err := q.WithContext(ctx).AfterSaleEvidence.Create(evidence); err != nil {
+				return fmt.Errorf("创建售后凭证失败: %w", err)
+			}
+		}
+
+		afterSale.ID = afterSaleModel.ID
+		afterSale.Version = afterSaleModel.Version
+		afterSale.CreatedAt = afterSaleModel.CreatedAt.Format("2006-01-02 15:04:05")
+		afterSale.UpdatedAt = afterSaleModel.UpdatedAt.Format("2006-01-02 15:04:05")
+		return nil
+	})
+}
+
+// GetByID 根据ID获取售后申请
+func (r *afterSaleRepository) GetByID(ctx context.Context, id string) (*aftersale.AfterSale, error) {
+	afterSaleModel, err := r.query.WithContext(ctx).AfterSale.Where(r.query.AfterSale.ID.Eq(id)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, aftersale.ErrAfterSaleNotFound
+		}
+		return nil, fmt.Errorf("获取售后申请失败: %w", err)
+	}
+
+	afterSales, err := r.withEvidences(ctx, []*model.AfterSale{afterSaleModel})
+	if err != nil {
+		return nil, err
+	}
+	return afterSales[0], nil
+}
+
+// ListByOrderID 获取订单的售后申请列表
+func (r *afterSaleRepository) ListByOrderID(ctx context.Context, orderID string) ([]*aftersale.AfterSale, error) {
+	a := r.query.AfterSale
+	afterSaleModels, err := r.query.WithContext(ctx).AfterSale.Where(a.OrderID.Eq(orderID)).Order(a.CreatedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取订单售后申请失败: %w", err)
+	}
+
+	return r.withEvidences(ctx, afterSaleModels)
+}
+
+// List 分页获取售后申请
+func (r *afterSaleRepository) List(ctx context.Context, userID string, status int32, page, pageSize int32) ([]*aftersale.AfterSale, int64, error) {
+	a := r.query.AfterSale
+	q := r.query.WithContext(ctx).AfterSale
+	if userID != "" {
+		q = q.Where(a.UserID.Eq(userID))
+	}
+	if status > 0 {
+		q = q.Where(a.Status.Eq(status))
+	}
+
+	// 获取总数
+	total, err := q.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取售后申请总数失败: %w", err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	afterSaleModels, err := q.Order(a.CreatedAt.Desc()).Limit(int(pageSize)).Offset(int(offset)).Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("获取售后申请列表失败: %w", err)
+	}
+
+	afterSales, err := r.withEvidences(ctx, afterSaleModels)
+	if err != nil {
+		return nil, 0, err
+	}
+	return afterSales, total, nil
+}
+
+// Update 更新售后申请（乐观锁）
+func (r *afterSaleRepository) Update(ctx context.Context, afterSale *aftersale.AfterSale) error {
+	afterSaleModel := r.domainToModel(afterSale)
+	afterSaleModel.Version = afterSale.Version + 1
+
+	a := r.query.AfterSale
+	result, err := r.query.WithContext(ctx).AfterSale.Where(a.ID.Eq(afterSale.ID), a.Version.Eq(afterSale.Version)).
+		Select(r.mutableColumns()...).
+		Updates(afterSaleModel)
+	if err != nil {
+		return fmt.Errorf("更新售后申请失败: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return aftersale.ErrAfterSaleConflict
+	}
+
+	afterSale.Version++
+	return nil
+}
+
+// mutableColumns 售后申请创建后可变更的列
+// 显式指定列，空值（如清除退款发起时间）也会写入，而不是被 Updates 忽略
+func (r *afterSaleRepository) mutableColumns() []field.Expr {
+	a := r.query.AfterSale
+	return []field.Expr{
+		a.Status, a.ApprovedAmount, a.RejectReason, a.OperatorID,
+		a.ReturnCarrierCode, a.ReturnTrackingNumber, a.RefundID, a.RefundRequestedAt,
+		a.ReviewedAt, a.ReceivedAt, a.RefundedAt, a.UpdatedAt, a.Version,
+	}
+}
+
+// withEvidences 加载售后凭证并转换为领域对象
+func (r *afterSaleRepository) withEvidences(ctx context.Context, afterSaleModels []*model.AfterSale) ([]*aftersale.AfterSale, error) {
+	afterSales := make([]*aftersale.AfterSale, 0, len(afterSaleModels))
+	if len(afterSaleModels) == 0 {
+		return afterSales, nil
+	}
+
+	ids := make([]string, 0, len(afterSaleModels))
+	byID := make(map[string]*aftersale.AfterSale, len(afterSaleModels))
+	for _, afterSaleModel := range afterSaleModels {
+		afterSale := r.modelToDomain(afterSaleModel)
+		afterSales = append(afterSales, afterSale)
+		byID[afterSale.ID] = afterSale
+		ids = append(ids, afterSale.ID)
+	}
+
+	e := r.query.AfterSaleEvidence
+	evidences, err := r.query.WithContext(ctx).AfterSaleEvidence.Where(e.AfterSaleID.In(ids...)).Order(e.CreatedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取售后凭证失败: %w", err)
+	}
+	for _, evidence := range evidences {
+		if afterSale, ok := byID[evidence.AfterSaleID]; ok {
+			afterSale.EvidenceFileIDs = append(afterSale.EvidenceFileIDs, evidence.FileID)
+		}
+	}
+
+	return afterSales, nil
+}
+
+// 领域对象转换为数据模型
+func (r *afterSaleRepository) domainToModel(afterSale *aftersale.AfterSale) *model.AfterSale {
+	afterSaleModel := &model.AfterSale{
+		ID:              afterSale.ID,
+		OrderID:         afterSale.OrderID,
+		OrderItemID:     afterSale.OrderItemID,
+		UserID:          afterSale.UserID,
+		Type:            afterSale.Type,
+		Status:          afterSale.Status,
+		Reason:          afterSale.Reason,
+		Quantity:        afterSale.Quantity,
+		RequestedAmount: afterSale.RequestedAmount,
+		ApprovedAmount:  afterSale.ApprovedAmount,
+		Version:         afterSale.Version,
+	}
+
+	if afterSale.Description != "" {
+		afterSaleModel.Description = &afterSale.Description
+	}
+	if afterSale.RejectReason != "" {
+		afterSaleModel.RejectReason = &afterSale.RejectReason
+	}
+	if afterSale.OperatorID != "" {
+		afterSaleModel.OperatorID = &afterSale.OperatorID
+	}
+	if afterSale.ReturnCarrierCode != "" {
+		afterSaleModel.ReturnCarrierCode = &afterSale.ReturnCarrierCode
+	}
+	if afterSale.ReturnTrackingNumber != "" {
+		afterSaleModel.ReturnTrackingNumber = &afterSale.ReturnTrackingNumber
+	}
+	if afterSale.RefundID != "" {
+		afterSaleModel.RefundID = &afterSale.RefundID
+	}
+
+	// 处理时间字段
+	afterSaleModel.ReviewedAt = parseLocalTime(afterSale.ReviewedAt)
+	afterSaleModel.ReceivedAt = parseLocalTime(afterSale.ReceivedAt)
+	afterSaleModel.RefundedAt = parseLocalTime(afterSale.RefundedAt)
+	afterSaleModel.RefundRequestedAt = parseLocalTime(afterSale.RefundRequestedAt)
+
+	return afterSaleModel
+}
+
+// 数据模型转换为领域对象
+func (r *afterSaleRepository) modelToDomain(afterSaleModel *model.AfterSale) *aftersale.AfterSale {
+	afterSale := &aftersale.AfterSale{
+		ID:              afterSaleModel.ID,
+		OrderID:         afterSaleModel.OrderID,
+		OrderItemID:     afterSaleModel.OrderItemID,
+		UserID:          afterSaleModel.UserID,
+		Type:            afterSaleModel.Type,
+		Status:          afterSaleModel.Status,
+		Reason:          afterSaleModel.Reason,
+		Quantity:        afterSaleModel.Quantity,
+		RequestedAmount: afterSaleModel.RequestedAmount,
+		ApprovedAmount:  afterSaleModel.ApprovedAmount,
+		CreatedAt:       afterSaleModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       afterSaleModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Version:         afterSaleModel.Version,
+	}
+
+	if afterSaleModel.Description != nil {
+		afterSale.Description = *afterSaleModel.Description
+	}
+	if afterSaleModel.RejectReason != nil {
+		afterSale.RejectReason = *afterSaleModel.RejectReason
+	}
+	if afterSaleModel.OperatorID != nil {
+		afterSale.OperatorID = *afterSaleModel.OperatorID
+	}
+	if afterSaleModel.ReturnCarrierCode != nil {
+		afterSale.ReturnCarrierCode = *afterSaleModel.ReturnCarrierCode
+	}
+	if afterSaleModel.ReturnTrackingNumber != nil {
+		afterSale.ReturnTrackingNumber = *afterSaleModel.ReturnTrackingNumber
+	}
+	if afterSaleModel.RefundID != nil {
+		afterSale.RefundID = *afterSaleModel.RefundID
+	}
+	if afterSaleModel.ReviewedAt != nil {
+		afterSale.ReviewedAt = afterSaleModel.ReviewedAt.Format("2006-01-02 15:04:05")
+	}
+	if afterSaleModel.ReceivedAt != nil {
+		afterSale.ReceivedAt = afterSaleModel.ReceivedAt.Format("2006-01-02 15:04:05")
+	}
+	if afterSaleModel.RefundedAt != nil {
+		afterSale.RefundedAt = afterSaleModel.RefundedAt.Format("2006-01-02 15:04:05")
+	}
+	if afterSaleModel.RefundRequestedAt != nil {
+		afterSale.RefundRequestedAt = afterSaleModel.RefundRequestedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return afterSale
+}
+
+// parseLocalTime 解析本地时间字符串，空字符串或格式错误时返回nil
+func parseLocalTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+func TestAfterSaleRepository_CreateConcurrent(t *testing.T) {
+	dsn := os.Getenv("ORDER_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("ORDER_TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	q := query.Use(db)
+	ctx := context.Background()
+
+	orderModel := &model.Order{
+		OrderNo:      "ORD" + uuid.NewString()[:8],
+		UserID:       uuid.NewString(),
+		Status:       int32(order.OrderStatusDelivered),
+		TotalAmount:  decimal.RequireFromString("50"),
+		ActualAmount: decimal.RequireFromString("50"),
+	}
+	require.NoError(t, q.WithContext(ctx).Order.Create(orderModel))
+	itemModel := &model.OrderItem{
+		OrderID:     orderModel.ID,
+		ProductID:   uuid.NewString(),
+		ProductName: "test",
+		Price:       decimal.RequireFromString("50"),
+		Quantity:    1,
+		TotalAmount: decimal.RequireFromString("50"),
+	}
+	require.NoError(t, q.WithContext(ctx).OrderItem.Create(itemModel))
+
+	orderEntity := &order.Order{ID: orderModel.ID, Status: orderModel.Status, ActualAmount: orderModel.ActualAmount}
+	item := &order.OrderItem{ID: itemModel.ID, Price: itemModel.Price, Quantity: itemModel.Quantity, TotalAmount: itemModel.TotalAmount}
+	repo := NewAfterSaleRepository(db, q)
+
+	// 同一商品项仅 1 件，并发申请只能有一个成功
+	const workers = 5
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			afterSale := &aftersale.AfterSale{
+				OrderID:     orderModel.ID,
+				OrderItemID: itemModel.ID,
+				UserID:      orderModel.UserID,
+				Type:        int32(aftersale.AfterSaleTypeRefundOnly),
+				Status:      int32(aftersale.AfterSaleStatusPending),
+				Reason:      int32(aftersale.AfterSaleReasonDamaged),
+				Quantity:    1,
+			}
+			errs[i] = repo.Create(ctx, afterSale, func(existing []*aftersale.AfterSale) error {
+				return aftersale.PlanAfterSale(orderEntity, item, existing, afterSale)
+			})
+		}()
+	}
+	wg.Wait()
+
+	var created int
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, aftersale.ErrQuantityExceedsOrder)
+	}
+	assert.Equal(t, 1, created)
+}
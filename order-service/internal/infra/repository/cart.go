@@ -3,22 +3,27 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
 	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
 	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
 )
 
-// cartRepository 购物车仓储实现
+// cartUpdateColumns 更新购物车项时写入的列，显式指定以便取消选中、数量等零值也能保存
+var cartUpdateColumns = []string{"quantity", "price", "selected", "version", "updated_at"}
+
+// cartRepository 购物车仓储实现，直接读写数据库
 type cartRepository struct {
 	db    *gorm.DB
 	query *query.Query
 }
 
-// NewCartRepository 创建购物车仓储
-func NewCartRepository(db *gorm.DB, q *query.Query) cart.Repository {
+// newPostgresCartRepository 创建直接读写数据库的购物车仓储
+func newPostgresCartRepository(db *gorm.DB, q *query.Query) *cartRepository {
 	return &cartRepository{
 		db:    db,
 		query: q,
@@ -95,7 +100,7 @@ func (r *cartRepository) GetByUserAndProduct(ctx context.Context, userID, produc
 func (r *cartRepository) Update(ctx context.Context, cartEntity *cart.ShoppingCart) error {
 	cartModel := r.domainToModel(cartEntity)
 
-	_, err := r.query.WithContext(ctx).ShoppingCart.Where(r.query.ShoppingCart.ID.Eq(cartEntity.ID)).Updates(cartModel)
+	err := r.db.WithContext(ctx).Model(&model.ShoppingCart{}).Where("id = ?", cartEntity.ID).Select(cartUpdateColumns).Updates(cartModel).Error
 	if err != nil {
 		return fmt.Errorf("更新购物车项失败: %w", err)
 	}
@@ -128,7 +133,7 @@ func (r *cartRepository) BatchUpdate(ctx context.Context, cartItems []*cart.Shop
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		for _, cartItem := range cartItems {
 			cartModel := r.domainToModel(cartItem)
-			if err := tx.Where("id = ?", cartItem.ID).Updates(cartModel).Error; err != nil {
+			if err := tx.Model(&model.ShoppingCart{}).Where("id = ?", cartItem.ID).Select(cartUpdateColumns).Updates(cartModel).Error; err != nil {
 				return fmt.Errorf("批量更新购物车项失败: %w", err)
 			}
 		}
@@ -136,6 +141,60 @@ func (r *cartRepository) BatchUpdate(ctx context.Context, cartItems []*cart.Shop
 	})
 }
 
+// SaveAll 在同一事务中创建或更新购物车项
+func (r *cartRepository) SaveAll(ctx context.Context, cartItems []*cart.ShoppingCart) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, cartItem := range cartItems {
+			cartModel := r.domainToModel(cartItem)
+			if cartItem.ID == "" {
+				if err := tx.Create(cartModel).Error; err != nil {
+					return fmt.Errorf("创建购物车项失败: %w", err)
+				}
+				cartItem.ID = cartModel.ID
+				continue
+			}
+			if err := tx.Model(&model.ShoppingCart{}).Where("id = ?", cartItem.ID).Select(cartUpdateColumns).Updates(cartModel).Error; err != nil {
+				return fmt.Errorf("更新购物车项失败: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// ReplaceByUserID 在同一事务中以 items 覆盖用户的购物车：不在 items 中的购物车项被删除，其余按ID创建或更新
+func (r *cartRepository) ReplaceByUserID(ctx context.Context, userID string, items []*cart.ShoppingCart) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+
+		stale := tx.Where("user_id = ?", userID)
+		if len(ids) > 0 {
+			stale = stale.Where("id NOT IN ?", ids)
+		}
+		if err := stale.Delete(&model.ShoppingCart{}).Error; err != nil {
+			return fmt.Errorf("删除购物车项失败: %w", err)
+		}
+
+		for _, item := range items {
+			cartModel := r.domainToModel(item)
+			cartModel.CreatedAt = parseCartTime(item.CreatedAt)
+			cartModel.UpdatedAt = parseCartTime(item.UpdatedAt)
+			// 只覆盖版本不高于写回内容的行，避免较早的写回覆盖较新的写回
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "id"}},
+				Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "shopping_carts.version <= excluded.version"}}},
+				DoUpdates: clause.AssignmentColumns(cartUpdateColumns),
+			}).Create(cartModel).Error
+			if err != nil {
+				return fmt.Errorf("保存购物车项失败: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 // GetSelectedItems 获取用户购物车中选中的商品
 func (r *cartRepository) GetSelectedItems(ctx context.Context, userID string) ([]*cart.ShoppingCart, error) {
 	cartModels, err := r.query.WithContext(ctx).ShoppingCart.Where(
@@ -204,3 +263,12 @@ func (r *cartRepository) modelToDomain(cartModel *model.ShoppingCart) *cart.Shop
 
 	return cartEntity
 }
+
+// parseCartTime 解析购物车项的时间，无法解析时返回零值，由数据库填充当前时间
+func parseCartTime(value string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
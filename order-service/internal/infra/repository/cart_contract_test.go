@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+// memoryCartStore 内存购物车存储，代替数据库测试 Redis 购物车仓储
+type memoryCartStore struct {
+	items map[string]*cart.ShoppingCart
+	err   error
+}
+
+func newMemoryCartStore(items ...*cart.ShoppingCart) *memoryCartStore {
+	store := &memoryCartStore{items: make(map[string]*cart.ShoppingCart)}
+	for _, item := range items {
+		copied := *item
+		store.items[item.ID] = &copied
+	}
+	return store
+}
+
+func (s *memoryCartStore) GetByID(_ context.Context, id string) (*cart.ShoppingCart, error) {
+	item, ok := s.items[id]
+	if !ok {
+		return nil, cart.ErrCartItemNotFound
+	}
+	copied := *item
+	return &copied, nil
+}
+
+func (s *memoryCartStore) GetByUserID(_ context.Context, userID string) ([]*cart.ShoppingCart, error) {
+	var items []*cart.ShoppingCart
+	for _, item := range s.items {
+		if item.UserID == userID {
+			copied := *item
+			items = append(items, &copied)
+		}
+	}
+	return items, nil
+}
+
+func (s *memoryCartStore) ReplaceByUserID(_ context.Context, userID string, items []*cart.ShoppingCart) error {
+	if s.err != nil {
+		return s.err
+	}
+	for id, item := range s.items {
+		if item.UserID == userID {
+			delete(s.items, id)
+		}
+	}
+	for _, item := range items {
+		copied := *item
+		s.items[item.ID] = &copied
+	}
+	return nil
+}
+
+func newCartItem(userID, productID, skuID string, quantity int32) *cart.ShoppingCart {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	return &cart.ShoppingCart{
+		UserID:    userID,
+		ProductID: productID,
+		SkuID:     skuID,
+		Quantity:  quantity,
+		Price:     decimal.RequireFromString("19.90"),
+		Selected:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   1,
+	}
+}
+
+func cartItemIDs(items []*cart.ShoppingCart) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+// testCartRepositoryContract 购物车仓储的契约测试，cart.Repository 的所有实现都必须通过
+// 每个用例使用新的用户ID，可以在共享的数据库上运行。
+func testCartRepositoryContract(t *testing.T, newRepo func(t *testing.T) cart.Repository) {
+	ctx := context.Background()
+
+	t.Run("create and get", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := uuid.NewString()
+
+		item := newCartItem(userID, uuid.NewString(), uuid.NewString(), 2)
+		require.NoError(t, repo.Create(ctx, item))
+		require.NotEmpty(t, item.ID)
+
+		got, err := repo.GetByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, userID, got.UserID)
+		assert.Equal(t, item.ProductID, got.ProductID)
+		assert.Equal(t, item.SkuID, got.SkuID)
+		assert.Equal(t, int32(2), got.Quantity)
+		assert.True(t, item.Price.Equal(got.Price))
+		assert.True(t, got.Selected)
+
+		_, err = repo.GetByID(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, cart.ErrCartItemNotFound)
+	})
+
+	t.Run("get by user and product", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := uuid.NewString()
+
+		item := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		require.NoError(t, repo.Create(ctx, item))
+
+		got, err := repo.GetByUserAndProduct(ctx, userID, item.ProductID, item.SkuID)
+		require.NoError(t, err)
+		assert.Equal(t, item.ID, got.ID)
+
+		_, err = repo.GetByUserAndProduct(ctx, userID, item.ProductID, uuid.NewString())
+		assert.ErrorIs(t, err, cart.ErrCartItemNotFound)
+		_, err = repo.GetByUserAndProduct(ctx, uuid.NewString(), item.ProductID, item.SkuID)
+		assert.ErrorIs(t, err, cart.ErrCartItemNotFound)
+	})
+
+	t.Run("update saves zero values", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := uuid.NewString()
+
+		item := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		require.NoError(t, repo.Create(ctx, item))
+
+		item.Quantity = 5
+		item.Selected = false
+		item.Version++
+		require.NoError(t, repo.Update(ctx, item))
+
+		got, err := repo.GetByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), got.Quantity)
+		assert.False(t, got.Selected)
+		assert.Equal(t, int32(2), got.Version)
+	})
+
+	t.Run("list, select and count by user", func(t *testing.T) {
+		repo := newRepo(t)
+		userID, otherUserID := uuid.NewString(), uuid.NewString()
+
+		first := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		second := newCartItem(userID, uuid.NewString(), uuid.NewString(), 3)
+		other := newCartItem(otherUserID, uuid.NewString(), uuid.NewString(), 1)
+		for _, item := range []*cart.ShoppingCart{first, second, other} {
+			require.NoError(t, repo.Create(ctx, item))
+		}
+
+		items, err := repo.GetByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{first.ID, second.ID}, cartItemIDs(items))
+
+		second.Selected = false
+		require.NoError(t, repo.BatchUpdate(ctx, []*cart.ShoppingCart{second}))
+		selected, err := repo.GetSelectedItems(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{first.ID}, cartItemIDs(selected))
+
+		count, err := repo.CountByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		count, err = repo.CountByUserID(ctx, uuid.NewString())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("save all creates and updates", func(t *testing.T) {
+		repo := newRepo(t)
+		userID := uuid.NewString()
+
+		existing := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		require.NoError(t, repo.Create(ctx, existing))
+
+		existing.Quantity = 4
+		created := newCartItem(userID, uuid.NewString(), uuid.NewString(), 2)
+		require.NoError(t, repo.SaveAll(ctx, []*cart.ShoppingCart{existing, created}))
+		require.NotEmpty(t, created.ID)
+
+		items, err := repo.GetByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{existing.ID, created.ID}, cartItemIDs(items))
+
+		got, err := repo.GetByID(ctx, existing.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), got.Quantity)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo := newRepo(t)
+		userID, otherUserID := uuid.NewString(), uuid.NewString()
+
+		first := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		second := newCartItem(userID, uuid.NewString(), uuid.NewString(), 1)
+		other := newCartItem(otherUserID, uuid.NewString(), uuid.NewString(), 1)
+		for _, item := range []*cart.ShoppingCart{first, second, other} {
+			require.NoError(t, repo.Create(ctx, item))
+		}
+
+		require.NoError(t, repo.Delete(ctx, first.ID))
+		_, err := repo.GetByID(ctx, first.ID)
+		assert.ErrorIs(t, err, cart.ErrCartItemNotFound)
+		require.NoError(t, repo.Delete(ctx, first.ID))
+
+		require.NoError(t, repo.DeleteByUserID(ctx, userID))
+		count, err := repo.CountByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		// 其他用户的购物车不受影响
+		items, err := repo.GetByUserID(ctx, otherUserID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{other.ID}, cartItemIDs(items))
+	})
+}
+
+func TestPostgresCartRepository(t *testing.T) {
+	dsn := os.Getenv("ORDER_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("ORDER_TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	testCartRepositoryContract(t, func(t *testing.T) cart.Repository {
+		return newPostgresCartRepository(db, query.Use(db))
+	})
+}
+
+func TestRedisCartRepository(t *testing.T) {
+	testCartRepositoryContract(t, func(t *testing.T) cart.Repository {
+		client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+		return newRedisCartRepository(client, newMemoryCartStore(), time.Hour)
+	})
+}
+
+func TestRedisCartRepository_WriteBehind(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	userID := uuid.NewString()
+	stored := newCartItem(userID, "p1", "s1", 1)
+	stored.ID = uuid.NewString()
+	store := newMemoryCartStore(stored)
+	repo := newRedisCartRepository(client, store, time.Hour)
+
+	// 缓存未命中时从数据库加载，按ID访问也能找到所属用户
+	got, err := repo.GetByID(ctx, stored.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "p1", got.ProductID)
+
+	// 变更只写入缓存，写回后才进入数据库
+	created := newCartItem(userID, "p2", "s2", 2)
+	require.NoError(t, repo.Create(ctx, created))
+	assert.Len(t, store.items, 1)
+
+	flushed, err := repo.Flush(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, flushed)
+	assert.Len(t, store.items, 2)
+
+	flushed, err = repo.Flush(ctx, 10)
+	require.NoError(t, err)
+	assert.Zero(t, flushed)
+
+	// 写回失败的用户放回待写回集合
+	require.NoError(t, repo.DeleteByUserID(ctx, userID))
+	store.err = errors.New("database unavailable")
+	_, err = repo.Flush(ctx, 10)
+	require.Error(t, err)
+	members, err := mr.Members(cartDirtyKey)
+	require.NoError(t, err)
+	assert.Equal(t, []string{userID}, members)
+
+	store.err = nil
+	flushed, err = repo.Flush(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, flushed)
+	assert.Empty(t, store.items)
+
+	// 结算前立即写回，结算后从缓存中移除已在数据库中删除的购物车项
+	checkedOut := newCartItem(userID, "p4", "s4", 1)
+	require.NoError(t, repo.Create(ctx, checkedOut))
+	require.NoError(t, repo.FlushUser(ctx, userID))
+	assert.Contains(t, store.items, checkedOut.ID)
+	delete(store.items, checkedOut.ID)
+	require.NoError(t, repo.Discard(ctx, userID, []string{checkedOut.ID}))
+	_, err = repo.GetByID(ctx, checkedOut.ID)
+	assert.ErrorIs(t, err, cart.ErrCartItemNotFound)
+
+	// 缓存淘汰后重新从数据库加载
+	require.NoError(t, repo.Create(ctx, newCartItem(userID, "p3", "s3", 1)))
+	_, err = repo.Flush(ctx, 10)
+	require.NoError(t, err)
+	mr.FlushAll()
+
+	items, err := repo.GetByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "p3", items[0].ProductID)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+// cartMergeQueue 游客购物车合并队列实现
+// 队列是 Redis List，元素为 auth.CartMergeRequest 的 JSON，与用户服务共用。
+type cartMergeQueue struct {
+	client redis.UniversalClient
+}
+
+// NewCartMergeQueue 创建游客购物车合并队列
+func NewCartMergeQueue(client redis.UniversalClient) cart.MergeQueue {
+	return &cartMergeQueue{client: client}
+}
+
+// Dequeue 取出合并请求，无法解析的请求直接丢弃
+func (q *cartMergeQueue) Dequeue(ctx context.Context, limit int) ([]*cart.MergeRequest, error) {
+	values, err := q.client.LPopCount(ctx, auth.CartMergeQueueKey, limit).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("取出购物车合并请求失败: %w", err)
+	}
+
+	requests := make([]*cart.MergeRequest, 0, len(values))
+	for _, value := range values {
+		var msg auth.CartMergeRequest
+		if err := json.Unmarshal([]byte(value), &msg); err != nil {
+			log.Printf("Discard malformed cart merge request %q: %v", value, err)
+			continue
+		}
+		requests = append(requests, &cart.MergeRequest{
+			UserID:    msg.UserID,
+			CartToken: msg.CartToken,
+			Attempts:  msg.Attempts,
+		})
+	}
+	return requests, nil
+}
+
+// Requeue 将合并请求放回队尾
+func (q *cartMergeQueue) Requeue(ctx context.Context, req *cart.MergeRequest) error {
+	data, err := json.Marshal(&auth.CartMergeRequest{
+		UserID:    req.UserID,
+		CartToken: req.CartToken,
+		Attempts:  req.Attempts,
+	})
+	if err != nil {
+		return err
+	}
+	if err := q.client.RPush(ctx, auth.CartMergeQueueKey, data).Err(); err != nil {
+		return fmt.Errorf("购物车合并请求重新排队失败: %w", err)
+	}
+	return nil
+}
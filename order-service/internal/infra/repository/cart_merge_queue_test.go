@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/common/auth"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+func TestCartMergeQueue(t *testing.T) {
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	queue := NewCartMergeQueue(client)
+
+	// 用户服务登录时写入的请求
+	data, err := json.Marshal(&auth.CartMergeRequest{UserID: "user-1", CartToken: "token-1"})
+	require.NoError(t, err)
+	require.NoError(t, client.RPush(ctx, auth.CartMergeQueueKey, data, "not json").Err())
+
+	requests, err := queue.Dequeue(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*cart.MergeRequest{{UserID: "user-1", CartToken: "token-1"}}, requests)
+
+	requests[0].Attempts++
+	require.NoError(t, queue.Requeue(ctx, requests[0]))
+	requests, err = queue.Dequeue(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, 1, requests[0].Attempts)
+
+	requests, err = queue.Dequeue(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+}
@@ -0,0 +1,446 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+const (
+	cartUserKeyPrefix = "cart:user:"
+	// cartOwnersKey 购物车项ID到用户ID的索引，用于按ID访问购物车项
+	cartOwnersKey = "cart:owners"
+	// cartDirtyKey 有变更、等待写回数据库的用户ID集合
+	cartDirtyKey = "cart:dirty"
+	// cartLoadedField 标记购物车已从数据库加载，空购物车也保留该字段，避免反复回源
+	cartLoadedField = "~loaded"
+)
+
+// loadCartScript 购物车缓存不存在时写入从数据库加载的购物车项，已存在时不覆盖
+// KEYS[1] 购物车缓存键；ARGV[1] 有效期（秒），其余参数为字段和值
+var loadCartScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return 1
+`)
+
+// redisCartRepository 基于 Redis 的购物车仓储实现
+// 每个用户的购物车是一个 Redis Hash，字段为商品和SKU，值为购物车项JSON。缓存未命中时从数据库加载；
+// 变更只写入缓存并把用户记入待写回集合，由 Flush 定期整车覆盖写回数据库。
+type redisCartRepository struct {
+	client redis.UniversalClient
+	store  cartStore
+	ttl    time.Duration
+}
+
+// newRedisCartRepository 创建基于 Redis 的购物车仓储
+func newRedisCartRepository(client redis.UniversalClient, store cartStore, ttl time.Duration) *redisCartRepository {
+	return &redisCartRepository{
+		client: client,
+		store:  store,
+		ttl:    ttl,
+	}
+}
+
+// Create 创建购物车项
+func (r *redisCartRepository) Create(ctx context.Context, cartEntity *cart.ShoppingCart) error {
+	cartEntity.ID = uuid.NewString()
+	return r.save(ctx, cartEntity.UserID, cartEntity)
+}
+
+// GetByID 根据ID获取购物车项
+func (r *redisCartRepository) GetByID(ctx context.Context, id string) (*cart.ShoppingCart, error) {
+	userID, err := r.owner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return nil, cart.ErrCartItemNotFound
+}
+
+// GetByUserID 根据用户ID获取购物车项列表，按加入时间倒序
+func (r *redisCartRepository) GetByUserID(ctx context.Context, userID string) ([]*cart.ShoppingCart, error) {
+	return r.load(ctx, userID)
+}
+
+// GetByUserAndProduct 根据用户ID和商品ID获取购物车项
+func (r *redisCartRepository) GetByUserAndProduct(ctx context.Context, userID, productID, skuID string) (*cart.ShoppingCart, error) {
+	if err := r.ensureLoaded(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	key := cartUserKeyPrefix + userID
+	var get *redis.StringCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.HGet(ctx, key, cartField(productID, skuID))
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, cart.ErrCartItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取购物车项失败: %w", err)
+	}
+
+	var cartEntity cart.ShoppingCart
+	if err := json.Unmarshal([]byte(get.Val()), &cartEntity); err != nil {
+		return nil, fmt.Errorf("解析购物车项失败: %w", err)
+	}
+	return &cartEntity, nil
+}
+
+// Update 更新购物车项
+func (r *redisCartRepository) Update(ctx context.Context, cartEntity *cart.ShoppingCart) error {
+	return r.save(ctx, cartEntity.UserID, cartEntity)
+}
+
+// Delete 删除购物车项
+func (r *redisCartRepository) Delete(ctx context.Context, id string) error {
+	cartEntity, err := r.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, cart.ErrCartItemNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	key := cartUserKeyPrefix + cartEntity.UserID
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, key, cartField(cartEntity.ProductID, cartEntity.SkuID))
+		pipe.HDel(ctx, cartOwnersKey, id)
+		pipe.SAdd(ctx, cartDirtyKey, cartEntity.UserID)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("删除购物车项失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteByUserID 删除用户的所有购物车项
+// 缓存中保留加载标记，写回时据此删除数据库中的购物车项。
+func (r *redisCartRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	items, err := r.load(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	key := cartUserKeyPrefix + userID
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, cartLoadedField, "1")
+		for _, item := range items {
+			pipe.HDel(ctx, cartOwnersKey, item.ID)
+		}
+		pipe.SAdd(ctx, cartDirtyKey, userID)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("清空购物车失败: %w", err)
+	}
+	return nil
+}
+
+// BatchUpdate 批量更新购物车项
+func (r *redisCartRepository) BatchUpdate(ctx context.Context, cartItems []*cart.ShoppingCart) error {
+	for userID, items := range groupByUser(cartItems) {
+		if err := r.save(ctx, userID, items...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveAll 创建没有ID的购物车项、更新已有的购物车项，同一用户的购物车项在一个事务中写入
+func (r *redisCartRepository) SaveAll(ctx context.Context, cartItems []*cart.ShoppingCart) error {
+	for _, cartItem := range cartItems {
+		if cartItem.ID == "" {
+			cartItem.ID = uuid.NewString()
+		}
+	}
+	return r.BatchUpdate(ctx, cartItems)
+}
+
+// GetSelectedItems 获取用户购物车中选中的商品
+func (r *redisCartRepository) GetSelectedItems(ctx context.Context, userID string) ([]*cart.ShoppingCart, error) {
+	items, err := r.load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*cart.ShoppingCart
+	for _, item := range items {
+		if item.Selected {
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+// CountByUserID 统计用户购物车商品数量
+func (r *redisCartRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	if err := r.ensureLoaded(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	count, err := r.client.HLen(ctx, cartUserKeyPrefix+userID).Result()
+	if err != nil {
+		return 0, fmt.Errorf("统计购物车商品数量失败: %w", err)
+	}
+	if count > 0 {
+		count-- // 不计加载标记
+	}
+	return count, nil
+}
+
+// Flush 将有变更的购物车整车覆盖写回数据库，返回写回的购物车数
+// 写回失败的用户放回待写回集合，下次重试；写回期间再次变更的购物车会重新进入待写回集合。
+func (r *redisCartRepository) Flush(ctx context.Context, limit int) (int, error) {
+	userIDs, err := r.client.SPopN(ctx, cartDirtyKey, int64(limit)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("获取待写回购物车失败: %w", err)
+	}
+
+	flushed := 0
+	for i, userID := range userIDs {
+		if err := r.flushUser(ctx, userID); err != nil {
+			if requeueErr := r.client.SAdd(ctx, cartDirtyKey, toInterfaces(userIDs[i:])...).Err(); requeueErr != nil {
+				return flushed, fmt.Errorf("写回购物车失败: %w，放回待写回集合失败: %v", err, requeueErr)
+			}
+			return flushed, err
+		}
+		flushed++
+	}
+	return flushed, nil
+}
+
+// FlushUser 立即将用户的购物车写回数据库
+func (r *redisCartRepository) FlushUser(ctx context.Context, userID string) error {
+	return r.flushUser(ctx, userID)
+}
+
+// Discard 从缓存中移除已在数据库中删除的购物车项，缓存中没有的购物车项忽略
+func (r *redisCartRepository) Discard(ctx context.Context, userID string, ids []string) error {
+	items, err := r.load(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	discard := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		discard[id] = true
+	}
+
+	key := cartUserKeyPrefix + userID
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, item := range items {
+			if discard[item.ID] {
+				pipe.HDel(ctx, key, cartField(item.ProductID, item.SkuID))
+				pipe.HDel(ctx, cartOwnersKey, item.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("移除购物车项失败: %w", err)
+	}
+	return nil
+}
+
+// flushUser 将用户的购物车写回数据库，缓存已被淘汰时跳过
+func (r *redisCartRepository) flushUser(ctx context.Context, userID string) error {
+	values, err := r.client.HGetAll(ctx, cartUserKeyPrefix+userID).Result()
+	if err != nil {
+		return fmt.Errorf("获取购物车失败: %w", err)
+	}
+	if _, ok := values[cartLoadedField]; !ok {
+		return nil
+	}
+
+	items, err := decodeCartItems(values)
+	if err != nil {
+		return err
+	}
+	if err := r.store.ReplaceByUserID(ctx, userID, items); err != nil {
+		return fmt.Errorf("写回购物车失败: %w", err)
+	}
+	return nil
+}
+
+// owner 获取购物车项所属的用户，索引中没有时从数据库查找
+func (r *redisCartRepository) owner(ctx context.Context, id string) (string, error) {
+	userID, err := r.client.HGet(ctx, cartOwnersKey, id).Result()
+	if err == nil {
+		return userID, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return "", fmt.Errorf("获取购物车项失败: %w", err)
+	}
+
+	cartEntity, err := r.store.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return cartEntity.UserID, nil
+}
+
+// ensureLoaded 购物车不在缓存中时从数据库加载
+func (r *redisCartRepository) ensureLoaded(ctx context.Context, userID string) error {
+	key := cartUserKeyPrefix + userID
+	exists, err := r.client.Exists(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("获取购物车失败: %w", err)
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	items, err := r.store.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	args := []interface{}{int64(r.ttl / time.Second), cartLoadedField, "1"}
+	owners := make([]interface{}, 0, len(items)*2)
+	for _, item := range items {
+		value, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("序列化购物车项失败: %w", err)
+		}
+		args = append(args, cartField(item.ProductID, item.SkuID), value)
+		owners = append(owners, item.ID, userID)
+	}
+
+	if err := loadCartScript.Run(ctx, r.client, []string{key}, args...).Err(); err != nil {
+		return fmt.Errorf("加载购物车失败: %w", err)
+	}
+	if len(owners) > 0 {
+		if err := r.client.HSet(ctx, cartOwnersKey, owners...).Err(); err != nil {
+			return fmt.Errorf("加载购物车失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// load 读取用户的全部购物车项并刷新过期时间，按加入时间倒序
+func (r *redisCartRepository) load(ctx context.Context, userID string) ([]*cart.ShoppingCart, error) {
+	if err := r.ensureLoaded(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	key := cartUserKeyPrefix + userID
+	var getAll *redis.MapStringStringCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getAll = pipe.HGetAll(ctx, key)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取购物车失败: %w", err)
+	}
+
+	return decodeCartItems(getAll.Val())
+}
+
+// save 写入用户的购物车项，并把用户记入待写回集合
+func (r *redisCartRepository) save(ctx context.Context, userID string, cartItems ...*cart.ShoppingCart) error {
+	if len(cartItems) == 0 {
+		return nil
+	}
+	if err := r.ensureLoaded(ctx, userID); err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, len(cartItems)*2)
+	owners := make([]interface{}, 0, len(cartItems)*2)
+	for _, cartItem := range cartItems {
+		value, err := json.Marshal(cartItem)
+		if err != nil {
+			return fmt.Errorf("序列化购物车项失败: %w", err)
+		}
+		values = append(values, cartField(cartItem.ProductID, cartItem.SkuID), value)
+		owners = append(owners, cartItem.ID, userID)
+	}
+
+	key := cartUserKeyPrefix + userID
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, values...)
+		pipe.HSet(ctx, cartOwnersKey, owners...)
+		pipe.SAdd(ctx, cartDirtyKey, userID)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("保存购物车项失败: %w", err)
+	}
+	return nil
+}
+
+// cartField 购物车项在用户购物车 Hash 中的字段，同一商品SKU只有一个购物车项
+func cartField(productID, skuID string) string {
+	return productID + "/" + skuID
+}
+
+// decodeCartItems 解析购物车 Hash 中的购物车项，按加入时间倒序
+func decodeCartItems(values map[string]string) ([]*cart.ShoppingCart, error) {
+	items := make([]*cart.ShoppingCart, 0, len(values))
+	for field, value := range values {
+		if field == cartLoadedField {
+			continue
+		}
+		var cartEntity cart.ShoppingCart
+		if err := json.Unmarshal([]byte(value), &cartEntity); err != nil {
+			return nil, fmt.Errorf("解析购物车项失败: %w", err)
+		}
+		items = append(items, &cartEntity)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].CreatedAt != items[j].CreatedAt {
+			return items[i].CreatedAt > items[j].CreatedAt
+		}
+		return items[i].ID < items[j].ID
+	})
+	return items, nil
+}
+
+// groupByUser 按用户分组购物车项
+func groupByUser(cartItems []*cart.ShoppingCart) map[string][]*cart.ShoppingCart {
+	groups := make(map[string][]*cart.ShoppingCart)
+	for _, cartItem := range cartItems {
+		groups[cartItem.UserID] = append(groups[cartItem.UserID], cartItem)
+	}
+	return groups
+}
+
+// toInterfaces 转换为 Redis 命令参数
+func toInterfaces(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return args
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+const (
+	// CartStoragePostgres 直接读写数据库
+	CartStoragePostgres = "postgres"
+	// CartStorageRedis Redis Hash 缓存，变更异步写回数据库
+	CartStorageRedis = "redis"
+)
+
+// cartStore 购物车的持久化存储，缓存未命中时从中加载，缓存中的变更写回其中
+type cartStore interface {
+	GetByID(ctx context.Context, id string) (*cart.ShoppingCart, error)
+	GetByUserID(ctx context.Context, userID string) ([]*cart.ShoppingCart, error)
+	ReplaceByUserID(ctx context.Context, userID string, items []*cart.ShoppingCart) error
+}
+
+// NewCartRepository 根据配置创建购物车仓储，只有使用 redis 方式时才会访问 Redis
+func NewCartRepository(cfg *config.OrderConfig, db *gorm.DB, q *query.Query, client redis.UniversalClient) (cart.Repository, error) {
+	switch cfg.Cart.Storage {
+	case CartStoragePostgres:
+		return newPostgresCartRepository(db, q), nil
+	case CartStorageRedis:
+		return newRedisCartRepository(client, newPostgresCartRepository(db, q), cfg.Cart.CacheTTL), nil
+	default:
+		return nil, fmt.Errorf("不支持的购物车存储方式: %s", cfg.Cart.Storage)
+	}
+}
+
+// NewCartFlusher 返回购物车仓储的写回器，直接读写数据库的仓储返回空操作的写回器
+func NewCartFlusher(repo cart.Repository) cart.Flusher {
+	if flusher, ok := repo.(cart.Flusher); ok {
+		return flusher
+	}
+	return noopCartFlusher{}
+}
+
+// noopCartFlusher 空操作的写回器
+type noopCartFlusher struct{}
+
+// Flush 没有需要写回的购物车
+func (noopCartFlusher) Flush(context.Context, int) (int, error) {
+	return 0, nil
+}
+
+// FlushUser 购物车已在数据库中
+func (noopCartFlusher) FlushUser(context.Context, string) error {
+	return nil
+}
+
+// Discard 没有缓存
+func (noopCartFlusher) Discard(context.Context, string, []string) error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+)
+
+// flashSaleRepository 秒杀活动仓储实现
+type flashSaleRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewFlashSaleRepository 创建秒杀活动仓储
+func NewFlashSaleRepository(db *gorm.DB, q *query.Query) flashsale.Repository {
+	return &flashSaleRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// Create 创建秒杀活动
+func (r *flashSaleRepository) Create(ctx context.Context, sale *flashsale.Sale) error {
+	saleModel := r.saleToModel(sale)
+	if err := r.query.WithContext(ctx).FlashSale.Create(saleModel); err != nil {
+		return fmt.Errorf("创建秒杀活动失败: %w", err)
+	}
+
+	sale.ID = saleModel.ID
+	sale.CreatedAt = saleModel.CreatedAt.Format("2006-01-02 15:04:05")
+	sale.UpdatedAt = saleModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// Get 获取秒杀活动
+func (r *flashSaleRepository) Get(ctx context.Context, id string) (*flashsale.Sale, error) {
+	f := r.query.FlashSale
+	saleModel, err := r.query.WithContext(ctx).FlashSale.Where(f.ID.Eq(id)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, flashsale.ErrSaleNotFound
+		}
+		return nil, fmt.Errorf("获取秒杀活动失败: %w", err)
+	}
+
+	return r.saleToEntity(saleModel), nil
+}
+
+// List 获取秒杀活动列表，按开始时间倒序
+func (r *flashSaleRepository) List(ctx context.Context) ([]*flashsale.Sale, error) {
+	f := r.query.FlashSale
+	saleModels, err := r.query.WithContext(ctx).FlashSale.Order(f.StartAt.Desc()).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取秒杀活动失败: %w", err)
+	}
+
+	return r.salesToEntities(saleModels), nil
+}
+
+// ListUnsettled 获取未结算的秒杀活动，按开始时间正序
+func (r *flashSaleRepository) ListUnsettled(ctx context.Context) ([]*flashsale.Sale, error) {
+	f := r.query.FlashSale
+	saleModels, err := r.query.WithContext(ctx).FlashSale.Where(f.Settled.Is(false)).Order(f.StartAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取未结算的秒杀活动失败: %w", err)
+	}
+
+	return r.salesToEntities(saleModels), nil
+}
+
+// UpdateProgress 保存预热、对账进度
+func (r *flashSaleRepository) UpdateProgress(ctx context.Context, sale *flashsale.Sale) error {
+	f := r.query.FlashSale
+	_, err := r.query.WithContext(ctx).FlashSale.Where(f.ID.Eq(sale.ID)).UpdateSimple(
+		f.Stock.Value(sale.Stock),
+		f.Preloaded.Value(sale.Preloaded),
+		f.Settled.Value(sale.Settled),
+		f.Sold.Value(sale.Sold),
+		f.UpdatedAt.Value(time.Now()),
+	)
+	if err != nil {
+		return fmt.Errorf("更新秒杀活动进度失败: %w", err)
+	}
+	return nil
+}
+
+// salesToEntities 批量转换秒杀活动
+func (r *flashSaleRepository) salesToEntities(saleModels []*model.FlashSale) []*flashsale.Sale {
+	sales := make([]*flashsale.Sale, 0, len(saleModels))
+	for _, saleModel := range saleModels {
+		sales = append(sales, r.saleToEntity(saleModel))
+	}
+	return sales
+}
+
+// saleToModel 将秒杀活动转换为数据库模型
+func (r *flashSaleRepository) saleToModel(sale *flashsale.Sale) *model.FlashSale {
+	return &model.FlashSale{
+		ID:           sale.ID,
+		Name:         sale.Name,
+		ProductID:    sale.ProductID,
+		SkuID:        sale.SkuID,
+		Price:        sale.Price,
+		Quota:        sale.Quota,
+		Stock:        sale.Stock,
+		PerUserLimit: sale.PerUserLimit,
+		StartAt:      parseSaleTime(sale.StartAt),
+		EndAt:        parseSaleTime(sale.EndAt),
+		Preloaded:    sale.Preloaded,
+		Settled:      sale.Settled,
+		Sold:         sale.Sold,
+	}
+}
+
+// saleToEntity 将数据库模型转换为秒杀活动
+func (r *flashSaleRepository) saleToEntity(saleModel *model.FlashSale) *flashsale.Sale {
+	return &flashsale.Sale{
+		ID:           saleModel.ID,
+		Name:         saleModel.Name,
+		ProductID:    saleModel.ProductID,
+		SkuID:        saleModel.SkuID,
+		Price:        saleModel.Price,
+		Quota:        saleModel.Quota,
+		Stock:        saleModel.Stock,
+		PerUserLimit: saleModel.PerUserLimit,
+		StartAt:      saleModel.StartAt.Format("2006-01-02 15:04:05"),
+		EndAt:        saleModel.EndAt.Format("2006-01-02 15:04:05"),
+		Preloaded:    saleModel.Preloaded,
+		Settled:      saleModel.Settled,
+		Sold:         saleModel.Sold,
+		CreatedAt:    saleModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    saleModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// parseSaleTime 解析秒杀活动的时间字段，格式已由领域实体校验
+func parseSaleTime(value string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	return t
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+)
+
+const (
+	// flashSaleKeyPrefix 活动库存和用户购买数量的键前缀，同一活动的键使用相同的 hash tag，保证 Lua 脚本在集群中可用
+	flashSaleKeyPrefix = "flashsale:{"
+	flashSaleTicketKey = "flashsale:ticket:"
+	// flashSaleQueueKey 等待创建订单的抢购记录ID队列
+	flashSaleQueueKey = "flashsale:queue"
+)
+
+// deductFlashSaleScript 扣减活动库存并累计用户的购买数量
+// KEYS[1] 活动库存；KEYS[2] 用户购买数量 Hash；ARGV[1] 用户ID；ARGV[2] 数量；ARGV[3] 每人限购数量，0 表示不限
+// 返回 1 成功，0 库存不足，-1 库存未预热，-2 超出每人限购
+var deductFlashSaleScript = redis.NewScript(`
+local stock = tonumber(redis.call('GET', KEYS[1]))
+if stock == nil then
+	return -1
+end
+local quantity = tonumber(ARGV[2])
+if stock < quantity then
+	return 0
+end
+local limit = tonumber(ARGV[3])
+if limit > 0 then
+	local bought = tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or '0')
+	if bought + quantity > limit then
+		return -2
+	end
+end
+redis.call('DECRBY', KEYS[1], quantity)
+redis.call('HINCRBY', KEYS[2], ARGV[1], quantity)
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl > 0 then
+	redis.call('PEXPIRE', KEYS[2], ttl)
+end
+return 1
+`)
+
+// restoreFlashSaleScript 退回活动库存和用户的购买数量，库存已过期时不再退回
+// KEYS[1] 活动库存；KEYS[2] 用户购买数量 Hash；ARGV[1] 用户ID；ARGV[2] 数量
+var restoreFlashSaleScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('INCRBY', KEYS[1], ARGV[2])
+if redis.call('HINCRBY', KEYS[2], ARGV[1], -tonumber(ARGV[2])) <= 0 then
+	redis.call('HDEL', KEYS[2], ARGV[1])
+end
+return 1
+`)
+
+// redisFlashSaleStore 基于 Redis 的秒杀库存和抢购记录队列
+// 活动库存为计数器，用户购买数量为 Hash，抢购记录以 JSON 保存并按活动建立索引，队列为抢购记录ID的 List。
+type redisFlashSaleStore struct {
+	client redis.UniversalClient
+}
+
+// NewFlashSaleStock 创建基于 Redis 的秒杀库存
+func NewFlashSaleStock(client redis.UniversalClient) flashsale.Stock {
+	return &redisFlashSaleStore{client: client}
+}
+
+// NewFlashSaleTicketQueue 创建基于 Redis 的抢购记录队列
+func NewFlashSaleTicketQueue(client redis.UniversalClient) flashsale.TicketQueue {
+	return &redisFlashSaleStore{client: client}
+}
+
+// flashSaleKey 活动的 Redis 键
+func flashSaleKey(saleID, name string) string {
+	return flashSaleKeyPrefix + saleID + "}:" + name
+}
+
+// Preload 预热活动库存，已预热时不覆盖
+func (s *redisFlashSaleStore) Preload(ctx context.Context, sale *flashsale.Sale, expireAt time.Time) error {
+	err := s.client.SetArgs(ctx, flashSaleKey(sale.ID, "stock"), sale.Stock, redis.SetArgs{
+		Mode:     "NX",
+		ExpireAt: expireAt,
+	}).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("预热秒杀库存失败: %w", err)
+	}
+	return nil
+}
+
+// Deduct 扣减活动库存
+func (s *redisFlashSaleStore) Deduct(ctx context.Context, sale *flashsale.Sale, userID string, quantity int32) error {
+	keys := []string{flashSaleKey(sale.ID, "stock"), flashSaleKey(sale.ID, "users")}
+	result, err := deductFlashSaleScript.Run(ctx, s.client, keys, userID, quantity, sale.PerUserLimit).Int()
+	if err != nil {
+		return fmt.Errorf("扣减秒杀库存失败: %w", err)
+	}
+
+	switch result {
+	case 1:
+		return nil
+	case 0:
+		return flashsale.ErrSoldOut
+	case -1:
+		return flashsale.ErrSaleNotActive
+	default:
+		return flashsale.ErrUserLimitExceeded
+	}
+}
+
+// Restore 退回活动库存
+func (s *redisFlashSaleStore) Restore(ctx context.Context, saleID, userID string, quantity int32) error {
+	keys := []string{flashSaleKey(saleID, "stock"), flashSaleKey(saleID, "users")}
+	if err := restoreFlashSaleScript.Run(ctx, s.client, keys, userID, quantity).Err(); err != nil {
+		return fmt.Errorf("退回秒杀库存失败: %w", err)
+	}
+	return nil
+}
+
+// Remaining 剩余活动库存，未预热或已过期时为 0
+func (s *redisFlashSaleStore) Remaining(ctx context.Context, saleID string) (int32, error) {
+	stock, err := s.client.Get(ctx, flashSaleKey(saleID, "stock")).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("获取秒杀库存失败: %w", err)
+	}
+	return int32(stock), nil
+}
+
+// Enqueue 保存抢购记录并排队
+func (s *redisFlashSaleStore) Enqueue(ctx context.Context, ticket *flashsale.Ticket, expireAt time.Time) error {
+	ticket.ID = uuid.NewString()
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return fmt.Errorf("序列化抢购记录失败: %w", err)
+	}
+
+	// 记录、索引和队列不在同一个 hash slot，不能使用事务；记录先于队列写入，消费时总能读到记录
+	indexKey := flashSaleKey(ticket.SaleID, "tickets")
+	_, err = s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SetArgs(ctx, flashSaleTicketKey+ticket.ID, data, redis.SetArgs{ExpireAt: expireAt})
+		pipe.SAdd(ctx, indexKey, ticket.ID)
+		pipe.ExpireAt(ctx, indexKey, expireAt)
+		pipe.RPush(ctx, flashSaleQueueKey, ticket.ID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("保存抢购记录失败: %w", err)
+	}
+	return nil
+}
+
+// Requeue 重新排队
+func (s *redisFlashSaleStore) Requeue(ctx context.Context, ticket *flashsale.Ticket) error {
+	if err := s.client.RPush(ctx, flashSaleQueueKey, ticket.ID).Err(); err != nil {
+		return fmt.Errorf("抢购记录重新排队失败: %w", err)
+	}
+	return nil
+}
+
+// Dequeue 按排队顺序取出最多 limit 条记录，已过期的记录直接丢弃
+func (s *redisFlashSaleStore) Dequeue(ctx context.Context, limit int) ([]*flashsale.Ticket, error) {
+	ids, err := s.client.LPopCount(ctx, flashSaleQueueKey, limit).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取排队的抢购记录失败: %w", err)
+	}
+	return s.getTickets(ctx, ids)
+}
+
+// Save 更新抢购记录，保留原有的过期时间
+func (s *redisFlashSaleStore) Save(ctx context.Context, ticket *flashsale.Ticket) error {
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return fmt.Errorf("序列化抢购记录失败: %w", err)
+	}
+	if err := s.client.SetArgs(ctx, flashSaleTicketKey+ticket.ID, data, redis.SetArgs{KeepTTL: true}).Err(); err != nil {
+		return fmt.Errorf("更新抢购记录失败: %w", err)
+	}
+	return nil
+}
+
+// Get 获取抢购记录
+func (s *redisFlashSaleStore) Get(ctx context.Context, id string) (*flashsale.Ticket, error) {
+	data, err := s.client.Get(ctx, flashSaleTicketKey+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, flashsale.ErrTicketNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取抢购记录失败: %w", err)
+	}
+
+	var ticket flashsale.Ticket
+	if err := json.Unmarshal(data, &ticket); err != nil {
+		return nil, fmt.Errorf("解析抢购记录失败: %w", err)
+	}
+	return &ticket, nil
+}
+
+// ListBySale 获取活动的全部抢购记录
+func (s *redisFlashSaleStore) ListBySale(ctx context.Context, saleID string) ([]*flashsale.Ticket, error) {
+	ids, err := s.client.SMembers(ctx, flashSaleKey(saleID, "tickets")).Result()
+	if err != nil {
+		return nil, fmt.Errorf("获取抢购记录失败: %w", err)
+	}
+	return s.getTickets(ctx, ids)
+}
+
+// getTickets 批量获取抢购记录，跳过已过期的记录
+func (s *redisFlashSaleStore) getTickets(ctx context.Context, ids []string) ([]*flashsale.Ticket, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// 抢购记录分布在不同的 hash slot，使用管道逐条读取
+	cmds := make([]*redis.StringCmd, 0, len(ids))
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			cmds = append(cmds, pipe.Get(ctx, flashSaleTicketKey+id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("获取抢购记录失败: %w", err)
+	}
+
+	tickets := make([]*flashsale.Ticket, 0, len(cmds))
+	for _, cmd := range cmds {
+		data, err := cmd.Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("获取抢购记录失败: %w", err)
+		}
+		var ticket flashsale.Ticket
+		if err := json.Unmarshal(data, &ticket); err != nil {
+			return nil, fmt.Errorf("解析抢购记录失败: %w", err)
+		}
+		tickets = append(tickets, &ticket)
+	}
+	return tickets, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/flashsale"
+)
+
+func TestRedisFlashSaleStock(t *testing.T) {
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	stock := NewFlashSaleStock(client)
+	sale := &flashsale.Sale{ID: "sale-1", Stock: 3, PerUserLimit: 2}
+	expireAt := time.Now().Add(time.Hour)
+
+	// 未预热时不能扣减
+	assert.ErrorIs(t, stock.Deduct(ctx, sale, "user-1", 1), flashsale.ErrSaleNotActive)
+
+	require.NoError(t, stock.Preload(ctx, sale, expireAt))
+	// 已预热时不覆盖
+	sale.Stock = 100
+	require.NoError(t, stock.Preload(ctx, sale, expireAt))
+
+	require.NoError(t, stock.Deduct(ctx, sale, "user-1", 2))
+	assert.ErrorIs(t, stock.Deduct(ctx, sale, "user-1", 1), flashsale.ErrUserLimitExceeded)
+	assert.ErrorIs(t, stock.Deduct(ctx, sale, "user-2", 2), flashsale.ErrSoldOut)
+	require.NoError(t, stock.Deduct(ctx, sale, "user-2", 1))
+
+	remaining, err := stock.Remaining(ctx, sale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), remaining)
+
+	// 退回后用户可以再次抢购
+	require.NoError(t, stock.Restore(ctx, sale.ID, "user-1", 2))
+	require.NoError(t, stock.Deduct(ctx, sale, "user-1", 1))
+	remaining, err = stock.Remaining(ctx, sale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), remaining)
+}
+
+func TestRedisFlashSaleTicketQueue(t *testing.T) {
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	queue := NewFlashSaleTicketQueue(client)
+	expireAt := time.Now().Add(time.Hour)
+
+	first := &flashsale.Ticket{SaleID: "sale-1", UserID: "user-1", Quantity: 1, Status: flashsale.TicketStatusQueued}
+	second := &flashsale.Ticket{SaleID: "sale-1", UserID: "user-2", Quantity: 2, Status: flashsale.TicketStatusQueued}
+	require.NoError(t, queue.Enqueue(ctx, first, expireAt))
+	require.NoError(t, queue.Enqueue(ctx, second, expireAt))
+	assert.NotEmpty(t, first.ID)
+
+	tickets, err := queue.Dequeue(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Equal(t, first.ID, tickets[0].ID)
+
+	tickets[0].Status = flashsale.TicketStatusCreated
+	tickets[0].OrderID = "order-1"
+	require.NoError(t, queue.Save(ctx, tickets[0]))
+
+	saved, err := queue.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, flashsale.TicketStatusCreated, saved.Status)
+	assert.Equal(t, "order-1", saved.OrderID)
+
+	all, err := queue.ListBySale(ctx, "sale-1")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, queue.Requeue(ctx, first))
+	tickets, err = queue.Dequeue(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, tickets, 2)
+	assert.Equal(t, second.ID, tickets[0].ID)
+	assert.Equal(t, first.ID, tickets[1].ID)
+
+	tickets, err = queue.Dequeue(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, tickets)
+
+	_, err = queue.Get(ctx, "missing")
+	assert.ErrorIs(t, err, flashsale.ErrTicketNotFound)
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/freight"
+)
+
+// freightRepository 运费模板仓储实现
+type freightRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewFreightRepository 创建运费模板仓储
+func NewFreightRepository(db *gorm.DB, q *query.Query) freight.Repository {
+	return &freightRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// CreateTemplate 创建运费模板
+func (r *freightRepository) CreateTemplate(ctx context.Context, template *freight.Template) error {
+	templateModel, err := r.templateToModel(template)
+	if err != nil {
+		return err
+	}
+
+	err = r.query.Transaction(func(tx *query.Query) error {
+		if template.IsDefault {
+			if err := r.clearDefault(ctx, tx); err != nil {
+				return err
+			}
+		}
+		if err := tx.WithContext(ctx).FreightTemplate.Create(templateModel); err != nil {
+			return fmt.Errorf("创建运费模板失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	template.ID = templateModel.ID
+	template.CreatedAt = templateModel.CreatedAt.Format("2006-01-02 15:04:05")
+	template.UpdatedAt = templateModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// UpdateTemplate 更新运费模板
+func (r *freightRepository) UpdateTemplate(ctx context.Context, template *freight.Template) error {
+	templateModel, err := r.templateToModel(template)
+	if err != nil {
+		return err
+	}
+
+	return r.query.Transaction(func(tx *query.Query) error {
+		if template.IsDefault {
+			if err := r.clearDefault(ctx, tx); err != nil {
+				return err
+			}
+		}
+
+		f := tx.FreightTemplate
+		result, err := tx.WithContext(ctx).FreightTemplate.
+			Select(f.Name, f.FirstWeight, f.AdditionalWeight, f.FirstFee, f.AdditionalFee, f.FreeThreshold,
+				f.Surcharge, f.Regions, f.IsDefault, f.UpdatedAt).
+			Where(f.ID.Eq(template.ID)).
+			Updates(templateModel)
+		if err != nil {
+			return fmt.Errorf("更新运费模板失败: %w", err)
+		}
+		if result.RowsAffected == 0 {
+			return freight.ErrTemplateNotFound
+		}
+
+		updated, err := tx.WithContext(ctx).FreightTemplate.Where(f.ID.Eq(template.ID)).First()
+		if err != nil {
+			return fmt.Errorf("获取运费模板失败: %w", err)
+		}
+		template.CreatedAt = updated.CreatedAt.Format("2006-01-02 15:04:05")
+		template.UpdatedAt = updated.UpdatedAt.Format("2006-01-02 15:04:05")
+		return nil
+	})
+}
+
+// clearDefault 取消当前的默认模板
+func (r *freightRepository) clearDefault(ctx context.Context, tx *query.Query) error {
+	f := tx.FreightTemplate
+	if _, err := tx.WithContext(ctx).FreightTemplate.Where(f.IsDefault.Is(true)).UpdateSimple(f.IsDefault.Value(false)); err != nil {
+		return fmt.Errorf("取消默认运费模板失败: %w", err)
+	}
+	return nil
+}
+
+// GetTemplate 获取运费模板
+func (r *freightRepository) GetTemplate(ctx context.Context, id string) (*freight.Template, error) {
+	f := r.query.FreightTemplate
+	templateModel, err := r.query.WithContext(ctx).FreightTemplate.Where(f.ID.Eq(id)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, freight.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("获取运费模板失败: %w", err)
+	}
+
+	return r.templateToEntity(templateModel)
+}
+
+// ListTemplates 获取全部运费模板，默认模板在前
+func (r *freightRepository) ListTemplates(ctx context.Context) ([]*freight.Template, error) {
+	f := r.query.FreightTemplate
+	templateModels, err := r.query.WithContext(ctx).FreightTemplate.Order(f.IsDefault.Desc(), f.CreatedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取运费模板失败: %w", err)
+	}
+
+	templates := make([]*freight.Template, 0, len(templateModels))
+	for _, templateModel := range templateModels {
+		template, err := r.templateToEntity(templateModel)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// GetDefaultTemplate 获取默认运费模板
+func (r *freightRepository) GetDefaultTemplate(ctx context.Context) (*freight.Template, error) {
+	f := r.query.FreightTemplate
+	templateModel, err := r.query.WithContext(ctx).FreightTemplate.Where(f.IsDefault.Is(true)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, freight.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("获取默认运费模板失败: %w", err)
+	}
+
+	return r.templateToEntity(templateModel)
+}
+
+// BindProduct 为商品绑定运费模板，templateID 为空时解除绑定
+func (r *freightRepository) BindProduct(ctx context.Context, productID, templateID string) error {
+	if templateID == "" {
+		p := r.query.ProductFreightTemplate
+		if _, err := r.query.WithContext(ctx).ProductFreightTemplate.Where(p.ProductID.Eq(productID)).Delete(); err != nil {
+			return fmt.Errorf("解除商品运费模板失败: %w", err)
+		}
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"template_id", "updated_at"}),
+	}).Create(&model.ProductFreightTemplate{
+		ProductID:  productID,
+		TemplateID: templateID,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("绑定商品运费模板失败: %w", err)
+	}
+	return nil
+}
+
+// GetByProductIDs 批量获取商品绑定的运费模板
+func (r *freightRepository) GetByProductIDs(ctx context.Context, productIDs []string) (map[string]*freight.Template, error) {
+	result := make(map[string]*freight.Template)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	p := r.query.ProductFreightTemplate
+	bindings, err := r.query.WithContext(ctx).ProductFreightTemplate.Where(p.ProductID.In(productIDs...)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取商品运费模板失败: %w", err)
+	}
+	if len(bindings) == 0 {
+		return result, nil
+	}
+
+	templateIDs := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		templateIDs = append(templateIDs, binding.TemplateID)
+	}
+	f := r.query.FreightTemplate
+	templateModels, err := r.query.WithContext(ctx).FreightTemplate.Where(f.ID.In(templateIDs...)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取运费模板失败: %w", err)
+	}
+
+	templates := make(map[string]*freight.Template, len(templateModels))
+	for _, templateModel := range templateModels {
+		template, err := r.templateToEntity(templateModel)
+		if err != nil {
+			return nil, err
+		}
+		templates[template.ID] = template
+	}
+
+	// 绑定的模板已被删除时按未绑定处理
+	for _, binding := range bindings {
+		if template, ok := templates[binding.TemplateID]; ok {
+			result[binding.ProductID] = template
+		}
+	}
+	return result, nil
+}
+
+// templateToModel 将运费模板转换为数据库模型
+func (r *freightRepository) templateToModel(template *freight.Template) (*model.FreightTemplate, error) {
+	regions := template.Regions
+	if regions == nil {
+		regions = []*freight.RegionRule{}
+	}
+	encoded, err := json.Marshal(regions)
+	if err != nil {
+		return nil, fmt.Errorf("序列化运费地区规则失败: %w", err)
+	}
+
+	return &model.FreightTemplate{
+		ID:               template.ID,
+		Name:             template.Name,
+		FirstWeight:      template.FirstWeight,
+		AdditionalWeight: template.AdditionalWeight,
+		FirstFee:         template.DefaultRule.FirstFee,
+		AdditionalFee:    template.DefaultRule.AdditionalFee,
+		FreeThreshold:    template.DefaultRule.FreeThreshold,
+		Surcharge:        template.DefaultRule.Surcharge,
+		Regions:          string(encoded),
+		IsDefault:        template.IsDefault,
+	}, nil
+}
+
+// templateToEntity 将数据库模型转换为运费模板
+func (r *freightRepository) templateToEntity(templateModel *model.FreightTemplate) (*freight.Template, error) {
+	var regions []*freight.RegionRule
+	if err := json.Unmarshal([]byte(templateModel.Regions), &regions); err != nil {
+		return nil, fmt.Errorf("运费模板 %s 地区规则格式错误: %w", templateModel.ID, err)
+	}
+
+	return &freight.Template{
+		ID:               templateModel.ID,
+		Name:             templateModel.Name,
+		FirstWeight:      templateModel.FirstWeight,
+		AdditionalWeight: templateModel.AdditionalWeight,
+		DefaultRule: freight.Rule{
+			FirstFee:      templateModel.FirstFee,
+			AdditionalFee: templateModel.AdditionalFee,
+			FreeThreshold: templateModel.FreeThreshold,
+			Surcharge:     templateModel.Surcharge,
+		},
+		Regions:   regions,
+		IsDefault: templateModel.IsDefault,
+		CreatedAt: templateModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: templateModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/people257/poor-guy-shop/order-service/cmd/grpc/config"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/cart"
+)
+
+const guestCartKeyPrefix = "cart:guest:"
+
+// guestCartRepository 游客购物车仓储实现
+// 每个游客购物车是一个 Redis Hash，字段为购物车项ID，值为购物车项JSON；每次访问都会刷新过期时间。
+type guestCartRepository struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+// NewGuestCartRepository 创建游客购物车仓储
+func NewGuestCartRepository(client redis.UniversalClient, cfg *config.OrderConfig) cart.GuestRepository {
+	return &guestCartRepository{
+		client: client,
+		ttl:    cfg.GuestCartTTL,
+	}
+}
+
+// ForToken 返回令牌对应的购物车仓储
+func (r *guestCartRepository) ForToken(token string) cart.Repository {
+	return &guestCart{
+		client: r.client,
+		key:    guestCartKeyPrefix + token,
+		ttl:    r.ttl,
+	}
+}
+
+// guestCart 单个游客购物车，仓储方法中的 userID 参数被忽略
+type guestCart struct {
+	client redis.UniversalClient
+	key    string
+	ttl    time.Duration
+}
+
+// Create 创建购物车项
+func (c *guestCart) Create(ctx context.Context, cartEntity *cart.ShoppingCart) error {
+	cartEntity.ID = uuid.NewString()
+	return c.save(ctx, cartEntity)
+}
+
+// GetByID 根据ID获取购物车项
+func (c *guestCart) GetByID(ctx context.Context, id string) (*cart.ShoppingCart, error) {
+	var get *redis.StringCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.HGet(ctx, c.key, id)
+		pipe.Expire(ctx, c.key, c.ttl)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, cart.ErrCartItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取游客购物车项失败: %w", err)
+	}
+
+	var cartEntity cart.ShoppingCart
+	if err := json.Unmarshal([]byte(get.Val()), &cartEntity); err != nil {
+		return nil, fmt.Errorf("解析游客购物车项失败: %w", err)
+	}
+	return &cartEntity, nil
+}
+
+// GetByUserID 获取游客购物车的全部商品，按加入时间倒序
+func (c *guestCart) GetByUserID(ctx context.Context, _ string) ([]*cart.ShoppingCart, error) {
+	return c.load(ctx)
+}
+
+// GetByUserAndProduct 根据商品获取购物车项
+func (c *guestCart) GetByUserAndProduct(ctx context.Context, _ string, productID, skuID string) (*cart.ShoppingCart, error) {
+	items, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.ProductID == productID && item.SkuID == skuID {
+			return item, nil
+		}
+	}
+	return nil, cart.ErrCartItemNotFound
+}
+
+// Update 更新购物车项
+func (c *guestCart) Update(ctx context.Context, cartEntity *cart.ShoppingCart) error {
+	return c.save(ctx, cartEntity)
+}
+
+// Delete 删除购物车项
+func (c *guestCart) Delete(ctx context.Context, id string) error {
+	if err := c.client.HDel(ctx, c.key, id).Err(); err != nil {
+		return fmt.Errorf("删除游客购物车项失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteByUserID 删除游客购物车
+func (c *guestCart) DeleteByUserID(ctx context.Context, _ string) error {
+	if err := c.client.Del(ctx, c.key).Err(); err != nil {
+		return fmt.Errorf("清空游客购物车失败: %w", err)
+	}
+	return nil
+}
+
+// BatchUpdate 批量更新购物车项
+func (c *guestCart) BatchUpdate(ctx context.Context, cartItems []*cart.ShoppingCart) error {
+	return c.save(ctx, cartItems...)
+}
+
+// SaveAll 创建或更新购物车项
+func (c *guestCart) SaveAll(ctx context.Context, cartItems []*cart.ShoppingCart) error {
+	for _, cartItem := range cartItems {
+		if cartItem.ID == "" {
+			cartItem.ID = uuid.NewString()
+		}
+	}
+	return c.save(ctx, cartItems...)
+}
+
+// GetSelectedItems 获取游客购物车中选中的商品
+func (c *guestCart) GetSelectedItems(ctx context.Context, _ string) ([]*cart.ShoppingCart, error) {
+	items, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*cart.ShoppingCart
+	for _, item := range items {
+		if item.Selected {
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+// CountByUserID 统计游客购物车商品数量
+func (c *guestCart) CountByUserID(ctx context.Context, _ string) (int64, error) {
+	count, err := c.client.HLen(ctx, c.key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("统计游客购物车商品数量失败: %w", err)
+	}
+	return count, nil
+}
+
+// load 读取全部购物车项并刷新过期时间
+func (c *guestCart) load(ctx context.Context) ([]*cart.ShoppingCart, error) {
+	var getAll *redis.MapStringStringCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		getAll = pipe.HGetAll(ctx, c.key)
+		pipe.Expire(ctx, c.key, c.ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("获取游客购物车失败: %w", err)
+	}
+
+	items := make([]*cart.ShoppingCart, 0, len(getAll.Val()))
+	for _, value := range getAll.Val() {
+		var cartEntity cart.ShoppingCart
+		if err := json.Unmarshal([]byte(value), &cartEntity); err != nil {
+			return nil, fmt.Errorf("解析游客购物车项失败: %w", err)
+		}
+		items = append(items, &cartEntity)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].CreatedAt != items[j].CreatedAt {
+			return items[i].CreatedAt > items[j].CreatedAt
+		}
+		return items[i].ID < items[j].ID
+	})
+	return items, nil
+}
+
+// save 写入购物车项并刷新过期时间
+func (c *guestCart) save(ctx context.Context, cartItems ...*cart.ShoppingCart) error {
+	if len(cartItems) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, len(cartItems)*2)
+	for _, cartItem := range cartItems {
+		value, err := json.Marshal(cartItem)
+		if err != nil {
+			return fmt.Errorf("序列化游客购物车项失败: %w", err)
+		}
+		values = append(values, cartItem.ID, value)
+	}
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, c.key, values...)
+		pipe.Expire(ctx, c.key, c.ttl)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("保存游客购物车项失败: %w", err)
+	}
+	return nil
+}
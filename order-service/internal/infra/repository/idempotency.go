@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/idempotency"
+)
+
+// idempotencyRepository 幂等记录仓储实现
+type idempotencyRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewIdempotencyRepository 创建幂等记录仓储
+func NewIdempotencyRepository(db *gorm.DB, q *query.Query) idempotency.Repository {
+	return &idempotencyRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// Acquire 占用幂等键，依赖 (user_id, operation, idempotency_key) 唯一索引保证并发下只有一个请求成功
+func (r *idempotencyRepository) Acquire(ctx context.Context, record *idempotency.Record) (*idempotency.Record, error) {
+	expiresAt, err := time.ParseInLocation("2006-01-02 15:04:05", record.ExpiresAt, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("过期时间格式错误: %w", err)
+	}
+
+	var existing *idempotency.Record
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx)
+		k := q.IdempotencyKey
+
+		// 1. 过期的记录视为不存在
+		if _, err := q.WithContext(ctx).IdempotencyKey.
+			Where(k.UserID.Eq(record.UserID), k.Operation.Eq(record.Operation), k.IdempotencyKey.Eq(record.Key), k.ExpiresAt.Lt(time.Now())).
+			Delete(); err != nil {
+			return fmt.Errorf("清理过期幂等记录失败: %w", err)
+		}
+
+		// 2. 插入处理中的记录，键已存在时不插入
+		keyModel := &model.IdempotencyKey{
+			UserID:         record.UserID,
+			Operation:      record.Operation,
+			IdempotencyKey: record.Key,
+			RequestHash:    record.RequestHash,
+			Status:         record.Status,
+			ExpiresAt:      expiresAt,
+		}
+		result := tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(keyModel)
+		if result.Error != nil {
+			return fmt.Errorf("创建幂等记录失败: %w", result.Error)
+		}
+		if result.RowsAffected > 0 {
+			record.ID = keyModel.ID
+			return nil
+		}
+
+		// 3. 键已被占用，返回已有记录
+		existingModel, err := q.WithContext(ctx).IdempotencyKey.
+			Where(k.UserID.Eq(record.UserID), k.Operation.Eq(record.Operation), k.IdempotencyKey.Eq(record.Key)).
+			First()
+		if err != nil {
+			return fmt.Errorf("获取幂等记录失败: %w", err)
+		}
+		existing = r.modelToDomain(existingModel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// Renew 延长处理中的幂等键的占用时长
+func (r *idempotencyRepository) Renew(ctx context.Context, record *idempotency.Record) error {
+	expiresAt, err := time.ParseInLocation("2006-01-02 15:04:05", record.ExpiresAt, time.Local)
+	if err != nil {
+		return fmt.Errorf("过期时间格式错误: %w", err)
+	}
+
+	k := r.query.IdempotencyKey
+	_, err = r.query.WithContext(ctx).IdempotencyKey.
+		Where(k.ID.Eq(record.ID), k.Status.Eq(int32(idempotency.StatusProcessing))).
+		UpdateSimple(k.ExpiresAt.Value(expiresAt), k.UpdatedAt.Value(time.Now()))
+	if err != nil {
+		return fmt.Errorf("续期幂等记录失败: %w", err)
+	}
+	return nil
+}
+
+// Complete 保存首次请求的响应
+func (r *idempotencyRepository) Complete(ctx context.Context, record *idempotency.Record) error {
+	expiresAt, err := time.ParseInLocation("2006-01-02 15:04:05", record.ExpiresAt, time.Local)
+	if err != nil {
+		return fmt.Errorf("过期时间格式错误: %w", err)
+	}
+
+	k := r.query.IdempotencyKey
+	_, err = r.query.WithContext(ctx).IdempotencyKey.
+		Where(k.ID.Eq(record.ID)).
+		Updates(map[string]interface{}{
+			"status":     record.Status,
+			"response":   record.Response,
+			"expires_at": expiresAt,
+			"updated_at": time.Now(),
+		})
+	if err != nil {
+		return fmt.Errorf("保存幂等响应失败: %w", err)
+	}
+	return nil
+}
+
+// Release 释放处理中的幂等键
+func (r *idempotencyRepository) Release(ctx context.Context, record *idempotency.Record) error {
+	k := r.query.IdempotencyKey
+	_, err := r.query.WithContext(ctx).IdempotencyKey.
+		Where(k.ID.Eq(record.ID), k.Status.Eq(int32(idempotency.StatusProcessing))).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("释放幂等记录失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired 删除在指定时间之前过期的记录
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	k := r.query.IdempotencyKey
+
+	var ids []string
+	if err := r.query.WithContext(ctx).IdempotencyKey.
+		Where(k.ExpiresAt.Lt(before)).
+		Limit(limit).
+		Pluck(k.ID, &ids); err != nil {
+		return 0, fmt.Errorf("获取过期幂等记录失败: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.query.WithContext(ctx).IdempotencyKey.Where(k.ID.In(ids...)).Delete()
+	if err != nil {
+		return 0, fmt.Errorf("删除过期幂等记录失败: %w", err)
+	}
+	return result.RowsAffected, nil
+}
+
+// 数据模型转换为领域对象
+func (r *idempotencyRepository) modelToDomain(keyModel *model.IdempotencyKey) *idempotency.Record {
+	record := &idempotency.Record{
+		ID:          keyModel.ID,
+		UserID:      keyModel.UserID,
+		Operation:   keyModel.Operation,
+		Key:         keyModel.IdempotencyKey,
+		RequestHash: keyModel.RequestHash,
+		Status:      keyModel.Status,
+		ExpiresAt:   keyModel.ExpiresAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:   keyModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   keyModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if keyModel.Response != nil {
+		record.Response = *keyModel.Response
+	}
+	return record
+}
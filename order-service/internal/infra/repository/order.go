@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 
 	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
@@ -27,53 +28,122 @@ func NewOrderRepository(db *gorm.DB, q *query.Query) order.Repository {
 }
 
 // Create 创建订单
-func (r *orderRepository) Create(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, address *order.OrderAddress) error {
+func (r *orderRepository) Create(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, address *order.OrderAddress, event *order.Event) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. 创建订单主记录
-		orderModel := r.domainToModel(orderEntity)
-		if err := tx.Create(orderModel).Error; err != nil {
-			return fmt.Errorf("创建订单失败: %w", err)
-		}
-
-		// 设置订单ID到实体
-		orderEntity.ID = orderModel.ID
-		orderEntity.OrderNo = orderModel.OrderNo
+		return r.create(tx, orderEntity, items, address, event)
+	})
+}
 
-		// 2. 创建订单商品项
-		for _, item := range items {
-			itemModel := r.itemDomainToModel(item)
-			itemModel.OrderID = orderModel.ID
-			if err := tx.Create(itemModel).Error; err != nil {
-				return fmt.Errorf("创建订单商品项失败: %w", err)
-			}
-			item.ID = itemModel.ID
-			item.OrderID = itemModel.OrderID
+// CreateFromCart 从购物车创建订单
+func (r *orderRepository) CreateFromCart(ctx context.Context, orderEntity *order.Order, items []*order.OrderItem, address *order.OrderAddress, cartItemIDs []string, event *order.Event) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.create(tx, orderEntity, items, address, event); err != nil {
+			return err
 		}
 
-		// 3. 创建订单地址
-		addressModel := r.addressDomainToModel(address)
-		addressModel.OrderID = orderModel.ID
-		if err := tx.Create(addressModel).Error; err != nil {
-			return fmt.Errorf("创建订单地址失败: %w", err)
+		// 移除已结算的购物车项，数量不一致说明购物车项已被并发结算或删除，回滚整个订单
+		c := query.Use(tx).ShoppingCart
+		result, err := c.WithContext(ctx).Where(c.ID.In(cartItemIDs...), c.UserID.Eq(orderEntity.UserID)).Delete()
+		if err != nil {
+			return fmt.Errorf("移除购物车项失败: %w", err)
 		}
-		address.ID = addressModel.ID
-		address.OrderID = addressModel.OrderID
-
-		// 4. 记录状态日志
-		remark := "订单创建"
-		statusLog := &model.OrderStatusLog{
-			OrderID:  orderModel.ID,
-			ToStatus: orderModel.Status,
-			Remark:   &remark,
+		if result.RowsAffected != int64(len(cartItemIDs)) {
+			return order.ErrCartChanged
 		}
-		if err := tx.Create(statusLog).Error; err != nil {
-			return fmt.Errorf("创建状态日志失败: %w", err)
+
+		return nil
+	})
+}
+
+// RestoreCartItems 恢复下单时软删除的购物车项
+func (r *orderRepository) RestoreCartItems(ctx context.Context, cartItemIDs []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		c := query.Use(tx).ShoppingCart
+		removed, err := c.WithContext(ctx).Unscoped().Where(c.ID.In(cartItemIDs...), c.DeletedAt.IsNotNull()).Find()
+		if err != nil {
+			return fmt.Errorf("获取已移除的购物车项失败: %w", err)
 		}
 
+		for _, item := range removed {
+			// 用户已重新加购同一商品时保留新的购物车项
+			existing := c.WithContext(ctx).Where(c.UserID.Eq(item.UserID), c.ProductID.Eq(item.ProductID))
+			if item.SkuID != nil {
+				existing = existing.Where(c.SkuID.Eq(*item.SkuID))
+			} else {
+				existing = existing.Where(c.SkuID.IsNull())
+			}
+			count, err := existing.Count()
+			if err != nil {
+				return fmt.Errorf("检查购物车项失败: %w", err)
+			}
+			if count > 0 {
+				continue
+			}
+
+			if _, err := c.WithContext(ctx).Unscoped().Where(c.ID.Eq(item.ID)).UpdateSimple(c.DeletedAt.Null(), c.UpdatedAt.Value(time.Now())); err != nil {
+				return fmt.Errorf("恢复购物车项失败: %w", err)
+			}
+		}
 		return nil
 	})
 }
 
+// create 在事务中创建订单主记录、商品项、地址、状态日志和预售订单的支付阶段，锁定优惠券并写入订单创建事件
+func (r *orderRepository) create(tx *gorm.DB, orderEntity *order.Order, items []*order.OrderItem, address *order.OrderAddress, event *order.Event) error {
+	// 1. 创建订单主记录
+	orderModel := r.domainToModel(orderEntity)
+	orderModel.Version = 1
+	if err := tx.Create(orderModel).Error; err != nil {
+		return fmt.Errorf("创建订单失败: %w", err)
+	}
+
+	// 设置订单ID到实体
+	orderEntity.ID = orderModel.ID
+	orderEntity.OrderNo = orderModel.OrderNo
+	orderEntity.Version = orderModel.Version
+
+	// 2. 创建订单商品项
+	for _, item := range items {
+		itemModel := r.itemDomainToModel(item)
+		itemModel.OrderID = orderModel.ID
+		if err := tx.Create(itemModel).Error; err != nil {
+			return fmt.Errorf("创建订单商品项失败: %w", err)
+		}
+		item.ID = itemModel.ID
+		item.OrderID = itemModel.OrderID
+	}
+
+	// 3. 创建订单地址
+	addressModel := r.addressDomainToModel(address)
+	addressModel.OrderID = orderModel.ID
+	if err := tx.Create(addressModel).Error; err != nil {
+		return fmt.Errorf("创建订单地址失败: %w", err)
+	}
+	address.ID = addressModel.ID
+	address.OrderID = addressModel.OrderID
+
+	// 4. 记录状态日志
+	statusLog := r.statusLogDomainToModel(order.NewStatusLog(orderModel.ID, 0, orderModel.Status, order.UserOperator(orderModel.UserID), "订单创建"))
+	if err := tx.Create(statusLog).Error; err != nil {
+		return fmt.Errorf("创建状态日志失败: %w", err)
+	}
+
+	// 5. 锁定订单使用的优惠券
+	if err := lockCoupons(tx.Statement.Context, query.Use(tx), orderEntity); err != nil {
+		return err
+	}
+
+	// 6. 创建预售订单的支付阶段
+	if err := createPayments(tx.Statement.Context, query.Use(tx), orderEntity); err != nil {
+		return err
+	}
+
+	// 7. 写入订单创建事件
+	event.OrderID = orderModel.ID
+	event.OrderNo = orderModel.OrderNo
+	return createEvent(tx, event)
+}
+
 // GetByID 根据ID获取订单
 func (r *orderRepository) GetByID(ctx context.Context, id string) (*order.Order, error) {
 	orderModel, err := r.query.WithContext(ctx).Order.Where(r.query.Order.ID.Eq(id)).First()
@@ -130,18 +200,215 @@ func (r *orderRepository) ListByUserID(ctx context.Context, userID string, statu
 	return orders, total, nil
 }
 
-// Update 更新订单
+// Search 按条件搜索订单，使用 (created_at, id) 作为 keyset 游标倒序翻页
+func (r *orderRepository) Search(ctx context.Context, filter *order.SearchFilter, after *order.SearchCursor, limit int) ([]*order.Order, *order.SearchCursor, error) {
+	o := r.query.Order
+	q := r.query.WithContext(ctx).Order
+
+	if filter.OrderNo != "" {
+		q = q.Where(o.OrderNo.Eq(filter.OrderNo))
+	}
+	if filter.UserID != "" {
+		q = q.Where(o.UserID.Eq(filter.UserID))
+	}
+	if len(filter.Statuses) > 0 {
+		q = q.Where(o.Status.In(filter.Statuses...))
+	}
+	if filter.PaymentMethod != "" {
+		q = q.Where(o.PaymentMethod.Eq(filter.PaymentMethod))
+	}
+	if filter.MinAmount != nil {
+		q = q.Where(o.ActualAmount.Gte(*filter.MinAmount))
+	}
+	if filter.MaxAmount != nil {
+		q = q.Where(o.ActualAmount.Lte(*filter.MaxAmount))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		q = q.Where(o.CreatedAt.Gte(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		q = q.Where(o.CreatedAt.Lt(filter.CreatedTo))
+	}
+	if !filter.PaidFrom.IsZero() {
+		q = q.Where(o.PaymentTime.Gte(filter.PaidFrom))
+	}
+	if !filter.PaidTo.IsZero() {
+		q = q.Where(o.PaymentTime.Lt(filter.PaidTo))
+	}
+	if after != nil {
+		// created_at < ? OR (created_at = ? AND id < ?)
+		q = q.Where(q.Where(o.CreatedAt.Lt(after.CreatedAt)).Or(o.CreatedAt.Eq(after.CreatedAt), o.ID.Lt(after.ID)))
+	}
+
+	// 多取一条判断是否还有下一页
+	orderModels, err := q.Order(o.CreatedAt.Desc(), o.ID.Desc()).Limit(limit + 1).Find()
+	if err != nil {
+		return nil, nil, fmt.Errorf("搜索订单失败: %w", err)
+	}
+
+	var next *order.SearchCursor
+	if len(orderModels) > limit {
+		orderModels = orderModels[:limit]
+		last := orderModels[limit-1]
+		next = &order.SearchCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	orders := make([]*order.Order, 0, len(orderModels))
+	for _, orderModel := range orderModels {
+		orders = append(orders, r.modelToDomain(orderModel))
+	}
+
+	return orders, next, nil
+}
+
+// Update 更新订单（乐观锁）
 func (r *orderRepository) Update(ctx context.Context, orderEntity *order.Order) error {
+	if err := r.update(ctx, r.query, orderEntity); err != nil {
+		return err
+	}
+
+	orderEntity.Version++
+	return nil
+}
+
+// UpdateStatus 更新订单状态，同一事务中记录状态日志、保存支付阶段并写入订单事件
+func (r *orderRepository) UpdateStatus(ctx context.Context, orderEntity *order.Order, statusLog *order.OrderStatusLog, event *order.Event) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx)
+		if err := r.update(ctx, q, orderEntity); err != nil {
+			return err
+		}
+
+		statusLog.OrderID = orderEntity.ID
+		logModel := r.statusLogDomainToModel(statusLog)
+		if err := q.WithContext(ctx).OrderStatusLog.Create(logModel); err != nil {
+			return fmt.Errorf("创建状态日志失败: %w", err)
+		}
+		statusLog.ID = logModel.ID
+		statusLog.CreatedAt = logModel.CreatedAt.Format("2006-01-02 15:04:05")
+
+		if err := settleCoupons(ctx, q, orderEntity); err != nil {
+			return err
+		}
+		if err := savePayments(ctx, q, orderEntity); err != nil {
+			return err
+		}
+
+		return createEvent(tx, event)
+	})
+	if err != nil {
+		return err
+	}
+
+	orderEntity.Version++
+	return nil
+}
+
+// UpdateAddress 替换订单地址快照，同一事务中按乐观锁更新订单并记录状态日志
+func (r *orderRepository) UpdateAddress(ctx context.Context, orderEntity *order.Order, address *order.OrderAddress, statusLog *order.OrderStatusLog) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx)
+		if err := r.update(ctx, q, orderEntity); err != nil {
+			return err
+		}
+
+		a := q.OrderAddress
+		addressModel := r.addressDomainToModel(address)
+		result, err := q.WithContext(ctx).OrderAddress.
+			Select(a.ReceiverName, a.ReceiverPhone, a.Province, a.City, a.District, a.Address, a.PostalCode, a.UpdatedAt).
+			Where(a.OrderID.Eq(orderEntity.ID)).
+			Updates(addressModel)
+		if err != nil {
+			return fmt.Errorf("更新订单地址失败: %w", err)
+		}
+		if result.RowsAffected == 0 {
+			return order.ErrOrderAddressNotFound
+		}
+
+		statusLog.OrderID = orderEntity.ID
+		logModel := r.statusLogDomainToModel(statusLog)
+		if err := q.WithContext(ctx).OrderStatusLog.Create(logModel); err != nil {
+			return fmt.Errorf("创建状态日志失败: %w", err)
+		}
+		statusLog.ID = logModel.ID
+		statusLog.CreatedAt = logModel.CreatedAt.Format("2006-01-02 15:04:05")
+		address.UpdatedAt = statusLog.CreatedAt
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	orderEntity.Version++
+	return nil
+}
+
+// update 按乐观锁更新订单主记录
+func (r *orderRepository) update(ctx context.Context, q *query.Query, orderEntity *order.Order) error {
 	orderModel := r.domainToModel(orderEntity)
+	orderModel.Version = orderEntity.Version + 1
 
-	_, err := r.query.WithContext(ctx).Order.Where(r.query.Order.ID.Eq(orderEntity.ID)).Updates(orderModel)
+	o := q.Order
+	result, err := q.WithContext(ctx).Order.Where(o.ID.Eq(orderEntity.ID), o.Version.Eq(orderEntity.Version)).Updates(orderModel)
 	if err != nil {
 		return fmt.Errorf("更新订单失败: %w", err)
 	}
+	if result.RowsAffected == 0 {
+		return order.ErrOrderConflict
+	}
 
 	return nil
 }
 
+// ListPaymentOverdue 获取支付超时的待付款、待付尾款订单
+func (r *orderRepository) ListPaymentOverdue(ctx context.Context, before time.Time, limit int) ([]*order.Order, error) {
+	o := r.query.Order
+	orderModels, err := r.query.WithContext(ctx).Order.
+		Where(
+			o.Status.In(int32(order.OrderStatusPendingPayment), int32(order.OrderStatusDepositPaid)),
+			o.PaymentDeadline.Lte(before),
+		).
+		Order(o.PaymentDeadline).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取支付超时订单失败: %w", err)
+	}
+
+	orders := make([]*order.Order, 0, len(orderModels))
+	for _, orderModel := range orderModels {
+		orders = append(orders, r.modelToDomain(orderModel))
+	}
+
+	return orders, nil
+}
+
+// ListReceiveOverdue 获取超过自动确认收货时间的已发货订单
+func (r *orderRepository) ListReceiveOverdue(ctx context.Context, shippedBefore, now time.Time, limit int) ([]*order.Order, error) {
+	o := r.query.Order
+	orderModels, err := r.query.WithContext(ctx).Order.
+		Where(
+			o.Status.Eq(int32(order.OrderStatusShipped)),
+			field.Or(
+				field.And(o.ReceiveDeadline.IsNull(), o.DeliveryTime.Lte(shippedBefore)),
+				o.ReceiveDeadline.Lte(now),
+			),
+		).
+		Order(o.DeliveryTime).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取待自动确认收货订单失败: %w", err)
+	}
+
+	orders := make([]*order.Order, 0, len(orderModels))
+	for _, orderModel := range orderModels {
+		orders = append(orders, r.modelToDomain(orderModel))
+	}
+
+	return orders, nil
+}
+
 // Delete 删除订单（软删除）
 func (r *orderRepository) Delete(ctx context.Context, id string) error {
 	_, err := r.query.WithContext(ctx).Order.Where(r.query.Order.ID.Eq(id)).Delete()
@@ -180,25 +447,10 @@ func (r *orderRepository) GetOrderAddress(ctx context.Context, orderID string) (
 	return r.addressModelToDomain(addressModel), nil
 }
 
-// CreateStatusLog 创建状态日志
-func (r *orderRepository) CreateStatusLog(ctx context.Context, orderID string, status int32, remark string) error {
-	remarkPtr := remark
-	statusLog := &model.OrderStatusLog{
-		OrderID:  orderID,
-		ToStatus: status,
-		Remark:   &remarkPtr,
-	}
-
-	if err := r.db.WithContext(ctx).Create(statusLog).Error; err != nil {
-		return fmt.Errorf("创建状态日志失败: %w", err)
-	}
-
-	return nil
-}
-
-// GetStatusLogs 获取订单状态日志
+// GetStatusLogs 获取订单状态日志，按时间正序
 func (r *orderRepository) GetStatusLogs(ctx context.Context, orderID string) ([]*order.OrderStatusLog, error) {
-	logModels, err := r.query.WithContext(ctx).OrderStatusLog.Where(r.query.OrderStatusLog.OrderID.Eq(orderID)).Order(r.query.OrderStatusLog.CreatedAt.Desc()).Find()
+	l := r.query.OrderStatusLog
+	logModels, err := r.query.WithContext(ctx).OrderStatusLog.Where(l.OrderID.Eq(orderID)).Order(l.CreatedAt, l.ID).Find()
 	if err != nil {
 		return nil, fmt.Errorf("获取状态日志失败: %w", err)
 	}
@@ -233,31 +485,20 @@ func (r *orderRepository) domainToModel(orderEntity *order.Order) *model.Order {
 		orderModel.ShippingFee = &orderEntity.ShippingFee
 	}
 
-	// 处理时间字段
-	if orderEntity.PaymentTime != "" {
-		if t, err := time.Parse("2006-01-02 15:04:05", orderEntity.PaymentTime); err == nil {
-			orderModel.PaymentTime = &t
-		}
-	}
-	if orderEntity.DeliveryTime != "" {
-		if t, err := time.Parse("2006-01-02 15:04:05", orderEntity.DeliveryTime); err == nil {
-			orderModel.DeliveryTime = &t
-		}
-	}
-	if orderEntity.ReceiveTime != "" {
-		if t, err := time.Parse("2006-01-02 15:04:05", orderEntity.ReceiveTime); err == nil {
-			orderModel.ReceiveTime = &t
-		}
-	}
-	if orderEntity.CancelTime != "" {
-		if t, err := time.Parse("2006-01-02 15:04:05", orderEntity.CancelTime); err == nil {
-			orderModel.CancelTime = &t
-		}
-	}
+	// 处理时间字段，领域对象中的时间均为本地时间
+	orderModel.PaymentTime = parseLocalTime(orderEntity.PaymentTime)
+	orderModel.DeliveryTime = parseLocalTime(orderEntity.DeliveryTime)
+	orderModel.ReceiveTime = parseLocalTime(orderEntity.ReceiveTime)
+	orderModel.CancelTime = parseLocalTime(orderEntity.CancelTime)
+	orderModel.PaymentDeadline = parseLocalTime(orderEntity.PaymentDeadline)
+	orderModel.ReceiveDeadline = parseLocalTime(orderEntity.ReceiveDeadline)
 
 	if orderEntity.CancelReason != "" {
 		orderModel.CancelReason = &orderEntity.CancelReason
 	}
+	if orderEntity.PresaleID != "" {
+		orderModel.PresaleID = &orderEntity.PresaleID
+	}
 
 	return orderModel
 }
@@ -308,22 +549,37 @@ func (r *orderRepository) modelToDomain(orderModel *model.Order) *order.Order {
 	if orderModel.CancelReason != nil {
 		orderEntity.CancelReason = *orderModel.CancelReason
 	}
+	if orderModel.PaymentDeadline != nil {
+		orderEntity.PaymentDeadline = orderModel.PaymentDeadline.Format("2006-01-02 15:04:05")
+	}
+	if orderModel.ReceiveDeadline != nil {
+		orderEntity.ReceiveDeadline = orderModel.ReceiveDeadline.Format("2006-01-02 15:04:05")
+	}
+	if orderModel.PresaleID != nil {
+		orderEntity.PresaleID = *orderModel.PresaleID
+	}
 
 	return orderEntity
 }
 
 // 订单商品项领域对象转换为数据模型
 func (r *orderRepository) itemDomainToModel(item *order.OrderItem) *model.OrderItem {
-	return &model.OrderItem{
+	itemModel := &model.OrderItem{
 		ID:          item.ID,
 		OrderID:     item.OrderID,
 		ProductID:   item.ProductID,
 		SkuID:       &item.SkuID,
 		Quantity:    item.Quantity,
 		Price:       item.Price,
+		TotalAmount: item.TotalAmount,
 		ProductName: item.ProductName,
 		SkuName:     &item.SkuName,
 	}
+	if item.ProductImage != "" {
+		itemModel.ProductImage = &item.ProductImage
+	}
+
+	return itemModel
 }
 
 // 订单商品项数据模型转换为领域对象
@@ -334,6 +590,7 @@ func (r *orderRepository) itemModelToDomain(itemModel *model.OrderItem) *order.O
 		ProductID:   itemModel.ProductID,
 		Quantity:    itemModel.Quantity,
 		Price:       itemModel.Price,
+		TotalAmount: itemModel.TotalAmount,
 		ProductName: itemModel.ProductName,
 		CreatedAt:   itemModel.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:   itemModel.UpdatedAt.Format("2006-01-02 15:04:05"),
@@ -345,6 +602,9 @@ func (r *orderRepository) itemModelToDomain(itemModel *model.OrderItem) *order.O
 	if itemModel.SkuName != nil {
 		item.SkuName = *itemModel.SkuName
 	}
+	if itemModel.ProductImage != nil {
+		item.ProductImage = *itemModel.ProductImage
+	}
 
 	return item
 }
@@ -389,15 +649,43 @@ func (r *orderRepository) addressModelToDomain(addressModel *model.OrderAddress)
 // 状态日志数据模型转换为领域对象
 func (r *orderRepository) statusLogModelToDomain(logModel *model.OrderStatusLog) *order.OrderStatusLog {
 	log := &order.OrderStatusLog{
-		ID:        logModel.ID,
-		OrderID:   logModel.OrderID,
-		Status:    logModel.ToStatus,
-		CreatedAt: logModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		ID:           logModel.ID,
+		OrderID:      logModel.OrderID,
+		Status:       logModel.ToStatus,
+		OperatorType: logModel.OperatorType,
+		CreatedAt:    logModel.CreatedAt.Format("2006-01-02 15:04:05"),
 	}
 
+	if logModel.FromStatus != nil {
+		log.FromStatus = *logModel.FromStatus
+	}
+	if logModel.OperatorID != nil {
+		log.OperatorID = *logModel.OperatorID
+	}
 	if logModel.Remark != nil {
 		log.Remark = *logModel.Remark
 	}
 
 	return log
 }
+
+// 状态日志领域对象转换为数据模型
+func (r *orderRepository) statusLogDomainToModel(log *order.OrderStatusLog) *model.OrderStatusLog {
+	logModel := &model.OrderStatusLog{
+		OrderID:      log.OrderID,
+		ToStatus:     log.Status,
+		OperatorType: log.OperatorType,
+	}
+
+	if log.FromStatus != 0 {
+		logModel.FromStatus = &log.FromStatus
+	}
+	if log.OperatorID != "" {
+		logModel.OperatorID = &log.OperatorID
+	}
+	if log.Remark != "" {
+		logModel.Remark = &log.Remark
+	}
+
+	return logModel
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// GetPayments 获取订单的支付阶段
+func (r *orderRepository) GetPayments(ctx context.Context, orderID string) ([]*order.OrderPayment, error) {
+	p := r.query.OrderPayment
+	paymentModels, err := r.query.WithContext(ctx).OrderPayment.Where(p.OrderID.Eq(orderID)).Order(p.Stage).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取订单支付阶段失败: %w", err)
+	}
+
+	return r.paymentsToEntities(paymentModels), nil
+}
+
+// UpdatePayment 更新支付阶段
+func (r *orderRepository) UpdatePayment(ctx context.Context, payment *order.OrderPayment) error {
+	return updatePayment(ctx, r.query, payment)
+}
+
+// ListBalanceDue 获取已开始支付但尚未创建支付单的尾款
+func (r *orderRepository) ListBalanceDue(ctx context.Context, now time.Time, limit int) ([]*order.OrderPayment, error) {
+	p := r.query.OrderPayment
+	o := r.query.Order
+	paymentModels, err := r.query.WithContext(ctx).OrderPayment.
+		Select(p.ALL).
+		Join(o, o.ID.EqCol(p.OrderID)).
+		Where(
+			p.Stage.Eq(int32(order.PaymentStageBalance)),
+			p.PaymentStatus.Eq(int32(order.PaymentStatusUnpaid)),
+			p.RequestedAt.IsNull(),
+			p.PayableFrom.Lte(now),
+			o.Status.Eq(int32(order.OrderStatusDepositPaid)),
+			o.DeletedAt.IsNull(),
+		).
+		Order(p.PayableFrom).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取待创建支付单的尾款失败: %w", err)
+	}
+
+	return r.paymentsToEntities(paymentModels), nil
+}
+
+// ListRefundingPayments 获取退款中的支付阶段
+func (r *orderRepository) ListRefundingPayments(ctx context.Context, limit int) ([]*order.OrderPayment, error) {
+	p := r.query.OrderPayment
+	paymentModels, err := r.query.WithContext(ctx).OrderPayment.
+		Where(p.PaymentStatus.Eq(int32(order.PaymentStatusRefunding))).
+		Order(p.UpdatedAt).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取退款中的支付阶段失败: %w", err)
+	}
+
+	return r.paymentsToEntities(paymentModels), nil
+}
+
+// createPayments 在创建订单的事务中写入预售订单的支付阶段
+func createPayments(ctx context.Context, q *query.Query, orderEntity *order.Order) error {
+	for _, payment := range orderEntity.PaymentStages {
+		payment.OrderID = orderEntity.ID
+		paymentModel := paymentToModel(payment)
+		if err := q.WithContext(ctx).OrderPayment.Create(paymentModel); err != nil {
+			return fmt.Errorf("创建订单支付阶段失败: %w", err)
+		}
+		payment.CreatedAt = paymentModel.CreatedAt.Format("2006-01-02 15:04:05")
+		payment.UpdatedAt = paymentModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	}
+	return nil
+}
+
+// savePayments 在订单状态变更的事务中保存已加载的支付阶段
+func savePayments(ctx context.Context, q *query.Query, orderEntity *order.Order) error {
+	for _, payment := range orderEntity.PaymentStages {
+		if err := updatePayment(ctx, q, payment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updatePayment 更新支付阶段的支付状态、退款金额和支付单创建时间
+func updatePayment(ctx context.Context, q *query.Query, payment *order.OrderPayment) error {
+	paymentModel := paymentToModel(payment)
+	p := q.OrderPayment
+	_, err := q.WithContext(ctx).OrderPayment.
+		Select(p.PaymentMethod, p.PaymentStatus, p.PaymentTime, p.RefundedAmount, p.RefundTime, p.RequestedAt, p.UpdatedAt).
+		Where(p.ID.Eq(payment.ID)).
+		Updates(paymentModel)
+	if err != nil {
+		return fmt.Errorf("更新订单支付阶段失败: %w", err)
+	}
+	return nil
+}
+
+// paymentsToEntities 批量转换支付阶段
+func (r *orderRepository) paymentsToEntities(paymentModels []*model.OrderPayment) []*order.OrderPayment {
+	payments := make([]*order.OrderPayment, 0, len(paymentModels))
+	for _, paymentModel := range paymentModels {
+		payments = append(payments, paymentToEntity(paymentModel))
+	}
+	return payments
+}
+
+// paymentToModel 将支付阶段转换为数据库模型
+func paymentToModel(payment *order.OrderPayment) *model.OrderPayment {
+	paymentModel := &model.OrderPayment{
+		ID:             payment.ID,
+		OrderID:        payment.OrderID,
+		PaymentNo:      payment.PaymentNo,
+		PaymentMethod:  payment.PaymentMethod,
+		PaymentAmount:  payment.Amount,
+		PaymentStatus:  payment.Status,
+		Stage:          payment.Stage,
+		RefundedAmount: payment.RefundedAmount,
+		Forfeitable:    payment.Forfeitable,
+		PaymentTime:    parseOptionalTime(payment.PaidAt),
+		PayableFrom:    parseOptionalTime(payment.PayableFrom),
+		Deadline:       parseOptionalTime(payment.Deadline),
+		RequestedAt:    parseOptionalTime(payment.RequestedAt),
+		UpdatedAt:      time.Now(),
+	}
+	if payment.RefundedAmount.IsPositive() {
+		paymentModel.RefundTime = parseOptionalTime(payment.UpdatedAt)
+	}
+	return paymentModel
+}
+
+// paymentToEntity 将数据库模型转换为支付阶段
+func paymentToEntity(paymentModel *model.OrderPayment) *order.OrderPayment {
+	return &order.OrderPayment{
+		ID:             paymentModel.ID,
+		OrderID:        paymentModel.OrderID,
+		PaymentNo:      paymentModel.PaymentNo,
+		PaymentMethod:  paymentModel.PaymentMethod,
+		Amount:         paymentModel.PaymentAmount,
+		Status:         paymentModel.PaymentStatus,
+		Stage:          paymentModel.Stage,
+		RefundedAmount: paymentModel.RefundedAmount,
+		Forfeitable:    paymentModel.Forfeitable,
+		PaidAt:         formatOptionalTime(paymentModel.PaymentTime),
+		PayableFrom:    formatOptionalTime(paymentModel.PayableFrom),
+		Deadline:       formatOptionalTime(paymentModel.Deadline),
+		RequestedAt:    formatOptionalTime(paymentModel.RequestedAt),
+		CreatedAt:      paymentModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      paymentModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// parseOptionalTime 解析可为空的时间字段
+func parseOptionalTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// formatOptionalTime 格式化可为空的时间字段
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+func TestOrderRepository_RestoreCartItems(t *testing.T) {
+	dsn := os.Getenv("ORDER_TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("ORDER_TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	q := query.Use(db)
+	ctx := context.Background()
+	c := q.ShoppingCart
+
+	userID := uuid.NewString()
+	newRow := func(productID string) *model.ShoppingCart {
+		row := &model.ShoppingCart{UserID: userID, ProductID: productID, Quantity: 2, Price: decimal.RequireFromString("10"), Selected: true}
+		require.NoError(t, q.WithContext(ctx).ShoppingCart.Create(row))
+		return row
+	}
+	restored := newRow(uuid.NewString())
+	readded := newRow(uuid.NewString())
+	_, err = q.WithContext(ctx).ShoppingCart.Where(c.ID.In(restored.ID, readded.ID)).Delete()
+	require.NoError(t, err)
+
+	// 下单期间用户重新加购了同一商品，这一项不再恢复
+	replacement := newRow(readded.ProductID)
+
+	repo := NewOrderRepository(db, q)
+	require.NoError(t, repo.RestoreCartItems(ctx, []string{restored.ID, readded.ID}))
+	// 重复补偿没有副作用
+	require.NoError(t, repo.RestoreCartItems(ctx, []string{restored.ID, readded.ID}))
+
+	rows, err := q.WithContext(ctx).ShoppingCart.Where(c.UserID.Eq(userID)).Find()
+	require.NoError(t, err)
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	assert.ElementsMatch(t, []string{restored.ID, replacement.ID}, ids)
+}
+
+func TestOrderRepository_DomainToModelTimes(t *testing.T) {
+	r := &orderRepository{}
+	orderModel := r.domainToModel(&order.Order{
+		PaymentTime:     "2025-03-01 10:00:00",
+		DeliveryTime:    "2025-03-02 10:00:00",
+		ReceiveTime:     "2025-03-03 10:00:00",
+		CancelTime:      "2025-03-04 10:00:00",
+		PaymentDeadline: "2025-03-05 10:00:00",
+		ReceiveDeadline: "2025-03-06 10:00:00",
+	})
+
+	// 所有时间字段按同一规则解析为本地时间，保证自动确认收货等按时间比较的逻辑一致
+	for _, value := range []*time.Time{
+		orderModel.PaymentTime, orderModel.DeliveryTime, orderModel.ReceiveTime,
+		orderModel.CancelTime, orderModel.PaymentDeadline, orderModel.ReceiveDeadline,
+	} {
+		require.NotNil(t, value)
+		assert.Equal(t, time.Local, value.Location())
+	}
+	assert.Equal(t, "2025-03-02 10:00:00", orderModel.DeliveryTime.Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// 发件箱事件状态
+const (
+	outboxStatusPending   int32 = 1 // 待投递
+	outboxStatusPublished int32 = 2 // 已投递
+	outboxStatusDead      int32 = 3 // 死信，超过重试次数后不再自动投递
+)
+
+// outboxRepository 事件发件箱仓储实现
+type outboxRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewOutboxRepository 创建事件发件箱仓储
+func NewOutboxRepository(db *gorm.DB, q *query.Query) order.OutboxRepository {
+	return &outboxRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// ListPending 获取已到投递时间的待投递事件
+// 按写入序号排序；同一订单更早的事件仍在退避等待时，跳过该订单后续的事件，保证订单内的投递顺序。
+func (r *outboxRepository) ListPending(ctx context.Context, limit int) ([]*order.OutboxMessage, error) {
+	e := r.query.OrderOutboxEvent
+	waiting := e.As("waiting")
+	now := time.Now()
+
+	eventModels, err := r.query.WithContext(ctx).OrderOutboxEvent.
+		Where(
+			e.Status.Eq(outboxStatusPending),
+			field.Or(e.NextAttemptAt.IsNull(), e.NextAttemptAt.Lte(now)),
+		).
+		Not(gen.Exists(waiting.WithContext(ctx).
+			Select(waiting.ID).
+			Where(
+				waiting.OrderID.EqCol(e.OrderID),
+				waiting.Status.Eq(outboxStatusPending),
+				waiting.Seq.LtCol(e.Seq),
+				waiting.NextAttemptAt.Gt(now),
+			))).
+		Order(e.Seq).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取待投递事件失败: %w", err)
+	}
+
+	messages := make([]*order.OutboxMessage, 0, len(eventModels))
+	for _, eventModel := range eventModels {
+		messages = append(messages, &order.OutboxMessage{
+			ID:        eventModel.ID,
+			Type:      order.EventType(eventModel.EventType),
+			OrderID:   eventModel.OrderID,
+			Payload:   []byte(eventModel.Payload),
+			Attempts:  eventModel.Attempts,
+			CreatedAt: eventModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return messages, nil
+}
+
+// MarkPublished 标记事件已投递
+func (r *outboxRepository) MarkPublished(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	e := r.query.OrderOutboxEvent
+	_, err := r.query.WithContext(ctx).OrderOutboxEvent.
+		Where(e.ID.In(ids...), e.Status.Eq(outboxStatusPending)).
+		UpdateSimple(e.Status.Value(outboxStatusPublished), e.PublishedAt.Value(time.Now()))
+	if err != nil {
+		return fmt.Errorf("标记事件已投递失败: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed 记录投递失败，事件到 retryAt 后再重试
+func (r *outboxRepository) MarkFailed(ctx context.Context, id string, reason string, retryAt time.Time) error {
+	e := r.query.OrderOutboxEvent
+	_, err := r.query.WithContext(ctx).OrderOutboxEvent.
+		Where(e.ID.Eq(id), e.Status.Eq(outboxStatusPending)).
+		UpdateSimple(e.Attempts.Add(1), e.LastError.Value(reason), e.NextAttemptAt.Value(retryAt))
+	if err != nil {
+		return fmt.Errorf("记录事件投递失败: %w", err)
+	}
+	return nil
+}
+
+// MarkDead 记录投递失败并将事件转为死信
+func (r *outboxRepository) MarkDead(ctx context.Context, id string, reason string) error {
+	e := r.query.OrderOutboxEvent
+	_, err := r.query.WithContext(ctx).OrderOutboxEvent.
+		Where(e.ID.Eq(id), e.Status.Eq(outboxStatusPending)).
+		UpdateSimple(e.Attempts.Add(1), e.LastError.Value(reason), e.Status.Value(outboxStatusDead))
+	if err != nil {
+		return fmt.Errorf("记录事件死信失败: %w", err)
+	}
+	return nil
+}
+
+// DeletePublished 删除在指定时间之前投递的事件
+func (r *outboxRepository) DeletePublished(ctx context.Context, before time.Time, limit int) (int64, error) {
+	e := r.query.OrderOutboxEvent
+
+	var ids []string
+	if err := r.query.WithContext(ctx).OrderOutboxEvent.
+		Where(e.Status.Eq(outboxStatusPublished), e.PublishedAt.Lt(before)).
+		Limit(limit).
+		Pluck(e.ID, &ids); err != nil {
+		return 0, fmt.Errorf("获取已投递事件失败: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.query.WithContext(ctx).OrderOutboxEvent.Where(e.ID.In(ids...)).Delete()
+	if err != nil {
+		return 0, fmt.Errorf("删除已投递事件失败: %w", err)
+	}
+	return result.RowsAffected, nil
+}
+
+// createEvent 在订单变更的事务中写入待投递的事件
+func createEvent(tx *gorm.DB, event *order.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("序列化订单事件失败: %w", err)
+	}
+
+	eventModel := &model.OrderOutboxEvent{
+		ID:        event.ID,
+		OrderID:   event.OrderID,
+		EventType: string(event.Type),
+		Payload:   string(payload),
+		Status:    outboxStatusPending,
+	}
+	if err := tx.Create(eventModel).Error; err != nil {
+		return fmt.Errorf("写入订单事件失败: %w", err)
+	}
+	return nil
+}
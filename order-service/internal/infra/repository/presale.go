@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/presale"
+)
+
+// presaleRepository 预售活动仓储实现
+type presaleRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewPresaleRepository 创建预售活动仓储
+func NewPresaleRepository(db *gorm.DB, q *query.Query) presale.Repository {
+	return &presaleRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// Create 创建预售活动
+func (r *presaleRepository) Create(ctx context.Context, p *presale.Presale) error {
+	presaleModel := r.presaleToModel(p)
+	if err := r.query.WithContext(ctx).Presale.Create(presaleModel); err != nil {
+		return fmt.Errorf("创建预售活动失败: %w", err)
+	}
+
+	p.ID = presaleModel.ID
+	p.CreatedAt = presaleModel.CreatedAt.Format("2006-01-02 15:04:05")
+	p.UpdatedAt = presaleModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// Get 获取预售活动
+func (r *presaleRepository) Get(ctx context.Context, id string) (*presale.Presale, error) {
+	ps := r.query.Presale
+	presaleModel, err := r.query.WithContext(ctx).Presale.Where(ps.ID.Eq(id)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, presale.ErrPresaleNotFound
+		}
+		return nil, fmt.Errorf("获取预售活动失败: %w", err)
+	}
+
+	return r.presaleToEntity(presaleModel), nil
+}
+
+// List 获取预售活动列表，按定金开始时间倒序
+func (r *presaleRepository) List(ctx context.Context) ([]*presale.Presale, error) {
+	ps := r.query.Presale
+	presaleModels, err := r.query.WithContext(ctx).Presale.Order(ps.DepositStartAt.Desc()).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取预售活动失败: %w", err)
+	}
+
+	presales := make([]*presale.Presale, 0, len(presaleModels))
+	for _, presaleModel := range presaleModels {
+		presales = append(presales, r.presaleToEntity(presaleModel))
+	}
+	return presales, nil
+}
+
+// presaleToModel 将预售活动转换为数据库模型，时间格式已由领域实体校验
+func (r *presaleRepository) presaleToModel(p *presale.Presale) *model.Presale {
+	return &model.Presale{
+		ID:             p.ID,
+		Name:           p.Name,
+		ProductID:      p.ProductID,
+		SkuID:          p.SkuID,
+		Price:          p.Price,
+		Deposit:        p.Deposit,
+		DepositPolicy:  int32(p.DepositPolicy),
+		DepositStartAt: parseSaleTime(p.DepositStartAt),
+		DepositEndAt:   parseSaleTime(p.DepositEndAt),
+		BalanceStartAt: parseSaleTime(p.BalanceStartAt),
+		BalanceEndAt:   parseSaleTime(p.BalanceEndAt),
+	}
+}
+
+// presaleToEntity 将数据库模型转换为预售活动
+func (r *presaleRepository) presaleToEntity(presaleModel *model.Presale) *presale.Presale {
+	return &presale.Presale{
+		ID:             presaleModel.ID,
+		Name:           presaleModel.Name,
+		ProductID:      presaleModel.ProductID,
+		SkuID:          presaleModel.SkuID,
+		Price:          presaleModel.Price,
+		Deposit:        presaleModel.Deposit,
+		DepositPolicy:  presale.DepositPolicy(presaleModel.DepositPolicy),
+		DepositStartAt: presaleModel.DepositStartAt.Format("2006-01-02 15:04:05"),
+		DepositEndAt:   presaleModel.DepositEndAt.Format("2006-01-02 15:04:05"),
+		BalanceStartAt: presaleModel.BalanceStartAt.Format("2006-01-02 15:04:05"),
+		BalanceEndAt:   presaleModel.BalanceEndAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:      presaleModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      presaleModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,338 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/promotion"
+)
+
+// promotionRepository 优惠券仓储实现
+type promotionRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewPromotionRepository 创建优惠券仓储
+func NewPromotionRepository(db *gorm.DB, q *query.Query) promotion.Repository {
+	return &promotionRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// CreateTemplate 创建优惠券模板
+func (r *promotionRepository) CreateTemplate(ctx context.Context, template *promotion.Template) error {
+	templateModel, err := r.templateToModel(template)
+	if err != nil {
+		return err
+	}
+	if err := r.query.WithContext(ctx).CouponTemplate.Create(templateModel); err != nil {
+		return fmt.Errorf("创建优惠券模板失败: %w", err)
+	}
+
+	template.ID = templateModel.ID
+	template.CreatedAt = templateModel.CreatedAt.Format("2006-01-02 15:04:05")
+	template.UpdatedAt = templateModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// GetTemplate 获取优惠券模板
+func (r *promotionRepository) GetTemplate(ctx context.Context, id string) (*promotion.Template, error) {
+	t := r.query.CouponTemplate
+	templateModel, err := r.query.WithContext(ctx).CouponTemplate.Where(t.ID.Eq(id)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, promotion.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("获取优惠券模板失败: %w", err)
+	}
+
+	return r.templateToEntity(templateModel)
+}
+
+// Claim 领取优惠券，锁定模板行保证并发领取时不超发
+func (r *promotionRepository) Claim(ctx context.Context, coupon *promotion.Coupon) error {
+	return r.query.Transaction(func(tx *query.Query) error {
+		t := tx.CouponTemplate
+		templateModel, err := tx.WithContext(ctx).CouponTemplate.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(t.ID.Eq(coupon.TemplateID)).
+			First()
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return promotion.ErrTemplateNotFound
+			}
+			return fmt.Errorf("获取优惠券模板失败: %w", err)
+		}
+		if templateModel.TotalQuantity > 0 && templateModel.IssuedQuantity >= templateModel.TotalQuantity {
+			return promotion.ErrCouponSoldOut
+		}
+
+		if templateModel.PerUserLimit > 0 {
+			c := tx.UserCoupon
+			claimed, err := tx.WithContext(ctx).UserCoupon.Where(c.TemplateID.Eq(coupon.TemplateID), c.UserID.Eq(coupon.UserID)).Count()
+			if err != nil {
+				return fmt.Errorf("统计已领取优惠券失败: %w", err)
+			}
+			if claimed >= int64(templateModel.PerUserLimit) {
+				return promotion.ErrClaimLimitReached
+			}
+		}
+
+		couponModel := r.couponToModel(coupon)
+		if err := tx.WithContext(ctx).UserCoupon.Create(couponModel); err != nil {
+			return fmt.Errorf("发放优惠券失败: %w", err)
+		}
+		if _, err := tx.WithContext(ctx).CouponTemplate.Where(t.ID.Eq(coupon.TemplateID)).UpdateSimple(t.IssuedQuantity.Add(1)); err != nil {
+			return fmt.Errorf("更新已领取数量失败: %w", err)
+		}
+
+		coupon.ID = couponModel.ID
+		coupon.CreatedAt = couponModel.CreatedAt.Format("2006-01-02 15:04:05")
+		coupon.UpdatedAt = couponModel.UpdatedAt.Format("2006-01-02 15:04:05")
+		return nil
+	})
+}
+
+// ListByUserID 获取用户的优惠券
+func (r *promotionRepository) ListByUserID(ctx context.Context, userID string, status promotion.CouponStatus) ([]*promotion.Coupon, error) {
+	c := r.query.UserCoupon
+	q := r.query.WithContext(ctx).UserCoupon.Where(c.UserID.Eq(userID))
+	if status != 0 {
+		q = q.Where(c.Status.Eq(int32(status)))
+	}
+
+	couponModels, err := q.Order(c.ValidTo, c.ID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取优惠券失败: %w", err)
+	}
+	return r.withTemplates(ctx, couponModels)
+}
+
+// GetByIDs 批量获取用户的优惠券
+func (r *promotionRepository) GetByIDs(ctx context.Context, userID string, ids []string) ([]*promotion.Coupon, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	c := r.query.UserCoupon
+	couponModels, err := r.query.WithContext(ctx).UserCoupon.Where(c.ID.In(ids...), c.UserID.Eq(userID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取优惠券失败: %w", err)
+	}
+	return r.withTemplates(ctx, couponModels)
+}
+
+// withTemplates 转换优惠券并加载所属模板
+func (r *promotionRepository) withTemplates(ctx context.Context, couponModels []*model.UserCoupon) ([]*promotion.Coupon, error) {
+	coupons := make([]*promotion.Coupon, 0, len(couponModels))
+	if len(couponModels) == 0 {
+		return coupons, nil
+	}
+
+	templateIDs := make([]string, 0, len(couponModels))
+	for _, couponModel := range couponModels {
+		templateIDs = append(templateIDs, couponModel.TemplateID)
+	}
+
+	t := r.query.CouponTemplate
+	templateModels, err := r.query.WithContext(ctx).CouponTemplate.Where(t.ID.In(templateIDs...)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取优惠券模板失败: %w", err)
+	}
+	templates := make(map[string]*promotion.Template, len(templateModels))
+	for _, templateModel := range templateModels {
+		template, err := r.templateToEntity(templateModel)
+		if err != nil {
+			return nil, err
+		}
+		templates[template.ID] = template
+	}
+
+	for _, couponModel := range couponModels {
+		coupon := r.couponToEntity(couponModel)
+		coupon.Template = templates[couponModel.TemplateID]
+		if coupon.Template == nil {
+			continue
+		}
+		coupons = append(coupons, coupon)
+	}
+	return coupons, nil
+}
+
+// templateToModel 将优惠券模板转换为数据库模型
+func (r *promotionRepository) templateToModel(template *promotion.Template) (*model.CouponTemplate, error) {
+	scopeIDs := template.ScopeIDs
+	if scopeIDs == nil {
+		scopeIDs = []string{}
+	}
+	encoded, err := json.Marshal(scopeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("序列化优惠券适用范围失败: %w", err)
+	}
+
+	return &model.CouponTemplate{
+		ID:             template.ID,
+		Name:           template.Name,
+		Type:           int32(template.Type),
+		Amount:         template.Amount,
+		PercentOff:     template.PercentOff,
+		Threshold:      template.Threshold,
+		MaxDiscount:    template.MaxDiscount,
+		Repeatable:     template.Repeatable,
+		ScopeType:      int32(template.ScopeType),
+		ScopeIds:       string(encoded),
+		Stackable:      template.Stackable,
+		TotalQuantity:  template.TotalQuantity,
+		IssuedQuantity: template.IssuedQuantity,
+		PerUserLimit:   template.PerUserLimit,
+		ValidFrom:      parseCouponTime(template.ValidFrom),
+		ValidTo:        parseCouponTime(template.ValidTo),
+		ValidDays:      template.ValidDays,
+	}, nil
+}
+
+// templateToEntity 将数据库模型转换为优惠券模板
+func (r *promotionRepository) templateToEntity(templateModel *model.CouponTemplate) (*promotion.Template, error) {
+	var scopeIDs []string
+	if err := json.Unmarshal([]byte(templateModel.ScopeIds), &scopeIDs); err != nil {
+		return nil, fmt.Errorf("优惠券模板 %s 适用范围格式错误: %w", templateModel.ID, err)
+	}
+
+	return &promotion.Template{
+		ID:             templateModel.ID,
+		Name:           templateModel.Name,
+		Type:           promotion.CouponType(templateModel.Type),
+		Amount:         templateModel.Amount,
+		PercentOff:     templateModel.PercentOff,
+		Threshold:      templateModel.Threshold,
+		MaxDiscount:    templateModel.MaxDiscount,
+		Repeatable:     templateModel.Repeatable,
+		ScopeType:      promotion.ScopeType(templateModel.ScopeType),
+		ScopeIDs:       scopeIDs,
+		Stackable:      templateModel.Stackable,
+		TotalQuantity:  templateModel.TotalQuantity,
+		IssuedQuantity: templateModel.IssuedQuantity,
+		PerUserLimit:   templateModel.PerUserLimit,
+		ValidFrom:      templateModel.ValidFrom.Format("2006-01-02 15:04:05"),
+		ValidTo:        templateModel.ValidTo.Format("2006-01-02 15:04:05"),
+		ValidDays:      templateModel.ValidDays,
+		CreatedAt:      templateModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      templateModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// couponToModel 将用户优惠券转换为数据库模型
+func (r *promotionRepository) couponToModel(coupon *promotion.Coupon) *model.UserCoupon {
+	couponModel := &model.UserCoupon{
+		ID:             coupon.ID,
+		TemplateID:     coupon.TemplateID,
+		UserID:         coupon.UserID,
+		Status:         int32(coupon.Status),
+		DiscountAmount: coupon.DiscountAmount,
+		ValidFrom:      parseCouponTime(coupon.ValidFrom),
+		ValidTo:        parseCouponTime(coupon.ValidTo),
+	}
+	if coupon.OrderID != "" {
+		couponModel.OrderID = &coupon.OrderID
+	}
+	return couponModel
+}
+
+// couponToEntity 将数据库模型转换为用户优惠券，不含模板
+func (r *promotionRepository) couponToEntity(couponModel *model.UserCoupon) *promotion.Coupon {
+	coupon := &promotion.Coupon{
+		ID:             couponModel.ID,
+		TemplateID:     couponModel.TemplateID,
+		UserID:         couponModel.UserID,
+		Status:         promotion.CouponStatus(couponModel.Status),
+		DiscountAmount: couponModel.DiscountAmount,
+		ValidFrom:      couponModel.ValidFrom.Format("2006-01-02 15:04:05"),
+		ValidTo:        couponModel.ValidTo.Format("2006-01-02 15:04:05"),
+		CreatedAt:      couponModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      couponModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if couponModel.OrderID != nil {
+		coupon.OrderID = *couponModel.OrderID
+	}
+	return coupon
+}
+
+// parseCouponTime 解析优惠券的时间字段，格式已由领域实体校验
+func parseCouponTime(value string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	return t
+}
+
+// lockCoupons 在订单落库的事务中锁定订单使用的优惠券
+// 只有未过期的可用优惠券才能锁定，任一张锁定失败时返回 ErrCouponUnavailable 回滚整个订单。
+func lockCoupons(ctx context.Context, q *query.Query, orderEntity *order.Order) error {
+	c := q.UserCoupon
+	now := time.Now()
+	for _, applied := range orderEntity.Coupons {
+		result, err := q.WithContext(ctx).UserCoupon.
+			Where(
+				c.ID.Eq(applied.CouponID),
+				c.UserID.Eq(orderEntity.UserID),
+				c.Status.Eq(int32(promotion.CouponStatusAvailable)),
+				c.ValidFrom.Lte(now),
+				c.ValidTo.Gt(now),
+			).
+			UpdateSimple(
+				c.Status.Value(int32(promotion.CouponStatusLocked)),
+				c.OrderID.Value(orderEntity.ID),
+				c.DiscountAmount.Value(applied.DiscountAmount),
+				c.UpdatedAt.Value(now),
+			)
+		if err != nil {
+			return fmt.Errorf("锁定优惠券失败: %w", err)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", promotion.ErrCouponUnavailable, applied.Name)
+		}
+	}
+	return nil
+}
+
+// settleCoupons 在订单状态变更的事务中处理订单使用的优惠券：支付时核销，取消时退回
+// 没有优惠金额的订单不会使用优惠券，跳过查询。
+func settleCoupons(ctx context.Context, q *query.Query, orderEntity *order.Order) error {
+	if !orderEntity.DiscountAmount.IsPositive() {
+		return nil
+	}
+
+	c := q.UserCoupon
+	now := time.Now()
+	switch order.OrderStatus(orderEntity.Status) {
+	case order.OrderStatusPaid:
+		_, err := q.WithContext(ctx).UserCoupon.
+			Where(c.OrderID.Eq(orderEntity.ID), c.Status.Eq(int32(promotion.CouponStatusLocked))).
+			UpdateSimple(c.Status.Value(int32(promotion.CouponStatusUsed)), c.UpdatedAt.Value(now))
+		if err != nil {
+			return fmt.Errorf("核销优惠券失败: %w", err)
+		}
+	case order.OrderStatusCancelled:
+		_, err := q.WithContext(ctx).UserCoupon.
+			Where(c.OrderID.Eq(orderEntity.ID), c.Status.In(int32(promotion.CouponStatusLocked), int32(promotion.CouponStatusUsed))).
+			UpdateSimple(
+				c.Status.Value(int32(promotion.CouponStatusAvailable)),
+				c.OrderID.Null(),
+				c.DiscountAmount.Value(decimal.Zero),
+				c.UpdatedAt.Value(now),
+			)
+		if err != nil {
+			return fmt.Errorf("退回优惠券失败: %w", err)
+		}
+	}
+	return nil
+}
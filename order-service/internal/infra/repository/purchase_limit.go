@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/purchaselimit"
+)
+
+// purchaseLimitRepository 限购规则仓储实现
+type purchaseLimitRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewPurchaseLimitRepository 创建限购规则仓储
+func NewPurchaseLimitRepository(db *gorm.DB, q *query.Query) purchaselimit.Repository {
+	return &purchaseLimitRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// GetBySkuID 获取SKU的限购规则
+func (r *purchaseLimitRepository) GetBySkuID(ctx context.Context, skuID string) (*purchaselimit.Limit, error) {
+	l := r.query.OrderPurchaseLimit
+	limitModel, err := r.query.WithContext(ctx).OrderPurchaseLimit.Where(l.SkuID.Eq(skuID)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, purchaselimit.ErrLimitNotFound
+		}
+		return nil, fmt.Errorf("获取限购规则失败: %w", err)
+	}
+
+	return r.modelToEntity(limitModel), nil
+}
+
+// GetBySkuIDs 批量获取限购规则
+func (r *purchaseLimitRepository) GetBySkuIDs(ctx context.Context, skuIDs []string) (map[string]*purchaselimit.Limit, error) {
+	limits := make(map[string]*purchaselimit.Limit)
+	if len(skuIDs) == 0 {
+		return limits, nil
+	}
+
+	l := r.query.OrderPurchaseLimit
+	limitModels, err := r.query.WithContext(ctx).OrderPurchaseLimit.Where(l.SkuID.In(skuIDs...)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取限购规则失败: %w", err)
+	}
+
+	for _, limitModel := range limitModels {
+		limits[limitModel.SkuID] = r.modelToEntity(limitModel)
+	}
+	return limits, nil
+}
+
+// Save 保存限购规则，已存在时覆盖
+func (r *purchaseLimitRepository) Save(ctx context.Context, limit *purchaselimit.Limit) error {
+	limitModel := &model.OrderPurchaseLimit{
+		SkuID:       limit.SkuID,
+		ProductID:   limit.ProductID,
+		MaxPerOrder: limit.MaxPerOrder,
+		MaxPerUser:  limit.MaxPerUser,
+		WindowDays:  limit.WindowDays,
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "sku_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"product_id", "max_per_order", "max_per_user", "window_days", "updated_at"}),
+	}).Create(limitModel).Error
+	if err != nil {
+		return fmt.Errorf("保存限购规则失败: %w", err)
+	}
+
+	limit.CreatedAt = limitModel.CreatedAt.Format("2006-01-02 15:04:05")
+	limit.UpdatedAt = limitModel.UpdatedAt.Format("2006-01-02 15:04:05")
+	return nil
+}
+
+// Delete 删除限购规则
+func (r *purchaseLimitRepository) Delete(ctx context.Context, skuID string) error {
+	l := r.query.OrderPurchaseLimit
+	result, err := r.query.WithContext(ctx).OrderPurchaseLimit.Where(l.SkuID.Eq(skuID)).Delete()
+	if err != nil {
+		return fmt.Errorf("删除限购规则失败: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return purchaselimit.ErrLimitNotFound
+	}
+	return nil
+}
+
+// CountPurchased 统计用户自 since 起下单购买的各SKU数量
+// 待付款的订单也计入，避免用户通过多笔未支付订单绕过限购。
+func (r *purchaseLimitRepository) CountPurchased(ctx context.Context, userID string, skuIDs []string, since time.Time) (map[string]int32, error) {
+	purchased := make(map[string]int32)
+	if len(skuIDs) == 0 {
+		return purchased, nil
+	}
+
+	oi := r.query.OrderItem
+	o := r.query.Order
+	q := r.query.WithContext(ctx).OrderItem.
+		Join(o, o.ID.EqCol(oi.OrderID)).
+		Where(
+			o.UserID.Eq(userID),
+			o.Status.NotIn(int32(order.OrderStatusCancelled), int32(order.OrderStatusRefunded)),
+			o.DeletedAt.IsNull(),
+			oi.SkuID.In(skuIDs...),
+		)
+	if !since.IsZero() {
+		q = q.Where(o.CreatedAt.Gte(since))
+	}
+
+	var rows []struct {
+		SkuID    string
+		Quantity int64
+	}
+	if err := q.Select(oi.SkuID, oi.Quantity.Sum().As("quantity")).Group(oi.SkuID).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("统计已购买数量失败: %w", err)
+	}
+
+	for _, row := range rows {
+		purchased[row.SkuID] = int32(row.Quantity)
+	}
+	return purchased, nil
+}
+
+// modelToEntity 将数据库模型转换为领域实体
+func (r *purchaseLimitRepository) modelToEntity(limitModel *model.OrderPurchaseLimit) *purchaselimit.Limit {
+	return &purchaselimit.Limit{
+		SkuID:       limitModel.SkuID,
+		ProductID:   limitModel.ProductID,
+		MaxPerOrder: limitModel.MaxPerOrder,
+		MaxPerUser:  limitModel.MaxPerUser,
+		WindowDays:  limitModel.WindowDays,
+		CreatedAt:   limitModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   limitModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
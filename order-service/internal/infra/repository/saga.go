@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/saga"
+)
+
+// sagaRepository Saga仓储实现
+type sagaRepository struct {
+	db    *gorm.DB
+	query *query.Query
+}
+
+// NewSagaRepository 创建Saga仓储
+func NewSagaRepository(db *gorm.DB, q *query.Query) saga.Repository {
+	return &sagaRepository{
+		db:    db,
+		query: q,
+	}
+}
+
+// Create 创建Saga
+func (r *sagaRepository) Create(ctx context.Context, sagaEntity *saga.Saga) error {
+	sagaModel := r.domainToModel(sagaEntity)
+	sagaModel.Version = 1
+	if err := r.query.WithContext(ctx).OrderSaga.Create(sagaModel); err != nil {
+		return fmt.Errorf("创建Saga失败: %w", err)
+	}
+
+	sagaEntity.ID = sagaModel.ID
+	sagaEntity.Version = sagaModel.Version
+	return nil
+}
+
+// Update 更新Saga（乐观锁）
+func (r *sagaRepository) Update(ctx context.Context, sagaEntity *saga.Saga) error {
+	s := r.query.OrderSaga
+	result, err := r.query.WithContext(ctx).OrderSaga.
+		Where(s.ID.Eq(sagaEntity.ID), s.Version.Eq(sagaEntity.Version)).
+		Updates(map[string]interface{}{
+			"status":       sagaEntity.Status,
+			"current_step": string(sagaEntity.CurrentStep),
+			"last_error":   sagaEntity.LastError,
+			"retry_count":  sagaEntity.RetryCount,
+			"updated_at":   time.Now(),
+			"version":      sagaEntity.Version + 1,
+		})
+	if err != nil {
+		return fmt.Errorf("更新Saga失败: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return saga.ErrSagaConflict
+	}
+
+	sagaEntity.Version++
+	return nil
+}
+
+// GetByOrderID 根据订单ID获取Saga
+func (r *sagaRepository) GetByOrderID(ctx context.Context, orderID string) (*saga.Saga, error) {
+	sagaModel, err := r.query.WithContext(ctx).OrderSaga.Where(r.query.OrderSaga.OrderID.Eq(orderID)).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, saga.ErrSagaNotFound
+		}
+		return nil, fmt.Errorf("获取Saga失败: %w", err)
+	}
+
+	return r.modelToDomain(sagaModel), nil
+}
+
+// ListStale 获取停止推进的未结束Saga
+func (r *sagaRepository) ListStale(ctx context.Context, before time.Time, limit int) ([]*saga.Saga, error) {
+	s := r.query.OrderSaga
+	sagaModels, err := r.query.WithContext(ctx).OrderSaga.
+		Where(
+			s.Status.In(int32(saga.SagaStatusRunning), int32(saga.SagaStatusCompensating)),
+			s.UpdatedAt.Lt(before),
+		).
+		Order(s.UpdatedAt).
+		Limit(limit).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取未结束Saga失败: %w", err)
+	}
+
+	sagas := make([]*saga.Saga, 0, len(sagaModels))
+	for _, sagaModel := range sagaModels {
+		sagas = append(sagas, r.modelToDomain(sagaModel))
+	}
+
+	return sagas, nil
+}
+
+// AppendLog 追加步骤日志
+func (r *sagaRepository) AppendLog(ctx context.Context, log *saga.StepLog) error {
+	logModel := &model.OrderSagaLog{
+		SagaID:  log.SagaID,
+		Step:    string(log.Step),
+		Action:  string(log.Action),
+		Success: log.Success,
+	}
+	if log.Error != "" {
+		logModel.Error = &log.Error
+	}
+
+	if err := r.query.WithContext(ctx).OrderSagaLog.Create(logModel); err != nil {
+		return fmt.Errorf("创建Saga日志失败: %w", err)
+	}
+
+	log.ID = logModel.ID
+	return nil
+}
+
+// GetLogs 获取步骤日志
+func (r *sagaRepository) GetLogs(ctx context.Context, sagaID string) ([]*saga.StepLog, error) {
+	l := r.query.OrderSagaLog
+	logModels, err := r.query.WithContext(ctx).OrderSagaLog.Where(l.SagaID.Eq(sagaID)).Order(l.CreatedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取Saga日志失败: %w", err)
+	}
+
+	logs := make([]*saga.StepLog, 0, len(logModels))
+	for _, logModel := range logModels {
+		log := &saga.StepLog{
+			ID:        logModel.ID,
+			SagaID:    logModel.SagaID,
+			Step:      saga.Step(logModel.Step),
+			Action:    saga.Action(logModel.Action),
+			Success:   logModel.Success,
+			CreatedAt: logModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if logModel.Error != nil {
+			log.Error = *logModel.Error
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// 领域对象转换为数据模型
+func (r *sagaRepository) domainToModel(sagaEntity *saga.Saga) *model.OrderSaga {
+	sagaModel := &model.OrderSaga{
+		ID:          sagaEntity.ID,
+		OrderID:     sagaEntity.OrderID,
+		SagaType:    sagaEntity.Type,
+		Status:      sagaEntity.Status,
+		CurrentStep: string(sagaEntity.CurrentStep),
+		RetryCount:  sagaEntity.RetryCount,
+		Version:     sagaEntity.Version,
+	}
+	if sagaEntity.LastError != "" {
+		sagaModel.LastError = &sagaEntity.LastError
+	}
+	if len(sagaEntity.CartItemIDs) > 0 {
+		cartItemIDs := strings.Join(sagaEntity.CartItemIDs, ",")
+		sagaModel.CartItemIDs = &cartItemIDs
+	}
+
+	return sagaModel
+}
+
+// 数据模型转换为领域对象
+func (r *sagaRepository) modelToDomain(sagaModel *model.OrderSaga) *saga.Saga {
+	sagaEntity := &saga.Saga{
+		ID:          sagaModel.ID,
+		OrderID:     sagaModel.OrderID,
+		Type:        sagaModel.SagaType,
+		Status:      sagaModel.Status,
+		CurrentStep: saga.Step(sagaModel.CurrentStep),
+		RetryCount:  sagaModel.RetryCount,
+		CreatedAt:   sagaModel.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   sagaModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Version:     sagaModel.Version,
+	}
+	if sagaModel.LastError != nil {
+		sagaEntity.LastError = *sagaModel.LastError
+	}
+	if sagaModel.CartItemIDs != nil && *sagaModel.CartItemIDs != "" {
+		sagaEntity.CartItemIDs = strings.Split(*sagaModel.CartItemIDs, ",")
+	}
+
+	return sagaEntity
+}
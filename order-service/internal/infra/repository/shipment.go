@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/query"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// CreateShipment 创建发货包裹并更新订单
+func (r *orderRepository) CreateShipment(ctx context.Context, orderEntity *order.Order, shipment *order.Shipment, event *order.Event) error {
+	shippedAt, err := time.ParseInLocation("2006-01-02 15:04:05", shipment.ShippedAt, time.Local)
+	if err != nil {
+		return fmt.Errorf("发货时间格式错误: %w", err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := query.Use(tx)
+
+		// 1. 按乐观锁更新订单
+		if err := r.update(ctx, q, orderEntity); err != nil {
+			return err
+		}
+
+		// 2. 创建包裹
+		shipmentModel := &model.OrderShipment{
+			OrderID:        orderEntity.ID,
+			CarrierCode:    shipment.CarrierCode,
+			TrackingNumber: shipment.TrackingNumber,
+			ShippedAt:      shippedAt,
+		}
+		if shipment.OperatorID != "" {
+			shipmentModel.OperatorID = &shipment.OperatorID
+		}
+		if err := q.WithContext(ctx).OrderShipment.Create(shipmentModel); err != nil {
+			return fmt.Errorf("创建发货包裹失败: %w", err)
+		}
+
+		// 3. 创建包裹商品项
+		for _, item := range shipment.Items {
+			itemModel := &model.OrderShipmentItem{
+				ShipmentID:  shipmentModel.ID,
+				OrderID:     orderEntity.ID,
+				OrderItemID: item.OrderItemID,
+				Quantity:    item.Quantity,
+			}
+			if err := q.WithContext(ctx).OrderShipmentItem.Create(itemModel); err != nil {
+				return fmt.Errorf("创建包裹商品项失败: %w", err)
+			}
+			item.ID = itemModel.ID
+			item.ShipmentID = shipmentModel.ID
+		}
+
+		// 4. 全部发货时记录状态日志并写入发货事件
+		if orderEntity.Status == int32(order.OrderStatusShipped) {
+			statusLog := r.statusLogDomainToModel(order.NewStatusLog(orderEntity.ID, int32(order.OrderStatusPaid), orderEntity.Status, order.AdminOperator(shipment.OperatorID), "订单已全部发货"))
+			if err := q.WithContext(ctx).OrderStatusLog.Create(statusLog); err != nil {
+				return fmt.Errorf("创建状态日志失败: %w", err)
+			}
+		}
+		if event != nil {
+			if err := createEvent(tx, event); err != nil {
+				return err
+			}
+		}
+
+		orderEntity.Version++
+		shipment.ID = shipmentModel.ID
+		shipment.CreatedAt = shipmentModel.CreatedAt.Format("2006-01-02 15:04:05")
+		shipment.UpdatedAt = shipmentModel.UpdatedAt.Format("2006-01-02 15:04:05")
+		return nil
+	})
+}
+
+// GetShipments 获取订单的发货包裹
+func (r *orderRepository) GetShipments(ctx context.Context, orderID string) ([]*order.Shipment, error) {
+	s := r.query.OrderShipment
+	shipmentModels, err := r.query.WithContext(ctx).OrderShipment.Where(s.OrderID.Eq(orderID)).Order(s.ShippedAt).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取发货包裹失败: %w", err)
+	}
+
+	i := r.query.OrderShipmentItem
+	itemModels, err := r.query.WithContext(ctx).OrderShipmentItem.Where(i.OrderID.Eq(orderID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("获取包裹商品项失败: %w", err)
+	}
+
+	shipments := make([]*order.Shipment, 0, len(shipmentModels))
+	byID := make(map[string]*order.Shipment, len(shipmentModels))
+	for _, shipmentModel := range shipmentModels {
+		shipment := &order.Shipment{
+			ID:             shipmentModel.ID,
+			OrderID:        shipmentModel.OrderID,
+			CarrierCode:    shipmentModel.CarrierCode,
+			TrackingNumber: shipmentModel.TrackingNumber,
+			ShippedAt:      shipmentModel.ShippedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:      shipmentModel.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:      shipmentModel.UpdatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if shipmentModel.OperatorID != nil {
+			shipment.OperatorID = *shipmentModel.OperatorID
+		}
+		shipments = append(shipments, shipment)
+		byID[shipment.ID] = shipment
+	}
+
+	for _, itemModel := range itemModels {
+		shipment, ok := byID[itemModel.ShipmentID]
+		if !ok {
+			continue
+		}
+		shipment.Items = append(shipment.Items, &order.ShipmentItem{
+			ID:          itemModel.ID,
+			ShipmentID:  itemModel.ShipmentID,
+			OrderItemID: itemModel.OrderItemID,
+			Quantity:    itemModel.Quantity,
+		})
+	}
+
+	return shipments, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/people257/poor-guy-shop/order-service/gen/gen/model"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/aftersale"
+	"github.com/people257/poor-guy-shop/order-service/internal/domain/order"
+)
+
+// periodSum 按周期汇总的金额
+type periodSum struct {
+	Period time.Time
+	Amount decimal.Decimal
+}
+
+// CountByStatus 按状态统计订单数
+func (r *orderRepository) CountByStatus(ctx context.Context, userID string, from, to time.Time) (*order.OrderStats, error) {
+	o := r.query.Order
+	q := r.query.WithContext(ctx).Order.Where(o.CreatedAt.Gte(from), o.CreatedAt.Lt(to))
+	if userID != "" {
+		q = q.Where(o.UserID.Eq(userID))
+	}
+
+	var rows []struct {
+		Status int32
+		Count  int64
+	}
+	if err := q.Select(o.Status, o.ID.Count().As("count")).Group(o.Status).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("统计订单数失败: %w", err)
+	}
+
+	stats := &order.OrderStats{}
+	for _, row := range rows {
+		stats.TotalOrders += row.Count
+		switch order.OrderStatus(row.Status) {
+		case order.OrderStatusPendingPayment, order.OrderStatusDepositPaid:
+			// 待付尾款的预售订单同样等待买家付款
+			stats.PendingOrders += row.Count
+		case order.OrderStatusPaid:
+			stats.PaidOrders = row.Count
+		case order.OrderStatusShipped:
+			stats.ShippedOrders = row.Count
+		case order.OrderStatusDelivered:
+			stats.DeliveredOrders = row.Count
+		case order.OrderStatusCancelled:
+			stats.CancelledOrders = row.Count
+		case order.OrderStatusRefunded:
+			stats.RefundedOrders = row.Count
+		}
+	}
+
+	return stats, nil
+}
+
+// SumAmountsByPeriod 按周期汇总金额，分别按下单时间、支付时间和退款完成时间分组在数据库中聚合
+func (r *orderRepository) SumAmountsByPeriod(ctx context.Context, userID string, granularity order.StatsGranularity, from, to time.Time) ([]*order.AmountBucket, error) {
+	orders := r.db.WithContext(ctx).Model(&model.Order{})
+	gmv, err := r.sumByPeriod(orders, "actual_amount", "created_at", userID, granularity, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("汇总下单金额失败: %w", err)
+	}
+	paid, err := r.sumByPeriod(orders, "actual_amount", "payment_time", userID, granularity, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("汇总支付金额失败: %w", err)
+	}
+	refunds := r.db.WithContext(ctx).Model(&model.AfterSale{}).Where("status = ?", int32(aftersale.AfterSaleStatusRefunded))
+	refund, err := r.sumByPeriod(refunds, "approved_amount", "refunded_at", userID, granularity, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("汇总退款金额失败: %w", err)
+	}
+
+	buckets := make(map[string]*order.AmountBucket)
+	bucket := func(period time.Time) *order.AmountBucket {
+		key := period.Format("2006-01-02")
+		if buckets[key] == nil {
+			buckets[key] = &order.AmountBucket{Period: key}
+		}
+		return buckets[key]
+	}
+	for _, sum := range gmv {
+		bucket(sum.Period).GMV = sum.Amount
+	}
+	for _, sum := range paid {
+		bucket(sum.Period).PaidAmount = sum.Amount
+	}
+	for _, sum := range refund {
+		bucket(sum.Period).RefundAmount = sum.Amount
+	}
+
+	result := make([]*order.AmountBucket, 0, len(buckets))
+	for _, b := range buckets {
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// sumByPeriod 按 date_trunc(granularity, timeColumn) 分组汇总 amountColumn，列名均为代码中的常量
+func (r *orderRepository) sumByPeriod(db *gorm.DB, amountColumn, timeColumn, userID string, granularity order.StatsGranularity, from, to time.Time) ([]periodSum, error) {
+	db = db.Session(&gorm.Session{}).
+		Select(fmt.Sprintf("date_trunc(?, %s) AS period, COALESCE(SUM(%s), 0) AS amount", timeColumn, amountColumn), string(granularity)).
+		Where(timeColumn+" >= ? AND "+timeColumn+" < ?", from, to)
+	if userID != "" {
+		db = db.Where("user_id = ?", userID)
+	}
+
+	var sums []periodSum
+	if err := db.Group("period").Scan(&sums).Error; err != nil {
+		return nil, err
+	}
+	return sums, nil
+}
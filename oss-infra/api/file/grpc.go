@@ -2,11 +2,15 @@ package file
 
 import (
 	"context"
+	"errors"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	filepb "github.com/people257/poor-guy-shop/oss-infra/gen/proto/oss/file"
 	"github.com/people257/poor-guy-shop/oss-infra/internal/application/file"
+	filedomain "github.com/people257/poor-guy-shop/oss-infra/internal/domain/file"
 )
 
 // Handler OSS文件服务gRPC处理器
@@ -65,3 +69,28 @@ func (h *Handler) GetDownloadUrl(ctx context.Context, req *filepb.GetDownloadUrl
 		ExpiresIn:   result.ExpiresIn,
 	}, nil
 }
+
+// GetFileInfo 获取文件信息
+func (h *Handler) GetFileInfo(ctx context.Context, req *filepb.GetFileInfoReq) (*filepb.GetFileInfoResp, error) {
+	result, err := h.fileApp.GetFileInfo(ctx, req.FileId)
+	if err != nil {
+		if errors.Is(err, filedomain.ErrFileNotFound) {
+			return nil, status.Errorf(codes.NotFound, "文件不存在")
+		}
+		return nil, err
+	}
+
+	return &filepb.GetFileInfoResp{
+		FileInfo: &filepb.FileInfo{
+			FileId:     result.FileID,
+			Filename:   result.Filename,
+			FileKey:    result.FileKey,
+			FileSize:   result.FileSize,
+			MimeType:   result.MimeType,
+			Category:   result.Category,
+			OwnerId:    result.OwnerID,
+			Visibility: result.Visibility,
+			CreatedAt:  timestamppb.New(result.CreatedAt),
+		},
+	}, nil
+}
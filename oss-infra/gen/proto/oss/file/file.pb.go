@@ -361,6 +361,95 @@ func (x *GetDownloadUrlResp) GetExpiresIn() int32 {
 	return 0
 }
 
+// 获取文件信息请求
+type GetFileInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileInfoReq) Reset() {
+	*x = GetFileInfoReq{}
+	mi := &file_oss_file_file_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileInfoReq) ProtoMessage() {}
+
+func (x *GetFileInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_oss_file_file_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileInfoReq.ProtoReflect.Descriptor instead.
+func (*GetFileInfoReq) Descriptor() ([]byte, []int) {
+	return file_oss_file_file_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFileInfoReq) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type GetFileInfoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileInfo      *FileInfo              `protobuf:"bytes,1,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileInfoResp) Reset() {
+	*x = GetFileInfoResp{}
+	mi := &file_oss_file_file_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileInfoResp) ProtoMessage() {}
+
+func (x *GetFileInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_oss_file_file_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileInfoResp.ProtoReflect.Descriptor instead.
+func (*GetFileInfoResp) Descriptor() ([]byte, []int) {
+	return file_oss_file_file_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFileInfoResp) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
 var File_oss_file_file_proto protoreflect.FileDescriptor
 
 const file_oss_file_file_proto_rawDesc = "" +
@@ -397,11 +486,16 @@ const file_oss_file_file_proto_rawDesc = "" +
 	"\x12GetDownloadUrlResp\x12!\n" +
 	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x02 \x01(\x05R\texpiresIn2\xf9\x01\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"3\n" +
+	"\x0eGetFileInfoReq\x12!\n" +
+	"\afile_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06fileId\"F\n" +
+	"\x0fGetFileInfoResp\x123\n" +
+	"\tfile_info\x18\x01 \x01(\v2\x16.ces.oss.file.FileInfoR\bfileInfo2\xc5\x02\n" +
 	"\vFileService\x12g\n" +
 	"\n" +
 	"UploadFile\x12\x1b.ces.oss.file.UploadFileReq\x1a\x1c.ces.oss.file.UploadFileResp\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/oss/file/upload\x12\x80\x01\n" +
-	"\x0eGetDownloadUrl\x12\x1f.ces.oss.file.GetDownloadUrlReq\x1a .ces.oss.file.GetDownloadUrlResp\"+\x82\xd3\xe4\x93\x02%\x12#/v1/oss/file/{file_id}/download-urlB\tZ\a/filepbb\x06proto3"
+	"\x0eGetDownloadUrl\x12\x1f.ces.oss.file.GetDownloadUrlReq\x1a .ces.oss.file.GetDownloadUrlResp\"+\x82\xd3\xe4\x93\x02%\x12#/v1/oss/file/{file_id}/download-url\x12J\n" +
+	"\vGetFileInfo\x12\x1c.ces.oss.file.GetFileInfoReq\x1a\x1d.ces.oss.file.GetFileInfoRespB\tZ\a/filepbb\x06proto3"
 
 var (
 	file_oss_file_file_proto_rawDescOnce sync.Once
@@ -415,27 +509,32 @@ func file_oss_file_file_proto_rawDescGZIP() []byte {
 	return file_oss_file_file_proto_rawDescData
 }
 
-var file_oss_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_oss_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_oss_file_file_proto_goTypes = []any{
 	(*FileInfo)(nil),              // 0: ces.oss.file.FileInfo
 	(*UploadFileReq)(nil),         // 1: ces.oss.file.UploadFileReq
 	(*UploadFileResp)(nil),        // 2: ces.oss.file.UploadFileResp
 	(*GetDownloadUrlReq)(nil),     // 3: ces.oss.file.GetDownloadUrlReq
 	(*GetDownloadUrlResp)(nil),    // 4: ces.oss.file.GetDownloadUrlResp
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*GetFileInfoReq)(nil),        // 5: ces.oss.file.GetFileInfoReq
+	(*GetFileInfoResp)(nil),       // 6: ces.oss.file.GetFileInfoResp
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_oss_file_file_proto_depIdxs = []int32{
-	5, // 0: ces.oss.file.FileInfo.created_at:type_name -> google.protobuf.Timestamp
+	7, // 0: ces.oss.file.FileInfo.created_at:type_name -> google.protobuf.Timestamp
 	0, // 1: ces.oss.file.UploadFileResp.file_info:type_name -> ces.oss.file.FileInfo
-	1, // 2: ces.oss.file.FileService.UploadFile:input_type -> ces.oss.file.UploadFileReq
-	3, // 3: ces.oss.file.FileService.GetDownloadUrl:input_type -> ces.oss.file.GetDownloadUrlReq
-	2, // 4: ces.oss.file.FileService.UploadFile:output_type -> ces.oss.file.UploadFileResp
-	4, // 5: ces.oss.file.FileService.GetDownloadUrl:output_type -> ces.oss.file.GetDownloadUrlResp
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 2: ces.oss.file.GetFileInfoResp.file_info:type_name -> ces.oss.file.FileInfo
+	1, // 3: ces.oss.file.FileService.UploadFile:input_type -> ces.oss.file.UploadFileReq
+	3, // 4: ces.oss.file.FileService.GetDownloadUrl:input_type -> ces.oss.file.GetDownloadUrlReq
+	5, // 5: ces.oss.file.FileService.GetFileInfo:input_type -> ces.oss.file.GetFileInfoReq
+	2, // 6: ces.oss.file.FileService.UploadFile:output_type -> ces.oss.file.UploadFileResp
+	4, // 7: ces.oss.file.FileService.GetDownloadUrl:output_type -> ces.oss.file.GetDownloadUrlResp
+	6, // 8: ces.oss.file.FileService.GetFileInfo:output_type -> ces.oss.file.GetFileInfoResp
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_oss_file_file_proto_init() }
@@ -449,7 +548,7 @@ func file_oss_file_file_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oss_file_file_proto_rawDesc), len(file_oss_file_file_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
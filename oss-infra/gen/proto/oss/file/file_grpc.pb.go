@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FileService_UploadFile_FullMethodName     = "/ces.oss.file.FileService/UploadFile"
 	FileService_GetDownloadUrl_FullMethodName = "/ces.oss.file.FileService/GetDownloadUrl"
+	FileService_GetFileInfo_FullMethodName    = "/ces.oss.file.FileService/GetFileInfo"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -31,6 +32,8 @@ type FileServiceClient interface {
 	UploadFile(ctx context.Context, in *UploadFileReq, opts ...grpc.CallOption) (*UploadFileResp, error)
 	// 获取文件下载URL
 	GetDownloadUrl(ctx context.Context, in *GetDownloadUrlReq, opts ...grpc.CallOption) (*GetDownloadUrlResp, error)
+	// 获取文件信息，供业务服务校验引用的文件是否存在及归属，不对外暴露HTTP接口
+	GetFileInfo(ctx context.Context, in *GetFileInfoReq, opts ...grpc.CallOption) (*GetFileInfoResp, error)
 }
 
 type fileServiceClient struct {
@@ -61,6 +64,16 @@ func (c *fileServiceClient) GetDownloadUrl(ctx context.Context, in *GetDownloadU
 	return out, nil
 }
 
+func (c *fileServiceClient) GetFileInfo(ctx context.Context, in *GetFileInfoReq, opts ...grpc.CallOption) (*GetFileInfoResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileInfoResp)
+	err := c.cc.Invoke(ctx, FileService_GetFileInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations should embed UnimplementedFileServiceServer
 // for forward compatibility.
@@ -69,6 +82,8 @@ type FileServiceServer interface {
 	UploadFile(context.Context, *UploadFileReq) (*UploadFileResp, error)
 	// 获取文件下载URL
 	GetDownloadUrl(context.Context, *GetDownloadUrlReq) (*GetDownloadUrlResp, error)
+	// 获取文件信息，供业务服务校验引用的文件是否存在及归属，不对外暴露HTTP接口
+	GetFileInfo(context.Context, *GetFileInfoReq) (*GetFileInfoResp, error)
 }
 
 // UnimplementedFileServiceServer should be embedded to have
@@ -84,6 +99,9 @@ func (UnimplementedFileServiceServer) UploadFile(context.Context, *UploadFileReq
 func (UnimplementedFileServiceServer) GetDownloadUrl(context.Context, *GetDownloadUrlReq) (*GetDownloadUrlResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadUrl not implemented")
 }
+func (UnimplementedFileServiceServer) GetFileInfo(context.Context, *GetFileInfoReq) (*GetFileInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileInfo not implemented")
+}
 func (UnimplementedFileServiceServer) testEmbeddedByValue() {}
 
 // UnsafeFileServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -140,6 +158,24 @@ func _FileService_GetDownloadUrl_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_GetFileInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetFileInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetFileInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetFileInfo(ctx, req.(*GetFileInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -155,6 +191,10 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDownloadUrl",
 			Handler:    _FileService_GetDownloadUrl_Handler,
 		},
+		{
+			MethodName: "GetFileInfo",
+			Handler:    _FileService_GetFileInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oss/file/file.proto",
@@ -224,6 +224,29 @@ func (s *Service) GetDownloadURL(ctx context.Context, req *GetDownloadURLReq) (*
 	}, nil
 }
 
+// GetFileInfo 获取文件信息，已删除的文件视为不存在
+func (s *Service) GetFileInfo(ctx context.Context, fileID string) (*FileInfoDTO, error) {
+	fileEntity, err := s.fileRepo.GetByID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if !fileEntity.IsActive() {
+		return nil, file.ErrFileNotFound
+	}
+
+	return &FileInfoDTO{
+		FileID:     fileEntity.ID,
+		Filename:   fileEntity.Filename,
+		FileKey:    fileEntity.FileKey,
+		FileSize:   fileEntity.FileSize,
+		MimeType:   fileEntity.MimeType,
+		Category:   fileEntity.Category,
+		OwnerID:    fileEntity.OwnerID,
+		Visibility: fileEntity.Visibility,
+		CreatedAt:  fileEntity.CreatedAt,
+	}, nil
+}
+
 // generateFileKey 生成文件存储键
 func (s *Service) generateFileKey(userID, fileID, filename string) string {
 	ext := filepath.Ext(filename)
@@ -2,6 +2,7 @@ package product
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/people257/poor-guy-shop/common/auth"
@@ -198,6 +199,9 @@ func (s *ProductServer) DeleteProduct(ctx context.Context, req *productpb.Delete
 func (s *ProductServer) GetProduct(ctx context.Context, req *productpb.GetProductReq) (*productpb.GetProductResp, error) {
 	result, err := s.productService.GetProduct(ctx, req.Id)
 	if err != nil {
+		if errors.Is(err, productdomain.ErrProductNotFound) {
+			return nil, status.Errorf(codes.NotFound, "商品不存在")
+		}
 		return nil, status.Errorf(codes.Internal, "获取商品失败: %v", err)
 	}
 
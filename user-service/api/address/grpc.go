@@ -2,8 +2,11 @@ package address
 
 import (
 	"context"
+	"errors"
 
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	addresspb "github.com/people257/poor-guy-shop/user-service/gen/proto/user/address"
@@ -171,6 +174,12 @@ func (s *AddressServer) GetAddress(ctx context.Context, req *addresspb.GetAddres
 	// 获取地址详情
 	addr, err := s.addressService.GetAddress(ctx, userID, req.AddressId)
 	if err != nil {
+		switch {
+		case errors.Is(err, address.ErrAddressNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, address.ErrAddressForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, err
 	}
 
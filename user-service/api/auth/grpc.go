@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	commonauth "github.com/people257/poor-guy-shop/common/auth"
 	authpb "github.com/people257/poor-guy-shop/user-service/gen/proto/user/auth"
 	"github.com/people257/poor-guy-shop/user-service/internal/application/auth"
 	infraAuth "github.com/people257/poor-guy-shop/user-service/internal/infra/auth"
@@ -38,8 +39,9 @@ func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginReq) (*authpb.L
 
 	// 调用应用服务
 	loginReq := &auth.LoginRequest{
-		Account:  req.Account,
-		Password: req.Password,
+		Account:   req.Account,
+		Password:  req.Password,
+		CartToken: commonauth.CartTokenFromContext(ctx),
 	}
 
 	resp, err := s.authService.Login(ctx, loginReq)
@@ -101,8 +103,9 @@ func (s *AuthServer) OTPLogin(ctx context.Context, req *authpb.OTPLoginReq) (*au
 
 	// 调用应用服务
 	otpReq := &auth.OTPLoginRequest{
-		Account: req.Account,
-		Captcha: req.Captcha,
+		Account:   req.Account,
+		Captcha:   req.Captcha,
+		CartToken: commonauth.CartTokenFromContext(ctx),
 	}
 
 	resp, err := s.authService.OTPLogin(ctx, otpReq)
@@ -38,6 +38,7 @@ func NewApplication(
 
 	e := gw.Echo
 	e.Use(auth.BuildMetadataMiddleware(authClient))
+	e.Use(auth.BuildCartTokenMiddleware())
 
 	return &Application{
 		Gateway: gw,
@@ -24,6 +24,7 @@ import (
 	"github.com/people257/poor-guy-shop/user-service/internal/infra"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/auth"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/captcha"
+	"github.com/people257/poor-guy-shop/user-service/internal/infra/cart"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/email"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/repository"
 )
@@ -54,7 +55,8 @@ func InitializeApplication(ctx context.Context, configPath2 string) (*Applicatio
 	captchaConfig := ProvideInternalCaptchaConfig(configConfig)
 	captchaService := captcha.NewEmailCaptchaService(emailService, client, captchaConfig)
 	authDomainService := auth2.NewDomainService(service, captchaService, refreshTokenRepository)
-	authService := auth3.NewService(domainService, authDomainService, userRepository)
+	cartMerger := cart.NewCartMerger(universalClient)
+	authService := auth3.NewService(domainService, authDomainService, userRepository, cartMerger)
 	authServer := auth4.NewAuthServer(authService, authAuth)
 	infoService := info.NewService(userRepository)
 	infoServer := info2.NewInfoServer(infoService, authAuth)
@@ -147,3 +147,5 @@ require (
 )
 
 replace github.com/people257/poor-guy-shop/common/server => ../common/server
+
+replace github.com/people257/poor-guy-shop/common/auth => ../common/auth
@@ -9,6 +9,13 @@ import (
 	"github.com/people257/poor-guy-shop/user-service/internal/domain/address"
 )
 
+var (
+	// ErrAddressNotFound 地址不存在
+	ErrAddressNotFound = errors.New("地址不存在")
+	// ErrAddressForbidden 地址不属于当前用户
+	ErrAddressForbidden = errors.New("无权限访问此地址")
+)
+
 // Service 地址应用服务
 type Service struct {
 	addressDomain *address.DomainService
@@ -194,12 +201,12 @@ func (s *Service) GetAddress(ctx context.Context, userID, addressID string) (*Ad
 		return nil, err
 	}
 	if addr == nil {
-		return nil, errors.New("地址不存在")
+		return nil, ErrAddressNotFound
 	}
 
 	// 检查权限
 	if addr.UserID != userID {
-		return nil, errors.New("无权限访问此地址")
+		return nil, ErrAddressForbidden
 	}
 
 	return s.toDTO(addr), nil
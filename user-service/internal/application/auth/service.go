@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"go.uber.org/zap"
+
 	"github.com/people257/poor-guy-shop/user-service/internal/domain/auth"
 	"github.com/people257/poor-guy-shop/user-service/internal/domain/user"
 )
@@ -13,6 +15,7 @@ type Service struct {
 	userDomainService *user.DomainService
 	authDomainService *auth.DomainService
 	userRepo          user.Repository
+	cartMerger        auth.CartMerger
 }
 
 // NewService 创建认证应用服务
@@ -20,18 +23,21 @@ func NewService(
 	userDomainService *user.DomainService,
 	authDomainService *auth.DomainService,
 	userRepo user.Repository,
+	cartMerger auth.CartMerger,
 ) *Service {
 	return &Service{
 		userDomainService: userDomainService,
 		authDomainService: authDomainService,
 		userRepo:          userRepo,
+		cartMerger:        cartMerger,
 	}
 }
 
 // LoginRequest 登录请求
 type LoginRequest struct {
-	Account  string
-	Password string
+	Account   string
+	Password  string
+	CartToken string // 游客购物车令牌，登录后合并到用户购物车
 }
 
 // LoginResponse 登录响应
@@ -56,6 +62,9 @@ func (s *Service) Login(ctx context.Context, req *LoginRequest) (*LoginResponse,
 		return nil, err
 	}
 
+	// 3. 合并游客购物车
+	s.mergeGuestCart(ctx, authenticatedUser.ID, req.CartToken)
+
 	return &LoginResponse{
 		UserID:       authenticatedUser.ID,
 		AccessToken:  tokens.AccessToken,
@@ -114,8 +123,9 @@ func (s *Service) EmailRegister(ctx context.Context, req *EmailRegisterRequest)
 
 // OTPLoginRequest OTP登录请求
 type OTPLoginRequest struct {
-	Account string
-	Captcha string
+	Account   string
+	Captcha   string
+	CartToken string // 游客购物车令牌，登录后合并到用户购物车
 }
 
 // OTPLogin OTP登录（应用服务编排）
@@ -140,6 +150,9 @@ func (s *Service) OTPLogin(ctx context.Context, req *OTPLoginRequest) (*LoginRes
 		return nil, err
 	}
 
+	// 4. 合并游客购物车
+	s.mergeGuestCart(ctx, u.ID, req.CartToken)
+
 	return &LoginResponse{
 		UserID:       u.ID,
 		AccessToken:  tokens.AccessToken,
@@ -290,3 +303,14 @@ func (s *Service) SendEmailOTP(ctx context.Context, req *SendEmailOTPRequest) er
 	// 3. 发送验证码（委托给认证领域服务）
 	return s.authDomainService.SendEmailVerificationCode(ctx, req.Email, req.Purpose)
 }
+
+// mergeGuestCart 合并游客购物车
+// 提交合并请求失败不影响登录，游客购物车保留在原处，下次登录时再次合并。
+func (s *Service) mergeGuestCart(ctx context.Context, userID, cartToken string) {
+	if cartToken == "" || s.cartMerger == nil {
+		return
+	}
+	if err := s.cartMerger.MergeGuestCart(ctx, userID, cartToken); err != nil {
+		zap.L().Warn("merge guest cart failed", zap.Error(err), zap.String("user_id", userID))
+	}
+}
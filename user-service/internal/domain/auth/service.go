@@ -62,3 +62,9 @@ type CaptchaService interface {
 	// VerifyEmailOTP 验证邮箱验证码
 	VerifyEmailOTP(ctx context.Context, email, otp, purpose string) error
 }
+
+// CartMerger 购物车合并接口，登录时将游客购物车合并到用户购物车
+type CartMerger interface {
+	// MergeGuestCart 提交合并请求，由订单服务将游客购物车令牌对应的购物车合并到用户购物车
+	MergeGuestCart(ctx context.Context, userID, cartToken string) error
+}
@@ -0,0 +1,37 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	commonauth "github.com/people257/poor-guy-shop/common/auth"
+	"github.com/people257/poor-guy-shop/user-service/internal/domain/auth"
+)
+
+var _ auth.CartMerger = (*Merger)(nil)
+
+// Merger 通过订单服务的合并队列合并游客购物车
+// 用户服务不调用订单服务接口，登录时只把合并请求写入 Redis 队列，由订单服务按请求中的用户ID合并。
+type Merger struct {
+	client redis.UniversalClient
+}
+
+// NewCartMerger 创建购物车合并队列
+func NewCartMerger(client redis.UniversalClient) auth.CartMerger {
+	return &Merger{client: client}
+}
+
+// MergeGuestCart 提交游客购物车合并请求
+func (m *Merger) MergeGuestCart(ctx context.Context, userID, cartToken string) error {
+	data, err := json.Marshal(&commonauth.CartMergeRequest{UserID: userID, CartToken: cartToken})
+	if err != nil {
+		return err
+	}
+	if err := m.client.RPush(ctx, commonauth.CartMergeQueueKey, data).Err(); err != nil {
+		return fmt.Errorf("enqueue guest cart merge: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/wire"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/auth"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/captcha"
+	"github.com/people257/poor-guy-shop/user-service/internal/infra/cart"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/email"
 	"github.com/people257/poor-guy-shop/user-service/internal/infra/repository"
 )
@@ -17,6 +18,7 @@ var InfraProviderSet = wire.NewSet(
 	auth.NewAuth,
 	email.NewSMTPService,
 	captcha.NewEmailCaptchaService,
+	cart.NewCartMerger,
 	// 配置提供者
 	ProvideAuthInfraConfig,
 )